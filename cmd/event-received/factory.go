@@ -22,6 +22,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/scheduled"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/search"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/secrets"
@@ -83,7 +84,9 @@ type Factory struct {
 	lpaStoreBaseURL             string
 	lpaStoreSecretARN           string
 	uidBaseURL                  string
+	useLocalUIDIssuer           bool
 	notifyBaseURL               string
+	payBaseURL                  string
 	eventBusName                string
 	searchEndpoint              string
 	searchIndexName             string
@@ -99,6 +102,7 @@ type Factory struct {
 	lambdaClient             LambdaClient
 	lpaStoreClient           LpaStoreClient
 	notifyClient             NotifyClient
+	payClient                PayClient
 	secretsClient            SecretsClient
 	accessCodeSender         AccessCodeSender
 	scheduledStore           ScheduledStore
@@ -174,7 +178,7 @@ func (f *Factory) AccessCodeSender(ctx context.Context) (AccessCodeSender, error
 		}
 
 		f.accessCodeSender = accesscode.NewSender(
-			accesscode.NewStore(f.dynamoClient),
+			accesscode.NewStore(f.dynamoClient, accesscode.DefaultExpiry),
 			notifyClient,
 			f.appPublicURL,
 			f.certificateProviderStartURL,
@@ -216,7 +220,11 @@ func (f *Factory) UidStore() (UidStore, error) {
 
 func (f *Factory) UidClient() UidClient {
 	if f.uidClient == nil {
-		f.uidClient = uid.New(f.uidBaseURL, f.LambdaClient())
+		if f.useLocalUIDIssuer {
+			f.uidClient = uid.NewLocalIssuer()
+		} else {
+			f.uidClient = uid.New(f.uidBaseURL, f.LambdaClient())
+		}
 	}
 
 	return f.uidClient
@@ -255,7 +263,7 @@ func (f *Factory) NotifyClient(ctx context.Context) (NotifyClient, error) {
 			return nil, fmt.Errorf("failed to get notify API secret: %w", err)
 		}
 
-		notifyClient, err := notify.New(f.logger, f.notifyBaseURL, notifyApiKey, f.httpClient, f.EventClient(), bundle)
+		notifyClient, err := notify.New(f.logger, f.notifyBaseURL, notifyApiKey, f.httpClient, f.EventClient(), bundle, notify.NewStore(f.dynamoClient))
 		if err != nil {
 			return nil, err
 		}
@@ -266,6 +274,24 @@ func (f *Factory) NotifyClient(ctx context.Context) (NotifyClient, error) {
 	return f.notifyClient, nil
 }
 
+func (f *Factory) PayClient(ctx context.Context) (PayClient, error) {
+	if f.payClient == nil {
+		secretsClient, err := f.SecretsClient()
+		if err != nil {
+			return nil, err
+		}
+
+		payApiKey, err := secretsClient.Secret(ctx, secrets.GovUkPay)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pay API secret: %w", err)
+		}
+
+		f.payClient = pay.New(f.logger, f.httpClient, f.payBaseURL, payApiKey)
+	}
+
+	return f.payClient, nil
+}
+
 func (f *Factory) CertificateProviderStore() CertificateProviderStore {
 	if f.certificateProviderStore == nil {
 		f.certificateProviderStore = certificateprovider.NewStore(f.dynamoClient)
@@ -8,6 +8,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/secrets"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/uid"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -145,6 +146,47 @@ func TestFactoryAccessCodeSenderWhenNotifyClientError(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestFactoryPayClient(t *testing.T) {
+	ctx := context.Background()
+
+	secretsClient := newMockSecretsClient(t)
+	secretsClient.EXPECT().
+		Secret(ctx, secrets.GovUkPay).
+		Return("a-key", nil)
+
+	factory := &Factory{secretsClient: secretsClient}
+
+	client, err := factory.PayClient(ctx)
+	assert.Nil(t, err)
+	assert.NotNil(t, client)
+}
+
+func TestFactoryPayClientWhenSet(t *testing.T) {
+	ctx := context.Background()
+
+	expected := newMockPayClient(t)
+
+	factory := &Factory{payClient: expected}
+
+	client, err := factory.PayClient(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, client)
+}
+
+func TestFactoryPayClientWhenSecretsClientError(t *testing.T) {
+	ctx := context.Background()
+
+	secretsClient := newMockSecretsClient(t)
+	secretsClient.EXPECT().
+		Secret(ctx, secrets.GovUkPay).
+		Return("", expectedError)
+
+	factory := &Factory{secretsClient: secretsClient}
+
+	_, err := factory.PayClient(ctx)
+	assert.ErrorIs(t, err, expectedError)
+}
+
 func TestFactoryLpaStoreClient(t *testing.T) {
 	secretsClient := newMockSecretsClient(t)
 
@@ -190,6 +232,13 @@ func TestFactoryUidClient(t *testing.T) {
 	assert.NotNil(t, client)
 }
 
+func TestFactoryUidClientWhenLocal(t *testing.T) {
+	factory := &Factory{useLocalUIDIssuer: true}
+
+	client := factory.UidClient()
+	assert.IsType(t, &uid.LocalIssuer{}, client)
+}
+
 func TestFactoryUidClientWhenSet(t *testing.T) {
 	expected := newMockUidClient(t)
 	factory := &Factory{uidClient: expected}
@@ -2,12 +2,14 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-lambda-go/events"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/uid"
 )
 
 func handleObjectTagsAdded(ctx context.Context, dynamodbClient dynamodbClient, event *events.S3Event, s3Client s3Client, documentStore DocumentStore) error {
@@ -51,6 +53,21 @@ func handleObjectTagsAdded(ctx context.Context, dynamodbClient dynamodbClient, e
 	return nil
 }
 
+// validateEventUID checks the uid of an event, if it has one, before it is used
+// to find an LPA.
+func validateEventUID(event *events.CloudWatchEvent) error {
+	var v uidEvent
+	if err := json.Unmarshal(event.Detail, &v); err != nil || v.UID == "" {
+		return nil
+	}
+
+	if !uid.Valid(v.UID) {
+		return fmt.Errorf("%w: %q", uid.ErrInvalidUID, v.UID)
+	}
+
+	return nil
+}
+
 func putDonor(ctx context.Context, donor *donordata.Provided, now func() time.Time, client dynamodbClient) error {
 	donor.UpdatedAt = now()
 	if err := donor.UpdateHash(); err != nil {
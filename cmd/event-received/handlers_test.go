@@ -13,6 +13,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/uid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -201,6 +202,30 @@ func TestGetDonorByLpaUIDWhenClientOneByUidError(t *testing.T) {
 	assert.Equal(t, fmt.Errorf("failed to resolve uid: %w", expectedError), err)
 }
 
+func TestValidateEventUID(t *testing.T) {
+	testcases := map[string]string{
+		"valid":    `{"uid":"M-3444-7777-999R"}`,
+		"no uid":   `{"lpaID":"123"}`,
+		"not json": `<xml/>`,
+	}
+
+	for name, detail := range testcases {
+		t.Run(name, func(t *testing.T) {
+			err := validateEventUID(&events.CloudWatchEvent{Detail: json.RawMessage(detail)})
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestValidateEventUIDWhenInvalid(t *testing.T) {
+	for _, detail := range []string{`{"uid":"M-3444-7777-999Q"}`, `{"uid":"m-3444-7777-999r"}`, `{"uid":"123"}`} {
+		t.Run(detail, func(t *testing.T) {
+			err := validateEventUID(&events.CloudWatchEvent{Detail: json.RawMessage(detail)})
+			assert.ErrorIs(t, err, uid.ErrInvalidUID)
+		})
+	}
+}
+
 func TestGetDonorByLpaUIDWhenPKMissing(t *testing.T) {
 	client := newMockDynamodbClient(t)
 	client.EXPECT().
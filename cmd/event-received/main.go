@@ -47,8 +47,10 @@ var (
 	attorneyStartURL            = os.Getenv("ATTORNEY_START_URL")
 	awsBaseURL                  = os.Getenv("AWS_BASE_URL")
 	notifyBaseURL               = os.Getenv("GOVUK_NOTIFY_BASE_URL")
+	payBaseURL                  = os.Getenv("GOVUK_PAY_BASE_URL")
 	evidenceBucketName          = os.Getenv("UPLOADS_S3_BUCKET_NAME")
 	uidBaseURL                  = os.Getenv("UID_BASE_URL")
+	useLocalUIDIssuer           = os.Getenv("USE_LOCAL_UID_ISSUER") == "1"
 	lpaStoreBaseURL             = os.Getenv("LPA_STORE_BASE_URL")
 	lpaStoreSecretARN           = os.Getenv("LPA_STORE_SECRET_ARN")
 	eventBusName                = os.Getenv("EVENT_BUS_NAME")
@@ -58,6 +60,7 @@ var (
 	xrayEnabled                 = os.Getenv("XRAY_ENABLED") == "1"
 	kmsKeyAlias                 = os.Getenv("S3_UPLOADS_KMS_KEY_ALIAS")
 	environment                 = os.Getenv("ENVIRONMENT")
+	feeSchedulesJSON            = os.Getenv("FEE_SCHEDULES")
 
 	cfg        aws.Config
 	httpClient *http.Client
@@ -75,6 +78,7 @@ type factory interface {
 	LpaStoreClient() (LpaStoreClient, error)
 	NotifyClient(ctx context.Context) (NotifyClient, error)
 	Now() func() time.Time
+	PayClient(ctx context.Context) (PayClient, error)
 	ScheduledStore() ScheduledStore
 	AccessCodeSender(ctx context.Context) (AccessCodeSender, error)
 	UidClient() UidClient
@@ -145,6 +149,10 @@ type NotifyClient interface {
 	SendActorSMS(context context.Context, to notify.ToMobile, lpaUID string, sms notify.SMS) error
 }
 
+type PayClient interface {
+	CreateRefund(ctx context.Context, paymentID string, body pay.CreateRefundBody) (pay.RefundResponse, error)
+}
+
 type Bundle interface {
 	For(lang localize.Lang) localize.Localizer
 }
@@ -208,7 +216,9 @@ func handler(ctx context.Context, event Event) (map[string]any, error) {
 		lpaStoreBaseURL:             lpaStoreBaseURL,
 		lpaStoreSecretARN:           lpaStoreSecretARN,
 		uidBaseURL:                  uidBaseURL,
+		useLocalUIDIssuer:           useLocalUIDIssuer,
 		notifyBaseURL:               notifyBaseURL,
+		payBaseURL:                  payBaseURL,
 		eventBusName:                eventBusName,
 		searchEndpoint:              searchEndpoint,
 		searchIndexName:             searchIndexName,
@@ -258,6 +268,9 @@ func handleCloudWatchEvent(ctx context.Context, factory *Factory, event *events.
 	}
 
 	logger.InfoContext(ctx, "handling event", slog.String("source", event.Source), slog.String("detailType", event.DetailType))
+	if err := validateEventUID(event); err != nil {
+		return fmt.Errorf("%s: %w", event.DetailType, err)
+	}
 	if err := handler.Handle(ctx, factory, event); err != nil {
 		return fmt.Errorf("%s: %w", event.DetailType, err)
 	}
@@ -302,6 +315,13 @@ func main() {
 		cfg.BaseEndpoint = aws.String(awsBaseURL)
 	}
 
+	feeSchedules, err := pay.ParseFeeSchedules([]byte(feeSchedulesJSON))
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to parse fee schedules", slog.Any("err", err))
+		return
+	}
+	pay.SetFeeSchedules(feeSchedules)
+
 	var tp *trace.TracerProvider
 	if xrayEnabled {
 		tp, err = telemetry.SetupLambda(ctx, &cfg.APIOptions)
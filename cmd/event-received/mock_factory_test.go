@@ -588,6 +588,64 @@ func (_c *mockFactory_Now_Call) RunAndReturn(run func() func() time.Time) *mockF
 	return _c
 }
 
+// PayClient provides a mock function with given fields: ctx
+func (_m *mockFactory) PayClient(ctx context.Context) (PayClient, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PayClient")
+	}
+
+	var r0 PayClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (PayClient, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) PayClient); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(PayClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockFactory_PayClient_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PayClient'
+type mockFactory_PayClient_Call struct {
+	*mock.Call
+}
+
+// PayClient is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *mockFactory_Expecter) PayClient(ctx interface{}) *mockFactory_PayClient_Call {
+	return &mockFactory_PayClient_Call{Call: _e.mock.On("PayClient", ctx)}
+}
+
+func (_c *mockFactory_PayClient_Call) Run(run func(ctx context.Context)) *mockFactory_PayClient_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *mockFactory_PayClient_Call) Return(_a0 PayClient, _a1 error) *mockFactory_PayClient_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockFactory_PayClient_Call) RunAndReturn(run func(context.Context) (PayClient, error)) *mockFactory_PayClient_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ScheduledStore provides a mock function with no fields
 func (_m *mockFactory) ScheduledStore() ScheduledStore {
 	ret := _m.Called()
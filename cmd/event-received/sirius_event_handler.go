@@ -45,7 +45,12 @@ func (h *siriusEventHandler) Handle(ctx context.Context, factory factory, cloudW
 			return err
 		}
 
-		return handleFeeApproved(ctx, factory.DynamoClient(), cloudWatchEvent, accessCodeSender, factory.EventClient(), appData, factory.Now(), notifyClient)
+		payClient, err := factory.PayClient(ctx)
+		if err != nil {
+			return err
+		}
+
+		return handleFeeApproved(ctx, factory.DynamoClient(), cloudWatchEvent, accessCodeSender, factory.EventClient(), appData, factory.Now(), notifyClient, payClient)
 
 	case "reduced-fee-declined":
 		return handleFeeDenied(ctx, factory.DynamoClient(), cloudWatchEvent, factory.Now())
@@ -146,6 +151,7 @@ func handleFeeApproved(
 	appData appcontext.Data,
 	now func() time.Time,
 	notifyClient NotifyClient,
+	payClient PayClient,
 ) error {
 	var v feeApprovedEvent
 	if err := json.Unmarshal(e.Detail, &v); err != nil {
@@ -157,13 +163,30 @@ func handleFeeApproved(
 		return fmt.Errorf("failed to get donor: %w", err)
 	}
 
-	if donor.Tasks.PayForLpa.IsCompleted() || donor.Tasks.PayForLpa.IsApproved() {
+	if donor.Tasks.PayForLpa.IsApproved() {
 		return nil
 	}
 
+	alreadyPaid := donor.Tasks.PayForLpa.IsCompleted()
 	donor.FeeType = v.ApprovedType
 
-	if donor.FeeAmount() <= 0 {
+	if alreadyPaid && donor.FeeAmount() >= 0 {
+		return nil
+	}
+
+	if donor.FeeAmount() < 0 {
+		if err := refundOverpayment(ctx, payClient, donor); err != nil {
+			// Save any refunds that were made so they are not repeated when the
+			// event is retried.
+			return errors.Join(err, putDonor(ctx, donor, now, client))
+		}
+	}
+
+	switch {
+	case alreadyPaid:
+		// only the refund was needed
+
+	case donor.FeeAmount() <= 0:
 		donor.Tasks.PayForLpa = task.PaymentStateCompleted
 
 		if donor.Tasks.SignTheLpa.IsCompleted() {
@@ -194,7 +217,8 @@ func handleFeeApproved(
 
 			donor.VoucherInvitedAt = now()
 		}
-	} else {
+
+	default:
 		donor.Tasks.PayForLpa = task.PaymentStateApproved
 	}
 
@@ -207,6 +231,42 @@ func handleFeeApproved(
 	return nil
 }
 
+// refundOverpayment refunds the amount paid over the cost of the LPA, taking it
+// from the most recent payments first.
+func refundOverpayment(ctx context.Context, payClient PayClient, donor *donordata.Provided) error {
+	overpaid := -donor.FeeAmount().Pence()
+
+	for i := len(donor.PaymentDetails) - 1; i >= 0 && overpaid > 0; i-- {
+		payment := &donor.PaymentDetails[i]
+
+		available := payment.Amount - payment.Refunded()
+		if available <= 0 {
+			continue
+		}
+
+		amount := min(overpaid, available)
+
+		refund, err := payClient.CreateRefund(ctx, payment.PaymentID, pay.CreateRefundBody{
+			Amount:                amount,
+			RefundAmountAvailable: available,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to refund payment %s: %w", payment.PaymentID, err)
+		}
+
+		payment.Refunds = append(payment.Refunds, donordata.Refund{
+			RefundID:  refund.RefundID,
+			Amount:    refund.AmountPence.Pence(),
+			Status:    refund.Status,
+			CreatedAt: refund.CreatedDate,
+		})
+
+		overpaid -= amount
+	}
+
+	return nil
+}
+
 func handleFurtherInfoRequested(ctx context.Context, client dynamodbClient, event *events.CloudWatchEvent, now func() time.Time) error {
 	var v uidEvent
 	if err := json.Unmarshal(event.Detail, &v); err != nil {
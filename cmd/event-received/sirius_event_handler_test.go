@@ -214,6 +214,9 @@ func TestHandleFeeApproved(t *testing.T) {
 	factory.EXPECT().
 		NotifyClient(ctx).
 		Return(notifyClient, nil)
+	factory.EXPECT().
+		PayClient(ctx).
+		Return(newMockPayClient(t), nil)
 
 	handler := &siriusEventHandler{}
 	err := handler.Handle(ctx, factory, e)
@@ -253,6 +256,22 @@ func TestHandleFeeApprovedWhenFactoryErrors(t *testing.T) {
 				Return(newMockNotifyClient(t), expectedError)
 			return factory
 		},
+		"PayClient": func(t *testing.T) *mockFactory {
+			factory := newMockFactory(t)
+			factory.EXPECT().
+				AppData().
+				Return(appcontext.Data{}, nil)
+			factory.EXPECT().
+				AccessCodeSender(mock.Anything).
+				Return(newMockAccessCodeSender(t), nil)
+			factory.EXPECT().
+				NotifyClient(mock.Anything).
+				Return(newMockNotifyClient(t), nil)
+			factory.EXPECT().
+				PayClient(mock.Anything).
+				Return(newMockPayClient(t), expectedError)
+			return factory
+		},
 	}
 
 	for name, setupFactoryFn := range testcases {
@@ -327,6 +346,9 @@ func TestHandleFeeApprovedWhenNotPaid(t *testing.T) {
 	factory.EXPECT().
 		NotifyClient(ctx).
 		Return(newMockNotifyClient(t), nil)
+	factory.EXPECT().
+		PayClient(ctx).
+		Return(newMockPayClient(t), nil)
 
 	handler := &siriusEventHandler{}
 	err := handler.Handle(ctx, factory, event)
@@ -369,7 +391,7 @@ func TestHandleFeeApprovedWhenNotSigned(t *testing.T) {
 		Put(ctx, &updatedDonorProvided).
 		Return(nil)
 
-	err := handleFeeApproved(ctx, client, event, nil, nil, appcontext.Data{}, testNowFn, nil)
+	err := handleFeeApproved(ctx, client, event, nil, nil, appcontext.Data{}, testNowFn, nil, nil)
 	assert.Nil(t, err)
 }
 
@@ -403,7 +425,7 @@ func TestHandleFeeApprovedWhenAlreadyPaidOrApproved(t *testing.T) {
 					return nil
 				})
 
-			err := handleFeeApproved(ctx, client, event, nil, nil, appcontext.Data{}, nil, nil)
+			err := handleFeeApproved(ctx, client, event, nil, nil, appcontext.Data{}, nil, nil, nil)
 			assert.Nil(t, err)
 		})
 	}
@@ -417,18 +439,21 @@ func TestHandleFeeApprovedWhenApprovedTypeDiffers(t *testing.T) {
 		approvedFeeType               pay.FeeType
 		updatedTaskState              task.PaymentState
 		payment                       donordata.Payment
+		refund                        int
 	}{
 		"Requested HalfFee, got QuarterFee": {
 			requestedFeeType: pay.HalfFee,
 			approvedFeeType:  pay.QuarterFee,
 			updatedTaskState: task.PaymentStateCompleted,
-			payment:          donordata.Payment{Amount: 4100},
+			payment:          donordata.Payment{PaymentID: "payment-id", Amount: 4100},
+			refund:           1800,
 		},
 		"Requested HalfFee, got NoFee": {
 			requestedFeeType: pay.HalfFee,
 			approvedFeeType:  pay.NoFee,
 			updatedTaskState: task.PaymentStateCompleted,
-			payment:          donordata.Payment{Amount: 4100},
+			payment:          donordata.Payment{PaymentID: "payment-id", Amount: 4100},
+			refund:           4100,
 		},
 		"Requested NoFee, got HalfFee": {
 			requestedFeeType: pay.NoFee,
@@ -446,7 +471,8 @@ func TestHandleFeeApprovedWhenApprovedTypeDiffers(t *testing.T) {
 			previousFeeType:               pay.PreviousFeeFull,
 			approvedFeeType:               pay.QuarterFee,
 			updatedTaskState:              task.PaymentStateCompleted,
-			payment:                       donordata.Payment{Amount: 4100},
+			payment:                       donordata.Payment{PaymentID: "payment-id", Amount: 4100},
+			refund:                        1800,
 		},
 		"Requested HalfFee RepeatApplicationFee (previously paid FullFee), got NoFee": {
 			requestedFeeType:              pay.RepeatApplicationFee,
@@ -454,7 +480,8 @@ func TestHandleFeeApprovedWhenApprovedTypeDiffers(t *testing.T) {
 			previousFeeType:               pay.PreviousFeeFull,
 			approvedFeeType:               pay.NoFee,
 			updatedTaskState:              task.PaymentStateCompleted,
-			payment:                       donordata.Payment{Amount: 4100},
+			payment:                       donordata.Payment{PaymentID: "payment-id", Amount: 4100},
+			refund:                        4100,
 		},
 		"Requested HalfFee RepeatApplicationFee (previously paid HalfFee), got QuarterFee": {
 			requestedFeeType:              pay.RepeatApplicationFee,
@@ -462,7 +489,8 @@ func TestHandleFeeApprovedWhenApprovedTypeDiffers(t *testing.T) {
 			previousFeeType:               pay.PreviousFeeHalf,
 			approvedFeeType:               pay.QuarterFee,
 			updatedTaskState:              task.PaymentStateCompleted,
-			payment:                       donordata.Payment{Amount: 4100},
+			payment:                       donordata.Payment{PaymentID: "payment-id", Amount: 4100},
+			refund:                        1800,
 		},
 		"Requested HalfFee RepeatApplicationFee (previously paid HalfFee), got NoFee": {
 			requestedFeeType:              pay.RepeatApplicationFee,
@@ -470,7 +498,8 @@ func TestHandleFeeApprovedWhenApprovedTypeDiffers(t *testing.T) {
 			previousFeeType:               pay.PreviousFeeHalf,
 			approvedFeeType:               pay.NoFee,
 			updatedTaskState:              task.PaymentStateCompleted,
-			payment:                       donordata.Payment{Amount: 4100},
+			payment:                       donordata.Payment{PaymentID: "payment-id", Amount: 4100},
+			refund:                        4100,
 		},
 		"Requested HalfFee RepeatApplicationFee (previously paid NoFee), got HalfFee": {
 			requestedFeeType:              pay.RepeatApplicationFee,
@@ -543,6 +572,18 @@ func TestHandleFeeApprovedWhenApprovedTypeDiffers(t *testing.T) {
 			updatedDonorProvided.ReducedFeeDecisionAt = testNow
 			updatedDonorProvided.Tasks.PayForLpa = tc.updatedTaskState
 			updatedDonorProvided.FeeType = tc.approvedFeeType
+
+			payClient := newMockPayClient(t)
+			if tc.refund > 0 {
+				payClient.EXPECT().
+					CreateRefund(ctx, "payment-id", pay.CreateRefundBody{Amount: tc.refund, RefundAmountAvailable: 4100}).
+					Return(pay.RefundResponse{RefundID: "refund-id", AmountPence: pay.AmountPence(tc.refund), Status: pay.RefundStatusSubmitted, CreatedDate: testNow}, nil)
+
+				refundedPayment := tc.payment
+				refundedPayment.Refunds = []donordata.Refund{{RefundID: "refund-id", Amount: tc.refund, Status: pay.RefundStatusSubmitted, CreatedAt: testNow}}
+				updatedDonorProvided.PaymentDetails = []donordata.Payment{refundedPayment}
+			}
+
 			updatedDonorProvided.UpdateHash()
 			updatedDonorProvided.UpdatedAt = testNow
 
@@ -550,12 +591,128 @@ func TestHandleFeeApprovedWhenApprovedTypeDiffers(t *testing.T) {
 				Put(ctx, &updatedDonorProvided).
 				Return(nil)
 
-			err := handleFeeApproved(ctx, client, event, nil, nil, appcontext.Data{}, testNowFn, nil)
+			err := handleFeeApproved(ctx, client, event, nil, nil, appcontext.Data{}, testNowFn, nil, payClient)
 			assert.Nil(t, err)
 		})
 	}
 }
 
+func TestHandleFeeApprovedWhenAlreadyPaidInFull(t *testing.T) {
+	event := &events.CloudWatchEvent{
+		DetailType: "reduced-fee-approved",
+		Detail:     json.RawMessage(`{"uid":"M-1111-2222-3333","approvedType":"HalfFee"}`),
+	}
+
+	donorProvided := &donordata.Provided{
+		PK:      dynamo.LpaKey("123"),
+		SK:      dynamo.LpaOwnerKey(dynamo.DonorKey("456")),
+		FeeType: pay.FullFee,
+		Tasks:   donordata.Tasks{PayForLpa: task.PaymentStateCompleted, SignTheLpa: task.StateCompleted},
+		PaymentDetails: []donordata.Payment{
+			{PaymentID: "payment-1", Amount: 9200, Refunds: []donordata.Refund{{Amount: 9200, Status: pay.RefundStatusError}}},
+			{PaymentID: "payment-2", Amount: 2000},
+		},
+	}
+
+	client := newMockDynamodbClient(t)
+	client.EXPECT().
+		OneByUID(ctx, "M-1111-2222-3333").
+		Return(dynamo.Keys{PK: dynamo.LpaKey("123"), SK: dynamo.DonorKey("456")}, nil)
+	client.
+		On("One", ctx, dynamo.LpaKey("123"), dynamo.DonorKey("456"), mock.Anything).
+		Return(func(ctx context.Context, pk dynamo.PK, sk dynamo.SK, v interface{}) error {
+			b, _ := attributevalue.Marshal(donorProvided)
+			attributevalue.Unmarshal(b, v)
+			return nil
+		})
+
+	payClient := newMockPayClient(t)
+	payClient.EXPECT().
+		CreateRefund(ctx, "payment-2", pay.CreateRefundBody{Amount: 2000, RefundAmountAvailable: 2000}).
+		Return(pay.RefundResponse{RefundID: "refund-2", AmountPence: 2000, Status: pay.RefundStatusSubmitted, CreatedDate: testNow}, nil)
+	payClient.EXPECT().
+		CreateRefund(ctx, "payment-1", pay.CreateRefundBody{Amount: 4600, RefundAmountAvailable: 9200}).
+		Return(pay.RefundResponse{RefundID: "refund-1", AmountPence: 4600, Status: pay.RefundStatusSubmitted, CreatedDate: testNow}, nil)
+
+	updatedDonorProvided := *donorProvided
+	updatedDonorProvided.FeeType = pay.HalfFee
+	updatedDonorProvided.ReducedFeeDecisionAt = testNow
+	updatedDonorProvided.PaymentDetails = []donordata.Payment{
+		{PaymentID: "payment-1", Amount: 9200, Refunds: []donordata.Refund{
+			{Amount: 9200, Status: pay.RefundStatusError},
+			{RefundID: "refund-1", Amount: 4600, Status: pay.RefundStatusSubmitted, CreatedAt: testNow},
+		}},
+		{PaymentID: "payment-2", Amount: 2000, Refunds: []donordata.Refund{
+			{RefundID: "refund-2", Amount: 2000, Status: pay.RefundStatusSubmitted, CreatedAt: testNow},
+		}},
+	}
+	updatedDonorProvided.UpdateHash()
+	updatedDonorProvided.UpdatedAt = testNow
+
+	client.EXPECT().
+		Put(ctx, &updatedDonorProvided).
+		Return(nil)
+
+	err := handleFeeApproved(ctx, client, event, nil, nil, appcontext.Data{}, testNowFn, nil, payClient)
+	assert.Nil(t, err)
+}
+
+func TestHandleFeeApprovedWhenRefundErrors(t *testing.T) {
+	event := &events.CloudWatchEvent{
+		DetailType: "reduced-fee-approved",
+		Detail:     json.RawMessage(`{"uid":"M-1111-2222-3333","approvedType":"NoFee"}`),
+	}
+
+	donorProvided := &donordata.Provided{
+		PK:      dynamo.LpaKey("123"),
+		SK:      dynamo.LpaOwnerKey(dynamo.DonorKey("456")),
+		FeeType: pay.HalfFee,
+		Tasks:   donordata.Tasks{PayForLpa: task.PaymentStatePending},
+		PaymentDetails: []donordata.Payment{
+			{PaymentID: "payment-1", Amount: 2300},
+			{PaymentID: "payment-2", Amount: 2300},
+		},
+	}
+
+	client := newMockDynamodbClient(t)
+	client.EXPECT().
+		OneByUID(ctx, "M-1111-2222-3333").
+		Return(dynamo.Keys{PK: dynamo.LpaKey("123"), SK: dynamo.DonorKey("456")}, nil)
+	client.
+		On("One", ctx, dynamo.LpaKey("123"), dynamo.DonorKey("456"), mock.Anything).
+		Return(func(ctx context.Context, pk dynamo.PK, sk dynamo.SK, v interface{}) error {
+			b, _ := attributevalue.Marshal(donorProvided)
+			attributevalue.Unmarshal(b, v)
+			return nil
+		})
+
+	payClient := newMockPayClient(t)
+	payClient.EXPECT().
+		CreateRefund(ctx, "payment-2", mock.Anything).
+		Return(pay.RefundResponse{RefundID: "refund-2", AmountPence: 2300, Status: pay.RefundStatusSubmitted, CreatedDate: testNow}, nil)
+	payClient.EXPECT().
+		CreateRefund(ctx, "payment-1", mock.Anything).
+		Return(pay.RefundResponse{}, expectedError)
+
+	updatedDonorProvided := *donorProvided
+	updatedDonorProvided.FeeType = pay.NoFee
+	updatedDonorProvided.PaymentDetails = []donordata.Payment{
+		{PaymentID: "payment-1", Amount: 2300},
+		{PaymentID: "payment-2", Amount: 2300, Refunds: []donordata.Refund{
+			{RefundID: "refund-2", Amount: 2300, Status: pay.RefundStatusSubmitted, CreatedAt: testNow},
+		}},
+	}
+	updatedDonorProvided.UpdateHash()
+	updatedDonorProvided.UpdatedAt = testNow
+
+	client.EXPECT().
+		Put(ctx, &updatedDonorProvided).
+		Return(nil)
+
+	err := handleFeeApproved(ctx, client, event, nil, nil, appcontext.Data{}, testNowFn, nil, payClient)
+	assert.ErrorIs(t, err, expectedError)
+}
+
 func TestHandleFeeApprovedWhenVoucherSelected(t *testing.T) {
 	event := &events.CloudWatchEvent{
 		DetailType: "reduced-fee-approved",
@@ -600,7 +757,7 @@ func TestHandleFeeApprovedWhenVoucherSelected(t *testing.T) {
 		}, appcontext.Data{}).
 		Return(nil)
 
-	err := handleFeeApproved(ctx, client, event, accessCodeSender, nil, appcontext.Data{}, testNowFn, nil)
+	err := handleFeeApproved(ctx, client, event, accessCodeSender, nil, appcontext.Data{}, testNowFn, nil, nil)
 	assert.Nil(t, err)
 }
 
@@ -632,7 +789,7 @@ func TestHandleFeeApprovedWhenVoucherSelectedAndAccessCodeSenderError(t *testing
 		SendVoucherInvite(mock.Anything, mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	err := handleFeeApproved(ctx, client, event, accessCodeSender, nil, appcontext.Data{}, testNowFn, nil)
+	err := handleFeeApproved(ctx, client, event, accessCodeSender, nil, appcontext.Data{}, testNowFn, nil, nil)
 	assert.ErrorIs(t, err, expectedError)
 }
 
@@ -657,7 +814,7 @@ func TestHandleFeeApprovedWhenDynamoClientPutError(t *testing.T) {
 		Put(ctx, mock.Anything).
 		Return(expectedError)
 
-	err := handleFeeApproved(ctx, client, event, nil, nil, appcontext.Data{}, testNowFn, nil)
+	err := handleFeeApproved(ctx, client, event, nil, nil, appcontext.Data{}, testNowFn, nil, nil)
 	assert.Equal(t, fmt.Errorf("failed to update donor provided details: %w", expectedError), err)
 }
 
@@ -694,7 +851,7 @@ func TestHandleFeeApprovedWhenAccessCodeSenderError(t *testing.T) {
 		SendCertificateProviderPrompt(ctx, appcontext.Data{}, mock.Anything).
 		Return(expectedError)
 
-	err := handleFeeApproved(ctx, client, event, accessCodeSender, eventClient, appcontext.Data{}, testNowFn, nil)
+	err := handleFeeApproved(ctx, client, event, accessCodeSender, eventClient, appcontext.Data{}, testNowFn, nil, nil)
 	assert.Equal(t, fmt.Errorf("failed to send share code to certificate provider: %w", expectedError), err)
 }
 
@@ -726,7 +883,7 @@ func TestHandleFeeApprovedWhenEventClientError(t *testing.T) {
 		SendCertificateProviderStarted(mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	err := handleFeeApproved(ctx, client, event, nil, eventClient, appcontext.Data{}, testNowFn, nil)
+	err := handleFeeApproved(ctx, client, event, nil, eventClient, appcontext.Data{}, testNowFn, nil, nil)
 	assert.Equal(t, fmt.Errorf("failed to send certificate-provider-started event: %w", expectedError), err)
 }
 
@@ -774,7 +931,7 @@ func TestHandleFeeApprovedWhenNotifyClientError(t *testing.T) {
 		T(mock.Anything).
 		Return("")
 
-	err := handleFeeApproved(ctx, client, event, accessCodeSender, eventClient, appcontext.Data{Localizer: localizer}, testNowFn, notifyClient)
+	err := handleFeeApproved(ctx, client, event, accessCodeSender, eventClient, appcontext.Data{Localizer: localizer}, testNowFn, notifyClient, nil)
 	assert.ErrorIs(t, err, expectedError)
 }
 
@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/gorilla/handlers"
 	"github.com/ministryofjustice/opg-go-common/template"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
@@ -36,6 +37,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/search"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/secrets"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/sesh"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/telemetry"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/templatefn"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/uid"
@@ -96,6 +98,7 @@ func run(ctx context.Context, logger *slog.Logger) error {
 		dynamoTableSessions         = os.Getenv("DYNAMODB_TABLE_SESSIONS")
 		notifyBaseURL               = os.Getenv("GOVUK_NOTIFY_BASE_URL")
 		ordnanceSurveyBaseURL       = os.Getenv("ORDNANCE_SURVEY_BASE_URL")
+		addressesFile               = os.Getenv("ADDRESSES_FILE")
 		payBaseURL                  = os.Getenv("GOVUK_PAY_BASE_URL")
 		port                        = os.Getenv("APP_PORT")
 		xrayEnabled                 = os.Getenv("XRAY_ENABLED") == "1"
@@ -116,12 +119,23 @@ func run(ctx context.Context, logger *slog.Logger) error {
 		searchEndpoint        = os.Getenv("SEARCH_ENDPOINT")
 		searchIndexName       = os.Getenv("SEARCH_INDEX_NAME")
 		searchIndexingEnabled = os.Getenv("SEARCH_INDEXING_DISABLED") != "1"
+		searchInMemory        = os.Getenv("SEARCH_BACKEND") == "memory"
 		useURL                = os.Getenv("USE_A_LASTING_POWER_OF_ATTORNEY_URL")
 		kmsKeyAlias           = os.Getenv("S3_UPLOADS_KMS_KEY_ALIAS")
 		useTestWitnessCode    = os.Getenv("USE_TEST_WITNESS_CODE") == "1" && devMode
+		useLocalUIDIssuer     = os.Getenv("USE_LOCAL_UID_ISSUER") == "1" && devMode
 		environment           = os.Getenv("ENVIRONMENT")
+		postcodeCacheDynamo   = os.Getenv("POSTCODE_CACHE_DYNAMODB") == "1"
+		postcodeMetrics       = os.Getenv("POSTCODE_METRICS_ENABLED") == "1"
+		feeSchedulesJSON      = os.Getenv("FEE_SCHEDULES")
 	)
 
+	feeSchedules, err := pay.ParseFeeSchedules([]byte(feeSchedulesJSON))
+	if err != nil {
+		return fmt.Errorf("invalid FEE_SCHEDULES: %w", err)
+	}
+	pay.SetFeeSchedules(feeSchedules)
+
 	staticHash, err := dirhash.HashDir(webDir+"/static", webDir, dirhash.DefaultHash)
 	if err != nil {
 		return err
@@ -170,6 +184,7 @@ func run(ctx context.Context, logger *slog.Logger) error {
 		StaticHash:    staticHash,
 		RumConfig:     rumConfig,
 		ActorTypes:    actor.TypeValues,
+		Capabilities:  supporterdata.CapabilityValues,
 		DonorStartURL: donorStartURL,
 	}))
 	if err != nil {
@@ -228,9 +243,14 @@ func run(ctx context.Context, logger *slog.Logger) error {
 
 	eventClient := event.NewClient(cfg, eventBusName, environment)
 
-	searchClient, err := search.NewClient(cfg, searchEndpoint, searchIndexName, searchIndexingEnabled)
-	if err != nil {
-		return err
+	var searchClient search.Searcher
+	if searchInMemory {
+		searchClient = search.NewMemoryClient(searchIndexingEnabled)
+	} else {
+		searchClient, err = search.NewClient(cfg, searchEndpoint, searchIndexName, searchIndexingEnabled)
+		if err != nil {
+			return err
+		}
 	}
 
 	if err := searchClient.CreateIndices(ctx); err != nil {
@@ -260,19 +280,37 @@ func run(ctx context.Context, logger *slog.Logger) error {
 
 	payClient := pay.New(logger, httpClient, payBaseURL, payApiKey)
 
-	osApiKey, err := secretsClient.Secret(ctx, secrets.OrdnanceSurvey)
-	if err != nil {
-		return err
+	var addressProvider place.AddressProvider
+	if addressesFile != "" {
+		addressProvider, err = place.NewFileProvider(addressesFile)
+		if err != nil {
+			return err
+		}
+	} else {
+		osApiKey, err := secretsClient.Secret(ctx, secrets.OrdnanceSurvey)
+		if err != nil {
+			return err
+		}
+
+		addressProvider = place.NewClient(ordnanceSurveyBaseURL, osApiKey, httpClient)
 	}
 
-	addressClient := place.NewClient(ordnanceSurveyBaseURL, osApiKey, httpClient)
+	var postcodeCache place.PostcodeCache
+	if postcodeCacheDynamo {
+		postcodeCache = place.NewDynamoCache(lpasDynamoClient)
+	}
+
+	addressClient := place.NewCachedClient(logger, addressProvider, postcodeCache, 24*time.Hour, 5*time.Second)
+	if postcodeMetrics {
+		go addressClient.ReportMetrics(ctx, telemetry.NewMetricsClient(cloudwatch.NewFromConfig(cfg), Tag), time.Minute)
+	}
 
 	notifyApiKey, err := secretsClient.Secret(ctx, secrets.GovUkNotify)
 	if err != nil {
 		return err
 	}
 
-	notifyClient, err := notify.New(logger, notifyBaseURL, notifyApiKey, httpClient, eventClient, bundle)
+	notifyClient, err := notify.New(logger, notifyBaseURL, notifyApiKey, httpClient, eventClient, bundle, notify.NewStore(lpasDynamoClient))
 	if err != nil {
 		return err
 	}
@@ -286,7 +324,10 @@ func run(ctx context.Context, logger *slog.Logger) error {
 
 	lpaStoreClient := lpastore.New(lpaStoreBaseURL, secretsClient, lpaStoreSecretARN, lambdaClient)
 
-	uidClient := uid.New(uidBaseURL, lambdaClient)
+	var uidClient page.HealthChecker = uid.New(uidBaseURL, lambdaClient)
+	if useLocalUIDIssuer {
+		uidClient = uid.NewLocalIssuer()
+	}
 
 	mux := http.NewServeMux()
 	mux.HandleFunc(page.PathHealthCheckService.String(), func(w http.ResponseWriter, r *http.Request) {})
@@ -300,6 +341,7 @@ func run(ctx context.Context, logger *slog.Logger) error {
 	})
 	mux.Handle("/static/", http.StripPrefix("/static", handlers.CompressHandler(page.CacheControlHeaders(http.FileServer(http.Dir(webDir+"/static/"))))))
 	mux.Handle(page.PathAuthRedirect.String(), page.AuthRedirect(logger, sessionStore))
+	mux.Handle(page.PathBackChannelLogout.String(), page.BackChannelLogout(logger, oneloginClient, sessionStore))
 	mux.Handle(page.PathCookiesConsent.String(), page.CookieConsent())
 
 	mux.Handle("/cy/", http.StripPrefix("/cy", app.App(
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"log"
 	"net/http"
+	"strings"
 
 	"github.com/ministryofjustice/opg-go-common/env"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
 )
 
 func main() {
@@ -30,6 +32,50 @@ func main() {
 		json.NewEncoder(w).Encode(map[string]string{"id": "an-sms-id"})
 	})
 
+	http.HandleFunc("/v2/notifications/letter", func(w http.ResponseWriter, r *http.Request) {
+		var v map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&v)
+
+		if content, ok := v["content"].(string); ok {
+			log.Println("precompiled letter:", v["reference"], v["postage"], len(content))
+			json.NewEncoder(w).Encode(map[string]any{"id": "a-precompiled-letter-id", "reference": v["reference"], "postage": "second"})
+			return
+		}
+
+		log.Println("letter:", v)
+		json.NewEncoder(w).Encode(map[string]string{"id": "a-letter-id"})
+	})
+
+	// templates are generated from the definitions so that the template checker
+	// can be run against this mock
+	http.HandleFunc("/v2/templates", func(w http.ResponseWriter, r *http.Request) {
+		var templates []notify.Template
+		for _, definition := range notify.TemplateDefinitions() {
+			var body strings.Builder
+			for _, field := range definition.Fields {
+				body.WriteString("((" + field + "))\n")
+			}
+
+			templates = append(templates, notify.Template{
+				ID:   definition.EnglishID,
+				Name: definition.Name,
+				Type: definition.Channel.String(),
+				Body: body.String(),
+			})
+
+			if definition.HasWelsh() {
+				templates = append(templates, notify.Template{
+					ID:   definition.WelshID,
+					Name: definition.Name + " (Welsh)",
+					Type: definition.Channel.String(),
+					Body: body.String(),
+				})
+			}
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{"templates": templates})
+	})
+
 	if err := http.ListenAndServe(":"+port, nil); err != nil {
 		log.Fatal(err)
 	}
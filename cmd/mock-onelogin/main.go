@@ -0,0 +1,59 @@
+// Mock One Login is a mock for GOV.UK's One Login service. It implements the
+// OIDC endpoints used by the onelogin package, and issues core identity JWTs
+// for the identity outcome chosen on its sign in page.
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	_ "embed"
+	"html/template"
+	"log"
+	"net/http"
+
+	"github.com/ministryofjustice/opg-go-common/env"
+)
+
+//go:embed authorize.gohtml
+var authorizeTemplate string
+
+func main() {
+	var (
+		port                = env.Get("PORT", "8080")
+		publicURL           = env.Get("PUBLIC_URL", "http://localhost:7012")
+		clientID            = env.Get("CLIENT_ID", "client-id-value")
+		redirectURL         = env.Get("REDIRECT_URL", "http://localhost:5050/auth/redirect")
+		templateSub         = env.Get("TEMPLATE_SUB", "") == "1"
+		templateSubDefault  = env.Get("TEMPLATE_SUB_DEFAULT", "random")
+		templateReturnCodes = env.Get("TEMPLATE_RETURN_CODES", "") == "1"
+	)
+
+	signingKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	identityKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	server := &server{
+		publicURL:           publicURL,
+		clientID:            clientID,
+		redirectURL:         redirectURL,
+		templateSub:         templateSub,
+		templateSubDefault:  templateSubDefault,
+		templateReturnCodes: templateReturnCodes,
+		signingKey:          signingKey,
+		identityKey:         identityKey,
+		tmpl:                template.Must(template.New("authorize").Parse(authorizeTemplate)),
+		sessions:            map[string]session{},
+	}
+
+	if err := http.ListenAndServe(":"+port, server.routes()); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,367 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/json"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MicahParks/jwkset"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/date"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/random"
+)
+
+const (
+	signingKeyID  = "mock-onelogin-signing-key"
+	didController = "did:web:mock-onelogin"
+	identityKeyID = didController + "#mock-onelogin-identity-key"
+	coreIdentity  = "https://vocab.account.gov.uk/v1/coreIdentityJWT"
+	testEmail     = "simulate-delivered@notifications.service.gov.uk"
+)
+
+// A session is created when the sign in page is submitted, it can be retrieved
+// by its code and then by its access token.
+type session struct {
+	Sub         string
+	Email       string
+	Nonce       string
+	ClientID    string
+	Identity    *identity
+	ReturnCodes []string
+	Requested   bool
+}
+
+type server struct {
+	publicURL           string
+	clientID            string
+	redirectURL         string
+	templateSub         bool
+	templateSubDefault  string
+	templateReturnCodes bool
+	signingKey          *rsa.PrivateKey
+	identityKey         *ecdsa.PrivateKey
+	tmpl                *template.Template
+
+	mu       sync.Mutex
+	sessions map[string]session
+}
+
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("GET /.well-known/openid-configuration", s.openidConfiguration)
+	mux.HandleFunc("GET /.well-known/jwks.json", s.jwks)
+	mux.HandleFunc("GET /.well-known/did.json", s.did)
+	mux.HandleFunc("/authorize", s.authorize)
+	mux.HandleFunc("POST /token", s.token)
+	mux.HandleFunc("GET /userinfo", s.userinfo)
+	mux.HandleFunc("GET /logout", s.logout)
+	return mux
+}
+
+// internalURL is the URL the app uses to reach the mock, whereas publicURL is
+// used by the browser.
+func internalURL(r *http.Request) string {
+	return "http://" + r.Host
+}
+
+func (s *server) openidConfiguration(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]string{
+		"authorization_endpoint": s.publicURL + "/authorize",
+		"token_endpoint":         internalURL(r) + "/token",
+		"issuer":                 internalURL(r),
+		"userinfo_endpoint":      internalURL(r) + "/userinfo",
+		"jwks_uri":               internalURL(r) + "/.well-known/jwks.json",
+		"end_session_endpoint":   s.publicURL + "/logout",
+	})
+}
+
+func (s *server) jwks(w http.ResponseWriter, r *http.Request) {
+	jwk, err := jwkset.NewJWKFromKey(&s.signingKey.PublicKey, jwkset.JWKOptions{
+		Metadata: jwkset.JWKMetadataOptions{KID: signingKeyID, ALG: jwkset.AlgRS256, USE: jwkset.UseSig},
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, jwkset.JWKSMarshal{Keys: []jwkset.JWKMarshal{jwk.Marshal()}})
+}
+
+func (s *server) did(w http.ResponseWriter, r *http.Request) {
+	jwk, err := jwkset.NewJWKFromKey(&s.identityKey.PublicKey, jwkset.JWKOptions{
+		Metadata: jwkset.JWKMetadataOptions{ALG: jwkset.AlgES256},
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "max-age=3600")
+	writeJSON(w, map[string]any{
+		"@context": []string{"https://www.w3.org/ns/did/v1", "https://w3id.org/security/jwk/v1"},
+		"id":       didController,
+		"assertionMethod": []map[string]any{{
+			"type":         "JsonWebKey",
+			"id":           identityKeyID,
+			"controller":   didController,
+			"publicKeyJwk": jwk.Marshal(),
+		}},
+	})
+}
+
+type authorizeData struct {
+	IdentityRequested  bool
+	TemplateSub        bool
+	TemplateSubDefault string
+	Email              string
+	IdentityUsers      []user
+	ReturnCodeUsers    []user
+}
+
+func (s *server) authorize(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if s.clientID != "" && r.FormValue("client_id") != s.clientID {
+		http.Error(w, "client_id does not match", http.StatusBadRequest)
+		return
+	}
+
+	if s.redirectURL != "" && r.FormValue("redirect_uri") != s.redirectURL {
+		http.Error(w, "redirect_uri does not match", http.StatusBadRequest)
+		return
+	}
+
+	identityRequested := strings.Contains(r.FormValue("claims"), coreIdentity)
+
+	if r.Method != http.MethodPost {
+		data := authorizeData{
+			IdentityRequested:  identityRequested,
+			TemplateSub:        s.templateSub,
+			TemplateSubDefault: s.templateSubDefault,
+			Email:              testEmail,
+			IdentityUsers:      identityUsers,
+		}
+		if s.templateReturnCodes {
+			data.ReturnCodeUsers = returnCodeUsers
+		}
+
+		if err := s.tmpl.Execute(w, data); err != nil {
+			log.Println("error rendering authorize:", err)
+		}
+		return
+	}
+
+	sess := session{
+		Sub:       "urn:fdc:mock-one-login:2023:" + random.AlphaNumeric(43),
+		Email:     r.PostFormValue("email"),
+		Nonce:     r.FormValue("nonce"),
+		ClientID:  r.FormValue("client_id"),
+		Requested: identityRequested,
+	}
+
+	subject := s.templateSubDefault
+	if s.templateSub {
+		subject = r.PostFormValue("subject")
+	}
+
+	if subject == "email" {
+		sess.Sub = sess.Email
+	}
+
+	if identityRequested {
+		switch value := r.PostFormValue("user"); value {
+		case "custom":
+			sess.Identity = &identity{
+				FirstNames:  r.PostFormValue("first-names"),
+				LastName:    r.PostFormValue("last-name"),
+				DateOfBirth: date.New(r.PostFormValue("year"), r.PostFormValue("month"), r.PostFormValue("day")),
+				Address:     richmondPlace("1"),
+			}
+		default:
+			user, ok := findUser(value, s.templateReturnCodes)
+			if !ok {
+				http.Error(w, "unknown user "+value, http.StatusBadRequest)
+				return
+			}
+
+			sess.Identity = user.Identity
+			sess.ReturnCodes = user.ReturnCodes
+		}
+	}
+
+	code := random.AlphaNumeric(32)
+	s.mu.Lock()
+	s.sessions[code] = sess
+	s.mu.Unlock()
+
+	log.Println("signed in:", sess.Sub, sess.Email)
+
+	http.Redirect(w, r, r.FormValue("redirect_uri")+"?"+url.Values{
+		"code":  {code},
+		"state": {r.FormValue("state")},
+	}.Encode(), http.StatusFound)
+}
+
+func (s *server) token(w http.ResponseWriter, r *http.Request) {
+	if r.PostFormValue("grant_type") != "authorization_code" {
+		http.Error(w, "unsupported grant_type", http.StatusBadRequest)
+		return
+	}
+
+	if r.PostFormValue("client_assertion_type") != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" || r.PostFormValue("client_assertion") == "" {
+		http.Error(w, "missing client_assertion", http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	sess, ok := s.sessions[r.PostFormValue("code")]
+	delete(s.sessions, r.PostFormValue("code"))
+	s.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "invalid code", http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now()
+	vot := "Cl.Cm"
+	if sess.Requested {
+		vot = "P2"
+	}
+
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   internalURL(r),
+		"sub":   sess.Sub,
+		"aud":   sess.ClientID,
+		"iat":   now.Unix(),
+		"exp":   now.Add(2 * time.Minute).Unix(),
+		"nonce": sess.Nonce,
+		"vot":   vot,
+		"sid":   random.AlphaNumeric(16),
+	})
+	idToken.Header["kid"] = signingKeyID
+
+	signedIDToken, err := idToken.SignedString(s.signingKey)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	accessToken := random.AlphaNumeric(32)
+	s.mu.Lock()
+	s.sessions[accessToken] = sess
+	s.mu.Unlock()
+
+	writeJSON(w, map[string]string{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"id_token":     signedIDToken,
+	})
+}
+
+func (s *server) userinfo(w http.ResponseWriter, r *http.Request) {
+	accessToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		http.Error(w, "missing access token", http.StatusUnauthorized)
+		return
+	}
+
+	s.mu.Lock()
+	sess, ok := s.sessions[accessToken]
+	s.mu.Unlock()
+
+	if !ok {
+		http.Error(w, "invalid access token", http.StatusUnauthorized)
+		return
+	}
+
+	userInfo := map[string]any{
+		"sub":            sess.Sub,
+		"email":          sess.Email,
+		"email_verified": true,
+		"phone":          "+447700900000",
+		"phone_verified": true,
+		"updated_at":     time.Now().Unix(),
+	}
+
+	if len(sess.ReturnCodes) > 0 {
+		var codes []map[string]string
+		for _, code := range sess.ReturnCodes {
+			codes = append(codes, map[string]string{"code": code})
+		}
+		userInfo["https://vocab.account.gov.uk/v1/returnCode"] = codes
+	}
+
+	if sess.Identity != nil {
+		coreIdentityJWT, err := s.coreIdentityJWT(sess)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		userInfo[coreIdentity] = coreIdentityJWT
+		userInfo["https://vocab.account.gov.uk/v1/address"] = []address{sess.Identity.Address}
+	}
+
+	writeJSON(w, userInfo)
+}
+
+func (s *server) coreIdentityJWT(sess session) (string, error) {
+	now := time.Now()
+
+	var nameParts []map[string]string
+	for _, name := range strings.Fields(sess.Identity.FirstNames) {
+		nameParts = append(nameParts, map[string]string{"type": "GivenName", "value": name})
+	}
+	if sess.Identity.LastName != "" {
+		nameParts = append(nameParts, map[string]string{"type": "FamilyName", "value": sess.Identity.LastName})
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": "https://identity.integration.account.gov.uk/",
+		"sub": sess.Sub,
+		"aud": sess.ClientID,
+		"nbf": now.Unix(),
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+		"vot": "P2",
+		"vtm": "https://oidc.integration.account.gov.uk/trustmark",
+		"vc": map[string]any{
+			"type": []string{"VerifiableCredential", "IdentityCheckCredential"},
+			"credentialSubject": map[string]any{
+				"name":      []map[string]any{{"nameParts": nameParts}},
+				"birthDate": []map[string]any{{"value": sess.Identity.DateOfBirth}},
+			},
+		},
+	})
+	token.Header["kid"] = identityKeyID
+
+	return token.SignedString(s.identityKey)
+}
+
+func (s *server) logout(w http.ResponseWriter, r *http.Request) {
+	redirect := r.FormValue("post_logout_redirect_uri")
+	if redirect == "" {
+		redirect = s.publicURL
+	}
+
+	http.Redirect(w, r, redirect, http.StatusFound)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Println("error writing response:", err)
+	}
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"html/template"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/date"
+	identitypkg "github.com/ministryofjustice/opg-modernising-lpa/internal/identity"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/onelogin"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/place"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID    = "client-id"
+	testRedirectURL = "http://app/auth/redirect"
+)
+
+type secretsClient struct{ privateKey []byte }
+
+func (c secretsClient) SecretBytes(ctx context.Context, name string) ([]byte, error) {
+	return c.privateKey, nil
+}
+
+var noRedirectClient = &http.Client{
+	CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+}
+
+func newTestClient(t *testing.T) (*onelogin.Client, *httptest.Server) {
+	signingKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	identityKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	s := &server{
+		clientID:            testClientID,
+		redirectURL:         testRedirectURL,
+		templateSub:         true,
+		templateSubDefault:  "random",
+		templateReturnCodes: true,
+		signingKey:          signingKey,
+		identityKey:         identityKey,
+		tmpl:                template.Must(template.New("authorize").Parse(authorizeTemplate)),
+		sessions:            map[string]session{},
+	}
+
+	ts := httptest.NewServer(s.routes())
+	t.Cleanup(ts.Close)
+	s.publicURL = ts.URL
+
+	appKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	appKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(appKey)})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	client := onelogin.New(ctx, slog.New(slog.DiscardHandler), ts.Client(), secretsClient{privateKey: appKeyPEM}, ts.URL, ts.URL, testClientID, testRedirectURL)
+
+	return client, ts
+}
+
+func signIn(t *testing.T, client *onelogin.Client, confidenceLevel onelogin.ConfidenceLevel, form url.Values) onelogin.UserInfo {
+	authURL, err := client.AuthCodeURL("a-state", "a-nonce", "en", confidenceLevel)
+	require.Nil(t, err)
+
+	resp, err := noRedirectClient.PostForm(authURL, form)
+	require.Nil(t, err)
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	location, _ := url.Parse(resp.Header.Get("Location"))
+	assert.Equal(t, "a-state", location.Query().Get("state"))
+
+	_, accessToken, err := client.Exchange(context.Background(), location.Query().Get("code"), "a-nonce")
+	require.Nil(t, err)
+
+	userInfo, err := client.UserInfo(context.Background(), accessToken)
+	require.Nil(t, err)
+
+	return userInfo
+}
+
+func TestSignIn(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	userInfo := signIn(t, client, onelogin.ConfidenceLevelNone, url.Values{
+		"email":   {"a@example.com"},
+		"subject": {"random"},
+	})
+
+	assert.True(t, strings.HasPrefix(userInfo.Sub, "urn:fdc:mock-one-login:2023:"))
+	assert.Equal(t, "a@example.com", userInfo.Email)
+	assert.Empty(t, userInfo.CoreIdentityJWT)
+}
+
+func TestSignInWithEmailSubject(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	userInfo := signIn(t, client, onelogin.ConfidenceLevelNone, url.Values{
+		"email":   {"a-sub"},
+		"subject": {"email"},
+	})
+
+	assert.Equal(t, "a-sub", userInfo.Sub)
+}
+
+func TestIdentity(t *testing.T) {
+	testcases := map[string]struct {
+		form     url.Values
+		expected identitypkg.UserData
+	}{
+		"donor": {
+			form: url.Values{"user": {"donor"}},
+			expected: identitypkg.UserData{
+				Status:      identitypkg.StatusConfirmed,
+				FirstNames:  "Sam",
+				LastName:    "Smith",
+				DateOfBirth: date.New("2000", "01", "02"),
+				CurrentAddress: place.Address{
+					Line1:      "1 RICHMOND PLACE",
+					Line2:      "KINGS HEATH",
+					TownOrCity: "BIRMINGHAM",
+					Postcode:   "B14 7ED",
+					Country:    "GB",
+				},
+			},
+		},
+		"custom": {
+			form: url.Values{
+				"user":        {"custom"},
+				"first-names": {"John Paul"},
+				"last-name":   {"Johnson"},
+				"day":         {"3"},
+				"month":       {"4"},
+				"year":        {"1980"},
+			},
+			expected: identitypkg.UserData{
+				Status:      identitypkg.StatusConfirmed,
+				FirstNames:  "John Paul",
+				LastName:    "Johnson",
+				DateOfBirth: date.New("1980", "04", "03"),
+				CurrentAddress: place.Address{
+					Line1:      "1 RICHMOND PLACE",
+					Line2:      "KINGS HEATH",
+					TownOrCity: "BIRMINGHAM",
+					Postcode:   "B14 7ED",
+					Country:    "GB",
+				},
+			},
+		},
+		"insufficient evidence": {
+			form:     url.Values{"user": {"return-code-x"}},
+			expected: identitypkg.UserData{Status: identitypkg.StatusInsufficientEvidence},
+		},
+		"failed": {
+			form:     url.Values{"user": {"return-code-t"}},
+			expected: identitypkg.UserData{Status: identitypkg.StatusFailed},
+		},
+		"passed without details": {
+			form:     url.Values{"user": {"return-code-a"}},
+			expected: identitypkg.UserData{Status: identitypkg.StatusInsufficientEvidence},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			client, _ := newTestClient(t)
+
+			userInfo := signIn(t, client, onelogin.ConfidenceLevelMedium, tc.form)
+
+			var userData identitypkg.UserData
+			assert.Eventually(t, func() bool {
+				var err error
+				userData, err = client.ParseIdentityClaim(userInfo)
+				return err == nil
+			}, time.Second, 10*time.Millisecond)
+
+			userData.CheckedAt = time.Time{}
+			assert.Equal(t, tc.expected, userData)
+		})
+	}
+}
+
+func TestGetAuthorize(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	authURL, _ := client.AuthCodeURL("a-state", "a-nonce", "en", onelogin.ConfidenceLevelMedium)
+
+	resp, err := http.Get(authURL)
+	require.Nil(t, err)
+	body, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "Sam Smith (donor)")
+	assert.Contains(t, string(body), "Unable to prove identity (X)")
+}
+
+func TestGetAuthorizeWhenClientIDDoesNotMatch(t *testing.T) {
+	_, ts := newTestClient(t)
+
+	resp, err := http.Get(ts.URL + "/authorize?" + url.Values{
+		"client_id":    {"other"},
+		"redirect_uri": {testRedirectURL},
+	}.Encode())
+	require.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestPostTokenWhenCodeInvalid(t *testing.T) {
+	_, ts := newTestClient(t)
+
+	resp, err := http.PostForm(ts.URL+"/token", url.Values{
+		"grant_type":            {"authorization_code"},
+		"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+		"client_assertion":      {"an-assertion"},
+		"code":                  {"what"},
+	})
+	require.Nil(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestGetLogout(t *testing.T) {
+	client, _ := newTestClient(t)
+
+	endSessionURL, _ := client.EndSessionURL("an-id-token", "http://app/start")
+
+	resp, err := noRedirectClient.Get(endSessionURL)
+	require.Nil(t, err)
+
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, "http://app/start", resp.Header.Get("Location"))
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/date"
+)
+
+// An identity is the details returned in the core identity JWT.
+type identity struct {
+	FirstNames  string
+	LastName    string
+	DateOfBirth date.Date
+	Address     address
+}
+
+type address struct {
+	BuildingNumber           string `json:"buildingNumber,omitempty"`
+	StreetName               string `json:"streetName,omitempty"`
+	DependentAddressLocality string `json:"dependentAddressLocality,omitempty"`
+	AddressLocality          string `json:"addressLocality,omitempty"`
+	PostalCode               string `json:"postalCode,omitempty"`
+	AddressCountry           string `json:"addressCountry,omitempty"`
+	ValidFrom                string `json:"validFrom,omitempty"`
+}
+
+// A user is an option on the sign in page for the outcome of an identity
+// check. Users match the actors created by the fixtures.
+type user struct {
+	Value       string
+	Label       string
+	Identity    *identity
+	ReturnCodes []string
+}
+
+var identityUsers = []user{
+	{
+		Value: "donor",
+		Label: "Sam Smith (donor)",
+		Identity: &identity{
+			FirstNames:  "Sam",
+			LastName:    "Smith",
+			DateOfBirth: date.New("2000", "1", "2"),
+			Address:     richmondPlace("1"),
+		},
+	},
+	{
+		Value: "certificate-provider",
+		Label: "Charlie Cooper (certificate provider)",
+		Identity: &identity{
+			FirstNames:  "Charlie",
+			LastName:    "Cooper",
+			DateOfBirth: date.New("1990", "1", "2"),
+			Address:     richmondPlace("5"),
+		},
+	},
+	{
+		Value: "voucher",
+		Label: "Vivian Vaughn (voucher)",
+		Identity: &identity{
+			FirstNames:  "Vivian",
+			LastName:    "Vaughn",
+			DateOfBirth: date.New("1990", "1", "2"),
+			Address:     richmondPlace("9"),
+		},
+	},
+}
+
+var returnCodeUsers = []user{
+	{Value: "return-code-x", Label: "Unable to prove identity (X)", ReturnCodes: []string{"X"}},
+	{Value: "return-code-t", Label: "Failed identity check (T)", ReturnCodes: []string{"T"}},
+	{Value: "return-code-d", Label: "Failed identity check (D)", ReturnCodes: []string{"D"}},
+	{Value: "return-code-a", Label: "Identity check passed without details (A)", ReturnCodes: []string{"A"}},
+}
+
+func richmondPlace(number string) address {
+	return address{
+		BuildingNumber:           number,
+		StreetName:               "RICHMOND PLACE",
+		DependentAddressLocality: "KINGS HEATH",
+		AddressLocality:          "BIRMINGHAM",
+		PostalCode:               "B14 7ED",
+		AddressCountry:           "GB",
+		ValidFrom:                "2000-01-01",
+	}
+}
+
+func findUser(value string, showReturnCodes bool) (user, bool) {
+	for _, u := range identityUsers {
+		if u.Value == value {
+			return u, true
+		}
+	}
+
+	if showReturnCodes {
+		for _, u := range returnCodeUsers {
+			if u.Value == value {
+				return u, true
+			}
+		}
+	}
+
+	return user{}, false
+}
@@ -1,29 +1,58 @@
-// Mock OS API is a mock for Ordnance Survey's Places API
+// Mock OS API is a mock for Ordnance Survey's Places API, serving addresses from
+// a local dataset.
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
 	"os"
+	"strings"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/place"
 )
 
 func main() {
 	port := os.Getenv("PORT")
+	addressesFile := os.Getenv("ADDRESSES_FILE")
+	if addressesFile == "" {
+		addressesFile = "data/addresses.csv"
+	}
+
+	provider, err := place.NewFileProvider(addressesFile)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	http.HandleFunc("/search/places/v1/postcode", func(w http.ResponseWriter, r *http.Request) {
 		postcode := r.URL.Query().Get("postcode")
 		log.Println("postcode searched:", postcode)
-		var postcodeJson []byte
-
-		switch postcode {
-		case "INVALID":
-			postcodeJson, _ = os.ReadFile("data/invalid-postcode-error.json")
-		case "NE234EE":
-			postcodeJson, _ = os.ReadFile("data/no-addresses-found.json")
-		default:
-			postcodeJson, _ = os.ReadFile("data/multiple-addresses.json")
+
+		var response any
+		details, err := provider.LookupDetails(postcode)
+		if badRequest := (place.BadRequestError{}); errors.As(err, &badRequest) {
+			w.WriteHeader(badRequest.Statuscode)
+			response = map[string]any{"error": badRequest}
+		} else {
+			results := make([]map[string]any, len(details))
+			for i, d := range details {
+				d.Address = fullAddress(d)
+				results[i] = map[string]any{"DPA": d}
+			}
+
+			response = map[string]any{
+				"header": map[string]any{
+					"query":        "postcode=" + postcode,
+					"totalresults": len(details),
+					"format":       "JSON",
+					"dataset":      "DPA",
+				},
+				"results": results,
+			}
 		}
 
+		postcodeJson, _ := json.Marshal(response)
 		w.Write(postcodeJson)
 		// to aid debugging e2e test failures
 		log.Println("OS mock response:", string(postcodeJson))
@@ -33,3 +62,14 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+func fullAddress(d place.AddressDetails) string {
+	var parts []string
+	for _, part := range []string{d.SubBuildingName, d.BuildingName, d.BuildingNumber, d.ThoroughFareName, d.DependentLocality, d.Town, d.Postcode} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,159 @@
+// Notify template check verifies that the emails, SMS and letters defined in
+// internal/notify match the templates configured in GOV.UK Notify.
+//
+// For each definition it reports:
+//
+//   - fields that are sent but not used by the template
+//   - placeholders in the template that no field provides
+//   - templates that do not have a separate Welsh variant
+//
+// Templates are read from a file exported in the same format as Notify's
+// /v2/templates response,
+//
+//	notify-template-check -file templates.json
+//
+// or requested from Notify, or a stand-in such as mock-notify, using the API
+// key in GOVUK_NOTIFY_API_KEY,
+//
+//	notify-template-check -base-url http://localhost:8080
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
+)
+
+var placeholderRe = regexp.MustCompile(`\(\(([^()]+)\)\)`)
+
+type templatesResponse struct {
+	Templates []notify.Template `json:"templates"`
+}
+
+func main() {
+	var (
+		file    = flag.String("file", "", "path to exported Notify templates")
+		baseURL = flag.String("base-url", "https://api.notifications.service.gov.uk", "base URL for Notify")
+	)
+	flag.Parse()
+
+	templates, err := loadTemplates(context.Background(), *file, *baseURL, os.Getenv("GOVUK_NOTIFY_API_KEY"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if problems := check(notify.TemplateDefinitions(), templates); len(problems) > 0 {
+		printProblems(os.Stdout, problems)
+		os.Exit(1)
+	}
+}
+
+func loadTemplates(ctx context.Context, file, baseURL, apiKey string) ([]notify.Template, error) {
+	if file != "" {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var v templatesResponse
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", file, err)
+		}
+
+		return v.Templates, nil
+	}
+
+	client, err := notify.New(nil, baseURL, apiKey, http.DefaultClient, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Templates(ctx)
+}
+
+type problem struct {
+	Name    string
+	Message string
+}
+
+func check(definitions []notify.TemplateDefinition, templates []notify.Template) []problem {
+	byID := map[string]notify.Template{}
+	for _, template := range templates {
+		byID[template.ID] = template
+	}
+
+	var problems []problem
+	for _, definition := range definitions {
+		report := func(format string, args ...any) {
+			problems = append(problems, problem{Name: definition.Name, Message: fmt.Sprintf(format, args...)})
+		}
+
+		variants := []struct{ lang, id string }{{"English", definition.EnglishID}}
+		if definition.HasWelsh() {
+			variants = append(variants, struct{ lang, id string }{"Welsh", definition.WelshID})
+		} else {
+			report("no Welsh template")
+		}
+
+		for _, variant := range variants {
+			template, ok := byID[variant.id]
+			if !ok {
+				report("%s template %s not found", variant.lang, variant.id)
+				continue
+			}
+
+			found := placeholders(template, definition.Channel)
+
+			for _, field := range definition.Fields {
+				if !slices.Contains(found, strings.ToLower(field)) {
+					report("field %s is not used in %s template", field, variant.lang)
+				}
+			}
+
+			for _, placeholder := range found {
+				if !slices.ContainsFunc(definition.Fields, func(field string) bool { return strings.ToLower(field) == placeholder }) {
+					report("placeholder ((%s)) in %s template has no matching field", placeholder, variant.lang)
+				}
+			}
+		}
+	}
+
+	return problems
+}
+
+// placeholders returns the lowercased names of the placeholders used in a
+// template. Notify matches personalisation case-insensitively, and optional
+// content is written as ((name??content)).
+func placeholders(template notify.Template, channel notify.Channel) []string {
+	var names []string
+	for _, match := range placeholderRe.FindAllStringSubmatch(template.Subject+"\n"+template.Body, -1) {
+		name, _, _ := strings.Cut(match[1], "??")
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		if channel.IsLetter() && strings.HasPrefix(name, "address_line_") {
+			continue
+		}
+
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+func printProblems(w io.Writer, problems []problem) {
+	for _, p := range problems {
+		fmt.Fprintf(w, "%s: %s\n", p.Name, p.Message)
+	}
+}
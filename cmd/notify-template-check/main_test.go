@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAPIKey = "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a"
+
+func TestCheck(t *testing.T) {
+	definitions := []notify.TemplateDefinition{{
+		Name:      "MatchingEmail",
+		Channel:   notify.ChannelEmail,
+		EnglishID: "en-1",
+		WelshID:   "cy-1",
+		Fields:    []string{"DonorFullName", "LpaType"},
+	}, {
+		Name:      "MismatchedSMS",
+		Channel:   notify.ChannelSMS,
+		EnglishID: "en-2",
+		WelshID:   "en-2",
+		Fields:    []string{"DonorFullName", "AccessCode"},
+	}, {
+		Name:      "MissingEmail",
+		Channel:   notify.ChannelEmail,
+		EnglishID: "en-3",
+		WelshID:   "cy-3",
+	}, {
+		Name:      "Letter",
+		Channel:   notify.ChannelLetter,
+		EnglishID: "en-4",
+		WelshID:   "cy-4",
+		Fields:    []string{"DonorFullName"},
+	}}
+
+	templates := []notify.Template{
+		{ID: "en-1", Subject: "About ((donorFullName))", Body: "Your ((LpaType)) LPA ((DonorFullName??has been sent))"},
+		{ID: "cy-1", Body: "((DonorFullName)) ((LpaType))"},
+		{ID: "en-2", Body: "((DonorFullName)) ((WitnessCode))"},
+		{ID: "en-3"},
+		{ID: "en-4", Body: "((address_line_1)) ((DonorFullName))"},
+		{ID: "cy-4", Body: "((address_line_1)) ((DonorFullName))"},
+	}
+
+	assert.Equal(t, []problem{
+		{Name: "MismatchedSMS", Message: "no Welsh template"},
+		{Name: "MismatchedSMS", Message: "field AccessCode is not used in English template"},
+		{Name: "MismatchedSMS", Message: "placeholder ((witnesscode)) in English template has no matching field"},
+		{Name: "MissingEmail", Message: "Welsh template cy-3 not found"},
+	}, check(definitions, templates))
+}
+
+func TestLoadTemplatesFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "templates.json")
+	_ = os.WriteFile(path, []byte(`{"templates":[{"id":"a","type":"email","body":"((Name))"}]}`), 0o644)
+
+	templates, err := loadTemplates(context.Background(), path, "", "")
+	assert.Nil(t, err)
+	assert.Equal(t, []notify.Template{{ID: "a", Type: "email", Body: "((Name))"}}, templates)
+}
+
+func TestLoadTemplatesFromFileWhenInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "templates.json")
+	_ = os.WriteFile(path, []byte(`not json`), 0o644)
+
+	_, err := loadTemplates(context.Background(), path, "", "")
+	assert.ErrorContains(t, err, "could not parse")
+}
+
+func TestLoadTemplatesFromNotify(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/templates", r.URL.Path)
+		_, _ = w.Write([]byte(`{"templates":[{"id":"a","type":"sms","body":"((Name))"}]}`))
+	}))
+	defer server.Close()
+
+	templates, err := loadTemplates(context.Background(), "", server.URL, testAPIKey)
+	assert.Nil(t, err)
+	assert.Equal(t, []notify.Template{{ID: "a", Type: "sms", Body: "((Name))"}}, templates)
+}
+
+func TestLoadTemplatesFromNotifyWhenInvalidAPIKey(t *testing.T) {
+	_, err := loadTemplates(context.Background(), "", "http://example.com", "bad")
+	assert.Error(t, err)
+}
+
+func TestPrintProblems(t *testing.T) {
+	var buf bytes.Buffer
+	printProblems(&buf, []problem{{Name: "AnEmail", Message: "no Welsh template"}})
+
+	assert.Equal(t, "AnEmail: no Welsh template\n", buf.String())
+}
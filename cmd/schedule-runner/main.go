@@ -13,6 +13,7 @@ import (
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/accesscode"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/attorney"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/certificateprovider"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
@@ -22,6 +23,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/scheduled"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/search"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/secrets"
@@ -38,6 +40,7 @@ var (
 	// TODO remove in MLPAB-2690
 	metricsEnabled              = os.Getenv("METRICS_ENABLED") == "1"
 	notifyBaseURL               = os.Getenv("GOVUK_NOTIFY_BASE_URL")
+	payBaseURL                  = os.Getenv("GOVUK_PAY_BASE_URL")
 	searchEndpoint              = os.Getenv("SEARCH_ENDPOINT")
 	searchIndexName             = os.Getenv("SEARCH_INDEX_NAME")
 	searchIndexingEnabled       = os.Getenv("SEARCH_INDEXING_DISABLED") != "1"
@@ -49,6 +52,7 @@ var (
 	attorneyStartURL            = os.Getenv("ATTORNEY_START_URL")
 	appPublicURL                = os.Getenv("APP_PUBLIC_URL")
 	environment                 = os.Getenv("ENVIRONMENT")
+	feeSchedulesJSON            = os.Getenv("FEE_SCHEDULES")
 
 	Tag string
 
@@ -68,6 +72,11 @@ func handleRunSchedule(ctx context.Context) error {
 		return fmt.Errorf("failed to get notify API secret: %w", err)
 	}
 
+	payApiKey, err := secretsClient.Secret(ctx, secrets.GovUkPay)
+	if err != nil {
+		return fmt.Errorf("failed to get pay API secret: %w", err)
+	}
+
 	bundle, err := localize.NewBundle("./lang/en.json", "./lang/cy.json")
 	if err != nil {
 		return err
@@ -75,14 +84,14 @@ func handleRunSchedule(ctx context.Context) error {
 
 	eventClient := event.NewClient(cfg, eventBusName, environment)
 
-	notifyClient, err := notify.New(logger, notifyBaseURL, notifyApiKey, httpClient, eventClient, bundle)
+	dynamoClient, err := dynamo.NewClient(cfg, tableName)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to create dynamodb client: %w", err)
 	}
 
-	dynamoClient, err := dynamo.NewClient(cfg, tableName)
+	notifyClient, err := notify.New(logger, notifyBaseURL, notifyApiKey, httpClient, eventClient, bundle, notify.NewStore(dynamoClient))
 	if err != nil {
-		return fmt.Errorf("failed to create dynamodb client: %w", err)
+		return err
 	}
 
 	searchClient, err := search.NewClient(cfg, searchEndpoint, searchIndexName, searchIndexingEnabled)
@@ -90,6 +99,8 @@ func handleRunSchedule(ctx context.Context) error {
 		return err
 	}
 
+	payClient := pay.New(logger, httpClient, payBaseURL, payApiKey)
+
 	lambdaClient := lambda.New(cfg, v4.NewSigner(), httpClient, time.Now)
 	lpaStoreClient := lpastore.New(lpaStoreBaseURL, secretsClient, lpaStoreSecretARN, lambdaClient)
 
@@ -98,6 +109,8 @@ func handleRunSchedule(ctx context.Context) error {
 	certificateProviderStore := certificateprovider.NewStore(dynamoClient)
 	attorneyStore := attorney.NewStore(dynamoClient)
 	lpaStoreResolvingService := lpastore.NewResolvingService(donorStore, lpaStoreClient)
+	accessCodeSender := accesscode.NewSender(accesscode.NewStore(dynamoClient, accesscode.DefaultExpiry), notifyClient, appPublicURL, certificateProviderStartURL, attorneyStartURL, eventClient, certificateProviderStore, scheduledStore)
+	paymentRecorder := donor.NewPaymentRecorder(donorStore, accessCodeSender, eventClient, notifyClient)
 
 	if Tag == "" {
 		Tag = os.Getenv("TAG")
@@ -115,6 +128,8 @@ func handleRunSchedule(ctx context.Context) error {
 		lpaStoreResolvingService,
 		notifyClient,
 		eventClient,
+		payClient,
+		paymentRecorder,
 		bundle,
 		metricsClient,
 		metricsEnabled,
@@ -167,6 +182,13 @@ func main() {
 		cfg.BaseEndpoint = aws.String(awsBaseURL)
 	}
 
+	feeSchedules, err := pay.ParseFeeSchedules([]byte(feeSchedulesJSON))
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to parse fee schedules", slog.Any("err", err))
+		return
+	}
+	pay.SetFeeSchedules(feeSchedules)
+
 	var tp *trace.TracerProvider
 	if xrayEnabled {
 		tp, err = telemetry.SetupLambda(ctx, &cfg.APIOptions)
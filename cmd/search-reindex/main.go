@@ -0,0 +1,195 @@
+// Search reindex rebuilds the OpenSearch LPA index so that changes to the index
+// definition can be rolled out.
+//
+// It creates a new index named after SEARCH_INDEX_NAME and the current time,
+// indexes every LPA in the lpas table into it, then atomically points the
+// SEARCH_INDEX_NAME alias at the new index. Any LPAs that fail to index are
+// listed and, unless -force is given, the alias is left unchanged.
+//
+// LPAs changed between being read and the alias being swapped will have been
+// indexed to the previous index, so the command should be run when the service
+// is quiet, or run a second time.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/search"
+)
+
+type DynamoClient interface {
+	Scan(ctx context.Context, params *dynamodb.ScanInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error)
+}
+
+type SearchClient interface {
+	CreateIndex(ctx context.Context, name string) error
+	BulkIndex(ctx context.Context, index string, lpas []search.Lpa) ([]search.BulkIndexFailure, error)
+	SwapAlias(ctx context.Context, alias, index string) ([]string, error)
+}
+
+func main() {
+	var (
+		awsBaseURL      = os.Getenv("AWS_BASE_URL")
+		tableName       = os.Getenv("LPAS_TABLE")
+		searchEndpoint  = os.Getenv("SEARCH_ENDPOINT")
+		searchIndexName = os.Getenv("SEARCH_INDEX_NAME")
+
+		batchSize = flag.Int("batch-size", 500, "number of LPAs to index in each request")
+		force     = flag.Bool("force", false, "swap the alias even if some LPAs failed to index")
+	)
+	flag.Parse()
+
+	ctx := context.Background()
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		log.Fatalf("failed to load default config: %v", err)
+	}
+
+	if awsBaseURL != "" {
+		cfg.BaseEndpoint = aws.String(awsBaseURL)
+
+		if !strings.Contains(awsBaseURL, "https") {
+			cfg.Credentials = credentials.NewStaticCredentialsProvider("test", "test", "test")
+			cfg.Region = "eu-west-1"
+		}
+	}
+
+	searchClient, err := search.NewClient(cfg, searchEndpoint, searchIndexName, true)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	r := &reindexer{
+		dynamoClient: dynamodb.NewFromConfig(cfg),
+		searchClient: searchClient,
+		out:          os.Stdout,
+		now:          time.Now,
+		table:        tableName,
+		alias:        searchIndexName,
+		batchSize:    *batchSize,
+		force:        *force,
+	}
+
+	if err := r.Run(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type reindexer struct {
+	dynamoClient DynamoClient
+	searchClient SearchClient
+	out          io.Writer
+	now          func() time.Time
+	table        string
+	alias        string
+	batchSize    int
+	force        bool
+}
+
+func (r *reindexer) Run(ctx context.Context) error {
+	index := r.alias + "_" + r.now().UTC().Format("20060102150405")
+
+	if err := r.searchClient.CreateIndex(ctx, index); err != nil {
+		return err
+	}
+	fmt.Fprintf(r.out, "created index %s\n", index)
+
+	var (
+		scanned, indexed int
+		batch            []search.Lpa
+		failures         []search.BulkIndexFailure
+	)
+
+	flush := func() error {
+		batchFailures, err := r.searchClient.BulkIndex(ctx, index, batch)
+		if err != nil {
+			return err
+		}
+
+		indexed += len(batch) - len(batchFailures)
+		failures = append(failures, batchFailures...)
+		batch = batch[:0]
+
+		fmt.Fprintf(r.out, "scanned %d, indexed %d, failed %d\n", scanned, indexed, len(failures))
+		return nil
+	}
+
+	paginator := dynamodb.NewScanPaginator(r.dynamoClient, &dynamodb.ScanInput{
+		TableName:        aws.String(r.table),
+		FilterExpression: aws.String("begins_with(PK, :lpa) AND (begins_with(SK, :donor) OR begins_with(SK, :organisation))"),
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":lpa":          &types.AttributeValueMemberS{Value: dynamo.LpaKey("").PK()},
+			":donor":        &types.AttributeValueMemberS{Value: dynamo.DonorKey("").SK()},
+			":organisation": &types.AttributeValueMemberS{Value: dynamo.OrganisationKey("").SK()},
+		},
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to scan %s: %w", r.table, err)
+		}
+
+		var donors []donordata.Provided
+		if err := attributevalue.UnmarshalListOfMaps(page.Items, &donors); err != nil {
+			return fmt.Errorf("failed to unmarshal donors: %w", err)
+		}
+
+		for _, donor := range donors {
+			scanned++
+
+			// LPAs without a UID are not indexed by the donor store either.
+			if donor.LpaUID == "" {
+				continue
+			}
+
+			batch = append(batch, search.LpaFromDonorProvided(&donor))
+			if len(batch) >= r.batchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+
+	for _, failure := range failures {
+		fmt.Fprintf(r.out, "failed to index %s: %s\n", failure.PK, failure.Reason)
+	}
+
+	if len(failures) > 0 && !r.force {
+		return fmt.Errorf("%d LPAs failed to index, alias %s has not been changed", len(failures), r.alias)
+	}
+
+	previous, err := r.searchClient.SwapAlias(ctx, r.alias, index)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(r.out, "alias %s now points to %s", r.alias, index)
+	if len(previous) > 0 {
+		fmt.Fprintf(r.out, ", replacing %s", strings.Join(previous, ", "))
+	}
+	fmt.Fprintln(r.out)
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/search"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	ctx           = context.Background()
+	expectedError = errors.New("err")
+	testNow       = time.Date(2023, time.April, 2, 3, 4, 5, 6, time.UTC)
+	testNowFn     = func() time.Time { return testNow }
+)
+
+const testIndex = "lpas_20230402030405"
+
+func marshalDonors(t *testing.T, donors ...donordata.Provided) []map[string]types.AttributeValue {
+	items := make([]map[string]types.AttributeValue, len(donors))
+	for i, donor := range donors {
+		item, err := attributevalue.MarshalMap(donor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		items[i] = item
+	}
+
+	return items
+}
+
+func TestReindexerRun(t *testing.T) {
+	donorA := donordata.Provided{PK: dynamo.LpaKey("a"), SK: dynamo.LpaOwnerKey(dynamo.DonorKey("x")), LpaUID: "M-A"}
+	donorB := donordata.Provided{PK: dynamo.LpaKey("b"), SK: dynamo.LpaOwnerKey(dynamo.DonorKey("y")), LpaUID: "M-B"}
+	donorC := donordata.Provided{PK: dynamo.LpaKey("c"), SK: dynamo.LpaOwnerKey(dynamo.OrganisationKey("z")), LpaUID: "M-C"}
+	withoutUID := donordata.Provided{PK: dynamo.LpaKey("d"), SK: dynamo.LpaOwnerKey(dynamo.DonorKey("w"))}
+
+	lastKey := map[string]types.AttributeValue{"PK": &types.AttributeValueMemberS{Value: "LPA#b"}}
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		Scan(ctx, mock.MatchedBy(func(input *dynamodb.ScanInput) bool {
+			return *input.TableName == "lpas-table" && input.ExclusiveStartKey == nil
+		}), mock.Anything).
+		Return(&dynamodb.ScanOutput{
+			Items:            marshalDonors(t, donorA, withoutUID, donorB),
+			LastEvaluatedKey: lastKey,
+		}, nil)
+	dynamoClient.EXPECT().
+		Scan(ctx, mock.MatchedBy(func(input *dynamodb.ScanInput) bool {
+			return input.ExclusiveStartKey != nil
+		}), mock.Anything).
+		Return(&dynamodb.ScanOutput{
+			Items: marshalDonors(t, donorC),
+		}, nil)
+
+	searchClient := newMockSearchClient(t)
+	searchClient.EXPECT().
+		CreateIndex(ctx, testIndex).
+		Return(nil)
+	searchClient.EXPECT().
+		BulkIndex(ctx, testIndex, []search.Lpa{search.LpaFromDonorProvided(&donorA), search.LpaFromDonorProvided(&donorB)}).
+		Return(nil, nil).
+		Once()
+	searchClient.EXPECT().
+		BulkIndex(ctx, testIndex, []search.Lpa{search.LpaFromDonorProvided(&donorC)}).
+		Return(nil, nil).
+		Once()
+	searchClient.EXPECT().
+		SwapAlias(ctx, "lpas", testIndex).
+		Return([]string{"lpas_20230101000000"}, nil)
+
+	var out bytes.Buffer
+	r := &reindexer{
+		dynamoClient: dynamoClient,
+		searchClient: searchClient,
+		out:          &out,
+		now:          testNowFn,
+		table:        "lpas-table",
+		alias:        "lpas",
+		batchSize:    2,
+	}
+
+	err := r.Run(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, `created index lpas_20230402030405
+scanned 3, indexed 2, failed 0
+scanned 4, indexed 3, failed 0
+alias lpas now points to lpas_20230402030405, replacing lpas_20230101000000
+`, out.String())
+}
+
+func TestReindexerRunWhenFailures(t *testing.T) {
+	donor := donordata.Provided{PK: dynamo.LpaKey("a"), SK: dynamo.LpaOwnerKey(dynamo.DonorKey("x")), LpaUID: "M-A"}
+
+	testcases := map[string]struct {
+		force  bool
+		swap   bool
+		output string
+		err    error
+	}{
+		"not forced": {
+			output: `created index lpas_20230402030405
+scanned 1, indexed 0, failed 1
+failed to index LPA#a: mapper_parsing_exception: bad
+`,
+			err: errors.New("1 LPAs failed to index, alias lpas has not been changed"),
+		},
+		"forced": {
+			force: true,
+			swap:  true,
+			output: `created index lpas_20230402030405
+scanned 1, indexed 0, failed 1
+failed to index LPA#a: mapper_parsing_exception: bad
+alias lpas now points to lpas_20230402030405
+`,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			dynamoClient := newMockDynamoClient(t)
+			dynamoClient.EXPECT().
+				Scan(ctx, mock.Anything, mock.Anything).
+				Return(&dynamodb.ScanOutput{Items: marshalDonors(t, donor)}, nil)
+
+			searchClient := newMockSearchClient(t)
+			searchClient.EXPECT().
+				CreateIndex(ctx, testIndex).
+				Return(nil)
+			searchClient.EXPECT().
+				BulkIndex(ctx, testIndex, mock.Anything).
+				Return([]search.BulkIndexFailure{{PK: "LPA#a", Reason: "mapper_parsing_exception: bad"}}, nil)
+			if tc.swap {
+				searchClient.EXPECT().
+					SwapAlias(ctx, "lpas", testIndex).
+					Return(nil, nil)
+			}
+
+			var out bytes.Buffer
+			r := &reindexer{
+				dynamoClient: dynamoClient,
+				searchClient: searchClient,
+				out:          &out,
+				now:          testNowFn,
+				table:        "lpas-table",
+				alias:        "lpas",
+				batchSize:    10,
+				force:        tc.force,
+			}
+
+			err := r.Run(ctx)
+			assert.Equal(t, tc.err, err)
+			assert.Equal(t, tc.output, out.String())
+		})
+	}
+}
+
+func TestReindexerRunWhenCreateIndexErrors(t *testing.T) {
+	searchClient := newMockSearchClient(t)
+	searchClient.EXPECT().
+		CreateIndex(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	r := &reindexer{searchClient: searchClient, out: &bytes.Buffer{}, now: testNowFn, alias: "lpas"}
+
+	err := r.Run(ctx)
+	assert.Equal(t, expectedError, err)
+}
+
+func TestReindexerRunWhenScanErrors(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		Scan(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, expectedError)
+
+	searchClient := newMockSearchClient(t)
+	searchClient.EXPECT().
+		CreateIndex(mock.Anything, mock.Anything).
+		Return(nil)
+
+	r := &reindexer{dynamoClient: dynamoClient, searchClient: searchClient, out: &bytes.Buffer{}, now: testNowFn, table: "lpas-table", alias: "lpas", batchSize: 10}
+
+	err := r.Run(ctx)
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestReindexerRunWhenBulkIndexErrors(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		Scan(mock.Anything, mock.Anything, mock.Anything).
+		Return(&dynamodb.ScanOutput{}, nil)
+
+	searchClient := newMockSearchClient(t)
+	searchClient.EXPECT().
+		CreateIndex(mock.Anything, mock.Anything).
+		Return(nil)
+	searchClient.EXPECT().
+		BulkIndex(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, expectedError)
+
+	r := &reindexer{dynamoClient: dynamoClient, searchClient: searchClient, out: &bytes.Buffer{}, now: testNowFn, table: "lpas-table", alias: "lpas", batchSize: 10}
+
+	err := r.Run(ctx)
+	assert.Equal(t, expectedError, err)
+}
+
+func TestReindexerRunWhenSwapAliasErrors(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		Scan(mock.Anything, mock.Anything, mock.Anything).
+		Return(&dynamodb.ScanOutput{}, nil)
+
+	searchClient := newMockSearchClient(t)
+	searchClient.EXPECT().
+		CreateIndex(mock.Anything, mock.Anything).
+		Return(nil)
+	searchClient.EXPECT().
+		BulkIndex(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil)
+	searchClient.EXPECT().
+		SwapAlias(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, expectedError)
+
+	r := &reindexer{dynamoClient: dynamoClient, searchClient: searchClient, out: &bytes.Buffer{}, now: testNowFn, table: "lpas-table", alias: "lpas", batchSize: 10}
+
+	err := r.Run(ctx)
+	assert.Equal(t, expectedError, err)
+}
@@ -89,7 +89,9 @@ cat > lambda-env.json <<EOF
   "DOCKER_HOST": "$DOCKER_HOST",
   "DONOR_START_URL": "$DONOR_START_URL",
   "EVENT_BUS_NAME": "$EVENT_BUS_NAME",
+  "FEE_SCHEDULES": "${FEE_SCHEDULES//\"/\\\"}",
   "GOVUK_NOTIFY_BASE_URL": "$GOVUK_NOTIFY_BASE_URL",
+  "GOVUK_PAY_BASE_URL": "$GOVUK_PAY_BASE_URL",
   "LPA_STORE_BASE_URL": "$LPA_STORE_BASE_URL",
   "LPA_STORE_SECRET_ARN": "$LPA_STORE_SECRET_ARN",
   "LPAS_TABLE": "$LPAS_TABLE",
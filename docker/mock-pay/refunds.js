@@ -0,0 +1,38 @@
+console.log(`Request - ${context.request.method} ${context.request.path}`);
+
+const refundsStore = stores.open('refunds');
+
+switch (context.request.method) {
+    case 'GET':
+        const refund = JSON.parse(refundsStore.load(context.request.pathParams.refundId))
+        refund.status = 'success'
+
+        respond()
+            .withStatusCode(200)
+            .withContent(JSON.stringify(refund))
+            .skipDefaultBehaviour()
+        break
+
+    case 'POST':
+        const body = JSON.parse(context.request.body);
+        const refundId = Math.random().toString(36).substring(2, 15);
+
+        const response = {
+            refund_id: refundId,
+            created_date: new Date().toISOString(),
+            amount: body.amount,
+            status: 'submitted',
+            settlement_summary: {},
+        }
+
+        refundsStore.save(refundId, JSON.stringify(response));
+
+        respond()
+            .withStatusCode(202)
+            .withContent(JSON.stringify(response))
+            .skipDefaultBehaviour()
+        break
+
+    default:
+        respond()
+}
@@ -1,17 +1,34 @@
 const characters = '346789QWERTYUPADFGHJKLXCVBNM'
 
-const generateRandom4Chars = function() {
+const generateRandomChars = function(length) {
     let result = [];
 
-    for(let i = 0; i < 4; i++) {
+    for(let i = 0; i < length; i++) {
         result.push(characters.charAt(Math.floor(Math.random() * characters.length)));
     }
 
     return result.join('');
 }
 
+// Luhn mod N, matching uid.Parse
+const checkCharacter = function(payload) {
+    var n = characters.length;
+    var factor = 2;
+    var sum = 0;
+
+    for(var i = payload.length - 1; i >= 0; i--) {
+        var addend = factor * characters.indexOf(payload.charAt(i));
+        sum += Math.floor(addend / n) + (addend % n);
+        factor = factor === 2 ? 1 : 2;
+    }
+
+    return characters.charAt((n - (sum % n)) % n);
+}
+
 //gross, but imposter doesn't support ES6 out of the box (also explains vars)
-const uid = `M-${generateRandom4Chars()}-${generateRandom4Chars()}-${generateRandom4Chars()}`
+const payload = generateRandomChars(11)
+const chars = payload + checkCharacter(payload)
+const uid = `M-${chars.substring(0, 4)}-${chars.substring(4, 8)}-${chars.substring(8, 12)}`
 
 respond()
     .withStatusCode(201)
@@ -1,10 +1,22 @@
 package accesscodedata
 
-import "github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+import (
+	"time"
 
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+)
+
+// ActorAccess points to the access code most recently sent to an actor, so
+// that sending a new code invalidates the previous one.
 type ActorAccess struct {
 	PK           dynamo.ActorAccessKeyType
 	SK           dynamo.MetadataKeyType
 	ShareKey     dynamo.AccessKeyType
 	ShareSortKey dynamo.AccessSortKeyType
+	ActorType    actor.Type
+	SentAt       time.Time
+	// CodeExpiresAt is when the access code expires, it is not named ExpiresAt
+	// as this record should not be removed by the TTL.
+	CodeExpiresAt time.Time
 }
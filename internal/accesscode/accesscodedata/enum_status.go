@@ -0,0 +1,88 @@
+// Code generated by "enumerator -type Status -linecomment -trimprefix"; DO NOT EDIT.
+
+package accesscodedata
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[StatusNotSent-0]
+	_ = x[StatusSent-1]
+	_ = x[StatusUsed-2]
+	_ = x[StatusExpired-3]
+}
+
+const _Status_name = "notSentsentusedexpired"
+
+var _Status_index = [...]uint8{0, 7, 11, 15, 22}
+
+func (i Status) String() string {
+	if i >= Status(len(_Status_index)-1) {
+		return "Status(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Status_name[_Status_index[i]:_Status_index[i+1]]
+}
+
+func (i Status) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *Status) UnmarshalText(text []byte) error {
+	val, err := ParseStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
+
+func (i Status) IsNotSent() bool {
+	return i == StatusNotSent
+}
+
+func (i Status) IsSent() bool {
+	return i == StatusSent
+}
+
+func (i Status) IsUsed() bool {
+	return i == StatusUsed
+}
+
+func (i Status) IsExpired() bool {
+	return i == StatusExpired
+}
+
+func ParseStatus(s string) (Status, error) {
+	switch s {
+	case "notSent":
+		return StatusNotSent, nil
+	case "sent":
+		return StatusSent, nil
+	case "used":
+		return StatusUsed, nil
+	case "expired":
+		return StatusExpired, nil
+	default:
+		return Status(0), fmt.Errorf("invalid Status '%s'", s)
+	}
+}
+
+type StatusOptions struct {
+	NotSent Status
+	Sent    Status
+	Used    Status
+	Expired Status
+}
+
+var StatusValues = StatusOptions{
+	NotSent: StatusNotSent,
+	Sent:    StatusSent,
+	Used:    StatusUsed,
+	Expired: StatusExpired,
+}
@@ -0,0 +1,11 @@
+package accesscodedata
+
+//go:generate go tool enumerator -type Status -linecomment -trimprefix
+type Status uint8
+
+const (
+	StatusNotSent Status = iota // notSent
+	StatusSent                  // sent
+	StatusUsed                  // used
+	StatusExpired               // expired
+)
@@ -0,0 +1,25 @@
+package accesscodedata
+
+import (
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor/actoruid"
+)
+
+// A Summary describes the access code most recently sent to an actor on an
+// LPA.
+type Summary struct {
+	ActorUID  actoruid.UID
+	ActorType actor.Type
+	FullName  string
+	Status    Status
+	SentAt    time.Time
+	ExpiresAt time.Time
+}
+
+// CanResend returns true if a new access code can replace the one sent. Codes
+// for vouchers are sent to the donor, so are resent as part of their journey.
+func (s Summary) CanResend() bool {
+	return !s.ActorType.IsVoucher() && (s.Status.IsSent() || s.Status.IsExpired())
+}
@@ -0,0 +1,27 @@
+package accesscodedata
+
+import (
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummaryCanResend(t *testing.T) {
+	testcases := map[string]struct {
+		summary  Summary
+		expected bool
+	}{
+		"sent":     {summary: Summary{ActorType: actor.TypeAttorney, Status: StatusSent}, expected: true},
+		"expired":  {summary: Summary{ActorType: actor.TypeCertificateProvider, Status: StatusExpired}, expected: true},
+		"used":     {summary: Summary{ActorType: actor.TypeAttorney, Status: StatusUsed}},
+		"not sent": {summary: Summary{ActorType: actor.TypeAttorney, Status: StatusNotSent}},
+		"voucher":  {summary: Summary{ActorType: actor.TypeVoucher, Status: StatusSent}},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.summary.CanResend())
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 
 	dynamo "github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
 	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 )
 
 // mockDynamoClient is an autogenerated mock type for the DynamoClient type
@@ -22,6 +24,65 @@ func (_m *mockDynamoClient) EXPECT() *mockDynamoClient_Expecter {
 	return &mockDynamoClient_Expecter{mock: &_m.Mock}
 }
 
+// AllByKeys provides a mock function with given fields: ctx, keys
+func (_m *mockDynamoClient) AllByKeys(ctx context.Context, keys []dynamo.Keys) ([]map[string]types.AttributeValue, error) {
+	ret := _m.Called(ctx, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllByKeys")
+	}
+
+	var r0 []map[string]types.AttributeValue
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []dynamo.Keys) ([]map[string]types.AttributeValue, error)); ok {
+		return rf(ctx, keys)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []dynamo.Keys) []map[string]types.AttributeValue); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]types.AttributeValue)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []dynamo.Keys) error); ok {
+		r1 = rf(ctx, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockDynamoClient_AllByKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllByKeys'
+type mockDynamoClient_AllByKeys_Call struct {
+	*mock.Call
+}
+
+// AllByKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keys []dynamo.Keys
+func (_e *mockDynamoClient_Expecter) AllByKeys(ctx interface{}, keys interface{}) *mockDynamoClient_AllByKeys_Call {
+	return &mockDynamoClient_AllByKeys_Call{Call: _e.mock.On("AllByKeys", ctx, keys)}
+}
+
+func (_c *mockDynamoClient_AllByKeys_Call) Run(run func(ctx context.Context, keys []dynamo.Keys)) *mockDynamoClient_AllByKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]dynamo.Keys))
+	})
+	return _c
+}
+
+func (_c *mockDynamoClient_AllByKeys_Call) Return(_a0 []map[string]types.AttributeValue, _a1 error) *mockDynamoClient_AllByKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockDynamoClient_AllByKeys_Call) RunAndReturn(run func(context.Context, []dynamo.Keys) ([]map[string]types.AttributeValue, error)) *mockDynamoClient_AllByKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, v
 func (_m *mockDynamoClient) Create(ctx context.Context, v interface{}) error {
 	ret := _m.Called(ctx, v)
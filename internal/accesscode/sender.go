@@ -266,6 +266,83 @@ func (s *Sender) SendVoucherAccessCode(ctx context.Context, provided *donordata.
 	return nil
 }
 
+// ResendAccessCode sends a new access code to an actor on the LPA, the code
+// previously sent to them can no longer be used.
+func (s *Sender) ResendAccessCode(ctx context.Context, appData appcontext.Data, lpa *lpadata.Lpa, actorUID actoruid.UID) error {
+	if lpa.CertificateProvider.UID == actorUID {
+		return s.resendCertificateProvider(ctx, appData, lpa)
+	}
+
+	if lpa.Attorneys.TrustCorporation.UID == actorUID {
+		accessCode, err := s.createAccessCode(ctx, lpa.LpaKey, lpa.LpaOwnerKey, lpa.LpaUID, lpa.Donor.LastName, actorUID, actor.TypeTrustCorporation)
+		if err != nil {
+			return err
+		}
+
+		return s.sendTrustCorporationCode(ctx, appData, lpa, lpa.Attorneys.TrustCorporation, accessCode)
+	}
+
+	if lpa.ReplacementAttorneys.TrustCorporation.UID == actorUID {
+		accessCode, err := s.createAccessCode(ctx, lpa.LpaKey, lpa.LpaOwnerKey, lpa.LpaUID, lpa.Donor.LastName, actorUID, actor.TypeReplacementTrustCorporation)
+		if err != nil {
+			return err
+		}
+
+		return s.sendReplacementTrustCorporationCode(ctx, appData, lpa, lpa.ReplacementAttorneys.TrustCorporation, accessCode)
+	}
+
+	if attorney, ok := lpa.Attorneys.Get(actorUID); ok {
+		accessCode, err := s.createAccessCode(ctx, lpa.LpaKey, lpa.LpaOwnerKey, lpa.LpaUID, lpa.Donor.LastName, actorUID, actor.TypeAttorney)
+		if err != nil {
+			return err
+		}
+
+		return s.sendOriginalAttorneyCode(ctx, appData, lpa, attorney, accessCode)
+	}
+
+	if attorney, ok := lpa.ReplacementAttorneys.Get(actorUID); ok {
+		accessCode, err := s.createAccessCode(ctx, lpa.LpaKey, lpa.LpaOwnerKey, lpa.LpaUID, lpa.Donor.LastName, actorUID, actor.TypeReplacementAttorney)
+		if err != nil {
+			return err
+		}
+
+		return s.sendReplacementAttorneyCode(ctx, appData, lpa, attorney, accessCode)
+	}
+
+	return fmt.Errorf("cannot resend access code to actor %s", actorUID.PrefixedString())
+}
+
+func (s *Sender) resendCertificateProvider(ctx context.Context, appData appcontext.Data, lpa *lpadata.Lpa) error {
+	accessCode, err := s.createAccessCode(ctx, lpa.LpaKey, lpa.LpaOwnerKey, lpa.LpaUID, lpa.Donor.LastName, lpa.CertificateProvider.UID, actor.TypeCertificateProvider)
+	if err != nil {
+		return err
+	}
+
+	if lpa.CertificateProvider.Channel.IsPaper() {
+		return s.sendPaperForm(ctx, lpa.LpaUID, actor.TypeCertificateProvider, lpa.CertificateProvider.UID, accessCode)
+	}
+
+	whatLpaCovers := "whatPropertyAndAffairsCovers"
+	if lpa.Type.IsPersonalWelfare() {
+		whatLpaCovers = "whatPersonalWelfareCovers"
+	}
+
+	// There is no certificate provider record yet, so assume English
+	to := notify.ToLpaCertificateProvider(&certificateproviderdata.Provided{ContactLanguagePreference: localize.En}, lpa)
+
+	return s.sendEmail(ctx, to, lpa.LpaUID, notify.CertificateProviderInviteEmail{
+		CertificateProviderFullName:  lpa.CertificateProvider.FullName(),
+		DonorFullName:                lpa.Donor.FullName(),
+		LpaType:                      localize.LowerFirst(appData.Localizer.T(lpa.Type.String())),
+		CertificateProviderStartURL:  s.certificateProviderStartURL,
+		DonorFirstNames:              lpa.Donor.FirstNames,
+		DonorFirstNamesPossessive:    appData.Localizer.Possessive(lpa.Donor.FirstNames),
+		WhatLpaCovers:                appData.Localizer.T(whatLpaCovers),
+		AccessCode:                   accessCode.Plain(),
+		CertificateProviderOptOutURL: fmt.Sprintf("%s%s", s.appPublicURL, page.PathCertificateProviderEnterAccessCodeOptOut),
+	})
+}
+
 func (s *Sender) sendOriginalAttorney(ctx context.Context, appData appcontext.Data, lpa *lpadata.Lpa, attorney lpadata.Attorney) error {
 	accessCode, err := s.createAccessCode(ctx, lpa.LpaKey, lpa.LpaOwnerKey, lpa.LpaUID, lpa.Donor.LastName, attorney.UID, actor.TypeAttorney)
 	if err != nil {
@@ -276,6 +353,10 @@ func (s *Sender) sendOriginalAttorney(ctx context.Context, appData appcontext.Da
 		return err
 	}
 
+	return s.sendOriginalAttorneyCode(ctx, appData, lpa, attorney, accessCode)
+}
+
+func (s *Sender) sendOriginalAttorneyCode(ctx context.Context, appData appcontext.Data, lpa *lpadata.Lpa, attorney lpadata.Attorney, accessCode accesscodedata.PlainText) error {
 	if attorney.Email == "" {
 		return s.sendPaperForm(ctx, lpa.LpaUID, actor.TypeAttorney, attorney.UID, accessCode)
 	}
@@ -303,6 +384,10 @@ func (s *Sender) sendReplacementAttorney(ctx context.Context, appData appcontext
 		return err
 	}
 
+	return s.sendReplacementAttorneyCode(ctx, appData, lpa, attorney, accessCode)
+}
+
+func (s *Sender) sendReplacementAttorneyCode(ctx context.Context, appData appcontext.Data, lpa *lpadata.Lpa, attorney lpadata.Attorney, accessCode accesscodedata.PlainText) error {
 	if attorney.Email == "" {
 		return s.sendPaperForm(ctx, lpa.LpaUID, actor.TypeReplacementAttorney, attorney.UID, accessCode)
 	}
@@ -334,6 +419,10 @@ func (s *Sender) sendTrustCorporation(ctx context.Context, appData appcontext.Da
 		return err
 	}
 
+	return s.sendTrustCorporationCode(ctx, appData, lpa, trustCorporation, accessCode)
+}
+
+func (s *Sender) sendTrustCorporationCode(ctx context.Context, appData appcontext.Data, lpa *lpadata.Lpa, trustCorporation lpadata.TrustCorporation, accessCode accesscodedata.PlainText) error {
 	if trustCorporation.Email == "" {
 		return s.sendPaperForm(ctx, lpa.LpaUID, actor.TypeTrustCorporation, trustCorporation.UID, accessCode)
 	}
@@ -365,6 +454,10 @@ func (s *Sender) sendReplacementTrustCorporation(ctx context.Context, appData ap
 		return err
 	}
 
+	return s.sendReplacementTrustCorporationCode(ctx, appData, lpa, trustCorporation, accessCode)
+}
+
+func (s *Sender) sendReplacementTrustCorporationCode(ctx context.Context, appData appcontext.Data, lpa *lpadata.Lpa, trustCorporation lpadata.TrustCorporation, accessCode accesscodedata.PlainText) error {
 	if trustCorporation.Email == "" {
 		return s.sendPaperForm(ctx, lpa.LpaUID, actor.TypeReplacementTrustCorporation, trustCorporation.UID, accessCode)
 	}
@@ -1898,3 +1898,235 @@ func TestSendVoucherInviteWhenNotifyClientError(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessCodeSenderResendAccessCodeToCertificateProvider(t *testing.T) {
+	actorUID := actoruid.New()
+	lpa := &lpadata.Lpa{
+		LpaKey:      dynamo.LpaKey("lpa"),
+		LpaOwnerKey: dynamo.LpaOwnerKey(dynamo.DonorKey("donor")),
+		LpaUID:      "lpa-uid",
+		CertificateProvider: lpadata.CertificateProvider{
+			UID:        actorUID,
+			FirstNames: "Joanna",
+			LastName:   "Jones",
+			Email:      "name@example.org",
+			Channel:    lpadata.ChannelOnline,
+		},
+		Donor: lpadata.Donor{
+			FirstNames: "Jan",
+			LastName:   "Smith",
+		},
+		Type: lpadata.LpaTypePropertyAndAffairs,
+	}
+
+	localizer := newMockLocalizer(t)
+	localizer.EXPECT().
+		T(lpa.Type.String()).
+		Return("Property and affairs").
+		Once()
+	localizer.EXPECT().
+		T("whatPropertyAndAffairsCovers").
+		Return("houses and stuff").
+		Once()
+	localizer.EXPECT().
+		Possessive("Jan").
+		Return("Jan’s")
+	testAppData.Localizer = localizer
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Put(ctx, actor.TypeCertificateProvider, testHashedCode, accesscodedata.Link{
+			LpaKey:      dynamo.LpaKey("lpa"),
+			LpaOwnerKey: dynamo.LpaOwnerKey(dynamo.DonorKey("donor")),
+			LpaUID:      "lpa-uid",
+			ActorUID:    actorUID,
+		}).
+		Return(nil)
+
+	notifyClient := newMockNotifyClient(t)
+	notifyClient.EXPECT().
+		SendActorEmail(ctx, notify.ToLpaCertificateProvider(&certificateproviderdata.Provided{ContactLanguagePreference: localize.En}, lpa), "lpa-uid", notify.CertificateProviderInviteEmail{
+			AccessCode:                   testPlainCode.Plain(),
+			CertificateProviderFullName:  "Joanna Jones",
+			DonorFirstNames:              "Jan",
+			DonorFullName:                "Jan Smith",
+			LpaType:                      "property and affairs",
+			CertificateProviderStartURL:  "http://example.com/certificate-provider",
+			DonorFirstNamesPossessive:    "Jan’s",
+			WhatLpaCovers:                "houses and stuff",
+			CertificateProviderOptOutURL: fmt.Sprintf("http://app%s", page.PathCertificateProviderEnterAccessCodeOptOut),
+		}).
+		Return(nil)
+
+	sender := &Sender{
+		accessCodeStore:             accessCodeStore,
+		notifyClient:                notifyClient,
+		appPublicURL:                "http://app",
+		certificateProviderStartURL: "http://example.com/certificate-provider",
+		generate:                    testGenerateFn,
+	}
+	err := sender.ResendAccessCode(ctx, testAppData, lpa, actorUID)
+
+	assert.Nil(t, err)
+}
+
+func TestAccessCodeSenderResendAccessCodeToPaperActors(t *testing.T) {
+	actorUID := actoruid.New()
+
+	testcases := map[actor.Type]*lpadata.Lpa{
+		actor.TypeCertificateProvider: {
+			CertificateProvider: lpadata.CertificateProvider{UID: actorUID, Channel: lpadata.ChannelPaper},
+		},
+		actor.TypeAttorney: {
+			Attorneys: lpadata.Attorneys{Attorneys: []lpadata.Attorney{{UID: actorUID}}},
+		},
+		actor.TypeReplacementAttorney: {
+			ReplacementAttorneys: lpadata.Attorneys{Attorneys: []lpadata.Attorney{{UID: actorUID}}},
+		},
+		actor.TypeTrustCorporation: {
+			Attorneys: lpadata.Attorneys{TrustCorporation: lpadata.TrustCorporation{UID: actorUID, Name: "Corp"}},
+		},
+		actor.TypeReplacementTrustCorporation: {
+			ReplacementAttorneys: lpadata.Attorneys{TrustCorporation: lpadata.TrustCorporation{UID: actorUID, Name: "Corp"}},
+		},
+	}
+
+	for actorType, lpa := range testcases {
+		t.Run(actorType.String(), func(t *testing.T) {
+			lpa.LpaUID = "lpa-uid"
+			lpa.Donor = lpadata.Donor{LastName: "Smith"}
+
+			accessCodeStore := newMockAccessCodeStore(t)
+			accessCodeStore.EXPECT().
+				Put(ctx, actorType, testHashedCode, mock.MatchedBy(func(link accesscodedata.Link) bool {
+					return link.ActorUID == actorUID && link.LpaUID == "lpa-uid"
+				})).
+				Return(nil)
+
+			eventClient := newMockEventClient(t)
+			eventClient.EXPECT().
+				SendPaperFormRequested(ctx, event.PaperFormRequested{
+					UID:        "lpa-uid",
+					ActorType:  actorType.String(),
+					ActorUID:   actorUID,
+					AccessCode: testPlainCode.Plain(),
+				}).
+				Return(nil)
+
+			sender := &Sender{
+				accessCodeStore: accessCodeStore,
+				eventClient:     eventClient,
+				generate:        testGenerateFn,
+			}
+			err := sender.ResendAccessCode(ctx, testAppData, lpa, actorUID)
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestAccessCodeSenderResendAccessCodeToAttorney(t *testing.T) {
+	actorUID := actoruid.New()
+	attorney := lpadata.Attorney{
+		UID:        actorUID,
+		FirstNames: "Amy",
+		LastName:   "Adams",
+		Email:      "amy@example.com",
+	}
+	lpa := &lpadata.Lpa{
+		LpaUID:    "lpa-uid",
+		Type:      lpadata.LpaTypePropertyAndAffairs,
+		Donor:     lpadata.Donor{FirstNames: "Jan", LastName: "Smith"},
+		Attorneys: lpadata.Attorneys{Attorneys: []lpadata.Attorney{attorney}},
+	}
+
+	localizer := newMockLocalizer(t)
+	localizer.EXPECT().
+		T(lpa.Type.String()).
+		Return("Property and affairs")
+	localizer.EXPECT().
+		Possessive("Jan").
+		Return("Jan’s")
+	testAppData.Localizer = localizer
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Put(ctx, actor.TypeAttorney, testHashedCode, accesscodedata.Link{
+			LpaUID:   "lpa-uid",
+			ActorUID: actorUID,
+		}).
+		Return(nil)
+
+	notifyClient := newMockNotifyClient(t)
+	notifyClient.EXPECT().
+		SendActorEmail(ctx, notify.ToLpaAttorney(attorney), "lpa-uid", notify.InitialOriginalAttorneyEmail{
+			AttorneyFullName:          "Amy Adams",
+			DonorFirstNames:           "Jan",
+			DonorFirstNamesPossessive: "Jan’s",
+			DonorFullName:             "Jan Smith",
+			LpaType:                   "property and affairs",
+			AttorneyStartPageURL:      "http://example.com/attorney",
+			AccessCode:                testPlainCode.Plain(),
+			AttorneyOptOutURL:         "http://app" + page.PathAttorneyEnterAccessCodeOptOut.Format(),
+		}).
+		Return(nil)
+
+	sender := &Sender{
+		accessCodeStore:  accessCodeStore,
+		notifyClient:     notifyClient,
+		appPublicURL:     "http://app",
+		attorneyStartURL: "http://example.com/attorney",
+		generate:         testGenerateFn,
+	}
+	err := sender.ResendAccessCode(ctx, testAppData, lpa, actorUID)
+
+	assert.Nil(t, err)
+}
+
+func TestAccessCodeSenderResendAccessCodeWhenActorNotFound(t *testing.T) {
+	sender := &Sender{}
+	err := sender.ResendAccessCode(ctx, testAppData, &lpadata.Lpa{
+		CertificateProvider: lpadata.CertificateProvider{UID: actoruid.New()},
+	}, actoruid.New())
+
+	assert.Error(t, err)
+}
+
+func TestAccessCodeSenderResendAccessCodeWhenAccessCodeStoreErrors(t *testing.T) {
+	actorUID := actoruid.New()
+
+	testcases := map[string]*lpadata.Lpa{
+		"certificate provider": {
+			CertificateProvider: lpadata.CertificateProvider{UID: actorUID},
+		},
+		"attorney": {
+			Attorneys: lpadata.Attorneys{Attorneys: []lpadata.Attorney{{UID: actorUID}}},
+		},
+		"replacement attorney": {
+			ReplacementAttorneys: lpadata.Attorneys{Attorneys: []lpadata.Attorney{{UID: actorUID}}},
+		},
+		"trust corporation": {
+			Attorneys: lpadata.Attorneys{TrustCorporation: lpadata.TrustCorporation{UID: actorUID}},
+		},
+		"replacement trust corporation": {
+			ReplacementAttorneys: lpadata.Attorneys{TrustCorporation: lpadata.TrustCorporation{UID: actorUID}},
+		},
+	}
+
+	for name, lpa := range testcases {
+		t.Run(name, func(t *testing.T) {
+			accessCodeStore := newMockAccessCodeStore(t)
+			accessCodeStore.EXPECT().
+				Put(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(expectedError)
+
+			sender := &Sender{
+				accessCodeStore: accessCodeStore,
+				generate:        testGenerateFn,
+			}
+			err := sender.ResendAccessCode(ctx, testAppData, lpa, actorUID)
+
+			assert.ErrorIs(t, err, expectedError)
+		})
+	}
+}
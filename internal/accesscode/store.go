@@ -6,11 +6,15 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/accesscode/accesscodedata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor/actoruid"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/random"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/rate"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
 )
@@ -23,15 +27,52 @@ type DynamoClient interface {
 	Put(ctx context.Context, v any) error
 	DeleteOne(ctx context.Context, pk dynamo.PK, sk dynamo.SK) error
 	WriteTransaction(ctx context.Context, transaction *dynamo.Transaction) error
+	AllByKeys(ctx context.Context, keys []dynamo.Keys) ([]map[string]dynamodbtypes.AttributeValue, error)
+}
+
+type Limiter interface {
+	Allow(ctx context.Context, policy rate.Policy, key string) error
+}
+
+// A Lifetime is how long an access code can be used for after it is sent.
+type Lifetime struct {
+	Years, Months, Days int
+}
+
+// Expiry gives the Lifetime of access codes by the type of actor they are sent
+// to, with Default used for any type not listed.
+type Expiry struct {
+	Default     Lifetime
+	ByActorType map[actor.Type]Lifetime
+}
+
+var DefaultExpiry = Expiry{
+	Default: Lifetime{Years: 2},
+	ByActorType: map[actor.Type]Lifetime{
+		// Donors are only sent access codes when invited by an organisation
+		actor.TypeDonor: {Months: 3},
+	},
+}
+
+func (e Expiry) from(t time.Time, actorType actor.Type) time.Time {
+	lifetime, ok := e.ByActorType[actorType]
+	if !ok {
+		lifetime = e.Default
+	}
+
+	return t.AddDate(lifetime.Years, lifetime.Months, lifetime.Days)
 }
 
 type Store struct {
 	dynamoClient DynamoClient
+	limiter      Limiter
+	expiry       Expiry
+	uuidString   func() string
 	now          func() time.Time
 }
 
-func NewStore(dynamoClient DynamoClient) *Store {
-	return &Store{dynamoClient: dynamoClient, now: time.Now}
+func NewStore(dynamoClient DynamoClient, expiry Expiry) *Store {
+	return &Store{dynamoClient: dynamoClient, limiter: rate.NewStore(dynamoClient), expiry: expiry, uuidString: random.UUID, now: time.Now}
 }
 
 func (s *Store) Get(ctx context.Context, actorType actor.Type, accessCode accesscodedata.Hashed) (accesscodedata.Link, error) {
@@ -40,7 +81,7 @@ func (s *Store) Get(ctx context.Context, actorType actor.Type, accessCode access
 		return accesscodedata.Link{}, err
 	}
 
-	if err := s.allowed(ctx); err != nil {
+	if err := s.limiter.Allow(ctx, rate.AccessCode, sessionKey(ctx)); err != nil {
 		return accesscodedata.Link{}, err
 	}
 
@@ -72,13 +113,16 @@ func (s *Store) Put(ctx context.Context, actorType actor.Type, accessCode access
 		data.SK = dynamo.AccessSortKey(dynamo.MetadataKey(accessCode.String()))
 	}
 	data.UpdatedAt = s.now()
-	data.ExpiresAt = s.now().AddDate(2, 0, 0)
+	data.ExpiresAt = s.expiry.from(s.now(), actorType)
 
 	newActorAccess := accesscodedata.ActorAccess{
-		PK:           dynamo.ActorAccessKey(data.ActorUID.String()),
-		SK:           dynamo.MetadataKey(data.ActorUID.String()),
-		ShareKey:     data.PK,
-		ShareSortKey: data.SK,
+		PK:            dynamo.ActorAccessKey(data.ActorUID.String()),
+		SK:            dynamo.MetadataKey(data.ActorUID.String()),
+		ShareKey:      data.PK,
+		ShareSortKey:  data.SK,
+		ActorType:     actorType,
+		SentAt:        data.UpdatedAt,
+		CodeExpiresAt: data.ExpiresAt,
 	}
 
 	transaction := dynamo.NewTransaction().
@@ -134,6 +178,11 @@ func (s *Store) GetDonorAccess(ctx context.Context) (supporterdata.LpaLink, erro
 }
 
 func (s *Store) PutDonorAccess(ctx context.Context, accessCode accesscodedata.Hashed, data accesscodedata.Link, inviteSentTo string) error {
+	session, err := appcontext.SessionFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
 	organisationKey, ok := data.LpaOwnerKey.Organisation()
 	if !ok {
 		return errors.New("accessCodeStore.PutDonorAccess can only be used by organisations")
@@ -147,13 +196,16 @@ func (s *Store) PutDonorAccess(ctx context.Context, accessCode accesscodedata.Ha
 	data.PK = pk
 	data.SK = dynamo.AccessSortKey(dynamo.DonorInviteKey(organisationKey, data.LpaKey))
 	data.UpdatedAt = s.now()
-	data.ExpiresAt = s.now().AddDate(0, 3, 0)
+	data.ExpiresAt = s.expiry.from(s.now(), actor.TypeDonor)
 
 	newActorAccess := accesscodedata.ActorAccess{
-		PK:           dynamo.ActorAccessKey(data.ActorUID.String()),
-		SK:           dynamo.MetadataKey(data.ActorUID.String()),
-		ShareKey:     data.PK,
-		ShareSortKey: data.SK,
+		PK:            dynamo.ActorAccessKey(data.ActorUID.String()),
+		SK:            dynamo.MetadataKey(data.ActorUID.String()),
+		ShareKey:      data.PK,
+		ShareSortKey:  data.SK,
+		ActorType:     actor.TypeDonor,
+		SentAt:        data.UpdatedAt,
+		CodeExpiresAt: data.ExpiresAt,
 	}
 
 	link := supporterdata.LpaLink{
@@ -167,7 +219,16 @@ func (s *Store) PutDonorAccess(ctx context.Context, accessCode accesscodedata.Ha
 	transaction := dynamo.NewTransaction().
 		Create(data).
 		Create(link).
-		Create(dynamo.ReservedSK(data.SK))
+		Create(dynamo.ReservedSK(data.SK)).
+		Create(&supporterdata.AuditEvent{
+			PK:         organisationKey,
+			SK:         dynamo.AuditLogKey(s.now(), s.uuidString()),
+			CreatedAt:  s.now(),
+			Action:     supporterdata.AuditActionDonorAccessInvited,
+			ActorEmail: session.Email,
+			Subject:    inviteSentTo,
+			LpaID:      data.LpaKey.ID(),
+		})
 
 	s.withActorAccess(ctx, transaction, data.ActorUID, newActorAccess)
 
@@ -194,58 +255,124 @@ func (s *Store) DeleteDonorAccess(ctx context.Context, supporterLink supporterda
 	return s.dynamoClient.WriteTransaction(ctx, transaction)
 }
 
-type accessLimiter struct {
-	PK        dynamo.AccessLimiterKeyType
-	SK        dynamo.MetadataKeyType
-	Version   int
-	ExpiresAt time.Time `dynamodbav:",unixtime"`
-	Limiter   *rate.Limiter
-}
+// Summaries returns the status of the access code most recently sent to each
+// actor on the LPA who can be sent one.
+func (s *Store) Summaries(ctx context.Context, lpa *lpadata.Lpa) ([]accesscodedata.Summary, error) {
+	summaries := lpaActors(lpa)
+	if len(summaries) == 0 {
+		return nil, nil
+	}
 
-func (s *Store) allowed(ctx context.Context) error {
-	data, err := appcontext.SessionFromContext(ctx)
-	// As a compromise we count unauthenticated requests together, these are for
-	// the opt-out pages. Otherwise we'd be leaving them open for abuse, or as a
-	// way to determine valid combinations to use to add LPAs. I suspect they'll
-	// never get enough legitimate use to ever hit the rate.
+	actorKeys := make([]dynamo.Keys, len(summaries))
+	for i, summary := range summaries {
+		actorKeys[i] = dynamo.Keys{
+			PK: dynamo.ActorAccessKey(summary.ActorUID.String()),
+			SK: dynamo.MetadataKey(summary.ActorUID.String()),
+		}
+	}
+
+	actorItems, err := s.dynamoClient.AllByKeys(ctx, actorKeys)
 	if err != nil {
-		data = &appcontext.Session{}
+		return nil, fmt.Errorf("retrieve actor access: %w", err)
 	}
 
-	var v accessLimiter
-	fresh := false
-	if err := s.dynamoClient.OneByPK(ctx, dynamo.AccessLimiterKey(data.SessionID), &v); err != nil {
-		if errors.Is(err, dynamo.NotFoundError{}) {
-			fresh = true
-			v = accessLimiter{
-				PK:      dynamo.AccessLimiterKey(data.SessionID),
-				SK:      dynamo.MetadataKey(data.SessionID),
-				Version: 1,
-				Limiter: rate.NewLimiter(s.now(), 5*time.Minute, 10, 10),
+	var actorAccesses []accesscodedata.ActorAccess
+	if err := attributevalue.UnmarshalListOfMaps(actorItems, &actorAccesses); err != nil {
+		return nil, fmt.Errorf("unmarshal actor access: %w", err)
+	}
+
+	if len(actorAccesses) == 0 {
+		return summaries, nil
+	}
+
+	actorAccessByKey := map[dynamo.ActorAccessKeyType]accesscodedata.ActorAccess{}
+	linkKeys := make([]dynamo.Keys, len(actorAccesses))
+	for i, actorAccess := range actorAccesses {
+		actorAccessByKey[actorAccess.PK] = actorAccess
+		linkKeys[i] = dynamo.Keys{PK: actorAccess.ShareKey, SK: actorAccess.ShareSortKey}
+	}
+
+	linkItems, err := s.dynamoClient.AllByKeys(ctx, linkKeys)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve access codes: %w", err)
+	}
+
+	var links []accesscodedata.Link
+	if err := attributevalue.UnmarshalListOfMaps(linkItems, &links); err != nil {
+		return nil, fmt.Errorf("unmarshal access codes: %w", err)
+	}
+
+	linkExpiresAt := map[string]time.Time{}
+	for _, link := range links {
+		linkExpiresAt[link.PK.PK()] = link.ExpiresAt.UTC()
+	}
+
+	now := s.now()
+	for i, summary := range summaries {
+		actorAccess, ok := actorAccessByKey[dynamo.ActorAccessKey(summary.ActorUID.String())]
+		if !ok {
+			continue
+		}
+
+		summaries[i].SentAt = actorAccess.SentAt
+		summaries[i].ExpiresAt = actorAccess.CodeExpiresAt
+
+		// A code is removed when it is used, or by the TTL after it has expired.
+		// Without an expiry we can't tell which happened, so treat it as expired
+		// to allow another code to be sent.
+		if expiresAt, ok := linkExpiresAt[actorAccess.ShareKey.PK()]; ok {
+			summaries[i].ExpiresAt = expiresAt
+
+			if expiresAt.Before(now) {
+				summaries[i].Status = accesscodedata.StatusExpired
+			} else {
+				summaries[i].Status = accesscodedata.StatusSent
 			}
+		} else if actorAccess.CodeExpiresAt.IsZero() || actorAccess.CodeExpiresAt.Before(now) {
+			summaries[i].Status = accesscodedata.StatusExpired
 		} else {
-			return fmt.Errorf("retrieve rate limiter: %w", err)
+			summaries[i].Status = accesscodedata.StatusUsed
 		}
 	}
 
-	allowed := v.Limiter.Allow(s.now())
-	v.ExpiresAt = s.now().Add(time.Hour)
+	return summaries, nil
+}
 
-	if fresh {
-		if err := s.dynamoClient.Create(ctx, v); err != nil {
-			return fmt.Errorf("create rate limiter: %w", err)
-		}
-	} else {
-		if err := s.dynamoClient.Put(ctx, v); err != nil {
-			return fmt.Errorf("update rate limiter: %w", err)
+// lpaActors lists the actors on the LPA who can be sent an access code.
+func lpaActors(lpa *lpadata.Lpa) []accesscodedata.Summary {
+	var summaries []accesscodedata.Summary
+	add := func(uid actoruid.UID, actorType actor.Type, fullName string) {
+		if !uid.IsZero() {
+			summaries = append(summaries, accesscodedata.Summary{ActorUID: uid, ActorType: actorType, FullName: fullName})
 		}
 	}
 
-	if !allowed {
-		return dynamo.ErrTooManyRequests
+	add(lpa.CertificateProvider.UID, actor.TypeCertificateProvider, lpa.CertificateProvider.FullName())
+	for _, attorney := range lpa.Attorneys.Attorneys {
+		add(attorney.UID, actor.TypeAttorney, attorney.FullName())
+	}
+	add(lpa.Attorneys.TrustCorporation.UID, actor.TypeTrustCorporation, lpa.Attorneys.TrustCorporation.Name)
+	for _, attorney := range lpa.ReplacementAttorneys.Attorneys {
+		add(attorney.UID, actor.TypeReplacementAttorney, attorney.FullName())
 	}
+	add(lpa.ReplacementAttorneys.TrustCorporation.UID, actor.TypeReplacementTrustCorporation, lpa.ReplacementAttorneys.TrustCorporation.Name)
+	add(lpa.Voucher.UID, actor.TypeVoucher, lpa.Voucher.FullName())
 
-	return nil
+	return summaries
+}
+
+// sessionKey returns the key to limit the rate of access code attempts by.
+func sessionKey(ctx context.Context) string {
+	data, err := appcontext.SessionFromContext(ctx)
+	// As a compromise we count unauthenticated requests together, these are for
+	// the opt-out pages. Otherwise we'd be leaving them open for abuse, or as a
+	// way to determine valid combinations to use to add LPAs. I suspect they'll
+	// never get enough legitimate use to ever hit the rate.
+	if err != nil {
+		return ""
+	}
+
+	return data.SessionID
 }
 
 func (s *Store) withActorAccess(ctx context.Context, transaction *dynamo.Transaction, actorUID actoruid.UID, newActorAccess accesscodedata.ActorAccess) error {
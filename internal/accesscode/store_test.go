@@ -7,11 +7,13 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/accesscode/accesscodedata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor/actoruid"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/rate"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
 	"github.com/stretchr/testify/assert"
@@ -75,27 +77,18 @@ func TestAccessCodeStoreGet(t *testing.T) {
 			ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{SessionID: "session-id"})
 			data := accesscodedata.Link{LpaKey: "lpa-id", ExpiresAt: testNow.Truncate(time.Second).Add(time.Hour)}
 
-			dynamoClient := newMockDynamoClient(t)
-			dynamoClient.EXPECT().
-				OneByPK(ctx, dynamo.AccessLimiterKey("session-id"), mock.Anything).
-				Return(nil).
-				SetData(accessLimiter{
-					Limiter: &rate.Limiter{TokenPer: time.Minute, MaxTokens: 5},
-				}).
-				Once()
-			dynamoClient.EXPECT().
-				Put(ctx, accessLimiter{
-					Limiter:   &rate.Limiter{TokenPer: time.Minute, MaxTokens: 5, Tokens: 4, TokensAt: testNow},
-					ExpiresAt: testNow.Add(time.Hour),
-				}).
+			limiter := newMockLimiter(t)
+			limiter.EXPECT().
+				Allow(ctx, rate.AccessCode, "session-id").
 				Return(nil)
+
+			dynamoClient := newMockDynamoClient(t)
 			dynamoClient.EXPECT().
 				OneByPK(ctx, tc.pk, mock.Anything).
 				Return(nil).
-				SetData(data).
-				Once()
+				SetData(data)
 
-			accessCodeStore := &Store{dynamoClient: dynamoClient, now: testNowFn}
+			accessCodeStore := &Store{dynamoClient: dynamoClient, limiter: limiter, now: testNowFn}
 
 			result, err := accessCodeStore.Get(ctx, tc.t, hashedCode)
 			assert.Nil(t, err)
@@ -134,27 +127,18 @@ func TestAccessCodeStoreGetWhenExpired(t *testing.T) {
 			ctx := context.Background()
 			data := accesscodedata.Link{LpaKey: "lpa-id", UpdatedAt: testNow.AddDate(-2, 0, -1)}
 
-			dynamoClient := newMockDynamoClient(t)
-			dynamoClient.EXPECT().
-				OneByPK(ctx, dynamo.AccessLimiterKey(""), mock.Anything).
-				Return(dynamo.NotFoundError{}).
-				Once()
-			dynamoClient.EXPECT().
-				Create(ctx, accessLimiter{
-					PK:        dynamo.AccessLimiterKey(""),
-					SK:        dynamo.MetadataKey(""),
-					Version:   1,
-					Limiter:   &rate.Limiter{TokenPer: 5 * time.Minute, MaxTokens: 10, Tokens: 9, TokensAt: testNow},
-					ExpiresAt: testNow.Add(time.Hour),
-				}).
+			limiter := newMockLimiter(t)
+			limiter.EXPECT().
+				Allow(ctx, rate.AccessCode, "").
 				Return(nil)
+
+			dynamoClient := newMockDynamoClient(t)
 			dynamoClient.EXPECT().
 				OneByPK(ctx, tc.pk, mock.Anything).
 				Return(nil).
-				SetData(data).
-				Once()
+				SetData(data)
 
-			accessCodeStore := &Store{dynamoClient: dynamoClient, now: testNowFn}
+			accessCodeStore := &Store{dynamoClient: dynamoClient, limiter: limiter, now: testNowFn}
 
 			_, err := accessCodeStore.Get(ctx, tc.t, hashedCode)
 			assert.ErrorIs(t, err, dynamo.NotFoundError{})
@@ -191,22 +175,12 @@ func TestAccessCodeStoreGetWhenLimited(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{SessionID: "session-id"})
 
-			dynamoClient := newMockDynamoClient(t)
-			dynamoClient.EXPECT().
-				OneByPK(ctx, dynamo.AccessLimiterKey("session-id"), mock.Anything).
-				Return(nil).
-				SetData(accessLimiter{
-					Limiter: &rate.Limiter{TokenPer: time.Minute, MaxTokens: 5, Tokens: -1, TokensAt: testNow.Add(-time.Minute)},
-				}).
-				Once()
-			dynamoClient.EXPECT().
-				Put(ctx, accessLimiter{
-					Limiter:   &rate.Limiter{TokenPer: time.Minute, MaxTokens: 5, Tokens: 0, TokensAt: testNow},
-					ExpiresAt: testNow.Add(time.Hour),
-				}).
-				Return(nil)
+			limiter := newMockLimiter(t)
+			limiter.EXPECT().
+				Allow(ctx, rate.AccessCode, "session-id").
+				Return(dynamo.ErrTooManyRequests)
 
-			accessCodeStore := &Store{dynamoClient: dynamoClient, now: testNowFn}
+			accessCodeStore := &Store{limiter: limiter, now: testNowFn}
 
 			_, err := accessCodeStore.Get(ctx, tc.t, hashedCode)
 			assert.ErrorIs(t, err, dynamo.ErrTooManyRequests)
@@ -222,52 +196,15 @@ func TestAccessCodeStoreGetForBadActorType(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
-func TestAccessCodeStoreGetWhenGetRateLimiterError(t *testing.T) {
+func TestAccessCodeStoreGetWhenLimiterErrors(t *testing.T) {
 	ctx := context.Background()
 
-	dynamoClient := newMockDynamoClient(t)
-	dynamoClient.EXPECT().
-		OneByPK(mock.Anything, mock.Anything, mock.Anything).
+	limiter := newMockLimiter(t)
+	limiter.EXPECT().
+		Allow(mock.Anything, mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	accessCodeStore := &Store{dynamoClient: dynamoClient, now: testNowFn}
-
-	_, err := accessCodeStore.Get(ctx, actor.TypeAttorney, accesscodedata.HashedFromString("123", "Jones"))
-	assert.ErrorIs(t, err, expectedError)
-}
-
-func TestAccessCodeStoreGetWhenPutRateLimiterError(t *testing.T) {
-	ctx := context.Background()
-
-	dynamoClient := newMockDynamoClient(t)
-	dynamoClient.EXPECT().
-		OneByPK(mock.Anything, mock.Anything, mock.Anything).
-		Return(nil).
-		SetData(accessLimiter{
-			Limiter: &rate.Limiter{TokenPer: time.Minute, MaxTokens: 5},
-		})
-	dynamoClient.EXPECT().
-		Put(mock.Anything, mock.Anything).
-		Return(expectedError)
-
-	accessCodeStore := &Store{dynamoClient: dynamoClient, now: testNowFn}
-
-	_, err := accessCodeStore.Get(ctx, actor.TypeAttorney, accesscodedata.HashedFromString("123", "Jones"))
-	assert.ErrorIs(t, err, expectedError)
-}
-
-func TestAccessCodeStoreGetWhenCreateRateLimiterError(t *testing.T) {
-	ctx := context.Background()
-
-	dynamoClient := newMockDynamoClient(t)
-	dynamoClient.EXPECT().
-		OneByPK(mock.Anything, mock.Anything, mock.Anything).
-		Return(dynamo.NotFoundError{})
-	dynamoClient.EXPECT().
-		Create(mock.Anything, mock.Anything).
-		Return(expectedError)
-
-	accessCodeStore := &Store{dynamoClient: dynamoClient, now: testNowFn}
+	accessCodeStore := &Store{limiter: limiter, now: testNowFn}
 
 	_, err := accessCodeStore.Get(ctx, actor.TypeAttorney, accesscodedata.HashedFromString("123", "Jones"))
 	assert.ErrorIs(t, err, expectedError)
@@ -278,21 +215,18 @@ func TestAccessCodeStoreGetOnError(t *testing.T) {
 	data := accesscodedata.Link{LpaKey: "lpa-id"}
 	_, hashedCode := accesscodedata.Generate("Jones")
 
-	dynamoClient := newMockDynamoClient(t)
-	dynamoClient.EXPECT().
-		OneByPK(mock.Anything, mock.Anything, mock.Anything).
-		Return(dynamo.NotFoundError{}).
-		Once()
-	dynamoClient.EXPECT().
-		Create(mock.Anything, mock.Anything).
+	limiter := newMockLimiter(t)
+	limiter.EXPECT().
+		Allow(mock.Anything, mock.Anything, mock.Anything).
 		Return(nil)
+
+	dynamoClient := newMockDynamoClient(t)
 	dynamoClient.EXPECT().
 		OneByPK(ctx, dynamo.AccessKey(dynamo.AttorneyAccessKey(hashedCode.String())), mock.Anything).
 		Return(expectedError).
-		SetData(data).
-		Once()
+		SetData(data)
 
-	accessCodeStore := &Store{dynamoClient: dynamoClient, now: testNowFn}
+	accessCodeStore := &Store{dynamoClient: dynamoClient, limiter: limiter, now: testNowFn}
 
 	_, err := accessCodeStore.Get(ctx, actor.TypeAttorney, hashedCode)
 	assert.Equal(t, expectedError, err)
@@ -349,14 +283,17 @@ func TestAccessCodeStorePut(t *testing.T) {
 				WriteTransaction(ctx, dynamo.NewTransaction().
 					Create(data).
 					Create(accesscodedata.ActorAccess{
-						PK:           dynamo.ActorAccessKey(actorUID.String()),
-						SK:           dynamo.MetadataKey(actorUID.String()),
-						ShareKey:     tc.pk,
-						ShareSortKey: tc.sk,
+						PK:            dynamo.ActorAccessKey(actorUID.String()),
+						SK:            dynamo.MetadataKey(actorUID.String()),
+						ShareKey:      tc.pk,
+						ShareSortKey:  tc.sk,
+						ActorType:     tc.actor,
+						SentAt:        testNow,
+						CodeExpiresAt: testNow.AddDate(2, 0, 0),
 					})).
 				Return(nil)
 
-			accessCodeStore := &Store{dynamoClient: dynamoClient, now: testNowFn}
+			accessCodeStore := &Store{dynamoClient: dynamoClient, expiry: DefaultExpiry, now: testNowFn}
 
 			err := accessCodeStore.Put(ctx, tc.actor, hashedCode, data)
 			assert.Nil(t, err)
@@ -420,15 +357,18 @@ func TestAccessCodeStorePutWhenHasAccessCode(t *testing.T) {
 				WriteTransaction(ctx, dynamo.NewTransaction().
 					Create(data).
 					Put(accesscodedata.ActorAccess{
-						PK:           dynamo.ActorAccessKey(actorUID.String()),
-						SK:           dynamo.MetadataKey(actorUID.String()),
-						ShareKey:     tc.pk,
-						ShareSortKey: tc.sk,
+						PK:            dynamo.ActorAccessKey(actorUID.String()),
+						SK:            dynamo.MetadataKey(actorUID.String()),
+						ShareKey:      tc.pk,
+						ShareSortKey:  tc.sk,
+						ActorType:     tc.actor,
+						SentAt:        testNow,
+						CodeExpiresAt: testNow.AddDate(2, 0, 0),
 					}).
 					Delete(dynamo.Keys{PK: actorAccess.ShareKey, SK: actorAccess.ShareSortKey})).
 				Return(nil)
 
-			accessCodeStore := &Store{dynamoClient: dynamoClient, now: testNowFn}
+			accessCodeStore := &Store{dynamoClient: dynamoClient, expiry: DefaultExpiry, now: testNowFn}
 
 			err := accessCodeStore.Put(ctx, tc.actor, hashedCode, data)
 			assert.Nil(t, err)
@@ -460,9 +400,10 @@ func TestAccessCodeStoreWhenOneByPKError(t *testing.T) {
 
 func TestNewAccessCodeStore(t *testing.T) {
 	client := newMockDynamoClient(t)
-	store := NewStore(client)
+	store := NewStore(client, DefaultExpiry)
 
 	assert.Equal(t, client, store.dynamoClient)
+	assert.Equal(t, DefaultExpiry, store.expiry)
 	assert.NotNil(t, store.now)
 }
 
@@ -515,7 +456,7 @@ func TestAccessCodeStoreGetDonorAccessWhenDynamoErrors(t *testing.T) {
 }
 
 func TestAccessCodeStorePutDonorAccess(t *testing.T) {
-	ctx := context.Background()
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "org-id", Email: "supporter@example.com"})
 	hashedCode := accesscodedata.HashedFromString("123", "Jones")
 	actorUID := actoruid.New()
 
@@ -539,11 +480,23 @@ func TestAccessCodeStorePutDonorAccess(t *testing.T) {
 			InviteSentAt: testNow,
 		}).
 		Create(dynamo.ReservedSK(accessCode.SK)).
+		Create(&supporterdata.AuditEvent{
+			PK:         dynamo.OrganisationKey("org-id"),
+			SK:         dynamo.AuditLogKey(testNow, "a-uuid"),
+			CreatedAt:  testNow,
+			Action:     supporterdata.AuditActionDonorAccessInvited,
+			ActorEmail: "supporter@example.com",
+			Subject:    "john@example.com",
+			LpaID:      "lpa-id",
+		}).
 		Create(accesscodedata.ActorAccess{
-			PK:           dynamo.ActorAccessKey(actorUID.String()),
-			SK:           dynamo.MetadataKey(actorUID.String()),
-			ShareKey:     accessCode.PK,
-			ShareSortKey: accessCode.SK,
+			PK:            dynamo.ActorAccessKey(actorUID.String()),
+			SK:            dynamo.MetadataKey(actorUID.String()),
+			ShareKey:      accessCode.PK,
+			ShareSortKey:  accessCode.SK,
+			ActorType:     actor.TypeDonor,
+			SentAt:        testNow,
+			CodeExpiresAt: testNow.AddDate(0, 3, 0),
 		})
 
 	dynamoClient := newMockDynamoClient(t)
@@ -554,7 +507,7 @@ func TestAccessCodeStorePutDonorAccess(t *testing.T) {
 		WriteTransaction(ctx, transaction).
 		Return(nil)
 
-	accessCodeStore := &Store{dynamoClient: dynamoClient, now: testNowFn}
+	accessCodeStore := &Store{dynamoClient: dynamoClient, expiry: DefaultExpiry, uuidString: func() string { return "a-uuid" }, now: testNowFn}
 
 	err := accessCodeStore.PutDonorAccess(ctx, hashedCode, accesscodedata.Link{
 		LpaOwnerKey: dynamo.LpaOwnerKey(dynamo.OrganisationKey("org-id")),
@@ -564,8 +517,15 @@ func TestAccessCodeStorePutDonorAccess(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestAccessCodeStorePutDonorAccessWhenSessionMissing(t *testing.T) {
+	accessCodeStore := &Store{}
+
+	err := accessCodeStore.PutDonorAccess(context.Background(), accesscodedata.HashedFromString("123", "Jones"), accesscodedata.Link{LpaOwnerKey: dynamo.LpaOwnerKey(dynamo.OrganisationKey("org-id")), LpaKey: dynamo.LpaKey("lpa-id")}, "john@example.com")
+	assert.Error(t, err)
+}
+
 func TestAccessCodeStorePutDonorAccessWhenDonor(t *testing.T) {
-	ctx := context.Background()
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{})
 
 	accessCodeStore := &Store{}
 
@@ -766,3 +726,213 @@ func TestAccessKeyWhenUnknownType(t *testing.T) {
 	_, err := accessCodeKey(actor.TypeAuthorisedSignatory, accesscodedata.HashedFromString("S", "Jones"))
 	assert.NotNil(t, err)
 }
+
+func TestAccessCodeStoreSummaries(t *testing.T) {
+	certificateProviderUID := actoruid.New()
+	attorneyUID := actoruid.New()
+	expiredAttorneyUID := actoruid.New()
+	unknownAttorneyUID := actoruid.New()
+	replacementAttorneyUID := actoruid.New()
+
+	lpa := &lpadata.Lpa{
+		CertificateProvider: lpadata.CertificateProvider{UID: certificateProviderUID, FirstNames: "Charlie", LastName: "Cooper"},
+		Attorneys: lpadata.Attorneys{Attorneys: []lpadata.Attorney{
+			{UID: attorneyUID, FirstNames: "Amy", LastName: "Adams"},
+			{UID: expiredAttorneyUID, FirstNames: "Bob", LastName: "Brown"},
+			{UID: unknownAttorneyUID, FirstNames: "Una", LastName: "Unwin"},
+		}},
+		ReplacementAttorneys: lpadata.Attorneys{Attorneys: []lpadata.Attorney{
+			{UID: replacementAttorneyUID, FirstNames: "Rob", LastName: "Roberts"},
+		}},
+	}
+
+	certificateProviderAccess := accesscodedata.ActorAccess{
+		PK:            dynamo.ActorAccessKey(certificateProviderUID.String()),
+		SK:            dynamo.MetadataKey(certificateProviderUID.String()),
+		ShareKey:      dynamo.AccessKey(dynamo.CertificateProviderAccessKey("a")),
+		ShareSortKey:  dynamo.AccessSortKey(dynamo.MetadataKey("a")),
+		ActorType:     actor.TypeCertificateProvider,
+		SentAt:        testNow.AddDate(0, -1, 0),
+		CodeExpiresAt: testNow.AddDate(1, 11, 0),
+	}
+	attorneyAccess := accesscodedata.ActorAccess{
+		PK:            dynamo.ActorAccessKey(attorneyUID.String()),
+		SK:            dynamo.MetadataKey(attorneyUID.String()),
+		ShareKey:      dynamo.AccessKey(dynamo.AttorneyAccessKey("b")),
+		ShareSortKey:  dynamo.AccessSortKey(dynamo.MetadataKey("b")),
+		ActorType:     actor.TypeAttorney,
+		SentAt:        testNow.AddDate(0, -2, 0),
+		CodeExpiresAt: testNow.AddDate(1, 10, 0),
+	}
+	expiredAttorneyAccess := accesscodedata.ActorAccess{
+		PK:            dynamo.ActorAccessKey(expiredAttorneyUID.String()),
+		SK:            dynamo.MetadataKey(expiredAttorneyUID.String()),
+		ShareKey:      dynamo.AccessKey(dynamo.AttorneyAccessKey("c")),
+		ShareSortKey:  dynamo.AccessSortKey(dynamo.MetadataKey("c")),
+		ActorType:     actor.TypeAttorney,
+		SentAt:        testNow.AddDate(-2, 0, -1),
+		CodeExpiresAt: testNow.AddDate(0, 0, -1),
+	}
+	unknownAttorneyAccess := accesscodedata.ActorAccess{
+		PK:           dynamo.ActorAccessKey(unknownAttorneyUID.String()),
+		SK:           dynamo.MetadataKey(unknownAttorneyUID.String()),
+		ShareKey:     dynamo.AccessKey(dynamo.AttorneyAccessKey("d")),
+		ShareSortKey: dynamo.AccessSortKey(dynamo.MetadataKey("d")),
+		ActorType:    actor.TypeAttorney,
+		SentAt:       testNow.AddDate(-1, 0, 0),
+	}
+
+	actorItems := make([]map[string]types.AttributeValue, 4)
+	for i, actorAccess := range []accesscodedata.ActorAccess{certificateProviderAccess, attorneyAccess, expiredAttorneyAccess, unknownAttorneyAccess} {
+		actorItems[i], _ = attributevalue.MarshalMap(actorAccess)
+	}
+
+	certificateProviderLink, _ := attributevalue.MarshalMap(accesscodedata.Link{
+		PK:        certificateProviderAccess.ShareKey,
+		SK:        certificateProviderAccess.ShareSortKey,
+		ExpiresAt: testNow.AddDate(1, 11, 0),
+	})
+	expiredAttorneyLink, _ := attributevalue.MarshalMap(accesscodedata.Link{
+		PK:        expiredAttorneyAccess.ShareKey,
+		SK:        expiredAttorneyAccess.ShareSortKey,
+		ExpiresAt: testNow.AddDate(0, 0, -1),
+	})
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		AllByKeys(ctx, []dynamo.Keys{
+			{PK: dynamo.ActorAccessKey(certificateProviderUID.String()), SK: dynamo.MetadataKey(certificateProviderUID.String())},
+			{PK: dynamo.ActorAccessKey(attorneyUID.String()), SK: dynamo.MetadataKey(attorneyUID.String())},
+			{PK: dynamo.ActorAccessKey(expiredAttorneyUID.String()), SK: dynamo.MetadataKey(expiredAttorneyUID.String())},
+			{PK: dynamo.ActorAccessKey(unknownAttorneyUID.String()), SK: dynamo.MetadataKey(unknownAttorneyUID.String())},
+			{PK: dynamo.ActorAccessKey(replacementAttorneyUID.String()), SK: dynamo.MetadataKey(replacementAttorneyUID.String())},
+		}).
+		Return(actorItems, nil)
+	dynamoClient.EXPECT().
+		AllByKeys(ctx, []dynamo.Keys{
+			{PK: certificateProviderAccess.ShareKey, SK: certificateProviderAccess.ShareSortKey},
+			{PK: attorneyAccess.ShareKey, SK: attorneyAccess.ShareSortKey},
+			{PK: expiredAttorneyAccess.ShareKey, SK: expiredAttorneyAccess.ShareSortKey},
+			{PK: unknownAttorneyAccess.ShareKey, SK: unknownAttorneyAccess.ShareSortKey},
+		}).
+		Return([]map[string]types.AttributeValue{certificateProviderLink, expiredAttorneyLink}, nil)
+
+	accessCodeStore := &Store{dynamoClient: dynamoClient, now: testNowFn}
+
+	summaries, err := accessCodeStore.Summaries(ctx, lpa)
+	assert.Nil(t, err)
+	assert.Equal(t, []accesscodedata.Summary{{
+		ActorUID:  certificateProviderUID,
+		ActorType: actor.TypeCertificateProvider,
+		FullName:  "Charlie Cooper",
+		Status:    accesscodedata.StatusSent,
+		SentAt:    certificateProviderAccess.SentAt,
+		ExpiresAt: testNow.AddDate(1, 11, 0).Truncate(time.Second),
+	}, {
+		ActorUID:  attorneyUID,
+		ActorType: actor.TypeAttorney,
+		FullName:  "Amy Adams",
+		Status:    accesscodedata.StatusUsed,
+		SentAt:    attorneyAccess.SentAt,
+		ExpiresAt: attorneyAccess.CodeExpiresAt,
+	}, {
+		ActorUID:  expiredAttorneyUID,
+		ActorType: actor.TypeAttorney,
+		FullName:  "Bob Brown",
+		Status:    accesscodedata.StatusExpired,
+		SentAt:    expiredAttorneyAccess.SentAt,
+		ExpiresAt: testNow.AddDate(0, 0, -1).Truncate(time.Second),
+	}, {
+		ActorUID:  unknownAttorneyUID,
+		ActorType: actor.TypeAttorney,
+		FullName:  "Una Unwin",
+		Status:    accesscodedata.StatusExpired,
+		SentAt:    unknownAttorneyAccess.SentAt,
+	}, {
+		ActorUID:  replacementAttorneyUID,
+		ActorType: actor.TypeReplacementAttorney,
+		FullName:  "Rob Roberts",
+	}}, summaries)
+}
+
+func TestAccessCodeStoreSummariesWhenNoActors(t *testing.T) {
+	accessCodeStore := &Store{}
+
+	summaries, err := accessCodeStore.Summaries(ctx, &lpadata.Lpa{})
+	assert.Nil(t, err)
+	assert.Nil(t, summaries)
+}
+
+func TestAccessCodeStoreSummariesWhenNoneSent(t *testing.T) {
+	uid := actoruid.New()
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		AllByKeys(ctx, mock.Anything).
+		Return(nil, nil)
+
+	accessCodeStore := &Store{dynamoClient: dynamoClient}
+
+	summaries, err := accessCodeStore.Summaries(ctx, &lpadata.Lpa{
+		CertificateProvider: lpadata.CertificateProvider{UID: uid, FirstNames: "Charlie", LastName: "Cooper"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []accesscodedata.Summary{{
+		ActorUID:  uid,
+		ActorType: actor.TypeCertificateProvider,
+		FullName:  "Charlie Cooper",
+	}}, summaries)
+}
+
+func TestAccessCodeStoreSummariesWhenActorAccessErrors(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		AllByKeys(ctx, mock.Anything).
+		Return(nil, expectedError)
+
+	accessCodeStore := &Store{dynamoClient: dynamoClient}
+
+	_, err := accessCodeStore.Summaries(ctx, &lpadata.Lpa{
+		CertificateProvider: lpadata.CertificateProvider{UID: actoruid.New()},
+	})
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestAccessCodeStoreSummariesWhenLinksError(t *testing.T) {
+	uid := actoruid.New()
+	actorItem, _ := attributevalue.MarshalMap(accesscodedata.ActorAccess{
+		PK: dynamo.ActorAccessKey(uid.String()),
+		SK: dynamo.MetadataKey(uid.String()),
+	})
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		AllByKeys(ctx, mock.Anything).
+		Return([]map[string]types.AttributeValue{actorItem}, nil).
+		Once()
+	dynamoClient.EXPECT().
+		AllByKeys(ctx, mock.Anything).
+		Return(nil, expectedError).
+		Once()
+
+	accessCodeStore := &Store{dynamoClient: dynamoClient}
+
+	_, err := accessCodeStore.Summaries(ctx, &lpadata.Lpa{
+		CertificateProvider: lpadata.CertificateProvider{UID: uid},
+	})
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestExpiryFrom(t *testing.T) {
+	expiry := Expiry{
+		Default: Lifetime{Years: 2},
+		ByActorType: map[actor.Type]Lifetime{
+			actor.TypeDonor:               {Months: 3},
+			actor.TypeCertificateProvider: {Days: 28},
+		},
+	}
+
+	assert.Equal(t, testNow.AddDate(2, 0, 0), expiry.from(testNow, actor.TypeAttorney))
+	assert.Equal(t, testNow.AddDate(0, 3, 0), expiry.from(testNow, actor.TypeDonor))
+	assert.Equal(t, testNow.AddDate(0, 0, 28), expiry.from(testNow, actor.TypeCertificateProvider))
+}
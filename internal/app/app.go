@@ -30,6 +30,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/place"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/random"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/rate"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/reuse"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/scheduled"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/search"
@@ -66,6 +67,7 @@ type DynamoClient interface {
 	OneByPartialSK(ctx context.Context, pk dynamo.PK, partialSK dynamo.SK, v any) error
 	OneBySK(ctx context.Context, sk dynamo.SK, v any) error
 	OneByUID(ctx context.Context, uid string) (dynamo.Keys, error)
+	PageByPartialSK(ctx context.Context, pk dynamo.PK, partialSK dynamo.SK, limit int32, after string, v any) (string, error)
 	Put(ctx context.Context, v any) error
 	Update(ctx context.Context, pk dynamo.PK, sk dynamo.SK, names map[string]string, values map[string]dynamodbtypes.AttributeValue, expression string) error
 	WriteTransaction(ctx context.Context, transaction *dynamo.Transaction) error
@@ -97,12 +99,12 @@ func App(
 	appPublicURL string,
 	payClient *pay.Client,
 	notifyClient *notify.Client,
-	addressClient *place.Client,
+	addressClient *place.CachedClient,
 	oneLoginClient *onelogin.Client,
 	s3Client S3Client,
 	eventClient *event.Client,
 	lpaStoreClient *lpastore.Client,
-	searchClient *search.Client,
+	searchClient search.Searcher,
 	useURL string,
 	donorStartURL string,
 	certificateProviderStartURL string,
@@ -115,14 +117,16 @@ func App(
 	donorStore := donor.NewStore(lpaDynamoClient, eventClient, logger, searchClient)
 	certificateProviderStore := certificateprovider.NewStore(lpaDynamoClient)
 	attorneyStore := attorney.NewStore(lpaDynamoClient)
-	accessCodeStore := accesscode.NewStore(lpaDynamoClient)
+	accessCodeStore := accesscode.NewStore(lpaDynamoClient, accesscode.DefaultExpiry)
 	dashboardStore := dashboard.NewStore(lpaDynamoClient, lpastore.NewResolvingService(donorStore, lpaStoreClient))
 	evidenceReceivedStore := &evidenceReceivedStore{dynamoClient: lpaDynamoClient}
 	organisationStore := supporter.NewOrganisationStore(lpaDynamoClient)
 	memberStore := supporter.NewMemberStore(lpaDynamoClient)
+	auditLogStore := supporter.NewAuditLogStore(lpaDynamoClient)
 	voucherStore := voucher.NewStore(lpaDynamoClient)
 	scheduledStore := scheduled.NewStore(lpaDynamoClient)
 	reuseStore := reuse.NewStore(lpaDynamoClient)
+	notificationStore := notify.NewStore(lpaDynamoClient)
 	progressTracker := task.ProgressTracker{Localizer: localizer}
 
 	accessCodeSender := accesscode.NewSender(accessCodeStore, notifyClient, appPublicURL, certificateProviderStartURL, attorneyStartURL, eventClient, certificateProviderStore, scheduledStore)
@@ -155,6 +159,8 @@ func App(
 		notFoundHandler)
 	handleRoot(page.PathSignOut, None,
 		page.SignOut(logger, sessionStore, oneLoginClient, donorStartURL))
+	handleRoot(page.PathSignOutAllDevices, None,
+		page.SignOutAllDevices(logger, sessionStore, oneLoginClient, donorStartURL))
 	handleRoot(page.PathStart, None,
 		page.Guidance(tmpls.Get("start.gohtml")))
 	handleRoot(page.PathCertificateProviderStart, None,
@@ -236,8 +242,11 @@ func App(
 		searchClient,
 		donorStore,
 		accessCodeStore,
+		accessCodeSender,
 		progressTracker,
 		lpaStoreResolvingService,
+		notificationStore,
+		auditLogStore,
 		donorStartURL,
 	)
 
@@ -311,12 +320,16 @@ func App(
 		voucherStore,
 		reuseStore,
 		bundle,
+		notificationStore,
+		memberStore,
 		donorStartURL,
 		certificateProviderStartURL,
 		attorneyStartURL,
 	)
 
-	return withAppData(page.ValidateCsrf(rootMux, sessionStore, random.AlphaNumeric, errorHandler), localizer, lang, devMode)
+	rateLimited := limitRequests(rootMux, rateLimits, rate.NewStore(lpaDynamoClient), sessionStore, tmpls.Get("error-429.gohtml"), logger, errorHandler)
+
+	return withAppData(page.ValidateCsrf(rateLimited, sessionStore, random.AlphaNumeric, errorHandler), localizer, lang, devMode)
 }
 
 func withAppData(next http.Handler, localizer localize.Localizer, lang localize.Lang, devMode bool) http.HandlerFunc {
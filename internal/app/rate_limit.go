@@ -0,0 +1,123 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/rate"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/voucher"
+)
+
+type RateLimiter interface {
+	Allow(ctx context.Context, policy rate.Policy, key string) error
+}
+
+// rateLimits lists the routes, as http.ServeMux patterns, that are limited and
+// the policy to apply to each.
+var rateLimits = map[string]rate.Policy{
+	"POST " + donor.PathYourAddress.String():                             rate.PostcodeLookup,
+	"POST " + donor.PathChooseAttorneysAddress.String():                  rate.PostcodeLookup,
+	"POST " + donor.PathEnterTrustCorporationAddress.String():            rate.PostcodeLookup,
+	"POST " + donor.PathChooseReplacementAttorneysAddress.String():       rate.PostcodeLookup,
+	"POST " + donor.PathEnterReplacementTrustCorporationAddress.String(): rate.PostcodeLookup,
+	"POST " + donor.PathCertificateProviderAddress.String():              rate.PostcodeLookup,
+	"POST " + donor.PathEnterPersonToNotifyAddress.String():              rate.PostcodeLookup,
+	"POST " + donor.PathEnterCorrespondentAddress.String():               rate.PostcodeLookup,
+	"POST " + donor.PathYourIndependentWitnessAddress.String():           rate.PostcodeLookup,
+
+	"POST " + donor.PathWitnessingYourSignature.String():               rate.WitnessCode,
+	"POST " + donor.PathResendCertificateProviderCode.String():         rate.WitnessCode,
+	"POST " + donor.PathResendIndependentWitnessCode.String():          rate.WitnessCode,
+	"POST " + donor.PathChangeCertificateProviderMobileNumber.String(): rate.WitnessCode,
+	"POST " + donor.PathChangeIndependentWitnessMobileNumber.String():  rate.WitnessCode,
+
+	"POST " + donor.PathAccessCodes.String():     rate.ResendAccessCode,
+	"POST " + supporter.PathAccessCodes.String(): rate.ResendAccessCode,
+
+	"POST " + supporter.PathInviteMember.String():      rate.InviteMember,
+	"POST " + supporter.PathBulkInviteMembers.String(): rate.BulkInviteMembers,
+
+	"POST " + voucher.PathConfirmAllowedToVouch.String(): rate.Voucher,
+	"POST " + voucher.PathVerifyDonorDetails.String():    rate.Voucher,
+}
+
+type tooManyRequestsData struct {
+	App    appcontext.Data
+	Errors validation.List
+}
+
+// limitRequests applies the policy for any route in limits before passing the
+// request to next. When a request is limited the template is shown instead.
+func limitRequests(next http.Handler, limits map[string]rate.Policy, limiter RateLimiter, sessionStore SessionStore, tmpl template.Template, logger Logger, errorHandler page.ErrorHandler) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/", next)
+
+	for pattern, policy := range limits {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			if err := limiter.Allow(r.Context(), policy, requestKey(r, policy.Key, sessionStore)); err != nil {
+				if !errors.Is(err, dynamo.ErrTooManyRequests) {
+					errorHandler(w, r, err)
+					return
+				}
+
+				logger.InfoContext(r.Context(), "request limited", slog.String("policy", policy.Name))
+				w.WriteHeader(http.StatusTooManyRequests)
+				if terr := tmpl(w, &tooManyRequestsData{App: appcontext.DataFromContext(r.Context())}); terr != nil {
+					logger.ErrorContext(r.Context(), "error rendering page", slog.Any("req", r), slog.Any("err", terr))
+					http.Error(w, "Encountered an error", http.StatusInternalServerError)
+				}
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	return mux
+}
+
+// requestKey identifies who is making the request, for the given type of key.
+// When a session or LPA is not available the IP address is used instead.
+func requestKey(r *http.Request, keyType rate.KeyType, sessionStore SessionStore) string {
+	switch keyType {
+	case rate.KeyTypeSession:
+		if loginSession, err := sessionStore.Login(r); err == nil {
+			return loginSession.SessionID()
+		}
+
+	case rate.KeyTypeLpa:
+		if id := r.PathValue("id"); id != "" {
+			return id
+		}
+	}
+
+	return requestIP(r)
+}
+
+// requestIP returns the address the request came from. The last entry of
+// X-Forwarded-For is used as it is the one added by our load balancer, earlier
+// entries can be set by the client.
+func requestIP(r *http.Request) string {
+	if forwardedFor := r.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+		parts := strings.Split(forwardedFor, ",")
+		return strings.TrimSpace(parts[len(parts)-1])
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
@@ -0,0 +1,178 @@
+package app
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/rate"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/sesh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	testSessionPolicy = rate.Policy{Name: "session", Key: rate.KeyTypeSession, TokenPer: time.Minute, Burst: 1}
+	testLpaPolicy     = rate.Policy{Name: "lpa", Key: rate.KeyTypeLpa, TokenPer: time.Minute, Burst: 1}
+	testLimits        = map[string]rate.Policy{
+		"POST /session":  testSessionPolicy,
+		"POST /lpa/{id}": testLpaPolicy,
+	}
+)
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusTeapot)
+}
+
+func TestLimitRequests(t *testing.T) {
+	loginSession := &sesh.LoginSession{Sub: "random"}
+
+	testcases := map[string]struct {
+		method string
+		url    string
+		setup  func(*mockRateLimiter, *mockSessionStore)
+	}{
+		"session": {
+			method: http.MethodPost,
+			url:    "/session",
+			setup: func(limiter *mockRateLimiter, sessionStore *mockSessionStore) {
+				sessionStore.EXPECT().Login(mock.Anything).Return(loginSession, nil)
+				limiter.EXPECT().Allow(mock.Anything, testSessionPolicy, loginSession.SessionID()).Return(nil)
+			},
+		},
+		"session missing": {
+			method: http.MethodPost,
+			url:    "/session",
+			setup: func(limiter *mockRateLimiter, sessionStore *mockSessionStore) {
+				sessionStore.EXPECT().Login(mock.Anything).Return(nil, expectedError)
+				limiter.EXPECT().Allow(mock.Anything, testSessionPolicy, "192.0.2.1").Return(nil)
+			},
+		},
+		"lpa": {
+			method: http.MethodPost,
+			url:    "/lpa/lpa-id",
+			setup: func(limiter *mockRateLimiter, sessionStore *mockSessionStore) {
+				limiter.EXPECT().Allow(mock.Anything, testLpaPolicy, "lpa-id").Return(nil)
+			},
+		},
+		"other method": {
+			method: http.MethodGet,
+			url:    "/session",
+			setup:  func(*mockRateLimiter, *mockSessionStore) {},
+		},
+		"other path": {
+			method: http.MethodPost,
+			url:    "/other",
+			setup:  func(*mockRateLimiter, *mockSessionStore) {},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(tc.method, tc.url, nil)
+			r.RemoteAddr = "192.0.2.1:1234"
+
+			limiter := newMockRateLimiter(t)
+			sessionStore := newMockSessionStore(t)
+			tc.setup(limiter, sessionStore)
+
+			limitRequests(http.HandlerFunc(okHandler), testLimits, limiter, sessionStore, nil, nil, nil).ServeHTTP(w, r)
+			resp := w.Result()
+
+			assert.Equal(t, http.StatusTeapot, resp.StatusCode)
+		})
+	}
+}
+
+func TestLimitRequestsWhenLimited(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequestWithContext(appcontext.ContextWithData(ctx, appcontext.Data{Page: "/x"}), http.MethodPost, "/lpa/lpa-id", nil)
+
+	limiter := newMockRateLimiter(t)
+	limiter.EXPECT().
+		Allow(mock.Anything, testLpaPolicy, "lpa-id").
+		Return(dynamo.ErrTooManyRequests)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		InfoContext(mock.Anything, "request limited", mock.Anything)
+
+	tmpl := func(w io.Writer, data any) error {
+		assert.Equal(t, &tooManyRequestsData{App: appcontext.Data{Page: "/x"}}, data)
+		return nil
+	}
+
+	limitRequests(http.HandlerFunc(okHandler), testLimits, limiter, nil, tmpl, logger, nil).ServeHTTP(w, r)
+	resp := w.Result()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+}
+
+func TestLimitRequestsWhenTemplateErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/lpa/lpa-id", nil)
+
+	limiter := newMockRateLimiter(t)
+	limiter.EXPECT().
+		Allow(mock.Anything, mock.Anything, mock.Anything).
+		Return(dynamo.ErrTooManyRequests)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		InfoContext(mock.Anything, mock.Anything, mock.Anything)
+	logger.EXPECT().
+		ErrorContext(mock.Anything, "error rendering page", mock.Anything, mock.Anything)
+
+	tmpl := func(io.Writer, any) error { return expectedError }
+
+	limitRequests(http.HandlerFunc(okHandler), testLimits, limiter, nil, tmpl, logger, nil).ServeHTTP(w, r)
+	resp := w.Result()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+}
+
+func TestLimitRequestsWhenLimiterErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/lpa/lpa-id", nil)
+
+	limiter := newMockRateLimiter(t)
+	limiter.EXPECT().
+		Allow(mock.Anything, mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	errorHandler := newMockErrorHandler(t)
+	errorHandler.EXPECT().
+		Execute(w, mock.Anything, expectedError)
+
+	limitRequests(http.HandlerFunc(okHandler), testLimits, limiter, nil, nil, nil, errorHandler.Execute).ServeHTTP(w, r)
+}
+
+func TestRequestIP(t *testing.T) {
+	testcases := map[string]struct {
+		forwardedFor string
+		remoteAddr   string
+		expected     string
+	}{
+		"remote addr":         {remoteAddr: "192.0.2.1:1234", expected: "192.0.2.1"},
+		"remote addr no port": {remoteAddr: "192.0.2.1", expected: "192.0.2.1"},
+		"forwarded for":       {forwardedFor: "192.0.2.2", remoteAddr: "192.0.2.1:1234", expected: "192.0.2.2"},
+		"forwarded for many":  {forwardedFor: "198.51.100.1, 192.0.2.2", remoteAddr: "192.0.2.1:1234", expected: "192.0.2.2"},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			r, _ := http.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tc.remoteAddr
+			if tc.forwardedFor != "" {
+				r.Header.Set("X-Forwarded-For", tc.forwardedFor)
+			}
+
+			assert.Equal(t, tc.expected, requestIP(r))
+		})
+	}
+}
@@ -32,14 +32,7 @@ func (s *uidStore) Set(ctx context.Context, provided *donordata.Provided, uid st
 	provided.LpaUID = uid
 	provided.UpdatedAt = s.now()
 
-	if err := s.searchClient.Index(ctx, search.Lpa{
-		PK: provided.PK.PK(),
-		SK: provided.SK.SK(),
-		Donor: search.LpaDonor{
-			FirstNames: provided.Donor.FirstNames,
-			LastName:   provided.Donor.LastName,
-		},
-	}); err != nil {
+	if err := s.searchClient.Index(ctx, search.LpaFromDonorProvided(provided)); err != nil {
 		return fmt.Errorf("uidStore index failed: %w", err)
 	}
 
@@ -43,9 +43,12 @@ func TestUidStoreSet(t *testing.T) {
 			searchClient := newMockSearchClient(t)
 			searchClient.EXPECT().
 				Index(ctx, search.Lpa{
-					PK:    dynamo.LpaKey("lpa-id").PK(),
-					SK:    tc.sk.SK(),
-					Donor: search.LpaDonor{FirstNames: "x", LastName: "y"},
+					PK:        dynamo.LpaKey("lpa-id").PK(),
+					SK:        tc.sk.SK(),
+					LpaUID:    "uid",
+					Status:    search.StatusInProgress,
+					Donor:     search.LpaDonor{FirstNames: "x", LastName: "y"},
+					UpdatedAt: testNow,
 				}).
 				Return(nil)
 
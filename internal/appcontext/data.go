@@ -84,6 +84,11 @@ func (d Data) IsAdmin() bool {
 	return d.SupporterData != nil && d.SupporterData.Permission.IsAdmin()
 }
 
+// Can returns true if the logged in supporter has the capability.
+func (d Data) Can(capability supporterdata.Capability) bool {
+	return d.SupporterData != nil && d.SupporterData.Permission.Can(capability)
+}
+
 func (d Data) EncodeQuery() string {
 	query := ""
 
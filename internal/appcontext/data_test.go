@@ -96,6 +96,12 @@ func TestAppDataIsAdmin(t *testing.T) {
 	assert.False(t, Data{}.IsAdmin())
 }
 
+func TestAppDataCan(t *testing.T) {
+	assert.True(t, Data{SupporterData: &SupporterData{Permission: supporterdata.PermissionAuditor}}.Can(supporterdata.CapabilityViewLpas))
+	assert.False(t, Data{SupporterData: &SupporterData{Permission: supporterdata.PermissionAuditor}}.Can(supporterdata.CapabilityCreateLpa))
+	assert.False(t, Data{}.Can(supporterdata.CapabilityViewLpas))
+}
+
 func TestAppDataEncodeQuery(t *testing.T) {
 	testCases := map[string]struct {
 		query               url.Values
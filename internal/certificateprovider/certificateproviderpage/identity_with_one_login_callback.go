@@ -2,6 +2,7 @@ package certificateproviderpage
 
 import (
 	"errors"
+	"log/slog"
 	"net/http"
 
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
@@ -12,7 +13,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
 )
 
-func IdentityWithOneLoginCallback(oneLoginClient OneLoginClient, sessionStore SessionStore, certificateProviderStore CertificateProviderStore, lpaStoreClient LpaStoreClient, eventClient EventClient) Handler {
+func IdentityWithOneLoginCallback(logger Logger, oneLoginClient OneLoginClient, sessionStore SessionStore, certificateProviderStore CertificateProviderStore, lpaStoreClient LpaStoreClient, eventClient EventClient) Handler {
 	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, certificateProvider *certificateproviderdata.Provided, lpa *lpadata.Lpa) error {
 		if certificateProvider.CertificateProviderIdentityConfirmed(lpa.CertificateProvider.FirstNames, lpa.CertificateProvider.LastName) {
 			return certificateprovider.PathIdentityDetails.Redirect(w, r, appData, certificateProvider.LpaID)
@@ -65,6 +66,14 @@ func IdentityWithOneLoginCallback(oneLoginClient OneLoginClient, sessionStore Se
 		}
 
 		if certificateProvider.IdentityUserData.Status.IsConfirmed() || certificateProvider.IdentityUserData.Status.IsFailed() {
+			if userData.Status.IsConfirmed() {
+				nameMatch := userData.ScoreName(lpa.CertificateProvider.FirstNames, lpa.CertificateProvider.LastName)
+				logger.InfoContext(r.Context(), "identity check mismatched",
+					slog.String("lpa_uid", lpa.LpaUID),
+					slog.Int("name_match_score", nameMatch.Score),
+					slog.Any("name_match_reasons", nameMatch.Reasons))
+			}
+
 			if err := eventClient.SendIdentityCheckMismatched(r.Context(), event.IdentityCheckMismatched{
 				LpaUID:   lpa.LpaUID,
 				ActorUID: certificateProvider.UID,
@@ -3,6 +3,7 @@ package certificateproviderpage
 import (
 	"context"
 	"errors"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -62,7 +63,7 @@ func TestGetIdentityWithOneLoginCallback(t *testing.T) {
 		SendCertificateProviderConfirmIdentity(r.Context(), "lpa-uid", updatedCertificateProvider).
 		Return(nil)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, certificateProviderStore, lpaStoreClient, nil)(testAppData, w, r, &certificateproviderdata.Provided{LpaID: "lpa-id"}, &lpadata.Lpa{LpaUID: "lpa-uid", CertificateProvider: lpadata.CertificateProvider{FirstNames: "John", LastName: "Doe"}})
+	err := IdentityWithOneLoginCallback(nil, oneLoginClient, sessionStore, certificateProviderStore, lpaStoreClient, nil)(testAppData, w, r, &certificateproviderdata.Provided{LpaID: "lpa-id"}, &lpadata.Lpa{LpaUID: "lpa-uid", CertificateProvider: lpadata.CertificateProvider{FirstNames: "John", LastName: "Doe"}})
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -122,7 +123,14 @@ func TestGetIdentityWithOneLoginCallbackWhenIdentityMismatched(t *testing.T) {
 		}).
 		Return(nil)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, certificateProviderStore, nil, eventClient)(testAppData, w, r, &certificateproviderdata.Provided{LpaID: "lpa-id", UID: actorUID}, &lpadata.Lpa{LpaUID: "lpa-uid", CertificateProvider: lpadata.CertificateProvider{FirstNames: "John", LastName: "Doe"}})
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		InfoContext(r.Context(), "identity check mismatched",
+			slog.String("lpa_uid", "lpa-uid"),
+			slog.Int("name_match_score", 0),
+			slog.Any("name_match_reasons", []identity.NameMatchReason{identity.NameMatchReasonFirstNameMismatch}))
+
+	err := IdentityWithOneLoginCallback(logger, oneLoginClient, sessionStore, certificateProviderStore, nil, eventClient)(testAppData, w, r, &certificateproviderdata.Provided{LpaID: "lpa-id", UID: actorUID}, &lpadata.Lpa{LpaUID: "lpa-uid", CertificateProvider: lpadata.CertificateProvider{FirstNames: "John", LastName: "Doe"}})
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -165,7 +173,11 @@ func TestGetIdentityWithOneLoginCallbackWhenIdentityMismatchedEventErrors(t *tes
 		SendIdentityCheckMismatched(mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, certificateProviderStore, nil, eventClient)(testAppData, w, r, &certificateproviderdata.Provided{LpaID: "lpa-id", UID: actorUID}, &lpadata.Lpa{LpaUID: "lpa-uid", CertificateProvider: lpadata.CertificateProvider{FirstNames: "John", LastName: "Doe"}})
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		InfoContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	err := IdentityWithOneLoginCallback(logger, oneLoginClient, sessionStore, certificateProviderStore, nil, eventClient)(testAppData, w, r, &certificateproviderdata.Provided{LpaID: "lpa-id", UID: actorUID}, &lpadata.Lpa{LpaUID: "lpa-uid", CertificateProvider: lpadata.CertificateProvider{FirstNames: "John", LastName: "Doe"}})
 
 	assert.Equal(t, expectedError, err)
 }
@@ -224,7 +236,7 @@ func TestGetIdentityWithOneLoginCallbackWhenIdentityCheckFailed(t *testing.T) {
 		}).
 		Return(nil)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, certificateProviderStore, nil, eventClient)(testAppData, w, r, &certificateproviderdata.Provided{LpaID: "lpa-id"}, lpa)
+	err := IdentityWithOneLoginCallback(nil, oneLoginClient, sessionStore, certificateProviderStore, nil, eventClient)(testAppData, w, r, &certificateproviderdata.Provided{LpaID: "lpa-id"}, lpa)
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -360,7 +372,7 @@ func TestGetIdentityWithOneLoginCallbackWhenIdentityNotConfirmed(t *testing.T) {
 			sessionStore := tc.sessionStore(t)
 			oneLoginClient := tc.oneLoginClient(t)
 
-			err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, tc.certificateProviderStore(t), nil, nil)(testAppData, w, r, &certificateproviderdata.Provided{LpaID: "lpa-id"}, &lpadata.Lpa{CertificateProvider: lpadata.CertificateProvider{}})
+			err := IdentityWithOneLoginCallback(nil, oneLoginClient, sessionStore, tc.certificateProviderStore(t), nil, nil)(testAppData, w, r, &certificateproviderdata.Provided{LpaID: "lpa-id"}, &lpadata.Lpa{CertificateProvider: lpadata.CertificateProvider{}})
 			resp := w.Result()
 
 			assert.Equal(t, tc.error, err)
@@ -396,7 +408,7 @@ func TestGetIdentityWithOneLoginCallbackWhenPutCertificateProviderStoreError(t *
 		ParseIdentityClaim(mock.Anything).
 		Return(identity.UserData{Status: identity.StatusConfirmed}, nil)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, certificateProviderStore, nil, nil)(testAppData, w, r, &certificateproviderdata.Provided{}, &lpadata.Lpa{CertificateProvider: lpadata.CertificateProvider{}})
+	err := IdentityWithOneLoginCallback(nil, oneLoginClient, sessionStore, certificateProviderStore, nil, nil)(testAppData, w, r, &certificateproviderdata.Provided{}, &lpadata.Lpa{CertificateProvider: lpadata.CertificateProvider{}})
 
 	assert.Equal(t, expectedError, err)
 }
@@ -432,7 +444,7 @@ func TestGetIdentityWithOneLoginCallbackWhenLpaStoreClientError(t *testing.T) {
 		SendCertificateProviderConfirmIdentity(mock.Anything, mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, certificateProviderStore, lpaStoreClient, nil)(testAppData, w, r, &certificateproviderdata.Provided{}, &lpadata.Lpa{CertificateProvider: lpadata.CertificateProvider{}})
+	err := IdentityWithOneLoginCallback(nil, oneLoginClient, sessionStore, certificateProviderStore, lpaStoreClient, nil)(testAppData, w, r, &certificateproviderdata.Provided{}, &lpadata.Lpa{CertificateProvider: lpadata.CertificateProvider{}})
 
 	assert.Equal(t, expectedError, err)
 }
@@ -443,7 +455,7 @@ func TestGetIdentityWithOneLoginCallbackWhenReturning(t *testing.T) {
 	now := time.Date(2012, time.January, 1, 2, 3, 4, 5, time.UTC)
 	userData := identity.UserData{Status: identity.StatusConfirmed, FirstNames: "first-names", LastName: "last-name", CheckedAt: now}
 
-	err := IdentityWithOneLoginCallback(nil, nil, nil, nil, nil)(testAppData, w, r, &certificateproviderdata.Provided{
+	err := IdentityWithOneLoginCallback(nil, nil, nil, nil, nil, nil)(testAppData, w, r, &certificateproviderdata.Provided{
 		IdentityUserData: userData,
 		LpaID:            "lpa-id",
 	}, &lpadata.Lpa{CertificateProvider: lpadata.CertificateProvider{FirstNames: "first-names", LastName: "last-name"}})
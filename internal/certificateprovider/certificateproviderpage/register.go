@@ -192,6 +192,8 @@ func Register(
 		ConfirmYourDetails(tmpls.Get("confirm_your_details.gohtml"), certificateProviderStore))
 	handleCertificateProvider(certificateprovider.PathYourRole, CanGoBack,
 		Guidance(tmpls.Get("your_role.gohtml")))
+	handleCertificateProvider(certificateprovider.PathWitnessCode, None,
+		WitnessCode(tmpls.Get("witness_code.gohtml"), donorStore, time.Now))
 	handleCertificateProvider(certificateprovider.PathReadTheDraftLpa, PresignImages,
 		Guidance(tmpls.Get("read_the_draft_lpa.gohtml")))
 
@@ -204,7 +206,7 @@ func Register(
 	handleCertificateProvider(certificateprovider.PathIdentityWithOneLogin, None,
 		IdentityWithOneLogin(oneLoginClient, sessionStore, random.AlphaNumeric))
 	handleCertificateProvider(certificateprovider.PathIdentityWithOneLoginCallback, None,
-		IdentityWithOneLoginCallback(oneLoginClient, sessionStore, certificateProviderStore, lpaStoreClient, eventClient))
+		IdentityWithOneLoginCallback(logger, oneLoginClient, sessionStore, certificateProviderStore, lpaStoreClient, eventClient))
 	handleCertificateProvider(certificateprovider.PathIdentityDetails, None,
 		Guidance(tmpls.Get("identity_details.gohtml")))
 
@@ -0,0 +1,46 @@
+package certificateproviderpage
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/certificateprovider/certificateproviderdata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/totp"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+)
+
+type witnessCodeData struct {
+	App        appcontext.Data
+	Errors     validation.List
+	Lpa        *lpadata.Lpa
+	Code       string
+	ValidUntil time.Time
+}
+
+// WitnessCode shows the certificate provider the current time-based code for
+// witnessing the donor sign, so they do not need to receive it by SMS.
+func WitnessCode(tmpl template.Template, donorStore DonorStore, now func() time.Time) Handler {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, _ *certificateproviderdata.Provided, lpa *lpadata.Lpa) error {
+		data := &witnessCodeData{
+			App: appData,
+			Lpa: lpa,
+		}
+
+		if !lpa.SignedForDonor() {
+			donor, err := donorStore.GetAny(r.Context())
+			if err != nil {
+				return err
+			}
+
+			if code, ok := donor.CertificateProviderTOTP.Current(now()); ok {
+				data.Code = code
+				data.ValidUntil = totp.StepTime(totp.Step(now()) + 1)
+			}
+		}
+
+		return tmpl(w, data)
+	}
+}
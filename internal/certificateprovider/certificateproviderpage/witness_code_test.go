@@ -0,0 +1,100 @@
+package certificateproviderpage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/totp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWitnessCode(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	code, _ := totp.Code(secret, testNow)
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpa := &lpadata.Lpa{LpaUID: "lpa-uid"}
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		GetAny(r.Context()).
+		Return(&donordata.Provided{
+			CertificateProviderTOTP: donordata.WitnessTOTP{Secret: secret, Created: testNow},
+		}, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &witnessCodeData{
+			App:        testAppData,
+			Lpa:        lpa,
+			Code:       code,
+			ValidUntil: totp.StepTime(totp.Step(testNow) + 1),
+		}).
+		Return(nil)
+
+	err := WitnessCode(template.Execute, donorStore, testNowFn)(testAppData, w, r, nil, lpa)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestGetWitnessCodeWhenNotSetUp(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpa := &lpadata.Lpa{LpaUID: "lpa-uid"}
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		GetAny(r.Context()).
+		Return(&donordata.Provided{}, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &witnessCodeData{App: testAppData, Lpa: lpa}).
+		Return(nil)
+
+	err := WitnessCode(template.Execute, donorStore, testNowFn)(testAppData, w, r, nil, lpa)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestGetWitnessCodeWhenAlreadySigned(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpa := &lpadata.Lpa{SignedAt: testNow, WitnessedByCertificateProviderAt: testNow}
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &witnessCodeData{App: testAppData, Lpa: lpa}).
+		Return(nil)
+
+	err := WitnessCode(template.Execute, nil, testNowFn)(testAppData, w, r, nil, lpa)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestGetWitnessCodeWhenDonorStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		GetAny(r.Context()).
+		Return(nil, expectedError)
+
+	err := WitnessCode(nil, donorStore, testNowFn)(testAppData, w, r, nil, &lpadata.Lpa{})
+
+	assert.Equal(t, expectedError, err)
+}
@@ -27,6 +27,7 @@ const (
 	PathTaskList                               = Path("/task-list")
 	PathWhatHappensNext                        = Path("/what-happens-next")
 	PathWhoIsEligible                          = Path("/certificate-provider-who-is-eligible")
+	PathWitnessCode                            = Path("/witness-code")
 	PathYourPreferredLanguage                  = Path("/your-preferred-language")
 	PathYourRole                               = Path("/your-role")
 )
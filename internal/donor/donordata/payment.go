@@ -1,6 +1,10 @@
 package donordata
 
-import "time"
+import (
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
+)
 
 type Payment struct {
 	// Reference generated for the payment
@@ -11,4 +15,48 @@ type Payment struct {
 	Amount int
 	// CreatedAt is when the payment was created
 	CreatedAt time.Time
+	// Refunds made against the payment
+	Refunds []Refund
+	// FeeScheduleFrom identifies the fee schedule that applied when the payment
+	// was made
+	FeeScheduleFrom time.Time
+}
+
+// HashInclude excludes fields added after payments were first recorded from the
+// hash when they are not set, so that existing payments keep the same hash.
+func (p Payment) HashInclude(field string, _ any) (bool, error) {
+	switch field {
+	case "Refunds":
+		return len(p.Refunds) > 0, nil
+	case "FeeScheduleFrom":
+		return !p.FeeScheduleFrom.IsZero(), nil
+	default:
+		return true, nil
+	}
+}
+
+// Refunded returns the amount, in pence, that has been refunded or is waiting
+// to be refunded. Refunds that errored are not included.
+func (p Payment) Refunded() int {
+	var refunded int
+	for _, refund := range p.Refunds {
+		if !refund.Status.IsError() {
+			refunded += refund.Amount
+		}
+	}
+
+	return refunded
+}
+
+type Refund struct {
+	// ID returned from GOV.UK Pay
+	RefundID string
+	// Amount is the amount refunded in pence
+	Amount int
+	// Status is the status of the refund reported by GOV.UK Pay
+	Status pay.RefundStatus
+	// CreatedAt is when the refund was created
+	CreatedAt time.Time
+	// StatusCheckedAt is when Status was last checked with GOV.UK Pay
+	StatusCheckedAt time.Time
 }
@@ -4,6 +4,7 @@ package donordata
 import (
 	"errors"
 	"iter"
+	"reflect"
 	"slices"
 	"time"
 
@@ -28,7 +29,7 @@ type Localizer interface {
 }
 
 const (
-	currentHashVersion                                       uint8 = 0
+	currentHashVersion                                       uint8 = 4
 	currentCheckedHashVersion                                uint8 = 0
 	currentCertificateProviderNotRelatedConfirmedHashVersion uint8 = 0
 	currentLpaStubHashVersion                                uint8 = 0
@@ -67,6 +68,9 @@ type Provided struct {
 	CreatedAt time.Time `checkhash:"-"`
 	// UpdatedAt is when the LPA was last updated
 	UpdatedAt time.Time `hash:"-" checkhash:"-"`
+	// AssignedMemberID is the ID of the organisation member the LPA is assigned
+	// to, if it was created by an organisation
+	AssignedMemberID string `checkhash:"-"`
 	// The donor the LPA relates to
 	Donor Donor
 	// Attorneys named in the LPA
@@ -171,6 +175,12 @@ type Provided struct {
 	IndependentWitnessCodes WitnessCodes `checkhash:"-"`
 	// When the signing was witnessed by the independent witness
 	WitnessedByIndependentWitnessAt time.Time `checkhash:"-"`
+	// Secret used for the certificate provider to witness signing with a
+	// time-based code
+	CertificateProviderTOTP WitnessTOTP `checkhash:"-"`
+	// Secret used for the independent witness to witness signing with a
+	// time-based code
+	IndependentWitnessTOTP WitnessTOTP `checkhash:"-"`
 	// Used to rate limit witness code attempts
 	WitnessCodeLimiter *rate.Limiter `checkhash:"-"`
 
@@ -187,6 +197,10 @@ type Provided struct {
 	// CostOfRepeatApplication is the fee the donor believes they are eligible
 	// for, if applying for a repeat of an LPA with reference prefixed M.
 	CostOfRepeatApplication pay.CostOfRepeatApplication `checkhash:"-"`
+	// PayTogetherWith is the key of another of the donor's LPAs that the donor
+	// has chosen to pay for in the same payment as this LPA. The other LPA is
+	// not changed until the payment is confirmed.
+	PayTogetherWith dynamo.LpaKeyType `checkhash:"-"`
 
 	// CertificateProviderInvitedAt records when the invite is sent to the
 	// certificate provider to act.
@@ -222,6 +236,10 @@ type Provided struct {
 	// progress tracker exemption/remission fee approved banner
 	HasSeenReducedFeeApprovalNotification bool `checkhash:"-"`
 
+	// HasSeenRefundNotification records if the donor has seen the progress
+	// tracker fee refunded banner
+	HasSeenRefundNotification bool `checkhash:"-"`
+
 	// HasSeenCertificateProviderIdentityMismatchResolvedNotification records if
 	// the donor has seen the progress tracker certificate provider identity
 	// confirmed banner
@@ -273,11 +291,37 @@ func (p *Provided) CanChangePersonalDetails() bool {
 		!p.DetailsVerifiedByVoucher
 }
 
-func (p *Provided) HashInclude(field string, _ any) (bool, error) {
+// IsAssignedTo returns true if the LPA has been assigned to the organisation
+// member.
+func (p *Provided) IsAssignedTo(memberID string) bool {
+	return p.AssignedMemberID != "" && p.AssignedMemberID == memberID
+}
+
+// hashFieldsAddedInVersion lists the fields added since the first HashVersion,
+// with the version that added them.
+var hashFieldsAddedInVersion = map[string]uint8{
+	"HasSeenRefundNotification": 1,
+	"PayTogetherWith":           2,
+	"CertificateProviderTOTP":   3,
+	"IndependentWitnessTOTP":    3,
+	"AssignedMemberID":          4,
+}
+
+// HashInclude excludes fields added after the donor's HashVersion from the hash
+// when they are not set, so that existing hashes still match. Once one of these
+// fields is set it is included, so the change is saved and the hash is moved
+// to the current version.
+func (p *Provided) HashInclude(field string, v any) (bool, error) {
 	if p.HashVersion > currentHashVersion {
 		return false, errors.New("HashVersion too high")
 	}
 
+	if version, ok := hashFieldsAddedInVersion[field]; ok && p.HashVersion < version {
+		if value, ok := v.(reflect.Value); ok && value.IsZero() {
+			return false, nil
+		}
+	}
+
 	return true, nil
 }
 
@@ -559,23 +603,68 @@ func (p *Provided) TrustCorporation() TrustCorporation {
 	return p.ReplacementAttorneys.TrustCorporation
 }
 
+// FeeSchedule returns the fees that apply to the LPA, based on when it was
+// applied for.
+func (p *Provided) FeeSchedule() pay.FeeSchedule {
+	return pay.FeeScheduleAt(p.CreatedAt)
+}
+
 func (p *Provided) Cost() int {
+	schedule := p.FeeSchedule()
+
 	if p.Tasks.PayForLpa.IsDenied() {
-		return pay.FeeFull
+		return schedule.Full
 	}
 
-	return pay.Cost(p.FeeType, p.PreviousFee, p.CostOfRepeatApplication)
+	return schedule.Cost(p.FeeType, p.PreviousFee, p.CostOfRepeatApplication)
 }
 
+// Paid returns the amount paid, less any refunds.
 func (p *Provided) Paid() pay.AmountPence {
 	var paid pay.AmountPence
 	for _, payment := range p.PaymentDetails {
-		paid += pay.AmountPence(payment.Amount)
+		paid += pay.AmountPence(payment.Amount - payment.Refunded())
 	}
 
 	return paid
 }
 
+// Refunded returns the amount refunded, or waiting to be refunded.
+func (p *Provided) Refunded() pay.AmountPence {
+	var refunded pay.AmountPence
+	for _, payment := range p.PaymentDetails {
+		refunded += pay.AmountPence(payment.Refunded())
+	}
+
+	return refunded
+}
+
+// SplitPayment divides an amount paid for several LPAs between them, giving
+// each the fee it has left to pay. Anything left over is given to the first
+// LPA.
+func SplitPayment(amount int, lpas ...*Provided) []int {
+	shares := make([]int, len(lpas))
+	for i, lpa := range lpas {
+		shares[i] = max(0, min(amount, lpa.FeeAmount().Pence()))
+		amount -= shares[i]
+	}
+
+	if len(shares) > 0 {
+		shares[0] += amount
+	}
+
+	return shares
+}
+
+// CanBePaidForWithAnotherLpa returns true if the full fee for the LPA can be
+// included in a payment made for another of the donor's LPAs.
+func (p *Provided) CanBePaidForWithAnotherLpa() bool {
+	return p.Tasks.CheckYourLpa.IsCompleted() &&
+		p.Tasks.PayForLpa.IsNotStarted() &&
+		p.FeeType.IsFullFee() &&
+		len(p.PaymentDetails) == 0
+}
+
 func (p *Provided) FeeAmount() pay.AmountPence {
 	return pay.AmountPence(p.Cost()) - p.Paid()
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor/actoruid"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/date"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/form"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/identity"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
@@ -170,6 +171,12 @@ func TestProvidedCanChange(t *testing.T) {
 	assert.False(t, (&Provided{SignedAt: time.Now()}).CanChange())
 }
 
+func TestProvidedIsAssignedTo(t *testing.T) {
+	assert.True(t, (&Provided{AssignedMemberID: "a"}).IsAssignedTo("a"))
+	assert.False(t, (&Provided{AssignedMemberID: "a"}).IsAssignedTo("b"))
+	assert.False(t, (&Provided{}).IsAssignedTo(""))
+}
+
 func TestProvidedCanChangePersonalDetails(t *testing.T) {
 	testcases := map[string]struct {
 		provided  Provided
@@ -212,7 +219,7 @@ func TestGenerateHash(t *testing.T) {
 	}
 
 	// DO change this value to match the updates
-	const modified uint64 = 0xfa2a077add59e3dc
+	const modified uint64 = 0xec40c3961ca7e7c5
 
 	// DO NOT change these initial hash values. If a field has been added/removed
 	// you will need to handle the version gracefully by modifying
@@ -220,6 +227,10 @@ func TestGenerateHash(t *testing.T) {
 	// version.
 	testcases := map[uint8]uint64{
 		0: 0x8f102e13ae7986a9,
+		1: 0xb621bb6a7c9e804c,
+		2: 0xa97e8aa761f45e9,
+		3: 0xed27ecfb48d5da4c,
+		4: 0x9b773c757808766d,
 	}
 
 	for version, initial := range testcases {
@@ -241,6 +252,28 @@ func TestGenerateHash(t *testing.T) {
 	}
 }
 
+func TestGenerateHashWhenAddedFieldSet(t *testing.T) {
+	testcases := map[string]func(*Provided){
+		"HasSeenRefundNotification": func(p *Provided) { p.HasSeenRefundNotification = true },
+		"PayTogetherWith":           func(p *Provided) { p.PayTogetherWith = dynamo.LpaKey("other") },
+		"CertificateProviderTOTP":   func(p *Provided) { p.CertificateProviderTOTP = WitnessTOTP{Secret: "SECRET"} },
+		"IndependentWitnessTOTP":    func(p *Provided) { p.IndependentWitnessTOTP = WitnessTOTP{Secret: "SECRET"} },
+		"AssignedMemberID":          func(p *Provided) { p.AssignedMemberID = "member-id" },
+	}
+
+	for name, setField := range testcases {
+		for version := uint8(0); version <= currentHashVersion; version++ {
+			t.Run(fmt.Sprintf("%sVersion%d", name, version), func(t *testing.T) {
+				donor := &Provided{HashVersion: version}
+				donor.Hash, _ = donor.generateHash()
+
+				setField(donor)
+				assert.True(t, donor.HashChanged())
+			})
+		}
+	}
+}
+
 func TestGenerateHashVersionTooHigh(t *testing.T) {
 	donor := &Provided{
 		HashVersion: currentHashVersion + 1,
@@ -745,12 +778,17 @@ func TestTrustCorporation(t *testing.T) {
 	assert.Equal(t, corporation, replacement.TrustCorporation())
 }
 
+func TestProvidedFeeSchedule(t *testing.T) {
+	provided := &Provided{CreatedAt: testNow}
+	assert.Equal(t, pay.FeeScheduleAt(testNow), provided.FeeSchedule())
+}
+
 func TestProvidedCost(t *testing.T) {
 	denied := &Provided{Tasks: Tasks{PayForLpa: task.PaymentStateDenied}}
-	assert.Equal(t, pay.FeeFull, denied.Cost())
+	assert.Equal(t, 9200, denied.Cost())
 
 	halfFee := &Provided{FeeType: pay.HalfFee}
-	assert.Equal(t, pay.FeeHalf, halfFee.Cost())
+	assert.Equal(t, 4600, halfFee.Cost())
 }
 
 func TestProvidedPaid(t *testing.T) {
@@ -761,16 +799,107 @@ func TestProvidedPaid(t *testing.T) {
 		PaymentDetails: []Payment{{Amount: 100}, {Amount: 20}, {Amount: 3}},
 	}
 	assert.Equal(t, pay.AmountPence(123), hasPaid.Paid())
+
+	hasRefund := &Provided{
+		PaymentDetails: []Payment{
+			{Amount: 100, Refunds: []Refund{{Amount: 10, Status: pay.RefundStatusSuccess}, {Amount: 20, Status: pay.RefundStatusError}}},
+			{Amount: 20, Refunds: []Refund{{Amount: 5, Status: pay.RefundStatusSubmitted}}},
+		},
+	}
+	assert.Equal(t, pay.AmountPence(105), hasRefund.Paid())
+}
+
+func TestProvidedRefunded(t *testing.T) {
+	notRefunded := &Provided{PaymentDetails: []Payment{{Amount: 100}}}
+	assert.Equal(t, pay.AmountPence(0), notRefunded.Refunded())
+
+	hasRefund := &Provided{
+		PaymentDetails: []Payment{
+			{Amount: 100, Refunds: []Refund{{Amount: 10, Status: pay.RefundStatusSuccess}, {Amount: 20, Status: pay.RefundStatusError}}},
+			{Amount: 20, Refunds: []Refund{{Amount: 5, Status: pay.RefundStatusSubmitted}}},
+		},
+	}
+	assert.Equal(t, pay.AmountPence(15), hasRefund.Refunded())
 }
 
 func TestProvidedFeeAmount(t *testing.T) {
 	notPaid := &Provided{}
-	assert.Equal(t, pay.AmountPence(pay.FeeFull), notPaid.FeeAmount())
+	assert.Equal(t, pay.AmountPence(9200), notPaid.FeeAmount())
 
-	halfFeePaid := &Provided{FeeType: pay.HalfFee, PaymentDetails: []Payment{{Amount: pay.FeeHalf}}}
+	halfFeePaid := &Provided{FeeType: pay.HalfFee, PaymentDetails: []Payment{{Amount: 4600}}}
 	assert.Equal(t, pay.AmountPence(0), halfFeePaid.FeeAmount())
 }
 
+func TestProvidedCanBePaidForWithAnotherLpa(t *testing.T) {
+	testcases := map[string]struct {
+		provided *Provided
+		expected bool
+	}{
+		"checked": {
+			provided: &Provided{Tasks: Tasks{CheckYourLpa: task.StateCompleted}},
+			expected: true,
+		},
+		"not checked": {
+			provided: &Provided{},
+		},
+		"payment started": {
+			provided: &Provided{Tasks: Tasks{CheckYourLpa: task.StateCompleted, PayForLpa: task.PaymentStateInProgress}},
+		},
+		"reduced fee": {
+			provided: &Provided{Tasks: Tasks{CheckYourLpa: task.StateCompleted}, FeeType: pay.HalfFee},
+		},
+		"paid": {
+			provided: &Provided{Tasks: Tasks{CheckYourLpa: task.StateCompleted}, PaymentDetails: []Payment{{Amount: 9200}}},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.provided.CanBePaidForWithAnotherLpa())
+		})
+	}
+}
+
+func TestSplitPayment(t *testing.T) {
+	testcases := map[string]struct {
+		amount   int
+		lpas     []*Provided
+		expected []int
+	}{
+		"exact": {
+			amount:   13800,
+			lpas:     []*Provided{{}, {FeeType: pay.HalfFee}},
+			expected: []int{9200, 4600},
+		},
+		"partly paid": {
+			amount:   13800,
+			lpas:     []*Provided{{PaymentDetails: []Payment{{Amount: 4600}}}, {}},
+			expected: []int{4600, 9200},
+		},
+		"over": {
+			amount:   20000,
+			lpas:     []*Provided{{}, {}},
+			expected: []int{10800, 9200},
+		},
+		"under": {
+			amount:   10000,
+			lpas:     []*Provided{{}, {}},
+			expected: []int{9200, 800},
+		},
+		"nothing to pay": {
+			amount:   9200,
+			lpas:     []*Provided{{PaymentDetails: []Payment{{Amount: 9200}}}, {}},
+			expected: []int{0, 9200},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SplitPayment(tc.amount, tc.lpas...))
+		})
+	}
+}
+
 func TestProvidedPaidAt(t *testing.T) {
 	notPaid := &Provided{}
 	assert.Equal(t, time.Time{}, notPaid.PaidAt())
@@ -1266,3 +1395,20 @@ func TestNamehasChanged(t *testing.T) {
 
 	assert.False(t, provided.Donor.NameHasChanged("a", "b", "c"))
 }
+
+func TestPaymentHashInclude(t *testing.T) {
+	include, _ := Payment{}.HashInclude("Amount", nil)
+	assert.True(t, include)
+
+	include, _ = Payment{}.HashInclude("Refunds", nil)
+	assert.False(t, include)
+
+	include, _ = Payment{Refunds: []Refund{{RefundID: "a"}}}.HashInclude("Refunds", nil)
+	assert.True(t, include)
+
+	include, _ = Payment{}.HashInclude("FeeScheduleFrom", nil)
+	assert.False(t, include)
+
+	include, _ = Payment{FeeScheduleFrom: testNow}.HashInclude("FeeScheduleFrom", nil)
+	assert.True(t, include)
+}
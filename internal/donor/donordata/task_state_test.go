@@ -373,11 +373,11 @@ func TestLpaCost(t *testing.T) {
 	}{
 		"denied": {
 			donor:    &Provided{FeeType: pay.HalfFee, Tasks: Tasks{PayForLpa: task.PaymentStateDenied}},
-			expected: pay.FeeFull,
+			expected: 9200,
 		},
 		"half": {
 			donor:    &Provided{FeeType: pay.HalfFee},
-			expected: pay.FeeHalf,
+			expected: 4600,
 		},
 	}
 
@@ -395,18 +395,18 @@ func TestFeeAmount(t *testing.T) {
 	}{
 		"not paid": {
 			Donor:        &Provided{FeeType: pay.HalfFee},
-			ExpectedCost: pay.AmountPence(pay.FeeHalf),
+			ExpectedCost: pay.AmountPence(4600),
 		},
 		"fully paid": {
-			Donor:        &Provided{FeeType: pay.HalfFee, PaymentDetails: []Payment{{Amount: pay.FeeHalf}}},
+			Donor:        &Provided{FeeType: pay.HalfFee, PaymentDetails: []Payment{{Amount: 4600}}},
 			ExpectedCost: pay.AmountPence(0),
 		},
 		"denied partially paid": {
-			Donor:        &Provided{FeeType: pay.HalfFee, PaymentDetails: []Payment{{Amount: pay.FeeHalf}}, Tasks: Tasks{PayForLpa: task.PaymentStateDenied}},
-			ExpectedCost: pay.AmountPence(pay.FeeHalf),
+			Donor:        &Provided{FeeType: pay.HalfFee, PaymentDetails: []Payment{{Amount: 4600}}, Tasks: Tasks{PayForLpa: task.PaymentStateDenied}},
+			ExpectedCost: pay.AmountPence(4600),
 		},
 		"denied fully paid": {
-			Donor:        &Provided{FeeType: pay.HalfFee, PaymentDetails: []Payment{{Amount: pay.FeeHalf}, {Amount: pay.FeeHalf}}, Tasks: Tasks{PayForLpa: task.PaymentStateDenied}},
+			Donor:        &Provided{FeeType: pay.HalfFee, PaymentDetails: []Payment{{Amount: 4600}, {Amount: 4600}}, Tasks: Tasks{PayForLpa: task.PaymentStateDenied}},
 			ExpectedCost: pay.AmountPence(0),
 		},
 	}
@@ -2,6 +2,8 @@ package donordata
 
 import (
 	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/totp"
 )
 
 const (
@@ -43,3 +45,59 @@ func (ws WitnessCodes) CanRequest(now time.Time) bool {
 	lastCode := ws[len(ws)-1]
 	return lastCode.Created.Add(witnessCodeRequestAfter).Before(now)
 }
+
+// WitnessTOTP lets a witness confirm they saw the donor sign with a code from
+// an authenticator app, or from their own signed in session, instead of one
+// sent by SMS.
+type WitnessTOTP struct {
+	Secret  string
+	Created time.Time
+	// UsedStep is the time step of the last code accepted, codes from the same
+	// or an earlier step cannot be used again.
+	UsedStep int64
+}
+
+func (w WitnessTOTP) IsZero() bool {
+	return w.Secret == ""
+}
+
+// Find returns the matching code, where Created is the start of the time step
+// it was generated for. As with WitnessCodes, a code will not be found once the
+// secret was created more than 2 hours ago.
+func (w WitnessTOTP) Find(code string, now time.Time) (WitnessCode, bool) {
+	if w.IsZero() || w.Created.Add(witnessCodeIgnoreAfter).Before(now) {
+		return WitnessCode{}, false
+	}
+
+	step, ok := totp.Match(w.Secret, code, now, 1)
+	if !ok || step <= w.UsedStep {
+		return WitnessCode{}, false
+	}
+
+	return WitnessCode{Code: code, Created: totp.StepTime(step)}, true
+}
+
+// Current returns the code for now, so it can be shown to a certificate
+// provider who is signed in. It returns false if the code would not be found.
+func (w WitnessTOTP) Current(now time.Time) (string, bool) {
+	if w.IsZero() || w.Created.Add(witnessCodeIgnoreAfter).Before(now) || totp.Step(now) <= w.UsedStep {
+		return "", false
+	}
+
+	code, err := totp.Code(w.Secret, now)
+	if err != nil {
+		return "", false
+	}
+
+	return code, true
+}
+
+// CanRequest returns true if a new secret can be created.
+func (w WitnessTOTP) CanRequest(now time.Time) bool {
+	return w.IsZero() || w.Created.Add(witnessCodeRequestAfter).Before(now)
+}
+
+// Use records that code has been accepted, so that it cannot be replayed.
+func (w *WitnessTOTP) Use(code WitnessCode) {
+	w.UsedStep = totp.Step(code.Created)
+}
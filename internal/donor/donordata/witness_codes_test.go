@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/totp"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -92,3 +93,138 @@ func TestWitnessCodesCanRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestWitnessTOTPFind(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	code := func(t time.Time) string {
+		c, _ := totp.Code(secret, t)
+		return c
+	}
+
+	testcases := map[string]struct {
+		totp     WitnessTOTP
+		code     string
+		expected bool
+	}{
+		"current": {
+			totp:     WitnessTOTP{Secret: secret, Created: testNow},
+			code:     code(testNow),
+			expected: true,
+		},
+		"previous step": {
+			totp:     WitnessTOTP{Secret: secret, Created: testNow},
+			code:     code(testNow.Add(-totp.Period)),
+			expected: true,
+		},
+		"too old": {
+			totp: WitnessTOTP{Secret: secret, Created: testNow},
+			code: code(testNow.Add(-2 * totp.Period)),
+		},
+		"already used": {
+			totp: WitnessTOTP{Secret: secret, Created: testNow, UsedStep: totp.Step(testNow)},
+			code: code(testNow),
+		},
+		"almost ignored": {
+			totp:     WitnessTOTP{Secret: secret, Created: testNow.Add(-2 * time.Hour)},
+			code:     code(testNow),
+			expected: true,
+		},
+		"ignored": {
+			totp: WitnessTOTP{Secret: secret, Created: testNow.Add(-2*time.Hour - time.Second)},
+			code: code(testNow),
+		},
+		"not set up": {
+			code: code(testNow),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			found, ok := tc.totp.Find(tc.code, testNow)
+			assert.Equal(t, tc.expected, ok)
+			if ok {
+				assert.Equal(t, tc.code, found.Code)
+				assert.False(t, found.HasExpired(testNow))
+			}
+		})
+	}
+}
+
+func TestWitnessTOTPUse(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	value, _ := totp.Code(secret, testNow)
+
+	witnessTOTP := WitnessTOTP{Secret: secret, Created: testNow}
+
+	code, ok := witnessTOTP.Find(value, testNow)
+	assert.True(t, ok)
+
+	witnessTOTP.Use(code)
+	assert.Equal(t, totp.Step(testNow), witnessTOTP.UsedStep)
+
+	_, ok = witnessTOTP.Find(value, testNow)
+	assert.False(t, ok)
+}
+
+func TestWitnessTOTPCurrent(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	expected, _ := totp.Code(secret, testNow)
+
+	testcases := map[string]struct {
+		totp     WitnessTOTP
+		expected bool
+	}{
+		"current": {
+			totp:     WitnessTOTP{Secret: secret, Created: testNow},
+			expected: true,
+		},
+		"already used": {
+			totp: WitnessTOTP{Secret: secret, Created: testNow, UsedStep: totp.Step(testNow)},
+		},
+		"ignored": {
+			totp: WitnessTOTP{Secret: secret, Created: testNow.Add(-2*time.Hour - time.Second)},
+		},
+		"invalid secret": {
+			totp: WitnessTOTP{Secret: "1", Created: testNow},
+		},
+		"not set up": {},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			code, ok := tc.totp.Current(testNow)
+			assert.Equal(t, tc.expected, ok)
+			if ok {
+				assert.Equal(t, expected, code)
+
+				_, found := tc.totp.Find(code, testNow)
+				assert.True(t, found)
+			}
+		})
+	}
+}
+
+func TestWitnessTOTPCanRequest(t *testing.T) {
+	testcases := map[string]struct {
+		totp     WitnessTOTP
+		expected bool
+	}{
+		"empty": {
+			expected: true,
+		},
+		"after 1 minute": {
+			totp:     WitnessTOTP{Secret: "a", Created: testNow.Add(-time.Minute - time.Second)},
+			expected: true,
+		},
+		"within 1 minute": {
+			totp:     WitnessTOTP{Secret: "a", Created: testNow.Add(-time.Minute)},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.totp.CanRequest(testNow))
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package donorpage
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/accesscode/accesscodedata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor/actoruid"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+)
+
+type accessCodesData struct {
+	App       appcontext.Data
+	Errors    validation.List
+	Donor     *donordata.Provided
+	Summaries []accesscodedata.Summary
+}
+
+func AccessCodes(tmpl template.Template, lpaStoreResolvingService LpaStoreResolvingService, accessCodeStore AccessCodeStore, accessCodeSender AccessCodeSender) Handler {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, provided *donordata.Provided) error {
+		lpa, err := lpaStoreResolvingService.Get(r.Context())
+		if err != nil {
+			return fmt.Errorf("error getting lpa: %w", err)
+		}
+
+		summaries, err := accessCodeStore.Summaries(r.Context(), lpa)
+		if err != nil {
+			return err
+		}
+
+		if r.Method == http.MethodPost {
+			actorUID, err := actoruid.Parse(r.FormValue("actor-uid"))
+			if err != nil {
+				return err
+			}
+
+			summary, ok := findResendable(summaries, actorUID)
+			if !ok {
+				return errors.New("cannot resend access code to actor")
+			}
+
+			if err := accessCodeSender.ResendAccessCode(r.Context(), appData, lpa, actorUID); err != nil {
+				return fmt.Errorf("error resending access code: %w", err)
+			}
+
+			return donor.PathAccessCodes.RedirectQuery(w, r, appData, provided, url.Values{
+				"resent": {summary.FullName},
+			})
+		}
+
+		return tmpl(w, &accessCodesData{
+			App:       appData,
+			Donor:     provided,
+			Summaries: summaries,
+		})
+	}
+}
+
+func findResendable(summaries []accesscodedata.Summary, actorUID actoruid.UID) (accesscodedata.Summary, bool) {
+	for _, summary := range summaries {
+		if summary.ActorUID == actorUID && summary.CanResend() {
+			return summary, true
+		}
+	}
+
+	return accesscodedata.Summary{}, false
+}
@@ -0,0 +1,198 @@
+package donorpage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/accesscode/accesscodedata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor/actoruid"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetAccessCodes(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	provided := &donordata.Provided{LpaID: "lpa-id"}
+	lpa := &lpadata.Lpa{LpaUID: "lpa-uid"}
+	summaries := []accesscodedata.Summary{{ActorUID: testUID, Status: accesscodedata.StatusSent}}
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(r.Context()).
+		Return(lpa, nil)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Summaries(r.Context(), lpa).
+		Return(summaries, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &accessCodesData{
+			App:       testAppData,
+			Donor:     provided,
+			Summaries: summaries,
+		}).
+		Return(nil)
+
+	err := AccessCodes(template.Execute, lpaStoreResolvingService, accessCodeStore, nil)(testAppData, w, r, provided)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestGetAccessCodesWhenLpaStoreResolvingServiceErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(nil, expectedError)
+
+	err := AccessCodes(nil, lpaStoreResolvingService, nil, nil)(testAppData, w, r, &donordata.Provided{})
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestGetAccessCodesWhenAccessCodeStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(&lpadata.Lpa{}, nil)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Summaries(mock.Anything, mock.Anything).
+		Return(nil, expectedError)
+
+	err := AccessCodes(nil, lpaStoreResolvingService, accessCodeStore, nil)(testAppData, w, r, &donordata.Provided{})
+	assert.Equal(t, expectedError, err)
+}
+
+func TestPostAccessCodes(t *testing.T) {
+	form := url.Values{"actor-uid": {testUID.String()}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	lpa := &lpadata.Lpa{LpaUID: "lpa-uid"}
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(r.Context()).
+		Return(lpa, nil)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Summaries(r.Context(), lpa).
+		Return([]accesscodedata.Summary{
+			{ActorUID: actoruid.New(), ActorType: actor.TypeAttorney, FullName: "Amy Adams", Status: accesscodedata.StatusSent},
+			{ActorUID: testUID, ActorType: actor.TypeAttorney, FullName: "Bob Brown", Status: accesscodedata.StatusExpired},
+		}, nil)
+
+	accessCodeSender := newMockAccessCodeSender(t)
+	accessCodeSender.EXPECT().
+		ResendAccessCode(r.Context(), testAppData, lpa, testUID).
+		Return(nil)
+
+	err := AccessCodes(nil, lpaStoreResolvingService, accessCodeStore, accessCodeSender)(testAppData, w, r, &donordata.Provided{LpaID: "lpa-id"})
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, donor.PathAccessCodes.FormatQuery("lpa-id", url.Values{"resent": {"Bob Brown"}}), resp.Header.Get("Location"))
+}
+
+func TestPostAccessCodesWhenCannotResend(t *testing.T) {
+	testcases := map[string]accesscodedata.Summary{
+		"used":     {ActorUID: testUID, ActorType: actor.TypeAttorney, Status: accesscodedata.StatusUsed},
+		"not sent": {ActorUID: testUID, ActorType: actor.TypeAttorney, Status: accesscodedata.StatusNotSent},
+		"voucher":  {ActorUID: testUID, ActorType: actor.TypeVoucher, Status: accesscodedata.StatusSent},
+		"other":    {ActorUID: actoruid.New(), ActorType: actor.TypeAttorney, Status: accesscodedata.StatusSent},
+	}
+
+	for name, summary := range testcases {
+		t.Run(name, func(t *testing.T) {
+			form := url.Values{"actor-uid": {testUID.String()}}
+
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+			r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+			lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+			lpaStoreResolvingService.EXPECT().
+				Get(mock.Anything).
+				Return(&lpadata.Lpa{}, nil)
+
+			accessCodeStore := newMockAccessCodeStore(t)
+			accessCodeStore.EXPECT().
+				Summaries(mock.Anything, mock.Anything).
+				Return([]accesscodedata.Summary{summary}, nil)
+
+			err := AccessCodes(nil, lpaStoreResolvingService, accessCodeStore, nil)(testAppData, w, r, &donordata.Provided{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPostAccessCodesWhenInvalidActorUID(t *testing.T) {
+	form := url.Values{"actor-uid": {"what"}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(&lpadata.Lpa{}, nil)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Summaries(mock.Anything, mock.Anything).
+		Return(nil, nil)
+
+	err := AccessCodes(nil, lpaStoreResolvingService, accessCodeStore, nil)(testAppData, w, r, &donordata.Provided{})
+	assert.Error(t, err)
+}
+
+func TestPostAccessCodesWhenAccessCodeSenderErrors(t *testing.T) {
+	form := url.Values{"actor-uid": {testUID.String()}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(&lpadata.Lpa{}, nil)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Summaries(mock.Anything, mock.Anything).
+		Return([]accesscodedata.Summary{{ActorUID: testUID, ActorType: actor.TypeAttorney, Status: accesscodedata.StatusSent}}, nil)
+
+	accessCodeSender := newMockAccessCodeSender(t)
+	accessCodeSender.EXPECT().
+		ResendAccessCode(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	err := AccessCodes(nil, lpaStoreResolvingService, accessCodeStore, accessCodeSender)(testAppData, w, r, &donordata.Provided{})
+	assert.ErrorIs(t, err, expectedError)
+}
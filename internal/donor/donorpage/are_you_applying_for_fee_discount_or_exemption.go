@@ -20,7 +20,7 @@ type areYouApplyingForFeeDiscountOrExemptionData struct {
 	Form                *form.YesNoForm
 }
 
-func AreYouApplyingForFeeDiscountOrExemption(tmpl template.Template, payer Handler, donorStore DonorStore) Handler {
+func AreYouApplyingForFeeDiscountOrExemption(tmpl template.Template, payer Handler, dashboardStore DashboardStore, donorStore DonorStore) Handler {
 	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, provided *donordata.Provided) error {
 		data := &areYouApplyingForFeeDiscountOrExemptionData{
 			App:                 appData,
@@ -45,6 +45,16 @@ func AreYouApplyingForFeeDiscountOrExemption(tmpl template.Template, payer Handl
 							return err
 						}
 					}
+
+					other, err := lpaToPayTogetherWith(r.Context(), appData, dashboardStore, donorStore, provided)
+					if err != nil {
+						return err
+					}
+
+					if other != nil {
+						return donor.PathPayForBothLpas.Redirect(w, r, appData, provided)
+					}
+
 					return payer(appData, w, r, provided)
 				} else {
 					return donor.PathWhichFeeTypeAreYouApplyingFor.Redirect(w, r, appData, provided)
@@ -7,9 +7,12 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dashboard/dashboarddata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/form"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
@@ -30,7 +33,7 @@ func TestGetAreYouApplyingForFeeDiscountOrExemption(t *testing.T) {
 		}).
 		Return(nil)
 
-	err := AreYouApplyingForFeeDiscountOrExemption(template.Execute, nil, nil)(testAppData, w, r, &donordata.Provided{})
+	err := AreYouApplyingForFeeDiscountOrExemption(template.Execute, nil, nil, nil)(testAppData, w, r, &donordata.Provided{})
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -49,7 +52,7 @@ func TestGetAreYouApplyingForFeeDiscountOrExemptionWhenTemplateErrors(t *testing
 		}).
 		Return(expectedError)
 
-	err := AreYouApplyingForFeeDiscountOrExemption(template.Execute, nil, nil)(testAppData, w, r, &donordata.Provided{})
+	err := AreYouApplyingForFeeDiscountOrExemption(template.Execute, nil, nil, nil)(testAppData, w, r, &donordata.Provided{})
 	resp := w.Result()
 
 	assert.Equal(t, expectedError, err)
@@ -81,7 +84,12 @@ func TestPostAreYouApplyingForFeeDiscountOrExemption(t *testing.T) {
 		}).
 		Return(nil)
 
-	err := AreYouApplyingForFeeDiscountOrExemption(nil, payer.Execute, donorStore)(testAppData, w, r, donor)
+	dashboardStore := newMockDashboardStore(t)
+	dashboardStore.EXPECT().
+		GetAll(r.Context()).
+		Return(dashboarddata.Results{}, nil)
+
+	err := AreYouApplyingForFeeDiscountOrExemption(nil, payer.Execute, dashboardStore, donorStore)(testAppData, w, r, donor)
 	assert.Nil(t, err)
 }
 
@@ -121,7 +129,12 @@ func TestPostAreYouApplyingForFeeDiscountOrExemptionWhenFeeTypeExistsAndIsNotFul
 		Return(nil).
 		Once()
 
-	err := AreYouApplyingForFeeDiscountOrExemption(nil, payer.Execute, donorStore)(testAppData, w, r, donor)
+	dashboardStore := newMockDashboardStore(t)
+	dashboardStore.EXPECT().
+		GetAll(r.Context()).
+		Return(dashboarddata.Results{}, nil)
+
+	err := AreYouApplyingForFeeDiscountOrExemption(nil, payer.Execute, dashboardStore, donorStore)(testAppData, w, r, donor)
 	assert.Nil(t, err)
 }
 
@@ -139,7 +152,7 @@ func TestPostAreYouApplyingForFeeDiscountOrExemptionWhenDonorStoreErrors(t *test
 		Put(r.Context(), mock.Anything).
 		Return(expectedError)
 
-	err := AreYouApplyingForFeeDiscountOrExemption(nil, nil, donorStore)(testAppData, w, r, &donordata.Provided{})
+	err := AreYouApplyingForFeeDiscountOrExemption(nil, nil, nil, donorStore)(testAppData, w, r, &donordata.Provided{})
 	assert.Equal(t, expectedError, err)
 }
 
@@ -164,7 +177,7 @@ func TestPostAreYouApplyingForFeeDiscountOrExemptionWhenDonorStoreErrorsAgain(t
 		Return(expectedError).
 		Once()
 
-	err := AreYouApplyingForFeeDiscountOrExemption(nil, nil, donorStore)(testAppData, w, r, donor)
+	err := AreYouApplyingForFeeDiscountOrExemption(nil, nil, nil, donorStore)(testAppData, w, r, donor)
 	assert.Equal(t, expectedError, err)
 }
 
@@ -187,10 +200,68 @@ func TestPostAreYouApplyingForFeeDiscountOrExemptionWhenPayerErrors(t *testing.T
 		Put(r.Context(), mock.Anything).
 		Return(nil)
 
-	err := AreYouApplyingForFeeDiscountOrExemption(nil, payer.Execute, donorStore)(testAppData, w, r, &donordata.Provided{})
+	dashboardStore := newMockDashboardStore(t)
+	dashboardStore.EXPECT().
+		GetAll(r.Context()).
+		Return(dashboarddata.Results{}, nil)
+
+	err := AreYouApplyingForFeeDiscountOrExemption(nil, payer.Execute, dashboardStore, donorStore)(testAppData, w, r, &donordata.Provided{})
 	assert.Equal(t, expectedError, err)
 }
 
+func TestPostAreYouApplyingForFeeDiscountOrExemptionWhenCanPayForBothLpas(t *testing.T) {
+	f := url.Values{
+		form.FieldNames.YesNo: {form.No.String()},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(f.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	dashboardStore := newMockDashboardStore(t)
+	dashboardStore.EXPECT().
+		GetAll(r.Context()).
+		Return(dashboarddata.Results{Donor: []dashboarddata.Actor{{Lpa: &lpadata.Lpa{LpaKey: dynamo.LpaKey("other"), Type: lpadata.LpaTypePersonalWelfare}}}}, nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Put(r.Context(), mock.Anything).
+		Return(nil)
+	donorStore.EXPECT().
+		One(r.Context(), dynamo.LpaKey("other"), mock.Anything).
+		Return(&donordata.Provided{Tasks: donordata.Tasks{CheckYourLpa: task.StateCompleted}}, nil)
+
+	err := AreYouApplyingForFeeDiscountOrExemption(nil, nil, dashboardStore, donorStore)(testAppData, w, r, &donordata.Provided{LpaID: "lpa-id", Type: lpadata.LpaTypePropertyAndAffairs})
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, donor.PathPayForBothLpas.Format("lpa-id"), resp.Header.Get("Location"))
+}
+
+func TestPostAreYouApplyingForFeeDiscountOrExemptionWhenDashboardStoreErrors(t *testing.T) {
+	f := url.Values{
+		form.FieldNames.YesNo: {form.No.String()},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(f.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	dashboardStore := newMockDashboardStore(t)
+	dashboardStore.EXPECT().
+		GetAll(r.Context()).
+		Return(dashboarddata.Results{}, expectedError)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Put(r.Context(), mock.Anything).
+		Return(nil)
+
+	err := AreYouApplyingForFeeDiscountOrExemption(nil, nil, dashboardStore, donorStore)(testAppData, w, r, &donordata.Provided{})
+	assert.ErrorIs(t, err, expectedError)
+}
+
 func TestPostAreYouApplyingForFeeDiscountOrExemptionWhenYes(t *testing.T) {
 	f := url.Values{
 		form.FieldNames.YesNo: {form.Yes.String()},
@@ -209,7 +280,7 @@ func TestPostAreYouApplyingForFeeDiscountOrExemptionWhenYes(t *testing.T) {
 		}).
 		Return(nil)
 
-	err := AreYouApplyingForFeeDiscountOrExemption(nil, nil, donorStore)(testAppData, w, r, &donordata.Provided{LpaID: "lpa-id", Donor: donordata.Donor{Email: "a@b.com"}})
+	err := AreYouApplyingForFeeDiscountOrExemption(nil, nil, nil, donorStore)(testAppData, w, r, &donordata.Provided{LpaID: "lpa-id", Donor: donordata.Donor{Email: "a@b.com"}})
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -235,7 +306,7 @@ func TestPostAreYouApplyingForFeeDiscountOrExemptionWhenValidationError(t *testi
 		})).
 		Return(nil)
 
-	err := AreYouApplyingForFeeDiscountOrExemption(template.Execute, nil, nil)(testAppData, w, r, &donordata.Provided{LpaID: "lpa-id", Donor: donordata.Donor{Email: "a@b.com"}})
+	err := AreYouApplyingForFeeDiscountOrExemption(template.Execute, nil, nil, nil)(testAppData, w, r, &donordata.Provided{LpaID: "lpa-id", Donor: donordata.Donor{Email: "a@b.com"}})
 	resp := w.Result()
 
 	assert.Nil(t, err)
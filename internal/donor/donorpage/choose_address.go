@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"strings"
 
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor/actoruid"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
@@ -41,6 +42,7 @@ type chooseAddressData struct {
 	MakingAnotherLPA  bool
 	CompletedAllTasks bool
 	CanTaskList       bool
+	LookupUnavailable bool
 }
 
 type titleKeys struct {
@@ -57,7 +59,17 @@ func (d *chooseAddressData) overrideTitleKeys(newTitleKeys titleKeys) {
 
 func lookupAddress(ctx context.Context, logger Logger, addressClient AddressClient, data *chooseAddressData, your bool) {
 	addresses, err := addressClient.LookupPostcode(ctx, data.Form.LookupPostcode)
-	if err != nil {
+	if errors.Is(err, place.ErrLookupTimeout) {
+		logger.WarnContext(ctx, "postcode lookup", slog.Any("err", err))
+
+		data.LookupUnavailable = true
+		data.Form.Action = "manual"
+		data.Form.Address = &place.Address{
+			Postcode: strings.ToUpper(data.Form.LookupPostcode),
+			Country:  "GB",
+		}
+		return
+	} else if err != nil {
 		logger.InfoContext(ctx, "postcode lookup", slog.Any("err", err))
 
 		if errors.As(err, &place.BadRequestError{}) {
@@ -0,0 +1,33 @@
+package donorpage
+
+import (
+	"net/http"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+)
+
+type communicationsSentData struct {
+	App           appcontext.Data
+	Errors        validation.List
+	Donor         *donordata.Provided
+	Notifications []notify.Notification
+}
+
+func CommunicationsSent(tmpl template.Template, notificationStore NotificationStore) Handler {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, provided *donordata.Provided) error {
+		notifications, err := notificationStore.GetAll(r.Context())
+		if err != nil {
+			return err
+		}
+
+		return tmpl(w, &communicationsSentData{
+			App:           appData,
+			Donor:         provided,
+			Notifications: notifications,
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package donorpage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCommunicationsSent(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	donor := &donordata.Provided{LpaUID: "M-0000"}
+	notifications := []notify.Notification{{NotifyID: "a"}, {NotifyID: "b"}}
+
+	notificationStore := newMockNotificationStore(t)
+	notificationStore.EXPECT().
+		GetAll(r.Context()).
+		Return(notifications, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &communicationsSentData{
+			App:           testAppData,
+			Donor:         donor,
+			Notifications: notifications,
+		}).
+		Return(nil)
+
+	err := CommunicationsSent(template.Execute, notificationStore)(testAppData, w, r, donor)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestGetCommunicationsSentWhenNotificationStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	notificationStore := newMockNotificationStore(t)
+	notificationStore.EXPECT().
+		GetAll(mock.Anything).
+		Return(nil, expectedError)
+
+	err := CommunicationsSent(nil, notificationStore)(testAppData, w, r, &donordata.Provided{})
+	assert.Equal(t, expectedError, err)
+}
+
+func TestGetCommunicationsSentWhenTemplateErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	notificationStore := newMockNotificationStore(t)
+	notificationStore.EXPECT().
+		GetAll(mock.Anything).
+		Return(nil, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, mock.Anything).
+		Return(expectedError)
+
+	err := CommunicationsSent(template.Execute, notificationStore)(testAppData, w, r, &donordata.Provided{})
+	assert.Equal(t, expectedError, err)
+}
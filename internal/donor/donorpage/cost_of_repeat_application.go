@@ -17,6 +17,7 @@ type costOfRepeatApplicationData struct {
 	App    appcontext.Data
 	Errors validation.List
 	Form   *form.SelectForm[pay.CostOfRepeatApplication, pay.CostOfRepeatApplicationOptions, *pay.CostOfRepeatApplication]
+	Fees   pay.FeeSchedule
 }
 
 func CostOfRepeatApplication(tmpl template.Template, donorStore DonorStore) Handler {
@@ -24,6 +25,7 @@ func CostOfRepeatApplication(tmpl template.Template, donorStore DonorStore) Hand
 		data := &costOfRepeatApplicationData{
 			App:  appData,
 			Form: form.NewSelectForm(provided.CostOfRepeatApplication, pay.CostOfRepeatApplicationValues, "whichFeeYouAreEligibleToPay"),
+			Fees: provided.FeeSchedule(),
 		}
 
 		if r.Method == http.MethodPost {
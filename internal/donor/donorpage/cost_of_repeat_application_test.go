@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
@@ -27,6 +28,7 @@ func TestGetCostOfRepeatApplication(t *testing.T) {
 		Execute(w, &costOfRepeatApplicationData{
 			App:  testAppData,
 			Form: form.NewEmptySelectForm[pay.CostOfRepeatApplication](pay.CostOfRepeatApplicationValues, "whichFeeYouAreEligibleToPay"),
+			Fees: pay.FeeScheduleAt(time.Time{}),
 		}).
 		Return(nil)
 
@@ -46,6 +48,7 @@ func TestGetCostOfRepeatApplicationFromStore(t *testing.T) {
 		Execute(w, &costOfRepeatApplicationData{
 			App:  testAppData,
 			Form: form.NewSelectForm(pay.CostOfRepeatApplicationHalfFee, pay.CostOfRepeatApplicationValues, "whichFeeYouAreEligibleToPay"),
+			Fees: pay.FeeScheduleAt(time.Time{}),
 		}).
 		Return(nil)
 
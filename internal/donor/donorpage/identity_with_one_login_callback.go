@@ -2,6 +2,7 @@ package donorpage
 
 import (
 	"errors"
+	"log/slog"
 	"net/http"
 	"net/url"
 
@@ -15,7 +16,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
 )
 
-func IdentityWithOneLoginCallback(oneLoginClient OneLoginClient, sessionStore SessionStore, donorStore DonorStore, scheduledStore ScheduledStore, eventClient EventClient) Handler {
+func IdentityWithOneLoginCallback(logger Logger, oneLoginClient OneLoginClient, sessionStore SessionStore, donorStore DonorStore, scheduledStore ScheduledStore, eventClient EventClient) Handler {
 	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, provided *donordata.Provided) error {
 		if provided.DonorIdentityConfirmed() {
 			return donor.PathIdentityDetails.Redirect(w, r, appData, provided)
@@ -54,6 +55,14 @@ func IdentityWithOneLoginCallback(oneLoginClient OneLoginClient, sessionStore Se
 		}
 
 		if (!provided.WitnessedByCertificateProviderAt.IsZero() && !provided.DonorIdentityConfirmed()) || provided.IdentityUserData.Status.IsFailed() {
+			if userData.Status.IsConfirmed() {
+				nameMatch := userData.ScoreName(provided.Donor.FirstNames, provided.Donor.LastName)
+				logger.InfoContext(r.Context(), "identity check mismatched",
+					slog.String("lpa_uid", provided.LpaUID),
+					slog.Int("name_match_score", nameMatch.Score),
+					slog.Any("name_match_reasons", nameMatch.Reasons))
+			}
+
 			if err := eventClient.SendIdentityCheckMismatched(r.Context(), event.IdentityCheckMismatched{
 				LpaUID:   provided.LpaUID,
 				ActorUID: provided.Donor.UID,
@@ -2,6 +2,7 @@ package donorpage
 
 import (
 	"errors"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -70,7 +71,7 @@ func TestGetIdentityWithOneLoginCallback(t *testing.T) {
 		}).
 		Return(nil)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, donorStore, scheduledStore, nil)(testAppData, w, r, &donordata.Provided{
+	err := IdentityWithOneLoginCallback(nil, oneLoginClient, sessionStore, donorStore, scheduledStore, nil)(testAppData, w, r, &donordata.Provided{
 		PK:    dynamo.LpaKey("hey"),
 		SK:    dynamo.LpaOwnerKey(dynamo.DonorKey("oh")),
 		LpaID: "lpa-id",
@@ -149,7 +150,14 @@ func TestGetIdentityWithOneLoginCallbackWhenIdentityMismatched(t *testing.T) {
 		}).
 		Return(nil)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, donorStore, scheduledStore, eventClient)(testAppData, w, r, &donordata.Provided{
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		InfoContext(r.Context(), "identity check mismatched",
+			slog.String("lpa_uid", "lpa-uid"),
+			slog.Int("name_match_score", 0),
+			slog.Any("name_match_reasons", []identity.NameMatchReason{identity.NameMatchReasonLastNameMismatch}))
+
+	err := IdentityWithOneLoginCallback(logger, oneLoginClient, sessionStore, donorStore, scheduledStore, eventClient)(testAppData, w, r, &donordata.Provided{
 		PK:                               dynamo.LpaKey("hey"),
 		SK:                               dynamo.LpaOwnerKey(dynamo.DonorKey("oh")),
 		LpaID:                            "lpa-id",
@@ -194,7 +202,11 @@ func TestGetIdentityWithOneLoginCallbackWhenIdentityMismatchedEventErrors(t *tes
 		SendIdentityCheckMismatched(mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, nil, nil, eventClient)(testAppData, w, r, &donordata.Provided{
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		InfoContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	err := IdentityWithOneLoginCallback(logger, oneLoginClient, sessionStore, nil, nil, eventClient)(testAppData, w, r, &donordata.Provided{
 		PK:                               dynamo.LpaKey("hey"),
 		SK:                               dynamo.LpaOwnerKey(dynamo.DonorKey("oh")),
 		LpaID:                            "lpa-id",
@@ -239,7 +251,7 @@ func TestGetIdentityWithOneLoginCallbackWhenScheduledStoreErrors(t *testing.T) {
 		Create(mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, donorStore, scheduledStore, nil)(testAppData, w, r, &donordata.Provided{
+	err := IdentityWithOneLoginCallback(nil, oneLoginClient, sessionStore, donorStore, scheduledStore, nil)(testAppData, w, r, &donordata.Provided{
 		PK:    dynamo.LpaKey("hey"),
 		SK:    dynamo.LpaOwnerKey(dynamo.DonorKey("oh")),
 		LpaID: "lpa-id",
@@ -378,7 +390,7 @@ func TestGetIdentityWithOneLoginCallbackWhenIdentityNotConfirmed(t *testing.T) {
 			oneLoginClient := tc.oneLoginClient(t)
 			eventClient := tc.eventClient(t)
 
-			err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, tc.donorStore(t), nil, eventClient)(testAppData, w, r, &donordata.Provided{})
+			err := IdentityWithOneLoginCallback(nil, oneLoginClient, sessionStore, tc.donorStore(t), nil, eventClient)(testAppData, w, r, &donordata.Provided{})
 			resp := w.Result()
 
 			assert.Equal(t, tc.error, err)
@@ -417,7 +429,7 @@ func TestGetIdentityWithOneLoginCallbackWhenInsufficientEvidenceReturnCodeClaimP
 		ParseIdentityClaim(mock.Anything).
 		Return(identity.UserData{Status: identity.StatusInsufficientEvidence}, nil)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, donorStore, nil, nil)(testAppData, w, r, &donordata.Provided{
+	err := IdentityWithOneLoginCallback(nil, oneLoginClient, sessionStore, donorStore, nil, nil)(testAppData, w, r, &donordata.Provided{
 		Donor: donordata.Donor{FirstNames: "John", LastName: "Doe"},
 		LpaID: "lpa-id",
 	})
@@ -473,7 +485,7 @@ func TestGetIdentityWithOneLoginCallbackWhenAnyOtherReturnCodeClaimPresent(t *te
 		}).
 		Return(nil)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, donorStore, nil, eventClient)(testAppData, w, r, &donordata.Provided{
+	err := IdentityWithOneLoginCallback(nil, oneLoginClient, sessionStore, donorStore, nil, eventClient)(testAppData, w, r, &donordata.Provided{
 		Donor:  donordata.Donor{UID: actorUID, FirstNames: "John", LastName: "Doe"},
 		LpaID:  "lpa-id",
 		LpaUID: "lpa-uid",
@@ -511,7 +523,7 @@ func TestGetIdentityWithOneLoginCallbackWhenPutDonorStoreError(t *testing.T) {
 		ParseIdentityClaim(mock.Anything).
 		Return(identity.UserData{Status: identity.StatusConfirmed}, nil)
 
-	err := IdentityWithOneLoginCallback(oneLoginClient, sessionStore, donorStore, nil, nil)(testAppData, w, r, &donordata.Provided{})
+	err := IdentityWithOneLoginCallback(nil, oneLoginClient, sessionStore, donorStore, nil, nil)(testAppData, w, r, &donordata.Provided{})
 
 	assert.Equal(t, expectedError, err)
 }
@@ -522,7 +534,7 @@ func TestGetIdentityWithOneLoginCallbackWhenReturning(t *testing.T) {
 	now := time.Date(2012, time.January, 1, 2, 3, 4, 5, time.UTC)
 	userData := identity.UserData{Status: identity.StatusConfirmed, FirstNames: "first-name", LastName: "last-name", CheckedAt: now}
 
-	err := IdentityWithOneLoginCallback(nil, nil, nil, nil, nil)(testAppData, w, r, &donordata.Provided{
+	err := IdentityWithOneLoginCallback(nil, nil, nil, nil, nil, nil)(testAppData, w, r, &donordata.Provided{
 		LpaID:            "lpa-id",
 		Donor:            donordata.Donor{FirstNames: "first-name", LastName: "last-name"},
 		IdentityUserData: userData,
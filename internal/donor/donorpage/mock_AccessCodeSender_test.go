@@ -3,12 +3,15 @@
 package donorpage
 
 import (
-	context "context"
-
+	actoruid "github.com/ministryofjustice/opg-modernising-lpa/internal/actor/actoruid"
 	appcontext "github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 
+	context "context"
+
 	donordata "github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
 
+	lpadata "github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -25,6 +28,55 @@ func (_m *mockAccessCodeSender) EXPECT() *mockAccessCodeSender_Expecter {
 	return &mockAccessCodeSender_Expecter{mock: &_m.Mock}
 }
 
+// ResendAccessCode provides a mock function with given fields: ctx, appData, lpa, actorUID
+func (_m *mockAccessCodeSender) ResendAccessCode(ctx context.Context, appData appcontext.Data, lpa *lpadata.Lpa, actorUID actoruid.UID) error {
+	ret := _m.Called(ctx, appData, lpa, actorUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendAccessCode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, appcontext.Data, *lpadata.Lpa, actoruid.UID) error); ok {
+		r0 = rf(ctx, appData, lpa, actorUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockAccessCodeSender_ResendAccessCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResendAccessCode'
+type mockAccessCodeSender_ResendAccessCode_Call struct {
+	*mock.Call
+}
+
+// ResendAccessCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - appData appcontext.Data
+//   - lpa *lpadata.Lpa
+//   - actorUID actoruid.UID
+func (_e *mockAccessCodeSender_Expecter) ResendAccessCode(ctx interface{}, appData interface{}, lpa interface{}, actorUID interface{}) *mockAccessCodeSender_ResendAccessCode_Call {
+	return &mockAccessCodeSender_ResendAccessCode_Call{Call: _e.mock.On("ResendAccessCode", ctx, appData, lpa, actorUID)}
+}
+
+func (_c *mockAccessCodeSender_ResendAccessCode_Call) Run(run func(ctx context.Context, appData appcontext.Data, lpa *lpadata.Lpa, actorUID actoruid.UID)) *mockAccessCodeSender_ResendAccessCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(appcontext.Data), args[2].(*lpadata.Lpa), args[3].(actoruid.UID))
+	})
+	return _c
+}
+
+func (_c *mockAccessCodeSender_ResendAccessCode_Call) Return(_a0 error) *mockAccessCodeSender_ResendAccessCode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockAccessCodeSender_ResendAccessCode_Call) RunAndReturn(run func(context.Context, appcontext.Data, *lpadata.Lpa, actoruid.UID) error) *mockAccessCodeSender_ResendAccessCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendCertificateProviderInvite provides a mock function with given fields: ctx, appData, provided
 func (_m *mockAccessCodeSender) SendCertificateProviderInvite(ctx context.Context, appData appcontext.Data, provided *donordata.Provided) error {
 	ret := _m.Called(ctx, appData, provided)
@@ -10,6 +10,8 @@ import (
 
 	context "context"
 
+	lpadata "github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -131,6 +133,65 @@ func (_c *mockAccessCodeStore_Get_Call) RunAndReturn(run func(context.Context, a
 	return _c
 }
 
+// Summaries provides a mock function with given fields: ctx, lpa
+func (_m *mockAccessCodeStore) Summaries(ctx context.Context, lpa *lpadata.Lpa) ([]accesscodedata.Summary, error) {
+	ret := _m.Called(ctx, lpa)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Summaries")
+	}
+
+	var r0 []accesscodedata.Summary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *lpadata.Lpa) ([]accesscodedata.Summary, error)); ok {
+		return rf(ctx, lpa)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *lpadata.Lpa) []accesscodedata.Summary); ok {
+		r0 = rf(ctx, lpa)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]accesscodedata.Summary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *lpadata.Lpa) error); ok {
+		r1 = rf(ctx, lpa)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockAccessCodeStore_Summaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Summaries'
+type mockAccessCodeStore_Summaries_Call struct {
+	*mock.Call
+}
+
+// Summaries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lpa *lpadata.Lpa
+func (_e *mockAccessCodeStore_Expecter) Summaries(ctx interface{}, lpa interface{}) *mockAccessCodeStore_Summaries_Call {
+	return &mockAccessCodeStore_Summaries_Call{Call: _e.mock.On("Summaries", ctx, lpa)}
+}
+
+func (_c *mockAccessCodeStore_Summaries_Call) Run(run func(ctx context.Context, lpa *lpadata.Lpa)) *mockAccessCodeStore_Summaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*lpadata.Lpa))
+	})
+	return _c
+}
+
+func (_c *mockAccessCodeStore_Summaries_Call) Return(_a0 []accesscodedata.Summary, _a1 error) *mockAccessCodeStore_Summaries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockAccessCodeStore_Summaries_Call) RunAndReturn(run func(context.Context, *lpadata.Lpa) ([]accesscodedata.Summary, error)) *mockAccessCodeStore_Summaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // newMockAccessCodeStore creates a new instance of mockAccessCodeStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newMockAccessCodeStore(t interface {
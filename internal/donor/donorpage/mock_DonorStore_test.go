@@ -9,6 +9,8 @@ import (
 
 	donordata "github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
 
+	dynamo "github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -282,6 +284,66 @@ func (_c *mockDonorStore_Link_Call) RunAndReturn(run func(context.Context, acces
 	return _c
 }
 
+// One provides a mock function with given fields: ctx, pk, sk
+func (_m *mockDonorStore) One(ctx context.Context, pk dynamo.LpaKeyType, sk dynamo.SK) (*donordata.Provided, error) {
+	ret := _m.Called(ctx, pk, sk)
+
+	if len(ret) == 0 {
+		panic("no return value specified for One")
+	}
+
+	var r0 *donordata.Provided
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dynamo.LpaKeyType, dynamo.SK) (*donordata.Provided, error)); ok {
+		return rf(ctx, pk, sk)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dynamo.LpaKeyType, dynamo.SK) *donordata.Provided); ok {
+		r0 = rf(ctx, pk, sk)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*donordata.Provided)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dynamo.LpaKeyType, dynamo.SK) error); ok {
+		r1 = rf(ctx, pk, sk)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockDonorStore_One_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'One'
+type mockDonorStore_One_Call struct {
+	*mock.Call
+}
+
+// One is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pk dynamo.LpaKeyType
+//   - sk dynamo.SK
+func (_e *mockDonorStore_Expecter) One(ctx interface{}, pk interface{}, sk interface{}) *mockDonorStore_One_Call {
+	return &mockDonorStore_One_Call{Call: _e.mock.On("One", ctx, pk, sk)}
+}
+
+func (_c *mockDonorStore_One_Call) Run(run func(ctx context.Context, pk dynamo.LpaKeyType, sk dynamo.SK)) *mockDonorStore_One_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dynamo.LpaKeyType), args[2].(dynamo.SK))
+	})
+	return _c
+}
+
+func (_c *mockDonorStore_One_Call) Return(_a0 *donordata.Provided, _a1 error) *mockDonorStore_One_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockDonorStore_One_Call) RunAndReturn(run func(context.Context, dynamo.LpaKeyType, dynamo.SK) (*donordata.Provided, error)) *mockDonorStore_One_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Put provides a mock function with given fields: ctx, donor
 func (_m *mockDonorStore) Put(ctx context.Context, donor *donordata.Provided) error {
 	ret := _m.Called(ctx, donor)
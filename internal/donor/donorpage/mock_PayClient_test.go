@@ -185,6 +185,64 @@ func (_c *mockPayClient_GetPayment_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetRefund provides a mock function with given fields: ctx, paymentID, refundID
+func (_m *mockPayClient) GetRefund(ctx context.Context, paymentID string, refundID string) (pay.RefundResponse, error) {
+	ret := _m.Called(ctx, paymentID, refundID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRefund")
+	}
+
+	var r0 pay.RefundResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (pay.RefundResponse, error)); ok {
+		return rf(ctx, paymentID, refundID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) pay.RefundResponse); ok {
+		r0 = rf(ctx, paymentID, refundID)
+	} else {
+		r0 = ret.Get(0).(pay.RefundResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, paymentID, refundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockPayClient_GetRefund_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRefund'
+type mockPayClient_GetRefund_Call struct {
+	*mock.Call
+}
+
+// GetRefund is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentID string
+//   - refundID string
+func (_e *mockPayClient_Expecter) GetRefund(ctx interface{}, paymentID interface{}, refundID interface{}) *mockPayClient_GetRefund_Call {
+	return &mockPayClient_GetRefund_Call{Call: _e.mock.On("GetRefund", ctx, paymentID, refundID)}
+}
+
+func (_c *mockPayClient_GetRefund_Call) Run(run func(ctx context.Context, paymentID string, refundID string)) *mockPayClient_GetRefund_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *mockPayClient_GetRefund_Call) Return(_a0 pay.RefundResponse, _a1 error) *mockPayClient_GetRefund_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockPayClient_GetRefund_Call) RunAndReturn(run func(context.Context, string, string) (pay.RefundResponse, error)) *mockPayClient_GetRefund_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // newMockPayClient creates a new instance of mockPayClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newMockPayClient(t interface {
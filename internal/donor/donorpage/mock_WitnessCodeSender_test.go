@@ -116,6 +116,100 @@ func (_c *mockWitnessCodeSender_SendToIndependentWitness_Call) RunAndReturn(run
 	return _c
 }
 
+// SetUpCertificateProviderTOTP provides a mock function with given fields: _a0, _a1
+func (_m *mockWitnessCodeSender) SetUpCertificateProviderTOTP(_a0 context.Context, _a1 *donordata.Provided) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUpCertificateProviderTOTP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *donordata.Provided) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockWitnessCodeSender_SetUpCertificateProviderTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUpCertificateProviderTOTP'
+type mockWitnessCodeSender_SetUpCertificateProviderTOTP_Call struct {
+	*mock.Call
+}
+
+// SetUpCertificateProviderTOTP is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *donordata.Provided
+func (_e *mockWitnessCodeSender_Expecter) SetUpCertificateProviderTOTP(_a0 interface{}, _a1 interface{}) *mockWitnessCodeSender_SetUpCertificateProviderTOTP_Call {
+	return &mockWitnessCodeSender_SetUpCertificateProviderTOTP_Call{Call: _e.mock.On("SetUpCertificateProviderTOTP", _a0, _a1)}
+}
+
+func (_c *mockWitnessCodeSender_SetUpCertificateProviderTOTP_Call) Run(run func(_a0 context.Context, _a1 *donordata.Provided)) *mockWitnessCodeSender_SetUpCertificateProviderTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*donordata.Provided))
+	})
+	return _c
+}
+
+func (_c *mockWitnessCodeSender_SetUpCertificateProviderTOTP_Call) Return(_a0 error) *mockWitnessCodeSender_SetUpCertificateProviderTOTP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockWitnessCodeSender_SetUpCertificateProviderTOTP_Call) RunAndReturn(run func(context.Context, *donordata.Provided) error) *mockWitnessCodeSender_SetUpCertificateProviderTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUpIndependentWitnessTOTP provides a mock function with given fields: _a0, _a1
+func (_m *mockWitnessCodeSender) SetUpIndependentWitnessTOTP(_a0 context.Context, _a1 *donordata.Provided) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUpIndependentWitnessTOTP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *donordata.Provided) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockWitnessCodeSender_SetUpIndependentWitnessTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUpIndependentWitnessTOTP'
+type mockWitnessCodeSender_SetUpIndependentWitnessTOTP_Call struct {
+	*mock.Call
+}
+
+// SetUpIndependentWitnessTOTP is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *donordata.Provided
+func (_e *mockWitnessCodeSender_Expecter) SetUpIndependentWitnessTOTP(_a0 interface{}, _a1 interface{}) *mockWitnessCodeSender_SetUpIndependentWitnessTOTP_Call {
+	return &mockWitnessCodeSender_SetUpIndependentWitnessTOTP_Call{Call: _e.mock.On("SetUpIndependentWitnessTOTP", _a0, _a1)}
+}
+
+func (_c *mockWitnessCodeSender_SetUpIndependentWitnessTOTP_Call) Run(run func(_a0 context.Context, _a1 *donordata.Provided)) *mockWitnessCodeSender_SetUpIndependentWitnessTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*donordata.Provided))
+	})
+	return _c
+}
+
+func (_c *mockWitnessCodeSender_SetUpIndependentWitnessTOTP_Call) Return(_a0 error) *mockWitnessCodeSender_SetUpIndependentWitnessTOTP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockWitnessCodeSender_SetUpIndependentWitnessTOTP_Call) RunAndReturn(run func(context.Context, *donordata.Provided) error) *mockWitnessCodeSender_SetUpIndependentWitnessTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // newMockWitnessCodeSender creates a new instance of mockWitnessCodeSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newMockWitnessCodeSender(t interface {
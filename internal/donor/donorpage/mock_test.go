@@ -67,6 +67,12 @@ func (m *mockSessionStore) withPaySession(r *http.Request) *mockSessionStore {
 	return m
 }
 
+func (m *mockSessionStore) withoutPaySession(r *http.Request) *mockSessionStore {
+	m.EXPECT().Payment(r).Return(nil, sesh.MissingSessionError("payment"))
+
+	return m
+}
+
 func (m *mockSessionStore) withExpiredPaySession(r *http.Request, w *httptest.ResponseRecorder) *mockSessionStore {
 	m.EXPECT().ClearPayment(r, w).Return(nil)
 
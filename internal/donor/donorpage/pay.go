@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/scheduled"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/scheduled/scheduleddata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/sesh"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
 )
@@ -18,6 +21,8 @@ func Pay(
 	sessionStore SessionStore,
 	donorStore DonorStore,
 	payClient PayClient,
+	scheduledStore ScheduledStore,
+	now func() time.Time,
 	appPublicURL string,
 ) Handler {
 	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, provided *donordata.Provided) error {
@@ -30,22 +35,67 @@ func Pay(
 			return donor.PathPendingPayment.Redirect(w, r, appData, provided)
 		}
 
+		var other *donordata.Provided
+		if provided.PayTogetherWith != "" {
+			var err error
+			other, err = donorStore.One(r.Context(), provided.PayTogetherWith, provided.SK)
+			if err != nil {
+				return fmt.Errorf("error retrieving LPA to pay together with: %w", err)
+			}
+
+			if !other.CanBePaidForWithAnotherLpa() {
+				other = nil
+			}
+		}
+
 		createPaymentBody := pay.CreatePaymentBody{
 			Amount:    provided.FeeAmount().Pence(),
 			Reference: provided.LpaUID,
-			Description: appData.Localizer.Format("typeLpa", map[string]any{
-				"Type": appData.Localizer.T(provided.Type.String()),
-			}),
 			ReturnURL: appPublicURL + appData.Lang.URL(donor.PathPaymentConfirmation.Format(provided.LpaID)),
 			Email:     provided.Donor.Email,
 			Language:  appData.Lang.String(),
 		}
 
+		if other != nil {
+			createPaymentBody.Amount += other.FeeAmount().Pence()
+			createPaymentBody.Description = appData.Localizer.Format("typeLpaAndTypeLpa", map[string]any{
+				"Type":      appData.Localizer.T(provided.Type.String()),
+				"OtherType": appData.Localizer.T(other.Type.String()),
+			})
+		} else {
+			createPaymentBody.Description = appData.Localizer.Format("typeLpa", map[string]any{
+				"Type": appData.Localizer.T(provided.Type.String()),
+			})
+		}
+
+		// Return to a payment already started for this LPA, rather than creating
+		// another, so that each payment is only scheduled for reconciliation once.
+		if paymentSession, err := sessionStore.Payment(r); err == nil {
+			payment, err := payClient.GetPayment(r.Context(), paymentSession.PaymentID)
+			if err == nil && !payment.State.Finished && payment.Reference == provided.LpaUID && payment.AmountPence.Pence() == createPaymentBody.Amount {
+				if nextUrl := payment.Links["next_url"].Href; payClient.CanRedirect(nextUrl) {
+					http.Redirect(w, r, nextUrl, http.StatusFound)
+					return nil
+				}
+			}
+		}
+
 		resp, err := payClient.CreatePayment(r.Context(), provided.LpaUID, createPaymentBody)
 		if err != nil {
 			return fmt.Errorf("error creating payment: %w", err)
 		}
 
+		if err := scheduledStore.Create(r.Context(), scheduled.Event{
+			At:                now().AddDate(0, 0, 1),
+			Action:            scheduleddata.ActionReconcilePayment,
+			TargetLpaKey:      provided.PK,
+			TargetLpaOwnerKey: provided.SK,
+			LpaUID:            provided.LpaUID,
+			PaymentID:         resp.PaymentID,
+		}); err != nil {
+			return fmt.Errorf("error scheduling payment reconciliation: %w", err)
+		}
+
 		if err = sessionStore.SetPayment(r, w, &sesh.PaymentSession{PaymentID: resp.PaymentID}); err != nil {
 			return err
 		}
@@ -0,0 +1,100 @@
+package donorpage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/form"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+)
+
+type payForBothLpasData struct {
+	App      appcontext.Data
+	Errors   validation.List
+	Form     *form.YesNoForm
+	OtherLpa *donordata.Provided
+	Amount   pay.AmountPence
+}
+
+func PayForBothLpas(tmpl template.Template, payer Handler, dashboardStore DashboardStore, donorStore DonorStore) Handler {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, provided *donordata.Provided) error {
+		other, err := lpaToPayTogetherWith(r.Context(), appData, dashboardStore, donorStore, provided)
+		if err != nil {
+			return err
+		}
+
+		if other == nil {
+			return payer(appData, w, r, provided)
+		}
+
+		data := &payForBothLpasData{
+			App:      appData,
+			Form:     form.NewYesNoForm(form.YesNoUnknown),
+			OtherLpa: other,
+			Amount:   provided.FeeAmount() + other.FeeAmount(),
+		}
+
+		if provided.PayTogetherWith == other.PK {
+			data.Form.YesNo = form.Yes
+		}
+
+		if r.Method == http.MethodPost {
+			data.Form = form.ReadYesNoForm(r, "whetherToPayForBothLpas")
+			data.Errors = data.Form.Validate()
+
+			if data.Errors.None() {
+				if data.Form.YesNo.IsYes() {
+					provided.PayTogetherWith = other.PK
+				} else if provided.PayTogetherWith != "" {
+					provided.PayTogetherWith = ""
+				} else {
+					return payer(appData, w, r, provided)
+				}
+
+				if err := donorStore.Put(r.Context(), provided); err != nil {
+					return err
+				}
+
+				return payer(appData, w, r, provided)
+			}
+		}
+
+		return tmpl(w, data)
+	}
+}
+
+// lpaToPayTogetherWith finds another of the donor's LPAs, of the other type,
+// that is ready to be paid for in the same payment as provided. It returns nil
+// if there is no such LPA.
+func lpaToPayTogetherWith(ctx context.Context, appData appcontext.Data, dashboardStore DashboardStore, donorStore DonorStore, provided *donordata.Provided) (*donordata.Provided, error) {
+	if appData.SupporterData != nil || !provided.FeeType.IsFullFee() {
+		return nil, nil
+	}
+
+	results, err := dashboardStore.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting LPAs: %w", err)
+	}
+
+	for _, result := range results.Donor {
+		if result.Lpa.LpaKey == provided.PK || result.Lpa.Type == provided.Type || result.Lpa.Submitted {
+			continue
+		}
+
+		other, err := donorStore.One(ctx, result.Lpa.LpaKey, result.Lpa.LpaOwnerKey)
+		if err != nil {
+			return nil, fmt.Errorf("error getting other LPA: %w", err)
+		}
+
+		if other.CanBePaidForWithAnotherLpa() {
+			return other, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,340 @@
+package donorpage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dashboard/dashboarddata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/form"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	testPayForBothLpasResults = dashboarddata.Results{Donor: []dashboarddata.Actor{
+		{Lpa: &lpadata.Lpa{LpaKey: dynamo.LpaKey("lpa-id"), Type: lpadata.LpaTypePropertyAndAffairs}},
+		{Lpa: &lpadata.Lpa{LpaKey: dynamo.LpaKey("same-type"), Type: lpadata.LpaTypePropertyAndAffairs}},
+		{Lpa: &lpadata.Lpa{LpaKey: dynamo.LpaKey("submitted"), Type: lpadata.LpaTypePersonalWelfare, Submitted: true}},
+		{Lpa: &lpadata.Lpa{LpaKey: dynamo.LpaKey("not-checked"), Type: lpadata.LpaTypePersonalWelfare, LpaOwnerKey: dynamo.LpaOwnerKey(dynamo.DonorKey("a"))}},
+		{Lpa: &lpadata.Lpa{LpaKey: dynamo.LpaKey("other"), Type: lpadata.LpaTypePersonalWelfare, LpaOwnerKey: dynamo.LpaOwnerKey(dynamo.DonorKey("a"))}},
+	}}
+	testPayForBothLpasProvided = func() *donordata.Provided {
+		return &donordata.Provided{
+			PK:    dynamo.LpaKey("lpa-id"),
+			LpaID: "lpa-id",
+			Type:  lpadata.LpaTypePropertyAndAffairs,
+		}
+	}
+	testPayForBothLpasOther = func() *donordata.Provided {
+		return &donordata.Provided{
+			PK:    dynamo.LpaKey("other"),
+			Type:  lpadata.LpaTypePersonalWelfare,
+			Tasks: donordata.Tasks{CheckYourLpa: task.StateCompleted},
+		}
+	}
+)
+
+func newPayForBothLpasStores(t *testing.T, r *http.Request, other *donordata.Provided) (*mockDashboardStore, *mockDonorStore) {
+	dashboardStore := newMockDashboardStore(t)
+	dashboardStore.EXPECT().
+		GetAll(r.Context()).
+		Return(testPayForBothLpasResults, nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		One(r.Context(), dynamo.LpaKey("not-checked"), dynamo.LpaOwnerKey(dynamo.DonorKey("a"))).
+		Return(&donordata.Provided{PK: dynamo.LpaKey("not-checked")}, nil)
+	donorStore.EXPECT().
+		One(r.Context(), dynamo.LpaKey("other"), dynamo.LpaOwnerKey(dynamo.DonorKey("a"))).
+		Return(other, nil)
+
+	return dashboardStore, donorStore
+}
+
+func TestGetPayForBothLpas(t *testing.T) {
+	testcases := map[string]struct {
+		provided *donordata.Provided
+		other    *donordata.Provided
+		yesNo    form.YesNo
+	}{
+		"not linked": {
+			provided: testPayForBothLpasProvided(),
+			other:    testPayForBothLpasOther(),
+			yesNo:    form.YesNoUnknown,
+		},
+		"linked": {
+			provided: &donordata.Provided{
+				PK:              dynamo.LpaKey("lpa-id"),
+				Type:            lpadata.LpaTypePropertyAndAffairs,
+				PayTogetherWith: dynamo.LpaKey("other"),
+			},
+			other: testPayForBothLpasOther(),
+			yesNo: form.Yes,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+			dashboardStore, donorStore := newPayForBothLpasStores(t, r, tc.other)
+
+			template := newMockTemplate(t)
+			template.EXPECT().
+				Execute(w, &payForBothLpasData{
+					App:      testAppData,
+					Form:     form.NewYesNoForm(tc.yesNo),
+					OtherLpa: tc.other,
+					Amount:   pay.AmountPence(18400),
+				}).
+				Return(nil)
+
+			err := PayForBothLpas(template.Execute, nil, dashboardStore, donorStore)(testAppData, w, r, tc.provided)
+			resp := w.Result()
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		})
+	}
+}
+
+func TestGetPayForBothLpasWhenNoOtherLpa(t *testing.T) {
+	testcases := map[string]struct {
+		appData        appcontext.Data
+		provided       *donordata.Provided
+		dashboardStore func(*testing.T) *mockDashboardStore
+	}{
+		"supporter": {
+			appData:        testSupporterAppData,
+			provided:       testPayForBothLpasProvided(),
+			dashboardStore: func(t *testing.T) *mockDashboardStore { return nil },
+		},
+		"reduced fee": {
+			appData:        testAppData,
+			provided:       &donordata.Provided{FeeType: pay.HalfFee},
+			dashboardStore: func(t *testing.T) *mockDashboardStore { return nil },
+		},
+		"no other LPAs": {
+			appData:  testAppData,
+			provided: testPayForBothLpasProvided(),
+			dashboardStore: func(t *testing.T) *mockDashboardStore {
+				s := newMockDashboardStore(t)
+				s.EXPECT().GetAll(mock.Anything).Return(dashboarddata.Results{}, nil)
+				return s
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+			payer := newMockHandler(t)
+			payer.EXPECT().
+				Execute(tc.appData, w, r, tc.provided).
+				Return(nil)
+
+			err := PayForBothLpas(nil, payer.Execute, tc.dashboardStore(t), nil)(tc.appData, w, r, tc.provided)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestGetPayForBothLpasWhenOtherLpaCannotBePaidFor(t *testing.T) {
+	testcases := map[string]func(*donordata.Provided){
+		"reduced fee": func(other *donordata.Provided) {
+			other.FeeType = pay.HalfFee
+		},
+		"payment started": func(other *donordata.Provided) {
+			other.Tasks.PayForLpa = task.PaymentStateInProgress
+		},
+		"already paid": func(other *donordata.Provided) {
+			other.PaymentDetails = []donordata.Payment{{PaymentID: "payment-id", Amount: 9200}}
+		},
+	}
+
+	for name, setup := range testcases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+			other := testPayForBothLpasOther()
+			setup(other)
+
+			dashboardStore, donorStore := newPayForBothLpasStores(t, r, other)
+			provided := testPayForBothLpasProvided()
+
+			payer := newMockHandler(t)
+			payer.EXPECT().
+				Execute(testAppData, w, r, provided).
+				Return(nil)
+
+			err := PayForBothLpas(nil, payer.Execute, dashboardStore, donorStore)(testAppData, w, r, provided)
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestGetPayForBothLpasWhenStoreErrors(t *testing.T) {
+	testcases := map[string]struct {
+		dashboardStore func(*testing.T) *mockDashboardStore
+		donorStore     func(*testing.T) *mockDonorStore
+	}{
+		"dashboard store": {
+			dashboardStore: func(t *testing.T) *mockDashboardStore {
+				s := newMockDashboardStore(t)
+				s.EXPECT().GetAll(mock.Anything).Return(dashboarddata.Results{}, expectedError)
+				return s
+			},
+			donorStore: func(t *testing.T) *mockDonorStore { return nil },
+		},
+		"donor store": {
+			dashboardStore: func(t *testing.T) *mockDashboardStore {
+				s := newMockDashboardStore(t)
+				s.EXPECT().GetAll(mock.Anything).Return(testPayForBothLpasResults, nil)
+				return s
+			},
+			donorStore: func(t *testing.T) *mockDonorStore {
+				s := newMockDonorStore(t)
+				s.EXPECT().One(mock.Anything, mock.Anything, mock.Anything).Return(nil, expectedError)
+				return s
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+			err := PayForBothLpas(nil, nil, tc.dashboardStore(t), tc.donorStore(t))(testAppData, w, r, testPayForBothLpasProvided())
+			assert.ErrorIs(t, err, expectedError)
+		})
+	}
+}
+
+func TestPostPayForBothLpasWhenYes(t *testing.T) {
+	f := url.Values{
+		form.FieldNames.YesNo: {form.Yes.String()},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(f.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	provided := testPayForBothLpasProvided()
+
+	dashboardStore, donorStore := newPayForBothLpasStores(t, r, testPayForBothLpasOther())
+	donorStore.EXPECT().
+		Put(r.Context(), &donordata.Provided{
+			PK:              dynamo.LpaKey("lpa-id"),
+			LpaID:           "lpa-id",
+			Type:            lpadata.LpaTypePropertyAndAffairs,
+			PayTogetherWith: dynamo.LpaKey("other"),
+		}).
+		Return(nil)
+
+	payer := newMockHandler(t)
+	payer.EXPECT().
+		Execute(testAppData, w, r, provided).
+		Return(nil)
+
+	err := PayForBothLpas(nil, payer.Execute, dashboardStore, donorStore)(testAppData, w, r, provided)
+	assert.Nil(t, err)
+}
+
+func TestPostPayForBothLpasWhenNo(t *testing.T) {
+	f := url.Values{
+		form.FieldNames.YesNo: {form.No.String()},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(f.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	provided := testPayForBothLpasProvided()
+	dashboardStore, donorStore := newPayForBothLpasStores(t, r, testPayForBothLpasOther())
+
+	payer := newMockHandler(t)
+	payer.EXPECT().
+		Execute(testAppData, w, r, provided).
+		Return(nil)
+
+	err := PayForBothLpas(nil, payer.Execute, dashboardStore, donorStore)(testAppData, w, r, provided)
+	assert.Nil(t, err)
+}
+
+func TestPostPayForBothLpasWhenNoAndLinked(t *testing.T) {
+	f := url.Values{
+		form.FieldNames.YesNo: {form.No.String()},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(f.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	provided := testPayForBothLpasProvided()
+	provided.PayTogetherWith = dynamo.LpaKey("other")
+
+	dashboardStore, donorStore := newPayForBothLpasStores(t, r, testPayForBothLpasOther())
+	donorStore.EXPECT().
+		Put(r.Context(), testPayForBothLpasProvided()).
+		Return(nil)
+
+	payer := newMockHandler(t)
+	payer.EXPECT().
+		Execute(testAppData, w, r, provided).
+		Return(nil)
+
+	err := PayForBothLpas(nil, payer.Execute, dashboardStore, donorStore)(testAppData, w, r, provided)
+	assert.Nil(t, err)
+}
+
+func TestPostPayForBothLpasWhenDonorStoreErrors(t *testing.T) {
+	f := url.Values{
+		form.FieldNames.YesNo: {form.Yes.String()},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(f.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	dashboardStore, donorStore := newPayForBothLpasStores(t, r, testPayForBothLpasOther())
+	donorStore.EXPECT().
+		Put(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	err := PayForBothLpas(nil, nil, dashboardStore, donorStore)(testAppData, w, r, testPayForBothLpasProvided())
+	assert.Equal(t, expectedError, err)
+}
+
+func TestPostPayForBothLpasWhenValidationError(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(""))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	dashboardStore, donorStore := newPayForBothLpasStores(t, r, testPayForBothLpasOther())
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, mock.MatchedBy(func(data *payForBothLpasData) bool {
+			return assert.Equal(t, validation.With(form.FieldNames.YesNo, validation.SelectError{Label: "whetherToPayForBothLpas"}), data.Errors)
+		})).
+		Return(nil)
+
+	err := PayForBothLpas(template.Execute, nil, dashboardStore, donorStore)(testAppData, w, r, testPayForBothLpasProvided())
+	assert.Nil(t, err)
+}
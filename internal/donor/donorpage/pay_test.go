@@ -8,8 +8,11 @@ import (
 
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/scheduled"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/scheduled/scheduleddata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/sesh"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
 	"github.com/stretchr/testify/assert"
@@ -38,7 +41,8 @@ func TestPay(t *testing.T) {
 			w := httptest.NewRecorder()
 			r, _ := http.NewRequest(http.MethodPost, "/about-payment", nil)
 
-			sessionStore := newMockSessionStore(t)
+			sessionStore := newMockSessionStore(t).
+				withoutPaySession(r)
 			sessionStore.EXPECT().
 				SetPayment(r, w, &sesh.PaymentSession{PaymentID: "a-fake-id"}).
 				Return(nil)
@@ -46,7 +50,7 @@ func TestPay(t *testing.T) {
 			payClient := newMockPayClient(t)
 			payClient.EXPECT().
 				CreatePayment(r.Context(), "lpa-uid", pay.CreatePaymentBody{
-					Amount:      pay.FeeFull,
+					Amount:      9200,
 					Reference:   "lpa-uid",
 					Description: "an-lpa-type",
 					ReturnURL:   "http://example.org/lpa/lpa-id/payment-confirmation",
@@ -65,6 +69,18 @@ func TestPay(t *testing.T) {
 				CanRedirect(tc.nextURL).
 				Return(tc.canRedirect)
 
+			scheduledStore := newMockScheduledStore(t)
+			scheduledStore.EXPECT().
+				Create(r.Context(), scheduled.Event{
+					At:                testNow.AddDate(0, 0, 1),
+					Action:            scheduleddata.ActionReconcilePayment,
+					TargetLpaKey:      dynamo.LpaKey("lpa-id"),
+					TargetLpaOwnerKey: dynamo.LpaOwnerKey(dynamo.DonorKey("donor")),
+					LpaUID:            "lpa-uid",
+					PaymentID:         "a-fake-id",
+				}).
+				Return(nil)
+
 			localizer := newMockLocalizer(t)
 			localizer.EXPECT().
 				T(lpadata.LpaTypePropertyAndAffairs.String()).
@@ -82,7 +98,9 @@ func TestPay(t *testing.T) {
 					InfoContext(r.Context(), "skipping payment", slog.String("next_url", tc.nextURL))
 			}
 
-			err := Pay(logger, sessionStore, nil, payClient, "http://example.org")(appData, w, r, &donordata.Provided{
+			err := Pay(logger, sessionStore, nil, payClient, scheduledStore, testNowFn, "http://example.org")(appData, w, r, &donordata.Provided{
+				PK:      dynamo.LpaKey("lpa-id"),
+				SK:      dynamo.LpaOwnerKey(dynamo.DonorKey("donor")),
 				LpaID:   "lpa-id",
 				LpaUID:  "lpa-uid",
 				Type:    lpadata.LpaTypePropertyAndAffairs,
@@ -98,6 +116,275 @@ func TestPay(t *testing.T) {
 	}
 }
 
+func TestPayWhenPaymentAlreadyStarted(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/about-payment", nil)
+
+	sessionStore := newMockSessionStore(t).
+		withPaySession(r)
+
+	payClient := newMockPayClient(t)
+	payClient.EXPECT().
+		GetPayment(r.Context(), "abc123").
+		Return(pay.GetPaymentResponse{
+			Reference:   "lpa-uid",
+			AmountPence: 9200,
+			State:       pay.State{Status: "started"},
+			Links:       map[string]pay.Link{"next_url": {Href: "https://www.payments.service.gov.uk/path-from/response"}},
+		}, nil)
+	payClient.EXPECT().
+		CanRedirect("https://www.payments.service.gov.uk/path-from/response").
+		Return(true)
+
+	localizer := newMockLocalizer(t)
+	localizer.EXPECT().
+		T(mock.Anything).
+		Return("a-type")
+	localizer.EXPECT().
+		Format(mock.Anything, mock.Anything).
+		Return("an-lpa-type")
+
+	appData := testAppData
+	appData.Localizer = localizer
+
+	err := Pay(nil, sessionStore, nil, payClient, nil, testNowFn, "http://example.org")(appData, w, r, &donordata.Provided{
+		LpaID:   "lpa-id",
+		LpaUID:  "lpa-uid",
+		FeeType: pay.FullFee,
+	})
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, "https://www.payments.service.gov.uk/path-from/response", resp.Header.Get("Location"))
+}
+
+func TestPayWhenStartedPaymentCannotBeUsed(t *testing.T) {
+	startedPayment := pay.GetPaymentResponse{
+		Reference:   "lpa-uid",
+		AmountPence: 9200,
+		State:       pay.State{Status: "started"},
+		Links:       map[string]pay.Link{"next_url": {Href: "https://www.payments.service.gov.uk/old"}},
+	}
+
+	testcases := map[string]struct {
+		payment     pay.GetPaymentResponse
+		err         error
+		canRedirect bool
+	}{
+		"finished": {
+			payment: func() pay.GetPaymentResponse {
+				p := startedPayment
+				p.State = pay.State{Status: "failed", Finished: true}
+				return p
+			}(),
+		},
+		"different lpa": {
+			payment: func() pay.GetPaymentResponse {
+				p := startedPayment
+				p.Reference = "other-uid"
+				return p
+			}(),
+		},
+		"different amount": {
+			payment: func() pay.GetPaymentResponse {
+				p := startedPayment
+				p.AmountPence = 4100
+				return p
+			}(),
+		},
+		"cannot redirect": {
+			payment: startedPayment,
+		},
+		"error": {
+			err: expectedError,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(http.MethodPost, "/about-payment", nil)
+
+			sessionStore := newMockSessionStore(t).
+				withPaySession(r)
+			sessionStore.EXPECT().
+				SetPayment(r, w, &sesh.PaymentSession{PaymentID: "new-id"}).
+				Return(nil)
+
+			payClient := newMockPayClient(t)
+			payClient.EXPECT().
+				GetPayment(r.Context(), "abc123").
+				Return(tc.payment, tc.err)
+			payClient.EXPECT().
+				CanRedirect("https://www.payments.service.gov.uk/old").
+				Return(false).
+				Maybe()
+			payClient.EXPECT().
+				CreatePayment(r.Context(), "lpa-uid", mock.Anything).
+				Return(&pay.CreatePaymentResponse{
+					PaymentID: "new-id",
+					Links:     map[string]pay.Link{"next_url": {Href: "https://www.payments.service.gov.uk/new"}},
+				}, nil)
+			payClient.EXPECT().
+				CanRedirect("https://www.payments.service.gov.uk/new").
+				Return(true)
+
+			scheduledStore := newMockScheduledStore(t)
+			scheduledStore.EXPECT().
+				Create(r.Context(), mock.MatchedBy(func(e scheduled.Event) bool { return e.PaymentID == "new-id" })).
+				Return(nil)
+
+			localizer := newMockLocalizer(t)
+			localizer.EXPECT().
+				T(mock.Anything).
+				Return("a-type")
+			localizer.EXPECT().
+				Format(mock.Anything, mock.Anything).
+				Return("an-lpa-type")
+
+			appData := testAppData
+			appData.Localizer = localizer
+
+			err := Pay(nil, sessionStore, nil, payClient, scheduledStore, testNowFn, "http://example.org")(appData, w, r, &donordata.Provided{
+				LpaID:   "lpa-id",
+				LpaUID:  "lpa-uid",
+				FeeType: pay.FullFee,
+			})
+			resp := w.Result()
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusFound, resp.StatusCode)
+			assert.Equal(t, "https://www.payments.service.gov.uk/new", resp.Header.Get("Location"))
+		})
+	}
+}
+
+func TestPayWhenPayingTogether(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/about-payment", nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		One(r.Context(), dynamo.LpaKey("other-id"), dynamo.LpaOwnerKey(dynamo.DonorKey("donor"))).
+		Return(&donordata.Provided{
+			PK:    dynamo.LpaKey("other-id"),
+			Type:  lpadata.LpaTypePersonalWelfare,
+			Tasks: donordata.Tasks{CheckYourLpa: task.StateCompleted},
+		}, nil)
+
+	sessionStore := newMockSessionStore(t).
+		withoutPaySession(r)
+	sessionStore.EXPECT().
+		SetPayment(r, w, &sesh.PaymentSession{PaymentID: "a-fake-id"}).
+		Return(nil)
+
+	payClient := newMockPayClient(t)
+	payClient.EXPECT().
+		CreatePayment(r.Context(), "lpa-uid", pay.CreatePaymentBody{
+			Amount:      18400,
+			Reference:   "lpa-uid",
+			Description: "both-lpa-types",
+			ReturnURL:   "http://example.org/lpa/lpa-id/payment-confirmation",
+			Email:       "a@b.com",
+			Language:    "en",
+		}).
+		Return(&pay.CreatePaymentResponse{
+			PaymentID: "a-fake-id",
+			Links:     map[string]pay.Link{"next_url": {Href: "https://www.payments.service.gov.uk/path-from/response"}},
+		}, nil)
+	payClient.EXPECT().
+		CanRedirect(mock.Anything).
+		Return(true)
+
+	scheduledStore := newMockScheduledStore(t)
+	scheduledStore.EXPECT().
+		Create(r.Context(), mock.Anything).
+		Return(nil)
+
+	localizer := newMockLocalizer(t)
+	localizer.EXPECT().
+		T(lpadata.LpaTypePropertyAndAffairs.String()).
+		Return("a-type")
+	localizer.EXPECT().
+		T(lpadata.LpaTypePersonalWelfare.String()).
+		Return("other-type")
+	localizer.EXPECT().
+		Format("typeLpaAndTypeLpa", map[string]any{"Type": "a-type", "OtherType": "other-type"}).
+		Return("both-lpa-types")
+
+	appData := testAppData
+	appData.Localizer = localizer
+
+	err := Pay(nil, sessionStore, donorStore, payClient, scheduledStore, testNowFn, "http://example.org")(appData, w, r, &donordata.Provided{
+		PK:              dynamo.LpaKey("lpa-id"),
+		SK:              dynamo.LpaOwnerKey(dynamo.DonorKey("donor")),
+		LpaID:           "lpa-id",
+		LpaUID:          "lpa-uid",
+		Type:            lpadata.LpaTypePropertyAndAffairs,
+		Donor:           donordata.Donor{Email: "a@b.com"},
+		PayTogetherWith: dynamo.LpaKey("other-id"),
+	})
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+}
+
+func TestPayWhenPayingTogetherWithLpaThatCannotBePaidFor(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/about-payment", nil)
+
+	sessionStore := newMockSessionStore(t).
+		withoutPaySession(r)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		One(r.Context(), mock.Anything, mock.Anything).
+		Return(&donordata.Provided{PK: dynamo.LpaKey("other-id"), Tasks: donordata.Tasks{CheckYourLpa: task.StateCompleted, PayForLpa: task.PaymentStateInProgress}}, nil)
+
+	payClient := newMockPayClient(t)
+	payClient.EXPECT().
+		CreatePayment(r.Context(), mock.Anything, mock.MatchedBy(func(body pay.CreatePaymentBody) bool {
+			return body.Amount == 9200 && body.Description == "an-lpa-type"
+		})).
+		Return(nil, expectedError)
+
+	localizer := newMockLocalizer(t)
+	localizer.EXPECT().
+		T(mock.Anything).
+		Return("a-type")
+	localizer.EXPECT().
+		Format("typeLpa", mock.Anything).
+		Return("an-lpa-type")
+
+	appData := testAppData
+	appData.Localizer = localizer
+
+	err := Pay(nil, sessionStore, donorStore, payClient, nil, nil, "")(appData, w, r, &donordata.Provided{
+		PK:              dynamo.LpaKey("lpa-id"),
+		PayTogetherWith: dynamo.LpaKey("other-id"),
+	})
+
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestPayWhenPayingTogetherDonorStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/about-payment", nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		One(r.Context(), mock.Anything, mock.Anything).
+		Return(nil, expectedError)
+
+	err := Pay(nil, nil, donorStore, nil, nil, nil, "")(testAppData, w, r, &donordata.Provided{
+		PayTogetherWith: dynamo.LpaKey("other-id"),
+	})
+
+	assert.ErrorIs(t, err, expectedError)
+}
+
 func TestPayWhenPaymentNotRequired(t *testing.T) {
 	testCases := map[string]struct {
 		feeType     pay.FeeType
@@ -125,7 +412,7 @@ func TestPayWhenPaymentNotRequired(t *testing.T) {
 				}).
 				Return(nil)
 
-			err := Pay(nil, nil, donorStore, nil, "")(testAppData, w, r, &donordata.Provided{
+			err := Pay(nil, nil, donorStore, nil, nil, nil, "")(testAppData, w, r, &donordata.Provided{
 				LpaID:            "lpa-id",
 				FeeType:          tc.feeType,
 				PreviousFee:      tc.previousFee,
@@ -161,7 +448,7 @@ func TestPayWhenPostingEvidence(t *testing.T) {
 				}).
 				Return(nil)
 
-			err := Pay(nil, nil, donorStore, nil, "")(testAppData, w, r, &donordata.Provided{
+			err := Pay(nil, nil, donorStore, nil, nil, nil, "")(testAppData, w, r, &donordata.Provided{
 				LpaID:            "lpa-id",
 				FeeType:          feeType,
 				EvidenceDelivery: pay.Post,
@@ -189,7 +476,7 @@ func TestPayWhenMoreEvidenceProvided(t *testing.T) {
 		}).
 		Return(nil)
 
-	err := Pay(nil, nil, donorStore, nil, "")(testAppData, w, r, &donordata.Provided{
+	err := Pay(nil, nil, donorStore, nil, nil, nil, "")(testAppData, w, r, &donordata.Provided{
 		LpaID:            "lpa-id",
 		FeeType:          pay.HalfFee,
 		Tasks:            donordata.Tasks{PayForLpa: task.PaymentStateMoreEvidenceRequired},
@@ -215,7 +502,7 @@ func TestPayWhenPaymentNotRequiredWhenDonorStorePutError(t *testing.T) {
 		}).
 		Return(expectedError)
 
-	err := Pay(nil, nil, donorStore, nil, "")(testAppData, w, r, &donordata.Provided{
+	err := Pay(nil, nil, donorStore, nil, nil, nil, "")(testAppData, w, r, &donordata.Provided{
 		LpaID:   "lpa-id",
 		FeeType: pay.NoFee,
 	})
@@ -229,7 +516,8 @@ func TestPayWhenFeeDenied(t *testing.T) {
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest(http.MethodPost, "/about-payment", nil)
 
-	sessionStore := newMockSessionStore(t)
+	sessionStore := newMockSessionStore(t).
+		withoutPaySession(r)
 	sessionStore.EXPECT().
 		SetPayment(r, w, &sesh.PaymentSession{PaymentID: "a-fake-id"}).
 		Return(nil)
@@ -237,7 +525,7 @@ func TestPayWhenFeeDenied(t *testing.T) {
 	payClient := newMockPayClient(t)
 	payClient.EXPECT().
 		CreatePayment(r.Context(), "lpa-uid", pay.CreatePaymentBody{
-			Amount:      pay.FeeHalf,
+			Amount:      4600,
 			Reference:   "lpa-uid",
 			Description: "an-lpa-type",
 			ReturnURL:   "http://example.org/lpa/lpa-id/payment-confirmation",
@@ -271,13 +559,18 @@ func TestPayWhenFeeDenied(t *testing.T) {
 	logger.EXPECT().
 		InfoContext(r.Context(), mock.Anything, mock.Anything)
 
-	err := Pay(logger, sessionStore, nil, payClient, "http://example.org")(appData, w, r, &donordata.Provided{
+	scheduledStore := newMockScheduledStore(t)
+	scheduledStore.EXPECT().
+		Create(r.Context(), mock.Anything).
+		Return(nil)
+
+	err := Pay(logger, sessionStore, nil, payClient, scheduledStore, testNowFn, "http://example.org")(appData, w, r, &donordata.Provided{
 		LpaID:          "lpa-id",
 		LpaUID:         "lpa-uid",
 		Donor:          donordata.Donor{Email: "a@b.com"},
 		FeeType:        pay.HalfFee,
 		Tasks:          donordata.Tasks{PayForLpa: task.PaymentStateDenied},
-		PaymentDetails: []donordata.Payment{{Amount: pay.FeeHalf}},
+		PaymentDetails: []donordata.Payment{{Amount: 4600}},
 	})
 	resp := w.Result()
 
@@ -290,6 +583,9 @@ func TestPayWhenCreatePaymentErrors(t *testing.T) {
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest(http.MethodPost, "/about-payment", nil)
 
+	sessionStore := newMockSessionStore(t).
+		withoutPaySession(r)
+
 	payClient := newMockPayClient(t)
 	payClient.EXPECT().
 		CreatePayment(mock.Anything, mock.Anything, mock.Anything).
@@ -306,7 +602,7 @@ func TestPayWhenCreatePaymentErrors(t *testing.T) {
 	appData := testAppData
 	appData.Localizer = localizer
 
-	err := Pay(nil, nil, nil, payClient, "")(appData, w, r, &donordata.Provided{})
+	err := Pay(nil, sessionStore, nil, payClient, nil, nil, "")(appData, w, r, &donordata.Provided{})
 
 	assert.ErrorIs(t, err, expectedError)
 }
@@ -315,7 +611,8 @@ func TestPayWhenSessionErrors(t *testing.T) {
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest(http.MethodPost, "/about-payment", nil)
 
-	sessionStore := newMockSessionStore(t)
+	sessionStore := newMockSessionStore(t).
+		withoutPaySession(r)
 	sessionStore.EXPECT().
 		SetPayment(r, w, mock.Anything).
 		Return(expectedError)
@@ -343,7 +640,45 @@ func TestPayWhenSessionErrors(t *testing.T) {
 	appData := testAppData
 	appData.Localizer = localizer
 
-	err := Pay(nil, sessionStore, nil, payClient, "")(appData, w, r, &donordata.Provided{})
+	scheduledStore := newMockScheduledStore(t)
+	scheduledStore.EXPECT().
+		Create(r.Context(), mock.Anything).
+		Return(nil)
+
+	err := Pay(nil, sessionStore, nil, payClient, scheduledStore, testNowFn, "")(appData, w, r, &donordata.Provided{})
 
 	assert.Equal(t, expectedError, err)
 }
+
+func TestPayWhenScheduledStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/about-payment", nil)
+
+	sessionStore := newMockSessionStore(t).
+		withoutPaySession(r)
+
+	payClient := newMockPayClient(t)
+	payClient.EXPECT().
+		CreatePayment(mock.Anything, mock.Anything, mock.Anything).
+		Return(&pay.CreatePaymentResponse{PaymentID: "a-fake-id"}, nil)
+
+	localizer := newMockLocalizer(t)
+	localizer.EXPECT().
+		T(mock.Anything).
+		Return("a-type")
+	localizer.EXPECT().
+		Format(mock.Anything, mock.Anything).
+		Return("an-lpa-type")
+
+	appData := testAppData
+	appData.Localizer = localizer
+
+	scheduledStore := newMockScheduledStore(t)
+	scheduledStore.EXPECT().
+		Create(r.Context(), mock.Anything).
+		Return(expectedError)
+
+	err := Pay(nil, sessionStore, nil, payClient, scheduledStore, testNowFn, "")(appData, w, r, &donordata.Provided{})
+
+	assert.ErrorIs(t, err, expectedError)
+}
@@ -6,17 +6,14 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
-	"slices"
 
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
-	"github.com/ministryofjustice/opg-modernising-lpa/internal/event"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
-	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
 )
 
-func PaymentConfirmation(logger Logger, payClient PayClient, donorStore DonorStore, sessionStore SessionStore, accessCodeSender AccessCodeSender, eventClient EventClient, notifyClient NotifyClient) Handler {
+func PaymentConfirmation(logger Logger, payClient PayClient, sessionStore SessionStore, notifyClient NotifyClient, paymentRecorder PaymentRecorder) Handler {
 	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, provided *donordata.Provided) error {
 		paymentSession, err := sessionStore.Payment(r)
 		if err != nil {
@@ -32,24 +29,6 @@ func PaymentConfirmation(logger Logger, payClient PayClient, donorStore DonorSto
 			return errors.New("TODO: we need to give some options")
 		}
 
-		paymentDetail := donordata.Payment{
-			PaymentReference: payment.Reference,
-			PaymentID:        payment.PaymentID,
-			Amount:           payment.AmountPence.Pence(),
-			CreatedAt:        payment.CreatedDate,
-		}
-		if !slices.Contains(provided.PaymentDetails, paymentDetail) {
-			provided.PaymentDetails = append(provided.PaymentDetails, paymentDetail)
-
-			if err := eventClient.SendPaymentReceived(r.Context(), event.PaymentReceived{
-				UID:       provided.LpaUID,
-				PaymentID: payment.PaymentID,
-				Amount:    payment.AmountPence.Pence(),
-			}); err != nil {
-				return err
-			}
-		}
-
 		if err := notifyClient.SendEmail(r.Context(), notify.ToPayee(payment), notify.PaymentConfirmationEmail{
 			DonorFullNamesPossessive: appData.Localizer.Possessive(provided.Donor.FullName()),
 			LpaType:                  appData.Localizer.T(provided.Type.String()),
@@ -71,53 +50,21 @@ func PaymentConfirmation(logger Logger, payClient PayClient, donorStore DonorSto
 			nextPage = donor.PathEvidenceSuccessfullyUploaded
 		}
 
-		switch provided.Tasks.PayForLpa {
-		case task.PaymentStateInProgress:
-			if provided.FeeType.IsFullFee() && provided.FeeAmount() == 0 {
-				provided.Tasks.PayForLpa = task.PaymentStateCompleted
-			} else {
-				provided.Tasks.PayForLpa = task.PaymentStatePending
-			}
-		case task.PaymentStateApproved, task.PaymentStateDenied:
-			if provided.FeeAmount() == 0 {
-				provided.Tasks.PayForLpa = task.PaymentStateCompleted
-				nextPage = donor.PathTaskList
-
-				if provided.Voucher.Allowed && provided.VoucherInvitedAt.IsZero() {
-					if err := accessCodeSender.SendVoucherInvite(r.Context(), provided, appData); err != nil {
-						return err
-					}
-
-					nextPage = donor.PathWeHaveContactedVoucher
-				}
+		wasDecided := provided.Tasks.PayForLpa.IsApproved() || provided.Tasks.PayForLpa.IsDenied()
+		voucherWasInvited := !provided.VoucherInvitedAt.IsZero()
 
-				if provided.Tasks.SignTheLpa.IsCompleted() {
-					if err := accessCodeSender.SendCertificateProviderPrompt(r.Context(), appData, provided); err != nil {
-						return fmt.Errorf("failed to send share code to certificate provider: %w", err)
-					}
+		if err := paymentRecorder.Record(r.Context(), appData, provided, payment); err != nil {
+			return err
+		}
 
-					if err := eventClient.SendCertificateProviderStarted(r.Context(), event.CertificateProviderStarted{
-						UID: provided.LpaUID,
-					}); err != nil {
-						return fmt.Errorf("failed to send certificate-provider-started event: %w", err)
-					}
+		if wasDecided && provided.Tasks.PayForLpa.IsCompleted() {
+			nextPage = donor.PathTaskList
 
-					if provided.Donor.Mobile != "" {
-						if err := notifyClient.SendActorSMS(r.Context(), notify.ToDonor(provided), provided.LpaUID, notify.OnlineDonorLPASubmissionConfirmation{
-							LpaType:            appData.Localizer.T(provided.Type.String()),
-							LpaReferenceNumber: provided.LpaUID,
-						}); err != nil {
-							return fmt.Errorf("failed to send SMS to donor: %w", err)
-						}
-					}
-				}
+			if !voucherWasInvited && !provided.VoucherInvitedAt.IsZero() {
+				nextPage = donor.PathWeHaveContactedVoucher
 			}
 		}
 
-		if err := donorStore.Put(r.Context(), provided); err != nil {
-			return fmt.Errorf("unable to update lpa in donorStore: %w", err)
-		}
-
 		if err := sessionStore.ClearPayment(r, w); err != nil {
 			logger.InfoContext(r.Context(), "unable to expire cookie in session", slog.Any("err", err))
 		}
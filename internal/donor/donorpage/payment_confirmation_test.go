@@ -9,10 +9,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/date"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
-	"github.com/ministryofjustice/opg-modernising-lpa/internal/event"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
@@ -22,19 +22,32 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-func TestGetPaymentConfirmationFullFee(t *testing.T) {
+func TestGetPaymentConfirmation(t *testing.T) {
 	testcases := map[string]struct {
+		feeType          pay.FeeType
 		evidenceDelivery pay.EvidenceDelivery
 		nextPage         donor.Path
 	}{
-		"empty": {
+		"full fee": {
+			feeType:  pay.FullFee,
 			nextPage: donor.PathTaskList,
 		},
 		"upload": {
+			feeType:          pay.HalfFee,
 			evidenceDelivery: pay.Upload,
 			nextPage:         donor.PathEvidenceSuccessfullyUploaded,
 		},
 		"post": {
+			feeType:          pay.HalfFee,
+			evidenceDelivery: pay.Post,
+			nextPage:         donor.PathPendingPayment,
+		},
+		"repeat application fee": {
+			feeType:  pay.RepeatApplicationFee,
+			nextPage: donor.PathEvidenceSuccessfullyUploaded,
+		},
+		"repeat application fee by post": {
+			feeType:          pay.RepeatApplicationFee,
 			evidenceDelivery: pay.Post,
 			nextPage:         donor.PathPendingPayment,
 		},
@@ -46,66 +59,37 @@ func TestGetPaymentConfirmationFullFee(t *testing.T) {
 			r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
 
 			payClient := newMockPayClient(t).
-				withASuccessfulPayment(pay.FeeFull, r.Context())
+				withASuccessfulPayment(9200, r.Context())
 
 			localizer := newMockLocalizer(t).
 				withEmailLocalizations()
 
-			testAppData.Localizer = localizer
+			appData := testAppData
+			appData.Localizer = localizer
 
 			sessionStore := newMockSessionStore(t).
 				withPaySession(r).
 				withExpiredPaySession(r, w)
 
-			donorStore := newMockDonorStore(t)
-			donorStore.EXPECT().
-				Put(r.Context(), &donordata.Provided{
-					Type:             lpadata.LpaTypePersonalWelfare,
-					Donor:            donordata.Donor{FirstNames: "a", LastName: "b"},
-					LpaID:            "lpa-id",
-					LpaUID:           "lpa-uid",
-					FeeType:          pay.FullFee,
-					EvidenceDelivery: tc.evidenceDelivery,
-					CertificateProvider: donordata.CertificateProvider{
-						Email: "certificateprovider@example.com",
-					},
-					PaymentDetails: []donordata.Payment{{
-						PaymentID:        "abc123",
-						PaymentReference: "123456789012",
-						Amount:           pay.FeeFull,
-					}},
-					Tasks: donordata.Tasks{
-						PayForLpa: task.PaymentStateCompleted,
-					},
-				}).
-				Return(nil)
-
-			eventClient := newMockEventClient(t)
-			eventClient.EXPECT().
-				SendPaymentReceived(r.Context(), event.PaymentReceived{
-					UID:       "lpa-uid",
-					PaymentID: "abc123",
-					Amount:    pay.FeeFull,
-				}).
-				Return(nil)
-
 			notifyClient := newMockNotifyClient(t).
 				withEmailPersonalizations(r.Context(), "£92")
 
-			err := PaymentConfirmation(newMockLogger(t), payClient, donorStore, sessionStore, nil, eventClient, notifyClient)(testAppData, w, r, &donordata.Provided{
+			provided := &donordata.Provided{
 				LpaID:            "lpa-id",
 				LpaUID:           "lpa-uid",
-				FeeType:          pay.FullFee,
+				FeeType:          tc.feeType,
 				EvidenceDelivery: tc.evidenceDelivery,
-				CertificateProvider: donordata.CertificateProvider{
-					Email: "certificateprovider@example.com",
-				},
-				Tasks: donordata.Tasks{
-					PayForLpa: task.PaymentStateInProgress,
-				},
-				Donor: donordata.Donor{FirstNames: "a", LastName: "b"},
-				Type:  lpadata.LpaTypePersonalWelfare,
-			})
+				Tasks:            donordata.Tasks{PayForLpa: task.PaymentStateInProgress},
+				Donor:            donordata.Donor{FirstNames: "a", LastName: "b"},
+				Type:             lpadata.LpaTypePersonalWelfare,
+			}
+
+			paymentRecorder := newMockPaymentRecorder(t)
+			paymentRecorder.EXPECT().
+				Record(r.Context(), appData, provided, successfulPayment(9200)).
+				Return(nil)
+
+			err := PaymentConfirmation(newMockLogger(t), payClient, sessionStore, notifyClient, paymentRecorder)(appData, w, r, provided)
 			resp := w.Result()
 
 			assert.Nil(t, err)
@@ -118,94 +102,32 @@ func TestGetPaymentConfirmationFullFee(t *testing.T) {
 	}
 }
 
-func TestGetPaymentConfirmationHalfFee(t *testing.T) {
-	w := httptest.NewRecorder()
-	r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
-
-	payClient := newMockPayClient(t).
-		withASuccessfulPayment(4100, r.Context())
-
-	localizer := newMockLocalizer(t).
-		withEmailLocalizations()
-
-	testAppData.Localizer = localizer
-
-	sessionStore := newMockSessionStore(t).
-		withPaySession(r).
-		withExpiredPaySession(r, w)
-
-	donorStore := newMockDonorStore(t)
-	donorStore.EXPECT().
-		Put(r.Context(), &donordata.Provided{
-			Type:    lpadata.LpaTypePersonalWelfare,
-			Donor:   donordata.Donor{FirstNames: "a", LastName: "b"},
-			LpaID:   "lpa-id",
-			LpaUID:  "lpa-uid",
-			FeeType: pay.HalfFee,
-			CertificateProvider: donordata.CertificateProvider{
-				Email: "certificateprovider@example.com",
-			},
-			PaymentDetails: []donordata.Payment{{
-				PaymentID:        "abc123",
-				PaymentReference: "123456789012",
-				Amount:           4100,
-			}},
-			Tasks: donordata.Tasks{
-				PayForLpa: task.PaymentStatePending,
-			},
-		}).
-		Return(nil)
-
-	eventClient := newMockEventClient(t)
-	eventClient.EXPECT().
-		SendPaymentReceived(r.Context(), event.PaymentReceived{
-			UID:       "lpa-uid",
-			PaymentID: "abc123",
-			Amount:    4100,
-		}).
-		Return(nil)
-
-	notifyClient := newMockNotifyClient(t).
-		withEmailPersonalizations(r.Context(), "£41")
-
-	err := PaymentConfirmation(newMockLogger(t), payClient, donorStore, sessionStore, nil, eventClient, notifyClient)(testAppData, w, r, &donordata.Provided{
-		Type:    lpadata.LpaTypePersonalWelfare,
-		Donor:   donordata.Donor{FirstNames: "a", LastName: "b"},
-		LpaID:   "lpa-id",
-		LpaUID:  "lpa-uid",
-		FeeType: pay.HalfFee,
-		CertificateProvider: donordata.CertificateProvider{
-			Email: "certificateprovider@example.com",
-		},
-		Tasks: donordata.Tasks{
-			PayForLpa: task.PaymentStateInProgress,
-		},
-	})
-	resp := w.Result()
-
-	assert.Nil(t, err)
-	assert.Equal(t, http.StatusFound, resp.StatusCode)
-	assert.Equal(t, donor.PathPaymentSuccessful.Format("lpa-id")+"?"+url.Values{
-		"reference": {"123456789012"},
-		"next":      {donor.PathTaskList.Format("lpa-id")},
-	}.Encode(), resp.Header.Get("Location"))
-}
-
-func TestGetPaymentConfirmationRepeatApplicationFee(t *testing.T) {
+func TestGetPaymentConfirmationApprovedOrDenied(t *testing.T) {
 	testcases := map[string]struct {
-		evidenceDelivery pay.EvidenceDelivery
-		nextPage         donor.Path
+		taskState    task.PaymentState
+		invite       bool
+		nextPage     donor.Path
+		completeTask bool
 	}{
-		"empty": {
-			nextPage: donor.PathEvidenceSuccessfullyUploaded,
+		"approved": {
+			taskState:    task.PaymentStateApproved,
+			completeTask: true,
+			nextPage:     donor.PathTaskList,
 		},
-		"upload": {
-			evidenceDelivery: pay.Upload,
-			nextPage:         donor.PathEvidenceSuccessfullyUploaded,
+		"denied": {
+			taskState:    task.PaymentStateDenied,
+			completeTask: true,
+			nextPage:     donor.PathTaskList,
 		},
-		"post": {
-			evidenceDelivery: pay.Post,
-			nextPage:         donor.PathPendingPayment,
+		"voucher invited": {
+			taskState:    task.PaymentStateApproved,
+			completeTask: true,
+			invite:       true,
+			nextPage:     donor.PathWeHaveContactedVoucher,
+		},
+		"not fully paid": {
+			taskState: task.PaymentStateDenied,
+			nextPage:  donor.PathEvidenceSuccessfullyUploaded,
 		},
 	}
 
@@ -215,242 +137,42 @@ func TestGetPaymentConfirmationRepeatApplicationFee(t *testing.T) {
 			r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
 
 			payClient := newMockPayClient(t).
-				withASuccessfulPayment(pay.FeeFull, r.Context())
-
-			localizer := newMockLocalizer(t).
-				withEmailLocalizations()
-
-			testAppData.Localizer = localizer
-
-			sessionStore := newMockSessionStore(t).
-				withPaySession(r).
-				withExpiredPaySession(r, w)
-
-			donorStore := newMockDonorStore(t)
-			donorStore.EXPECT().
-				Put(r.Context(), &donordata.Provided{
-					Type:             lpadata.LpaTypePersonalWelfare,
-					Donor:            donordata.Donor{FirstNames: "a", LastName: "b"},
-					LpaID:            "lpa-id",
-					LpaUID:           "lpa-uid",
-					FeeType:          pay.RepeatApplicationFee,
-					EvidenceDelivery: tc.evidenceDelivery,
-					CertificateProvider: donordata.CertificateProvider{
-						Email: "certificateprovider@example.com",
-					},
-					PaymentDetails: []donordata.Payment{{
-						PaymentID:        "abc123",
-						PaymentReference: "123456789012",
-						Amount:           pay.FeeFull,
-					}},
-					Tasks: donordata.Tasks{
-						PayForLpa: task.PaymentStatePending,
-					},
-				}).
-				Return(nil)
-
-			eventClient := newMockEventClient(t)
-			eventClient.EXPECT().
-				SendPaymentReceived(r.Context(), event.PaymentReceived{
-					UID:       "lpa-uid",
-					PaymentID: "abc123",
-					Amount:    pay.FeeFull,
-				}).
-				Return(nil)
-
-			notifyClient := newMockNotifyClient(t).
-				withEmailPersonalizations(r.Context(), "£92")
-
-			err := PaymentConfirmation(newMockLogger(t), payClient, donorStore, sessionStore, nil, eventClient, notifyClient)(testAppData, w, r, &donordata.Provided{
-				LpaUID:           "lpa-uid",
-				LpaID:            "lpa-id",
-				FeeType:          pay.RepeatApplicationFee,
-				EvidenceDelivery: tc.evidenceDelivery,
-				CertificateProvider: donordata.CertificateProvider{
-					Email: "certificateprovider@example.com",
-				},
-				Tasks: donordata.Tasks{
-					PayForLpa: task.PaymentStateInProgress,
-				},
-				Donor: donordata.Donor{FirstNames: "a", LastName: "b"},
-				Type:  lpadata.LpaTypePersonalWelfare,
-			})
-			resp := w.Result()
-
-			assert.Nil(t, err)
-			assert.Equal(t, http.StatusFound, resp.StatusCode)
-			assert.Equal(t, donor.PathPaymentSuccessful.Format("lpa-id")+"?"+url.Values{
-				"reference": {"123456789012"},
-				"next":      {tc.nextPage.Format("lpa-id")},
-			}.Encode(), resp.Header.Get("Location"))
-		})
-	}
-}
-
-func TestGetPaymentConfirmationApprovedOrDenied(t *testing.T) {
-	for _, taskState := range []task.PaymentState{task.PaymentStateApproved, task.PaymentStateDenied} {
-		t.Run(taskState.String(), func(t *testing.T) {
-			w := httptest.NewRecorder()
-			r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
-
-			payClient := newMockPayClient(t).
-				withASuccessfulPayment(pay.FeeFull, r.Context())
+				withASuccessfulPayment(9200, r.Context())
 
 			localizer := newMockLocalizer(t).
 				withEmailLocalizations()
 
-			testAppData.Localizer = localizer
+			appData := testAppData
+			appData.Localizer = localizer
 
 			sessionStore := newMockSessionStore(t).
 				withPaySession(r).
 				withExpiredPaySession(r, w)
 
-			donorStore := newMockDonorStore(t)
-			donorStore.EXPECT().
-				Put(r.Context(), &donordata.Provided{
-					Type:    lpadata.LpaTypePersonalWelfare,
-					Donor:   donordata.Donor{FirstNames: "a", LastName: "b"},
-					LpaID:   "lpa-id",
-					LpaUID:  "lpa-uid",
-					FeeType: pay.FullFee,
-					CertificateProvider: donordata.CertificateProvider{
-						Email: "certificateprovider@example.com",
-					},
-					PaymentDetails: []donordata.Payment{{
-						PaymentID:        "abc123",
-						PaymentReference: "123456789012",
-						Amount:           pay.FeeFull,
-					}},
-					Tasks: donordata.Tasks{
-						PayForLpa: task.PaymentStateCompleted,
-					},
-				}).
-				Return(nil)
-
-			eventClient := newMockEventClient(t)
-			eventClient.EXPECT().
-				SendPaymentReceived(r.Context(), event.PaymentReceived{
-					UID:       "lpa-uid",
-					PaymentID: "abc123",
-					Amount:    pay.FeeFull,
-				}).
-				Return(nil)
-
 			notifyClient := newMockNotifyClient(t).
 				withEmailPersonalizations(r.Context(), "£92")
 
-			err := PaymentConfirmation(newMockLogger(t), payClient, donorStore, sessionStore, nil, eventClient, notifyClient)(testAppData, w, r, &donordata.Provided{
-				Type:    lpadata.LpaTypePersonalWelfare,
-				Donor:   donordata.Donor{FirstNames: "a", LastName: "b"},
-				LpaID:   "lpa-id",
-				LpaUID:  "lpa-uid",
-				FeeType: pay.FullFee,
-				CertificateProvider: donordata.CertificateProvider{
-					Email: "certificateprovider@example.com",
-				},
-				Tasks: donordata.Tasks{
-					PayForLpa: taskState,
-				},
-			})
-			resp := w.Result()
-
-			assert.Nil(t, err)
-			assert.Equal(t, http.StatusFound, resp.StatusCode)
-			assert.Equal(t, donor.PathPaymentSuccessful.Format("lpa-id")+"?"+url.Values{
-				"reference": {"123456789012"},
-				"next":      {donor.PathTaskList.Format("lpa-id")},
-			}.Encode(), resp.Header.Get("Location"))
-		})
-	}
-}
-
-func TestGetPaymentConfirmationApprovedOrDeniedWhenSigned(t *testing.T) {
-	for _, taskState := range []task.PaymentState{task.PaymentStateApproved, task.PaymentStateDenied} {
-		t.Run(taskState.String(), func(t *testing.T) {
-			w := httptest.NewRecorder()
-			r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
-
-			updatedDonor := &donordata.Provided{
-				Type:    lpadata.LpaTypePersonalWelfare,
-				Donor:   donordata.Donor{FirstNames: "a", LastName: "b", Mobile: "c"},
-				LpaID:   "lpa-id",
-				LpaUID:  "lpa-uid",
-				FeeType: pay.FullFee,
-				CertificateProvider: donordata.CertificateProvider{
-					Email: "certificateprovider@example.com",
-				},
-				PaymentDetails: []donordata.Payment{{
-					PaymentID:        "abc123",
-					PaymentReference: "123456789012",
-					Amount:           pay.FeeFull,
-				}},
-				Tasks: donordata.Tasks{
-					PayForLpa:  task.PaymentStateCompleted,
-					SignTheLpa: task.StateCompleted,
-				},
-			}
-
-			payClient := newMockPayClient(t).
-				withASuccessfulPayment(pay.FeeFull, r.Context())
-
-			localizer := newMockLocalizer(t).
-				withEmailLocalizations()
-			localizer.EXPECT().
-				T("personal-welfare").
-				Return("a")
-
-			testAppData.Localizer = localizer
-
-			sessionStore := newMockSessionStore(t).
-				withPaySession(r).
-				withExpiredPaySession(r, w)
-
-			donorStore := newMockDonorStore(t)
-			donorStore.EXPECT().
-				Put(r.Context(), updatedDonor).
-				Return(nil)
-
-			accessCodeSender := newMockAccessCodeSender(t)
-			accessCodeSender.EXPECT().
-				SendCertificateProviderPrompt(r.Context(), testAppData, updatedDonor).
-				Return(nil)
-
-			eventClient := newMockEventClient(t)
-			eventClient.EXPECT().
-				SendPaymentReceived(r.Context(), event.PaymentReceived{
-					UID:       "lpa-uid",
-					PaymentID: "abc123",
-					Amount:    pay.FeeFull,
-				}).
-				Return(nil)
-			eventClient.EXPECT().
-				SendCertificateProviderStarted(r.Context(), event.CertificateProviderStarted{
-					UID: "lpa-uid",
-				}).
-				Return(nil)
-
-			notifyClient := newMockNotifyClient(t).
-				withEmailPersonalizations(r.Context(), "£92")
-			notifyClient.EXPECT().
-				SendActorSMS(r.Context(), notify.ToDonor(updatedDonor), "lpa-uid", notify.OnlineDonorLPASubmissionConfirmation{
-					LpaType:            "a",
-					LpaReferenceNumber: "lpa-uid",
+			paymentRecorder := newMockPaymentRecorder(t)
+			paymentRecorder.EXPECT().
+				Record(r.Context(), appData, mock.Anything, successfulPayment(9200)).
+				Run(func(_ context.Context, _ appcontext.Data, provided *donordata.Provided, _ pay.GetPaymentResponse) {
+					if tc.completeTask {
+						provided.Tasks.PayForLpa = task.PaymentStateCompleted
+					}
+					if tc.invite {
+						provided.VoucherInvitedAt = testNow
+					}
 				}).
 				Return(nil)
 
-			err := PaymentConfirmation(newMockLogger(t), payClient, donorStore, sessionStore, accessCodeSender, eventClient, notifyClient)(testAppData, w, r, &donordata.Provided{
-				Type:    lpadata.LpaTypePersonalWelfare,
-				Donor:   donordata.Donor{FirstNames: "a", LastName: "b", Mobile: "c"},
-				LpaID:   "lpa-id",
-				LpaUID:  "lpa-uid",
-				FeeType: pay.FullFee,
-				CertificateProvider: donordata.CertificateProvider{
-					Email: "certificateprovider@example.com",
-				},
-				Tasks: donordata.Tasks{
-					PayForLpa:  taskState,
-					SignTheLpa: task.StateCompleted,
-				},
+			err := PaymentConfirmation(newMockLogger(t), payClient, sessionStore, notifyClient, paymentRecorder)(appData, w, r, &donordata.Provided{
+				LpaID:            "lpa-id",
+				LpaUID:           "lpa-uid",
+				FeeType:          pay.HalfFee,
+				EvidenceDelivery: pay.Upload,
+				Tasks:            donordata.Tasks{PayForLpa: tc.taskState},
+				Donor:            donordata.Donor{FirstNames: "a", LastName: "b"},
+				Type:             lpadata.LpaTypePersonalWelfare,
 			})
 			resp := w.Result()
 
@@ -458,123 +180,12 @@ func TestGetPaymentConfirmationApprovedOrDeniedWhenSigned(t *testing.T) {
 			assert.Equal(t, http.StatusFound, resp.StatusCode)
 			assert.Equal(t, donor.PathPaymentSuccessful.Format("lpa-id")+"?"+url.Values{
 				"reference": {"123456789012"},
-				"next":      {donor.PathTaskList.Format("lpa-id")},
-			}.Encode(), resp.Header.Get("Location"))
-		})
-	}
-}
-
-func TestGetPaymentConfirmationApprovedOrDeniedWhenVoucherAllowed(t *testing.T) {
-	for _, task := range []task.PaymentState{task.PaymentStateApproved, task.PaymentStateDenied} {
-		t.Run(task.String(), func(t *testing.T) {
-			w := httptest.NewRecorder()
-			r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
-
-			payClient := newMockPayClient(t).
-				withASuccessfulPayment(pay.FeeFull, r.Context())
-
-			localizer := newMockLocalizer(t).
-				withEmailLocalizations()
-
-			testAppData.Localizer = localizer
-
-			sessionStore := newMockSessionStore(t).
-				withPaySession(r).
-				withExpiredPaySession(r, w)
-
-			donorStore := newMockDonorStore(t)
-			donorStore.EXPECT().
-				Put(r.Context(), mock.Anything).
-				Return(nil)
-
-			eventClient := newMockEventClient(t)
-			eventClient.EXPECT().
-				SendPaymentReceived(r.Context(), mock.Anything).
-				Return(nil)
-
-			notifyClient := newMockNotifyClient(t).
-				withEmailPersonalizations(r.Context(), "£92")
-
-			provided := &donordata.Provided{
-				Type:    lpadata.LpaTypePersonalWelfare,
-				Donor:   donordata.Donor{FirstNames: "a", LastName: "b"},
-				LpaID:   "lpa-id",
-				LpaUID:  "lpa-uid",
-				FeeType: pay.FullFee,
-				CertificateProvider: donordata.CertificateProvider{
-					Email: "certificateprovider@example.com",
-				},
-				Voucher: donordata.Voucher{Allowed: true},
-				Tasks: donordata.Tasks{
-					PayForLpa: task,
-				},
-			}
-
-			accessCodeSender := newMockAccessCodeSender(t)
-			accessCodeSender.EXPECT().
-				SendVoucherInvite(r.Context(), provided, testAppData).
-				Return(nil)
-
-			err := PaymentConfirmation(newMockLogger(t), payClient, donorStore, sessionStore, accessCodeSender, eventClient, notifyClient)(testAppData, w, r, provided)
-			resp := w.Result()
-
-			assert.Nil(t, err)
-			assert.Equal(t, http.StatusFound, resp.StatusCode)
-			assert.Equal(t, donor.PathPaymentSuccessful.Format("lpa-id")+"?"+url.Values{
-				"reference": {"123456789012"},
-				"next":      {donor.PathWeHaveContactedVoucher.Format("lpa-id")},
+				"next":      {tc.nextPage.Format("lpa-id")},
 			}.Encode(), resp.Header.Get("Location"))
 		})
 	}
 }
 
-func TestGetPaymentConfirmationWhenVoucherAllowedAccessCodeError(t *testing.T) {
-	w := httptest.NewRecorder()
-	r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
-
-	payClient := newMockPayClient(t).
-		withASuccessfulPayment(pay.FeeFull, r.Context())
-
-	sessionStore := newMockSessionStore(t).
-		withPaySession(r)
-
-	localizer := newMockLocalizer(t).
-		withEmailLocalizations()
-
-	testAppData.Localizer = localizer
-
-	eventClient := newMockEventClient(t)
-	eventClient.EXPECT().
-		SendPaymentReceived(r.Context(), mock.Anything).
-		Return(nil)
-
-	notifyClient := newMockNotifyClient(t).
-		withEmailPersonalizations(r.Context(), "£92")
-
-	accessCodeSender := newMockAccessCodeSender(t)
-	accessCodeSender.EXPECT().
-		SendVoucherInvite(mock.Anything, mock.Anything, mock.Anything).
-		Return(expectedError)
-
-	err := PaymentConfirmation(newMockLogger(t), payClient, nil, sessionStore, accessCodeSender, eventClient, notifyClient)(testAppData, w, r, &donordata.Provided{
-		Type:    lpadata.LpaTypePersonalWelfare,
-		Donor:   donordata.Donor{FirstNames: "a", LastName: "b"},
-		LpaUID:  "lpa-uid",
-		FeeType: pay.FullFee,
-		CertificateProvider: donordata.CertificateProvider{
-			Email: "certificateprovider@example.com",
-		},
-		Voucher: donordata.Voucher{Allowed: true},
-		Tasks: donordata.Tasks{
-			PayForLpa: task.PaymentStateDenied,
-		},
-	})
-	resp := w.Result()
-
-	assert.Error(t, err)
-	assert.Equal(t, http.StatusOK, resp.StatusCode)
-}
-
 func TestGetPaymentConfirmationWhenNotSuccess(t *testing.T) {
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
@@ -592,14 +203,9 @@ func TestGetPaymentConfirmationWhenNotSuccess(t *testing.T) {
 			},
 		}, nil)
 
-	err := PaymentConfirmation(newMockLogger(t), payClient, nil, sessionStore, nil, nil, nil)(testAppData, w, r, &donordata.Provided{
+	err := PaymentConfirmation(newMockLogger(t), payClient, sessionStore, nil, nil)(testAppData, w, r, &donordata.Provided{
 		LpaUID: "lpa-uid",
-		CertificateProvider: donordata.CertificateProvider{
-			Email: "certificateprovider@example.com",
-		},
-		Tasks: donordata.Tasks{
-			PayForLpa: task.PaymentStateInProgress,
-		},
+		Tasks:  donordata.Tasks{PayForLpa: task.PaymentStateInProgress},
 	})
 
 	assert.Error(t, err)
@@ -614,7 +220,7 @@ func TestGetPaymentConfirmationWhenErrorGettingSession(t *testing.T) {
 		Payment(r).
 		Return(nil, expectedError)
 
-	err := PaymentConfirmation(nil, newMockPayClient(t), nil, sessionStore, nil, nil, nil)(testAppData, w, r, &donordata.Provided{})
+	err := PaymentConfirmation(nil, newMockPayClient(t), sessionStore, nil, nil)(testAppData, w, r, &donordata.Provided{})
 	resp := w.Result()
 
 	assert.Equal(t, expectedError, err)
@@ -633,7 +239,7 @@ func TestGetPaymentConfirmationWhenErrorGettingPayment(t *testing.T) {
 		GetPayment(r.Context(), "abc123").
 		Return(pay.GetPaymentResponse{}, expectedError)
 
-	err := PaymentConfirmation(nil, payClient, nil, sessionStore, nil, nil, nil)(testAppData, w, r, &donordata.Provided{})
+	err := PaymentConfirmation(nil, payClient, sessionStore, nil, nil)(testAppData, w, r, &donordata.Provided{})
 	resp := w.Result()
 
 	assert.ErrorIs(t, err, expectedError)
@@ -644,11 +250,6 @@ func TestGetPaymentConfirmationWhenErrorExpiringSession(t *testing.T) {
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
 
-	donorStore := newMockDonorStore(t)
-	donorStore.EXPECT().
-		Put(r.Context(), mock.Anything).
-		Return(nil)
-
 	sessionStore := newMockSessionStore(t).
 		withPaySession(r)
 	sessionStore.EXPECT().
@@ -660,25 +261,23 @@ func TestGetPaymentConfirmationWhenErrorExpiringSession(t *testing.T) {
 		InfoContext(r.Context(), "unable to expire cookie in session", slog.Any("err", expectedError))
 
 	payClient := newMockPayClient(t).
-		withASuccessfulPayment(pay.FeeFull, r.Context())
-
-	eventClient := newMockEventClient(t)
-	eventClient.EXPECT().
-		SendPaymentReceived(r.Context(), mock.Anything).
-		Return(nil)
+		withASuccessfulPayment(9200, r.Context())
 
 	localizer := newMockLocalizer(t).
 		withEmailLocalizations()
 
-	testAppData.Localizer = localizer
+	appData := testAppData
+	appData.Localizer = localizer
 
 	notifyClient := newMockNotifyClient(t).
 		withEmailPersonalizations(r.Context(), "£92")
 
-	err := PaymentConfirmation(logger, payClient, donorStore, sessionStore, nil, eventClient, notifyClient)(testAppData, w, r, &donordata.Provided{
-		CertificateProvider: donordata.CertificateProvider{
-			Email: "certificateprovider@example.com",
-		},
+	paymentRecorder := newMockPaymentRecorder(t)
+	paymentRecorder.EXPECT().
+		Record(r.Context(), appData, mock.Anything, mock.Anything).
+		Return(nil)
+
+	err := PaymentConfirmation(logger, payClient, sessionStore, notifyClient, paymentRecorder)(appData, w, r, &donordata.Provided{
 		Type:   lpadata.LpaTypePersonalWelfare,
 		Donor:  donordata.Donor{FirstNames: "a", LastName: "b"},
 		LpaUID: "lpa-uid",
@@ -694,33 +293,6 @@ func TestGetPaymentConfirmationWhenErrorExpiringSession(t *testing.T) {
 	}.Encode(), resp.Header.Get("Location"))
 }
 
-func TestGetPaymentConfirmationWhenEventClientError(t *testing.T) {
-	w := httptest.NewRecorder()
-	r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
-
-	payClient := newMockPayClient(t).
-		withASuccessfulPayment(4100, r.Context())
-
-	sessionStore := newMockSessionStore(t).
-		withPaySession(r)
-
-	eventClient := newMockEventClient(t)
-	eventClient.EXPECT().
-		SendPaymentReceived(r.Context(), mock.Anything).
-		Return(expectedError)
-
-	err := PaymentConfirmation(nil, payClient, nil, sessionStore, nil, eventClient, nil)(testAppData, w, r, &donordata.Provided{
-		FeeType: pay.HalfFee,
-		CertificateProvider: donordata.CertificateProvider{
-			Email: "certificateprovider@example.com",
-		},
-	})
-	resp := w.Result()
-
-	assert.ErrorIs(t, err, expectedError)
-	assert.Equal(t, http.StatusOK, resp.StatusCode)
-}
-
 func TestGetPaymentConfirmationWhenNotifyClientError(t *testing.T) {
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
@@ -731,11 +303,6 @@ func TestGetPaymentConfirmationWhenNotifyClientError(t *testing.T) {
 	sessionStore := newMockSessionStore(t).
 		withPaySession(r)
 
-	eventClient := newMockEventClient(t)
-	eventClient.EXPECT().
-		SendPaymentReceived(r.Context(), mock.Anything).
-		Return(nil)
-
 	notifyClient := newMockNotifyClient(t)
 	notifyClient.EXPECT().
 		SendEmail(mock.Anything, mock.Anything, mock.Anything).
@@ -744,58 +311,12 @@ func TestGetPaymentConfirmationWhenNotifyClientError(t *testing.T) {
 	localizer := newMockLocalizer(t).
 		withEmailLocalizations()
 
-	testAppData.Localizer = localizer
-
-	err := PaymentConfirmation(nil, payClient, nil, sessionStore, nil, eventClient, notifyClient)(testAppData, w, r, &donordata.Provided{
-		Type:    lpadata.LpaTypePersonalWelfare,
-		Donor:   donordata.Donor{FirstNames: "a", LastName: "b"},
-		FeeType: pay.HalfFee,
-		CertificateProvider: donordata.CertificateProvider{
-			Email: "certificateprovider@example.com",
-		},
-	})
-	resp := w.Result()
-
-	assert.ErrorIs(t, err, expectedError)
-	assert.Equal(t, http.StatusOK, resp.StatusCode)
-}
-
-func TestGetPaymentConfirmationHalfFeeWhenDonorStorePutError(t *testing.T) {
-	w := httptest.NewRecorder()
-	r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
-
-	payClient := newMockPayClient(t).
-		withASuccessfulPayment(4100, r.Context())
-
-	sessionStore := newMockSessionStore(t).
-		withPaySession(r)
-
-	donorStore := newMockDonorStore(t)
-	donorStore.EXPECT().
-		Put(r.Context(), mock.Anything).
-		Return(expectedError)
-
-	eventClient := newMockEventClient(t)
-	eventClient.EXPECT().
-		SendPaymentReceived(r.Context(), mock.Anything).
-		Return(nil)
-
-	localizer := newMockLocalizer(t).
-		withEmailLocalizations()
-
-	testAppData.Localizer = localizer
+	appData := testAppData
+	appData.Localizer = localizer
 
-	notifyClient := newMockNotifyClient(t).
-		withEmailPersonalizations(r.Context(), "£41")
-
-	err := PaymentConfirmation(nil, payClient, donorStore, sessionStore, nil, eventClient, notifyClient)(testAppData, w, r, &donordata.Provided{
-		LpaUID:  "lpa-uid",
-		Type:    lpadata.LpaTypePersonalWelfare,
-		Donor:   donordata.Donor{FirstNames: "a", LastName: "b"},
-		FeeType: pay.HalfFee,
-		CertificateProvider: donordata.CertificateProvider{
-			Email: "certificateprovider@example.com",
-		},
+	err := PaymentConfirmation(nil, payClient, sessionStore, notifyClient, nil)(appData, w, r, &donordata.Provided{
+		Type:  lpadata.LpaTypePersonalWelfare,
+		Donor: donordata.Donor{FirstNames: "a", LastName: "b"},
 	})
 	resp := w.Result()
 
@@ -803,171 +324,63 @@ func TestGetPaymentConfirmationHalfFeeWhenDonorStorePutError(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
-func TestGetPaymentConfirmationWhenEventClientErrors(t *testing.T) {
+func TestGetPaymentConfirmationWhenPaymentRecorderErrors(t *testing.T) {
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
 
 	payClient := newMockPayClient(t).
-		withASuccessfulPayment(pay.FeeFull, r.Context())
+		withASuccessfulPayment(9200, r.Context())
 
 	sessionStore := newMockSessionStore(t).
 		withPaySession(r)
 
-	accessCodeSender := newMockAccessCodeSender(t)
-	accessCodeSender.EXPECT().
-		SendCertificateProviderPrompt(mock.Anything, mock.Anything, mock.Anything).
-		Return(nil)
-
-	eventClient := newMockEventClient(t)
-	eventClient.EXPECT().
-		SendPaymentReceived(r.Context(), mock.Anything).
-		Return(nil)
-	eventClient.EXPECT().
-		SendCertificateProviderStarted(r.Context(), mock.Anything).
-		Return(expectedError)
-
 	localizer := newMockLocalizer(t).
 		withEmailLocalizations()
 
-	testAppData.Localizer = localizer
+	appData := testAppData
+	appData.Localizer = localizer
 
 	notifyClient := newMockNotifyClient(t).
 		withEmailPersonalizations(r.Context(), "£92")
 
-	err := PaymentConfirmation(newMockLogger(t), payClient, nil, sessionStore, accessCodeSender, eventClient, notifyClient)(testAppData, w, r, &donordata.Provided{
-		LpaUID:  "lpa-uid",
-		Type:    lpadata.LpaTypePersonalWelfare,
-		Donor:   donordata.Donor{FirstNames: "a", LastName: "b"},
-		FeeType: pay.FullFee,
-		CertificateProvider: donordata.CertificateProvider{
-			Email: "certificateprovider@example.com",
-		},
-		Tasks: donordata.Tasks{
-			PayForLpa:  task.PaymentStateApproved,
-			SignTheLpa: task.StateCompleted,
-		},
-	})
-
-	assert.ErrorIs(t, err, expectedError)
-}
-
-func TestGetPaymentConfirmationWhenAccessCodeSenderErrors(t *testing.T) {
-	w := httptest.NewRecorder()
-	r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
-
-	payClient := newMockPayClient(t).
-		withASuccessfulPayment(pay.FeeFull, r.Context())
-
-	sessionStore := newMockSessionStore(t).
-		withPaySession(r)
-
-	accessCodeSender := newMockAccessCodeSender(t)
-	accessCodeSender.EXPECT().
-		SendCertificateProviderPrompt(mock.Anything, mock.Anything, mock.Anything).
+	paymentRecorder := newMockPaymentRecorder(t)
+	paymentRecorder.EXPECT().
+		Record(r.Context(), appData, mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	eventClient := newMockEventClient(t)
-	eventClient.EXPECT().
-		SendPaymentReceived(r.Context(), mock.Anything).
-		Return(nil)
-
-	localizer := newMockLocalizer(t).
-		withEmailLocalizations()
-
-	testAppData.Localizer = localizer
-
-	notifyClient := newMockNotifyClient(t).
-		withEmailPersonalizations(r.Context(), "£92")
-
-	err := PaymentConfirmation(newMockLogger(t), payClient, nil, sessionStore, accessCodeSender, eventClient, notifyClient)(testAppData, w, r, &donordata.Provided{
-		LpaUID:  "lpa-uid",
-		Type:    lpadata.LpaTypePersonalWelfare,
-		Donor:   donordata.Donor{FirstNames: "a", LastName: "b"},
-		FeeType: pay.FullFee,
-		CertificateProvider: donordata.CertificateProvider{
-			Email: "certificateprovider@example.com",
-		},
-		Tasks: donordata.Tasks{
-			PayForLpa:  task.PaymentStateApproved,
-			SignTheLpa: task.StateCompleted,
-		},
+	err := PaymentConfirmation(nil, payClient, sessionStore, notifyClient, paymentRecorder)(appData, w, r, &donordata.Provided{
+		LpaUID: "lpa-uid",
+		Type:   lpadata.LpaTypePersonalWelfare,
+		Donor:  donordata.Donor{FirstNames: "a", LastName: "b"},
 	})
+	resp := w.Result()
 
 	assert.ErrorIs(t, err, expectedError)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
-func TestGetPaymentConfirmationWhenNotifyClientSMSError(t *testing.T) {
-	w := httptest.NewRecorder()
-	r, _ := http.NewRequest(http.MethodGet, "/payment-confirmation", nil)
-
-	payClient := newMockPayClient(t).
-		withASuccessfulPayment(pay.FeeFull, r.Context())
-
-	sessionStore := newMockSessionStore(t).
-		withPaySession(r)
-
-	accessCodeSender := newMockAccessCodeSender(t)
-	accessCodeSender.EXPECT().
-		SendCertificateProviderPrompt(mock.Anything, mock.Anything, mock.Anything).
-		Return(nil)
-
-	eventClient := newMockEventClient(t)
-	eventClient.EXPECT().
-		SendPaymentReceived(r.Context(), mock.Anything).
-		Return(nil)
-	eventClient.EXPECT().
-		SendCertificateProviderStarted(r.Context(), mock.Anything).
-		Return(nil)
-
-	localizer := newMockLocalizer(t).
-		withEmailLocalizations()
-	localizer.EXPECT().
-		T(mock.Anything).
-		Return("")
-
-	testAppData.Localizer = localizer
-
-	notifyClient := newMockNotifyClient(t).
-		withEmailPersonalizations(r.Context(), "£92")
-	notifyClient.EXPECT().
-		SendActorSMS(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
-		Return(expectedError)
-
-	err := PaymentConfirmation(newMockLogger(t), payClient, nil, sessionStore, accessCodeSender, eventClient, notifyClient)(testAppData, w, r, &donordata.Provided{
-		LpaUID:  "lpa-uid",
-		Type:    lpadata.LpaTypePersonalWelfare,
-		Donor:   donordata.Donor{FirstNames: "a", LastName: "b", Mobile: "a"},
-		FeeType: pay.FullFee,
-		CertificateProvider: donordata.CertificateProvider{
-			Email: "certificateprovider@example.com",
+func successfulPayment(amount int) pay.GetPaymentResponse {
+	return pay.GetPaymentResponse{
+		Email: "a@example.com",
+		State: pay.State{
+			Status:   "success",
+			Finished: true,
 		},
-		Tasks: donordata.Tasks{
-			PayForLpa:  task.PaymentStateApproved,
-			SignTheLpa: task.StateCompleted,
+		PaymentID:   "abc123",
+		Reference:   "123456789012",
+		AmountPence: pay.AmountPence(amount),
+		SettlementSummary: pay.SettlementSummary{
+			CaptureSubmitTime: time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC),
+			CapturedDate:      date.New("2000", "01", "02"),
 		},
-	})
-
-	assert.ErrorIs(t, err, expectedError)
+		CardDetails: pay.CardDetails{CardholderName: "a b"},
+	}
 }
 
 func (m *mockPayClient) withASuccessfulPayment(amount int, ctx context.Context) *mockPayClient {
 	m.EXPECT().
 		GetPayment(ctx, "abc123").
-		Return(pay.GetPaymentResponse{
-			Email: "a@example.com",
-			State: pay.State{
-				Status:   "success",
-				Finished: true,
-			},
-			PaymentID:   "abc123",
-			Reference:   "123456789012",
-			AmountPence: pay.AmountPence(amount),
-			SettlementSummary: pay.SettlementSummary{
-				CaptureSubmitTime: time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC),
-				CapturedDate:      date.New("2000", "01", "02"),
-			},
-			CardDetails: pay.CardDetails{CardholderName: "a b"},
-		}, nil)
+		Return(successfulPayment(amount), nil)
 
 	return m
 }
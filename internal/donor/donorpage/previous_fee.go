@@ -16,6 +16,7 @@ type previousFeeData struct {
 	App    appcontext.Data
 	Errors validation.List
 	Form   *form.SelectForm[pay.PreviousFee, pay.PreviousFeeOptions, *pay.PreviousFee]
+	Fees   pay.FeeSchedule
 }
 
 func PreviousFee(tmpl template.Template, payer Handler, donorStore DonorStore) Handler {
@@ -23,6 +24,7 @@ func PreviousFee(tmpl template.Template, payer Handler, donorStore DonorStore) H
 		data := &previousFeeData{
 			App:  appData,
 			Form: form.NewSelectForm(provided.PreviousFee, pay.PreviousFeeValues, "howMuchYouPreviouslyPaid"),
+			Fees: provided.FeeSchedule(),
 		}
 
 		if r.Method == http.MethodPost {
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
@@ -26,6 +27,7 @@ func TestGetPreviousFee(t *testing.T) {
 		Execute(w, &previousFeeData{
 			App:  testAppData,
 			Form: form.NewEmptySelectForm[pay.PreviousFee](pay.PreviousFeeValues, "howMuchYouPreviouslyPaid"),
+			Fees: pay.FeeScheduleAt(time.Time{}),
 		}).
 		Return(nil)
 
@@ -45,6 +47,7 @@ func TestGetPreviousFeeFromStore(t *testing.T) {
 		Execute(w, &previousFeeData{
 			App:  testAppData,
 			Form: form.NewSelectForm(pay.PreviousFeeHalf, pay.PreviousFeeValues, "howMuchYouPreviouslyPaid"),
+			Fees: pay.FeeScheduleAt(time.Time{}),
 		}).
 		Return(nil)
 
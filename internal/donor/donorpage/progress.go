@@ -3,6 +3,7 @@ package donorpage
 import (
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"time"
 
@@ -16,6 +17,10 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/voucher/voucherdata"
 )
 
+// refundStatusCheckInterval is how long a refund status is used for before it
+// is checked with GOV.UK Pay again.
+const refundStatusCheckInterval = time.Hour
+
 type progressData struct {
 	App                  appcontext.Data
 	Errors               validation.List
@@ -33,7 +38,7 @@ func (d *progressData) addSuccess(heading, body string) {
 	d.SuccessNotifications = append(d.SuccessNotifications, page.Notification{Heading: heading, BodyHTML: body})
 }
 
-func Progress(tmpl template.Template, lpaStoreResolvingService LpaStoreResolvingService, progressTracker ProgressTracker, certificateProviderStore CertificateProviderStore, voucherStore VoucherStore, donorStore DonorStore, now func() time.Time) Handler {
+func Progress(logger Logger, tmpl template.Template, lpaStoreResolvingService LpaStoreResolvingService, progressTracker ProgressTracker, certificateProviderStore CertificateProviderStore, voucherStore VoucherStore, donorStore DonorStore, payClient PayClient, now func() time.Time) Handler {
 	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, donor *donordata.Provided) error {
 		lpa, err := lpaStoreResolvingService.Get(r.Context())
 		if err != nil {
@@ -243,6 +248,55 @@ func Progress(tmpl template.Template, lpaStoreResolvingService LpaStoreResolving
 			donor.HasSeenReducedFeeApprovalNotification = true
 		}
 
+		refundSubmitted := false
+		for i, payment := range donor.PaymentDetails {
+			for j, refund := range payment.Refunds {
+				if !refund.Status.IsSubmitted() {
+					continue
+				}
+
+				if now().Sub(refund.StatusCheckedAt) < refundStatusCheckInterval {
+					refundSubmitted = true
+					continue
+				}
+
+				refundResponse, err := payClient.GetRefund(r.Context(), payment.PaymentID, refund.RefundID)
+				if err != nil {
+					// Pay being unavailable should not stop the donor seeing their
+					// progress, so assume the refund is still in progress.
+					logger.WarnContext(r.Context(), "error getting refund", slog.String("payment_id", payment.PaymentID), slog.String("refund_id", refund.RefundID), slog.Any("err", err))
+					refundSubmitted = true
+					continue
+				}
+
+				donor.PaymentDetails[i].Refunds[j].Status = refundResponse.Status
+				donor.PaymentDetails[i].Refunds[j].StatusCheckedAt = now()
+				if refundResponse.Status.IsSubmitted() {
+					refundSubmitted = true
+				}
+			}
+		}
+
+		if refundSubmitted {
+			data.addInfo(
+				"weAreRefundingPartOfYourFee",
+				appData.Localizer.Format(
+					"weAreRefundingAmountToYourCard",
+					map[string]any{"Amount": donor.Refunded().String()},
+				),
+			)
+		} else if !donor.HasSeenRefundNotification && donor.Refunded() > 0 {
+			data.addSuccess(
+				"weHaveRefundedPartOfYourFee",
+				appData.Localizer.Format(
+					"weHaveRefundedAmountToYourCard",
+					map[string]any{"Amount": donor.Refunded().String()},
+				),
+			)
+
+			donor.HasSeenRefundNotification = true
+		}
+
 		if donor.RegisteringWithCourtOfProtection &&
 			donor.Tasks.PayForLpa.IsCompleted() &&
 			!donor.WitnessedByCertificateProviderAt.IsZero() {
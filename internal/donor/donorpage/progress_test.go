@@ -2,6 +2,7 @@ package donorpage
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -42,6 +43,7 @@ func TestGetProgress(t *testing.T) {
 		setupCertificateProviderStore func(*mockCertificateProviderStore_GetAny_Call)
 		setupVoucherStore             func(*mockVoucherStore_GetAny_Call)
 		setupDonorStore               func(*testing.T, *mockDonorStore)
+		setupPayClient                func(*mockPayClient)
 		lpa                           *lpadata.Lpa
 		infoNotifications             []page.Notification
 		successNotifications          []page.Notification
@@ -502,13 +504,114 @@ func TestGetProgress(t *testing.T) {
 			setupCertificateProviderStore: certificateProviderStoreNotFound,
 			setupDonorStore:               donorStoreNoUpdate,
 		},
+		"refund submitted": {
+			donor: &donordata.Provided{
+				Tasks: donordata.Tasks{PayForLpa: task.PaymentStateCompleted},
+				PaymentDetails: []donordata.Payment{{
+					PaymentID: "payment-id",
+					Amount:    8200,
+					Refunds:   []donordata.Refund{{RefundID: "refund-id", Amount: 4100, Status: pay.RefundStatusSubmitted}},
+				}},
+			},
+			lpa:                           &lpadata.Lpa{},
+			setupCertificateProviderStore: certificateProviderStoreNotFound,
+			setupPayClient: func(c *mockPayClient) {
+				c.EXPECT().
+					GetRefund(mock.Anything, "payment-id", "refund-id").
+					Return(pay.RefundResponse{Status: pay.RefundStatusSubmitted}, nil)
+			},
+			infoNotifications: []page.Notification{
+				{Heading: "weAreRefundingPartOfYourFee", BodyHTML: "A"},
+			},
+			setupDonorStore: func(_ *testing.T, s *mockDonorStore) {
+				s.EXPECT().
+					Put(mock.Anything, mock.MatchedBy(func(donor *donordata.Provided) bool {
+						refund := donor.PaymentDetails[0].Refunds[0]
+						return refund.Status.IsSubmitted() && time.Since(refund.StatusCheckedAt) < time.Minute
+					})).
+					Return(nil)
+			},
+			setupLocalizer: func(t *testing.T) *mockLocalizer {
+				l := newMockLocalizer(t)
+				l.EXPECT().Format("weAreRefundingAmountToYourCard", map[string]any{"Amount": "£41"}).Return("A")
+				return l
+			},
+		},
+		"refund submitted - recently checked": {
+			donor: &donordata.Provided{
+				Tasks: donordata.Tasks{PayForLpa: task.PaymentStateCompleted},
+				PaymentDetails: []donordata.Payment{{
+					PaymentID: "payment-id",
+					Amount:    8200,
+					Refunds:   []donordata.Refund{{RefundID: "refund-id", Amount: 4100, Status: pay.RefundStatusSubmitted, StatusCheckedAt: time.Now().Add(-time.Minute)}},
+				}},
+			},
+			lpa:                           &lpadata.Lpa{},
+			setupCertificateProviderStore: certificateProviderStoreNotFound,
+			infoNotifications: []page.Notification{
+				{Heading: "weAreRefundingPartOfYourFee", BodyHTML: "A"},
+			},
+			setupDonorStore: donorStoreNoUpdate,
+			setupLocalizer: func(t *testing.T) *mockLocalizer {
+				l := newMockLocalizer(t)
+				l.EXPECT().Format("weAreRefundingAmountToYourCard", map[string]any{"Amount": "£41"}).Return("A")
+				return l
+			},
+		},
+		"refund succeeded": {
+			donor: &donordata.Provided{
+				Tasks: donordata.Tasks{PayForLpa: task.PaymentStateCompleted},
+				PaymentDetails: []donordata.Payment{{
+					PaymentID: "payment-id",
+					Amount:    8200,
+					Refunds:   []donordata.Refund{{RefundID: "refund-id", Amount: 4100, Status: pay.RefundStatusSubmitted}},
+				}},
+			},
+			lpa:                           &lpadata.Lpa{},
+			setupCertificateProviderStore: certificateProviderStoreNotFound,
+			setupPayClient: func(c *mockPayClient) {
+				c.EXPECT().
+					GetRefund(mock.Anything, "payment-id", "refund-id").
+					Return(pay.RefundResponse{Status: pay.RefundStatusSuccess}, nil)
+			},
+			successNotifications: []page.Notification{
+				{Heading: "weHaveRefundedPartOfYourFee", BodyHTML: "A"},
+			},
+			setupDonorStore: func(_ *testing.T, s *mockDonorStore) {
+				s.EXPECT().
+					Put(mock.Anything, mock.MatchedBy(func(donor *donordata.Provided) bool {
+						refund := donor.PaymentDetails[0].Refunds[0]
+						return donor.HasSeenRefundNotification && refund.Status.IsSuccess() && time.Since(refund.StatusCheckedAt) < time.Minute
+					})).
+					Return(nil)
+			},
+			setupLocalizer: func(t *testing.T) *mockLocalizer {
+				l := newMockLocalizer(t)
+				l.EXPECT().Format("weHaveRefundedAmountToYourCard", map[string]any{"Amount": "£41"}).Return("A")
+				return l
+			},
+		},
+		"refund succeeded - has seen notification": {
+			donor: &donordata.Provided{
+				Tasks: donordata.Tasks{PayForLpa: task.PaymentStateCompleted},
+				PaymentDetails: []donordata.Payment{{
+					PaymentID: "payment-id",
+					Amount:    8200,
+					Refunds:   []donordata.Refund{{RefundID: "refund-id", Amount: 4100, Status: pay.RefundStatusSuccess}},
+				}},
+				HasSeenRefundNotification: true,
+			},
+			lpa:                           &lpadata.Lpa{},
+			setupCertificateProviderStore: certificateProviderStoreNotFound,
+			setupDonorStore:               donorStoreNoUpdate,
+		},
 		"applied for reduced fee": {
 			donor: &donordata.Provided{
 				Tasks: donordata.Tasks{
 					PayForLpa: task.PaymentStatePending,
 				},
 				FeeType:        pay.HalfFee,
-				PaymentDetails: []donordata.Payment{{Amount: pay.FeeHalf}},
+				PaymentDetails: []donordata.Payment{{Amount: 4600}},
 			},
 			lpa:                           &lpadata.Lpa{},
 			setupCertificateProviderStore: certificateProviderStoreNotFound,
@@ -822,6 +925,11 @@ func TestGetProgress(t *testing.T) {
 				testAppData.Localizer = tc.setupLocalizer(t)
 			}
 
+			payClient := newMockPayClient(t)
+			if tc.setupPayClient != nil {
+				tc.setupPayClient(payClient)
+			}
+
 			voucherStore := newMockVoucherStore(t)
 			if tc.setupVoucherStore != nil {
 				tc.setupVoucherStore(voucherStore.EXPECT().GetAny(r.Context()))
@@ -838,7 +946,7 @@ func TestGetProgress(t *testing.T) {
 				}).
 				Return(nil)
 
-			err := Progress(template.Execute, lpaStoreResolvingService, progressTracker, certificateProviderStore, voucherStore, donorStore, time.Now)(testAppData, w, r, tc.donor)
+			err := Progress(nil, template.Execute, lpaStoreResolvingService, progressTracker, certificateProviderStore, voucherStore, donorStore, payClient, time.Now)(testAppData, w, r, tc.donor)
 			resp := w.Result()
 
 			assert.Nil(t, err)
@@ -856,7 +964,7 @@ func TestGetProgressWhenLpaStoreClientErrors(t *testing.T) {
 		Get(mock.Anything).
 		Return(nil, expectedError)
 
-	err := Progress(nil, lpaStoreResolvingService, nil, nil, nil, nil, nil)(testAppData, w, r, &donordata.Provided{LpaUID: "lpa-uid"})
+	err := Progress(nil, nil, lpaStoreResolvingService, nil, nil, nil, nil, nil, nil)(testAppData, w, r, &donordata.Provided{LpaUID: "lpa-uid"})
 	assert.ErrorIs(t, err, expectedError)
 }
 
@@ -874,7 +982,7 @@ func TestGetProgressWhenCertificateProviderStoreErrors(t *testing.T) {
 		GetAny(mock.Anything).
 		Return(nil, expectedError)
 
-	err := Progress(nil, lpaStoreResolvingService, nil, certificateProviderStore, nil, nil, nil)(testAppData, w, r, &donordata.Provided{LpaUID: "lpa-uid"})
+	err := Progress(nil, nil, lpaStoreResolvingService, nil, certificateProviderStore, nil, nil, nil, nil)(testAppData, w, r, &donordata.Provided{LpaUID: "lpa-uid"})
 	assert.ErrorIs(t, err, expectedError)
 }
 
@@ -897,7 +1005,7 @@ func TestGetProgressWhenVoucherStoreErrors(t *testing.T) {
 		GetAny(mock.Anything).
 		Return(nil, expectedError)
 
-	err := Progress(nil, lpaStoreResolvingService, nil, certificateProviderStore, voucherStore, nil, testNowFn)(testAppData, w, r, &donordata.Provided{
+	err := Progress(nil, nil, lpaStoreResolvingService, nil, certificateProviderStore, voucherStore, nil, nil, testNowFn)(testAppData, w, r, &donordata.Provided{
 		LpaUID:  "lpa-uid",
 		Tasks:   donordata.Tasks{ConfirmYourIdentity: task.IdentityStateCompleted},
 		Voucher: donordata.Voucher{FirstNames: "a"},
@@ -934,7 +1042,7 @@ func TestGetProgressOnTemplateError(t *testing.T) {
 		Execute(w, mock.Anything).
 		Return(expectedError)
 
-	err := Progress(template.Execute, lpaStoreResolvingService, progressTracker, certificateProviderStore, nil, donorStore, testNowFn)(testAppData, w, r, &donordata.Provided{LpaUID: "lpa-uid"})
+	err := Progress(nil, template.Execute, lpaStoreResolvingService, progressTracker, certificateProviderStore, nil, donorStore, nil, testNowFn)(testAppData, w, r, &donordata.Provided{LpaUID: "lpa-uid"})
 	assert.Equal(t, expectedError, err)
 }
 
@@ -962,7 +1070,7 @@ func TestGetProgressOnDonorStoreError(t *testing.T) {
 		Put(mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	err := Progress(nil, lpaStoreResolvingService, progressTracker, certificateProviderStore, nil, donorStore, time.Now)(testAppData, w, r, &donordata.Provided{
+	err := Progress(nil, nil, lpaStoreResolvingService, progressTracker, certificateProviderStore, nil, donorStore, nil, time.Now)(testAppData, w, r, &donordata.Provided{
 		LpaUID:               "lpa-uid",
 		Tasks:                donordata.Tasks{PayForLpa: task.PaymentStateCompleted},
 		ReducedFeeDecisionAt: time.Now(),
@@ -970,3 +1078,62 @@ func TestGetProgressOnDonorStoreError(t *testing.T) {
 
 	assert.ErrorContains(t, err, "failed to update donor: err")
 }
+
+func TestGetProgressWhenPayClientErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(&lpadata.Lpa{}, nil)
+
+	progressTracker := newMockProgressTracker(t)
+	progressTracker.EXPECT().
+		Progress(mock.Anything).
+		Return(task.Progress{})
+
+	certificateProviderStore := newMockCertificateProviderStore(t)
+	certificateProviderStore.EXPECT().
+		GetAny(mock.Anything).
+		Return(nil, dynamo.NotFoundError{})
+
+	payClient := newMockPayClient(t)
+	payClient.EXPECT().
+		GetRefund(mock.Anything, mock.Anything, mock.Anything).
+		Return(pay.RefundResponse{}, expectedError)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		WarnContext(r.Context(), "error getting refund", slog.String("payment_id", "payment-id"), slog.String("refund_id", "refund-id"), slog.Any("err", expectedError))
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Put(mock.Anything, mock.Anything).
+		Return(nil)
+
+	localizer := newMockLocalizer(t)
+	localizer.EXPECT().
+		Format("weAreRefundingAmountToYourCard", map[string]any{"Amount": "£46"}).
+		Return("A")
+
+	appData := testAppData
+	appData.Localizer = localizer
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, mock.MatchedBy(func(data *progressData) bool {
+			return assert.Equal(t, []page.Notification{{Heading: "weAreRefundingPartOfYourFee", BodyHTML: "A"}}, data.InfoNotifications)
+		})).
+		Return(nil)
+
+	err := Progress(logger, template.Execute, lpaStoreResolvingService, progressTracker, certificateProviderStore, nil, donorStore, payClient, testNowFn)(appData, w, r, &donordata.Provided{
+		LpaUID: "lpa-uid",
+		PaymentDetails: []donordata.Payment{{
+			PaymentID: "payment-id",
+			Refunds:   []donordata.Refund{{RefundID: "refund-id", Status: pay.RefundStatusSubmitted, Amount: 4600}},
+		}},
+	})
+
+	assert.Nil(t, err)
+}
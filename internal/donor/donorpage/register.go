@@ -3,6 +3,7 @@ package donorpage
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/document"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/event"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/form"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/identity"
@@ -32,6 +34,8 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/scheduled"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/scheduled/scheduleddata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/sesh"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/uid"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/voucher/voucherdata"
@@ -54,6 +58,7 @@ type Logger interface {
 type DonorStore interface {
 	Get(ctx context.Context) (*donordata.Provided, error)
 	Latest(ctx context.Context) (*donordata.Provided, error)
+	One(ctx context.Context, pk dynamo.LpaKeyType, sk dynamo.SK) (*donordata.Provided, error)
 	Put(ctx context.Context, donor *donordata.Provided) error
 	Delete(ctx context.Context) error
 	Link(ctx context.Context, data accesscodedata.Link, donorEmail string) error
@@ -102,6 +107,7 @@ type S3Client interface {
 type PayClient interface {
 	CreatePayment(ctx context.Context, lpaUID string, body pay.CreatePaymentBody) (*pay.CreatePaymentResponse, error)
 	GetPayment(ctx context.Context, id string) (pay.GetPaymentResponse, error)
+	GetRefund(ctx context.Context, paymentID, refundID string) (pay.RefundResponse, error)
 	CanRedirect(url string) bool
 }
 
@@ -114,6 +120,11 @@ type AccessCodeSender interface {
 	SendCertificateProviderPrompt(ctx context.Context, appData appcontext.Data, provided *donordata.Provided) error
 	SendVoucherAccessCode(ctx context.Context, donor *donordata.Provided, appData appcontext.Data) error
 	SendVoucherInvite(ctx context.Context, donor *donordata.Provided, appData appcontext.Data) error
+	ResendAccessCode(ctx context.Context, appData appcontext.Data, lpa *lpadata.Lpa, actorUID actoruid.UID) error
+}
+
+type PaymentRecorder interface {
+	Record(ctx context.Context, appData appcontext.Data, provided *donordata.Provided, payment pay.GetPaymentResponse) error
 }
 
 type OneLoginClient interface {
@@ -142,6 +153,8 @@ type SessionStore interface {
 type WitnessCodeSender interface {
 	SendToCertificateProvider(context.Context, *donordata.Provided) error
 	SendToIndependentWitness(context.Context, *donordata.Provided) error
+	SetUpCertificateProviderTOTP(context.Context, *donordata.Provided) error
+	SetUpIndependentWitnessTOTP(context.Context, *donordata.Provided) error
 }
 
 type UidClient interface {
@@ -193,6 +206,7 @@ type LpaStoreClient interface {
 type AccessCodeStore interface {
 	Get(ctx context.Context, actorType actor.Type, code accesscodedata.Hashed) (accesscodedata.Link, error)
 	DeleteByActor(ctx context.Context, actorUID actoruid.UID) error
+	Summaries(ctx context.Context, lpa *lpadata.Lpa) ([]accesscodedata.Summary, error)
 }
 
 type ScheduledStore interface {
@@ -200,6 +214,14 @@ type ScheduledStore interface {
 	DeleteAllActionByUID(ctx context.Context, actions []scheduleddata.Action, uid string) error
 }
 
+type NotificationStore interface {
+	GetAll(ctx context.Context) ([]notify.Notification, error)
+}
+
+type MemberStore interface {
+	Get(ctx context.Context) (*supporterdata.Member, error)
+}
+
 type ErrorHandler func(http.ResponseWriter, *http.Request, error)
 
 type ProgressTracker interface {
@@ -276,11 +298,13 @@ func Register(
 	voucherStore VoucherStore,
 	reuseStore ReuseStore,
 	bundle Bundle,
+	notificationStore NotificationStore,
+	memberStore MemberStore,
 	donorStartURL string,
 	certificateProviderStartURL string,
 	attorneyStartURL string,
 ) {
-	payer := Pay(logger, sessionStore, donorStore, payClient, appPublicURL)
+	payer := Pay(logger, sessionStore, donorStore, payClient, scheduledStore, time.Now, appPublicURL)
 
 	handleRoot := makeHandle(rootMux, sessionStore, errorHandler, donorStartURL)
 
@@ -292,10 +316,14 @@ func Register(
 		page.EnterAccessCode(tmpls.Get("enter_access_code.gohtml"), accessCodeStore, sessionStore, actor.TypeDonor,
 			EnterAccessCode(logger, donorStore, eventClient)))
 
-	handleWithDonor := makeLpaHandle(rootMux, sessionStore, errorHandler, donorStore, donorStartURL)
+	handleWithDonor := makeLpaHandle(rootMux, sessionStore, errorHandler, donorStore, memberStore, donorStartURL)
 
 	handleWithDonor(donor.PathViewLPA, page.None,
 		ViewLpa(tmpls.Get("view_lpa.gohtml"), lpaStoreClient))
+	handleWithDonor(donor.PathCommunicationsSent, page.None,
+		CommunicationsSent(tmpls.Get("communications_sent.gohtml"), notificationStore))
+	handleWithDonor(donor.PathAccessCodes, page.None,
+		AccessCodes(tmpls.Get("access_codes.gohtml"), lpaStoreResolvingService, accessCodeStore, accessCodeSender))
 
 	handleWithDonor(donor.PathDeleteThisLpa, page.None,
 		DeleteLpa(tmpls.Get("delete_this_lpa.gohtml"), donorStore, notifyClient, certificateProviderStartURL, eventClient))
@@ -499,7 +527,9 @@ func Register(
 	handleWithDonor(donor.PathAboutPayment, page.None,
 		Guidance(tmpls.Get("about_payment.gohtml")))
 	handleWithDonor(donor.PathAreYouApplyingForFeeDiscountOrExemption, page.CanGoBack,
-		AreYouApplyingForFeeDiscountOrExemption(tmpls.Get("are_you_applying_for_a_different_fee_type.gohtml"), payer, donorStore))
+		AreYouApplyingForFeeDiscountOrExemption(tmpls.Get("are_you_applying_for_a_different_fee_type.gohtml"), payer, dashboardStore, donorStore))
+	handleWithDonor(donor.PathPayForBothLpas, page.CanGoBack,
+		PayForBothLpas(tmpls.Get("pay_for_both_lpas.gohtml"), payer, dashboardStore, donorStore))
 	handleWithDonor(donor.PathWhichFeeTypeAreYouApplyingFor, page.CanGoBack,
 		WhichFeeTypeAreYouApplyingFor(tmpls.Get("which_fee_type_are_you_applying_for.gohtml"), donorStore))
 	handleWithDonor(donor.PathPreviousApplicationNumber, page.CanGoBack,
@@ -519,7 +549,7 @@ func Register(
 	handleWithDonor(donor.PathPayFee, page.None,
 		payer)
 	handleWithDonor(donor.PathPaymentConfirmation, page.None,
-		PaymentConfirmation(logger, payClient, donorStore, sessionStore, accessCodeSender, eventClient, notifyClient))
+		PaymentConfirmation(logger, payClient, sessionStore, notifyClient, donor.NewPaymentRecorder(donorStore, accessCodeSender, eventClient, notifyClient)))
 	handleWithDonor(donor.PathPaymentSuccessful, page.None,
 		Guidance(tmpls.Get("payment_successful.gohtml")))
 	handleWithDonor(donor.PathEvidenceSuccessfullyUploaded, page.None,
@@ -538,7 +568,7 @@ func Register(
 	handleWithDonor(donor.PathIdentityWithOneLogin, page.CanGoBack,
 		IdentityWithOneLogin(oneLoginClient, sessionStore, random.AlphaNumeric))
 	handleWithDonor(donor.PathIdentityWithOneLoginCallback, page.CanGoBack,
-		IdentityWithOneLoginCallback(oneLoginClient, sessionStore, donorStore, scheduledStore, eventClient))
+		IdentityWithOneLoginCallback(logger, oneLoginClient, sessionStore, donorStore, scheduledStore, eventClient))
 	handleWithDonor(donor.PathIdentityDetails, page.None,
 		IdentityDetails(tmpls.Get("identity_details.gohtml"), donorStore, eventClient))
 	handleWithDonor(donor.PathIdentityDetailsUpdated, page.None,
@@ -608,7 +638,7 @@ func Register(
 		WarningInterruption(tmpls.Get("warning_interruption.gohtml")))
 
 	handleWithDonor(donor.PathProgress, page.None,
-		Progress(tmpls.Get("progress.gohtml"), lpaStoreResolvingService, progressTracker, certificateProviderStore, voucherStore, donorStore, time.Now))
+		Progress(logger, tmpls.Get("progress.gohtml"), lpaStoreResolvingService, progressTracker, certificateProviderStore, voucherStore, donorStore, payClient, time.Now))
 
 	handleWithDonor(donor.PathUploadEvidenceSSE, page.None,
 		UploadEvidenceSSE(documentStore, logger, 3*time.Minute, 2*time.Second, time.Now))
@@ -644,7 +674,7 @@ func makeHandle(mux *http.ServeMux, store SessionStore, errorHandler page.ErrorH
 	}
 }
 
-func makeLpaHandle(mux *http.ServeMux, store SessionStore, errorHandler page.ErrorHandler, donorStore DonorStore, donorStartURL string) func(donor.Path, page.HandleOpt, Handler) {
+func makeLpaHandle(mux *http.ServeMux, store SessionStore, errorHandler page.ErrorHandler, donorStore DonorStore, memberStore MemberStore, donorStartURL string) func(donor.Path, page.HandleOpt, Handler) {
 	return func(path donor.Path, opt page.HandleOpt, h Handler) {
 		mux.HandleFunc(path.String(), func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
@@ -673,9 +703,23 @@ func makeLpaHandle(mux *http.ServeMux, store SessionStore, errorHandler page.Err
 				ctx = appcontext.ContextWithSession(ctx, sessionData)
 			}
 
+			// the member is loaded, rather than using the permission stored in the
+			// login session, so that changes and suspensions apply immediately
+			var member *supporterdata.Member
 			if loginSession.OrganisationID != "" {
 				sessionData.OrganisationID = loginSession.OrganisationID
 				sessionData.Email = loginSession.Email
+
+				member, err = memberStore.Get(ctx)
+				if err != nil {
+					errorHandler(w, r, err)
+					return
+				}
+
+				if member.Status.IsSuspended() {
+					supporter.PathDashboard.Redirect(w, r, appData)
+					return
+				}
 			}
 
 			appData.Page = path.Format(appData.LpaID)
@@ -695,6 +739,11 @@ func makeLpaHandle(mux *http.ServeMux, store SessionStore, errorHandler page.Err
 				return
 			}
 
+			if member != nil && !member.Permission.CanEditLpa(lpa.IsAssignedTo(member.ID)) {
+				errorHandler(w, r, errors.New("permission denied"))
+				return
+			}
+
 			if lpa.Donor.Email == "" && loginSession.OrganisationID == "" {
 				lpa.Donor.Email = loginSession.Email
 				err = donorStore.Put(ctx, lpa)
@@ -705,11 +754,12 @@ func makeLpaHandle(mux *http.ServeMux, store SessionStore, errorHandler page.Err
 				}
 			}
 
-			if loginSession.OrganisationID != "" {
+			if member != nil {
 				appData.SupporterData = &appcontext.SupporterData{
 					LpaType:          lpa.Type,
 					DonorFullName:    lpa.Donor.FullName(),
 					OrganisationName: loginSession.OrganisationName,
+					Permission:       member.Permission,
 				}
 			}
 
@@ -22,6 +22,8 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/place"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/sesh"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -29,7 +31,7 @@ import (
 
 func TestRegister(t *testing.T) {
 	mux := http.NewServeMux()
-	Register(mux, &slog.Logger{}, template.Templates{}, &mockSessionStore{}, &mockDonorStore{}, &onelogin.Client{}, &place.Client{}, "http://example.org", &pay.Client{}, &mockAccessCodeSender{}, &mockWitnessCodeSender{}, nil, &mockCertificateProviderStore{}, &mockNotifyClient{}, &mockEvidenceReceivedStore{}, &mockDocumentStore{}, &mockEventClient{}, &mockDashboardStore{}, &mockLpaStoreClient{}, &mockAccessCodeStore{}, &mockProgressTracker{}, &lpastore.ResolvingService{}, &mockScheduledStore{}, &mockVoucherStore{}, &mockReuseStore{}, &mockBundle{}, "donorStartURL", "certificateProviderStartURL", "attorneyStartURL")
+	Register(mux, &slog.Logger{}, template.Templates{}, &mockSessionStore{}, &mockDonorStore{}, &onelogin.Client{}, &place.Client{}, "http://example.org", &pay.Client{}, &mockAccessCodeSender{}, &mockWitnessCodeSender{}, nil, &mockCertificateProviderStore{}, &mockNotifyClient{}, &mockEvidenceReceivedStore{}, &mockDocumentStore{}, &mockEventClient{}, &mockDashboardStore{}, &mockLpaStoreClient{}, &mockAccessCodeStore{}, &mockProgressTracker{}, &lpastore.ResolvingService{}, &mockScheduledStore{}, &mockVoucherStore{}, &mockReuseStore{}, &mockBundle{}, &mockNotificationStore{}, &mockMemberStore{}, "donorStartURL", "certificateProviderStartURL", "attorneyStartURL")
 
 	assert.Implements(t, (*http.Handler)(nil), mux)
 }
@@ -130,6 +132,7 @@ func TestMakeLpaHandleWhenDetailsProvidedAndUIDExists(t *testing.T) {
 	testCases := map[string]struct {
 		expectedAppData appcontext.Data
 		loginSesh       *sesh.LoginSession
+		member          *supporterdata.Member
 		expectedSession *appcontext.Session
 	}{
 		"donor": {
@@ -154,6 +157,23 @@ func TestMakeLpaHandleWhenDetailsProvidedAndUIDExists(t *testing.T) {
 				},
 			},
 			loginSesh:       &sesh.LoginSession{Sub: "random", OrganisationID: "org-id"},
+			member:          &supporterdata.Member{ID: "member-id"},
+			expectedSession: &appcontext.Session{SessionID: "cmFuZG9t", OrganisationID: "org-id", LpaID: "123"},
+		},
+		"organisation case handler assigned": {
+			expectedAppData: appcontext.Data{
+				Page:      "/lpa/123/path",
+				ActorType: actor.TypeDonor,
+				SessionID: "cmFuZG9t",
+				LpaID:     "123",
+				SupporterData: &appcontext.SupporterData{
+					DonorFullName: "Jane Smith",
+					LpaType:       lpadata.LpaTypePropertyAndAffairs,
+					Permission:    supporterdata.PermissionCaseHandler,
+				},
+			},
+			loginSesh:       &sesh.LoginSession{Sub: "random", OrganisationID: "org-id"},
+			member:          &supporterdata.Member{ID: "member-id", Permission: supporterdata.PermissionCaseHandler},
 			expectedSession: &appcontext.Session{SessionID: "cmFuZG9t", OrganisationID: "org-id", LpaID: "123"},
 		},
 	}
@@ -180,12 +200,20 @@ func TestMakeLpaHandleWhenDetailsProvidedAndUIDExists(t *testing.T) {
 					Address:     place.Address{Postcode: "ABC123"},
 					Email:       "a@example.com",
 				},
-					Type:   lpadata.LpaTypePropertyAndAffairs,
-					Tasks:  donordata.Tasks{YourDetails: task.StateCompleted},
-					LpaUID: "a-uid",
+					Type:             lpadata.LpaTypePropertyAndAffairs,
+					Tasks:            donordata.Tasks{YourDetails: task.StateCompleted},
+					LpaUID:           "a-uid",
+					AssignedMemberID: "member-id",
 				}, nil)
 
-			handle := makeLpaHandle(mux, sessionStore, nil, donorStore, "http://example.com/start")
+			memberStore := newMockMemberStore(t)
+			if tc.member != nil {
+				memberStore.EXPECT().
+					Get(mock.Anything).
+					Return(tc.member, nil)
+			}
+
+			handle := makeLpaHandle(mux, sessionStore, nil, donorStore, memberStore, "http://example.com/start")
 			handle("/path", page.None, func(appData appcontext.Data, hw http.ResponseWriter, hr *http.Request, _ *donordata.Provided) error {
 				assert.Equal(t, tc.expectedAppData, appData)
 
@@ -244,7 +272,7 @@ func TestMakeHandleLpaWhenDonorEmailNotSet(t *testing.T) {
 		}).
 		Return(nil)
 
-	handle := makeLpaHandle(mux, sessionStore, nil, donorStore, "http://example.com/start")
+	handle := makeLpaHandle(mux, sessionStore, nil, donorStore, nil, "http://example.com/start")
 	handle("/path", page.None, func(appData appcontext.Data, hw http.ResponseWriter, hr *http.Request, _ *donordata.Provided) error {
 		hw.WriteHeader(http.StatusTeapot)
 		return nil
@@ -267,7 +295,7 @@ func TestMakeLpaHandleWhenSessionStoreError(t *testing.T) {
 		Login(r).
 		Return(nil, expectedError)
 
-	handle := makeLpaHandle(mux, sessionStore, nil, nil, "http://example.com/start")
+	handle := makeLpaHandle(mux, sessionStore, nil, nil, nil, "http://example.com/start")
 	handle("/path", page.None, func(_ appcontext.Data, _ http.ResponseWriter, _ *http.Request, _ *donordata.Provided) error {
 		return expectedError
 	})
@@ -316,7 +344,7 @@ func TestMakeLpaHandleWhenDonorStoreError(t *testing.T) {
 			errorHandler.EXPECT().
 				Execute(w, r, expectedError)
 
-			handle := makeLpaHandle(mux, sessionStore, errorHandler.Execute, donorStore(), "http://example.com/start")
+			handle := makeLpaHandle(mux, sessionStore, errorHandler.Execute, donorStore(), nil, "http://example.com/start")
 			handle("/path", page.None, func(_ appcontext.Data, _ http.ResponseWriter, _ *http.Request, _ *donordata.Provided) error {
 				return expectedError
 			})
@@ -330,6 +358,97 @@ func TestMakeLpaHandleWhenDonorStoreError(t *testing.T) {
 
 }
 
+func TestMakeLpaHandleWhenSupporterCannotEdit(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/lpa/id/path", nil)
+
+	mux := http.NewServeMux()
+
+	sessionStore := newMockSessionStore(t)
+	sessionStore.EXPECT().
+		Login(r).
+		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Permission: supporterdata.PermissionAdmin, MemberID: "other-member-id"}, nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		Get(mock.Anything).
+		Return(&supporterdata.Member{ID: "member-id", Permission: supporterdata.PermissionCaseHandler}, nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(mock.Anything).
+		Return(&donordata.Provided{AssignedMemberID: "other-member-id"}, nil)
+
+	errorHandler := newMockErrorHandler(t)
+	errorHandler.EXPECT().
+		Execute(w, r, mock.MatchedBy(func(err error) bool {
+			return err.Error() == "permission denied"
+		}))
+
+	handle := makeLpaHandle(mux, sessionStore, errorHandler.Execute, donorStore, memberStore, "http://example.com/start")
+	handle("/path", page.None, func(_ appcontext.Data, _ http.ResponseWriter, _ *http.Request, _ *donordata.Provided) error {
+		return nil
+	})
+
+	mux.ServeHTTP(w, r)
+}
+
+func TestMakeLpaHandleWhenMemberStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/lpa/id/path", nil)
+
+	mux := http.NewServeMux()
+
+	sessionStore := newMockSessionStore(t)
+	sessionStore.EXPECT().
+		Login(r).
+		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id"}, nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		Get(mock.Anything).
+		Return(nil, expectedError)
+
+	errorHandler := newMockErrorHandler(t)
+	errorHandler.EXPECT().
+		Execute(w, r, expectedError)
+
+	handle := makeLpaHandle(mux, sessionStore, errorHandler.Execute, nil, memberStore, "http://example.com/start")
+	handle("/path", page.None, func(_ appcontext.Data, _ http.ResponseWriter, _ *http.Request, _ *donordata.Provided) error {
+		return nil
+	})
+
+	mux.ServeHTTP(w, r)
+}
+
+func TestMakeLpaHandleWhenMemberSuspended(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/lpa/id/path", nil)
+
+	mux := http.NewServeMux()
+
+	sessionStore := newMockSessionStore(t)
+	sessionStore.EXPECT().
+		Login(r).
+		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Permission: supporterdata.PermissionAdmin}, nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		Get(mock.Anything).
+		Return(&supporterdata.Member{ID: "member-id", Permission: supporterdata.PermissionAdmin, Status: supporterdata.StatusSuspended}, nil)
+
+	handle := makeLpaHandle(mux, sessionStore, nil, nil, memberStore, "http://example.com/start")
+	handle("/path", page.None, func(_ appcontext.Data, _ http.ResponseWriter, _ *http.Request, _ *donordata.Provided) error {
+		return nil
+	})
+
+	mux.ServeHTTP(w, r)
+	resp := w.Result()
+
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, supporter.PathDashboard.Format(), resp.Header.Get("Location"))
+}
+
 func TestMakeLpaHandleWhenCannotGoToURL(t *testing.T) {
 	path := donor.PathWhenCanTheLpaBeUsed
 	w := httptest.NewRecorder()
@@ -347,7 +466,7 @@ func TestMakeLpaHandleWhenCannotGoToURL(t *testing.T) {
 		Get(mock.Anything).
 		Return(&donordata.Provided{LpaID: "123", Donor: donordata.Donor{Email: "a@example.com"}}, nil)
 
-	handle := makeLpaHandle(mux, sessionStore, nil, donorStore, "http://example.com/start")
+	handle := makeLpaHandle(mux, sessionStore, nil, donorStore, nil, "http://example.com/start")
 	handle(path, page.None, func(_ appcontext.Data, _ http.ResponseWriter, _ *http.Request, _ *donordata.Provided) error {
 		return nil
 	})
@@ -395,7 +514,7 @@ func TestMakeLpaHandleWhenCannotGoToTaskList(t *testing.T) {
 			},
 		}, nil)
 
-	handle := makeLpaHandle(mux, sessionStore, nil, donorStore, "http://example.com/start")
+	handle := makeLpaHandle(mux, sessionStore, nil, donorStore, nil, "http://example.com/start")
 	handle(path, page.None, func(_ appcontext.Data, _ http.ResponseWriter, _ *http.Request, _ *donordata.Provided) error {
 		return nil
 	})
@@ -423,7 +542,7 @@ func TestMakeLpaHandleSessionExistingSession(t *testing.T) {
 		Return(&donordata.Provided{Donor: donordata.Donor{Email: "a@example.com"}}, nil)
 
 	mux := http.NewServeMux()
-	handle := makeLpaHandle(mux, sessionStore, nil, donorStore, "http://example.com/start")
+	handle := makeLpaHandle(mux, sessionStore, nil, donorStore, nil, "http://example.com/start")
 	handle("/path", page.RequireSession|page.CanGoBack, func(appData appcontext.Data, hw http.ResponseWriter, hr *http.Request, _ *donordata.Provided) error {
 		assert.Equal(t, appcontext.Data{
 			Page:      "/lpa/123/path",
@@ -466,7 +585,7 @@ func TestMakeLpaHandleErrors(t *testing.T) {
 		Return(&donordata.Provided{Donor: donordata.Donor{Email: "a@example.com"}}, nil)
 
 	mux := http.NewServeMux()
-	handle := makeLpaHandle(mux, sessionStore, errorHandler.Execute, donorStore, "http://example.com/start")
+	handle := makeLpaHandle(mux, sessionStore, errorHandler.Execute, donorStore, nil, "http://example.com/start")
 	handle("/path", page.RequireSession, func(_ appcontext.Data, _ http.ResponseWriter, _ *http.Request, _ *donordata.Provided) error {
 		return expectedError
 	})
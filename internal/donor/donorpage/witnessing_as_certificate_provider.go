@@ -13,6 +13,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/rate"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/totp"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
 )
 
@@ -40,6 +41,7 @@ func WitnessingAsCertificateProvider(
 
 		if r.Method == http.MethodPost {
 			data.Form = readWitnessingAsCertificateProviderForm(r)
+			data.Form.TOTP = !provided.CertificateProviderTOTP.IsZero()
 			data.Errors = data.Form.Validate()
 
 			if provided.WitnessCodeLimiter == nil {
@@ -49,7 +51,7 @@ func WitnessingAsCertificateProvider(
 			if !provided.WitnessCodeLimiter.Allow(now()) {
 				data.Errors.Add("witness-code", validation.CustomError{Label: "tooManyWitnessCodeAttempts"})
 			} else {
-				code, found := provided.CertificateProviderCodes.Find(data.Form.Code, now())
+				code, found := findWitnessCode(provided.CertificateProviderCodes, &provided.CertificateProviderTOTP, data.Form.Code, now())
 				if !found {
 					data.Errors.Add("witness-code", validation.CustomError{Label: "witnessCodeDoesNotMatch"})
 				} else if code.HasExpired(now()) {
@@ -97,6 +99,7 @@ func WitnessingAsCertificateProvider(
 
 type witnessingAsCertificateProviderForm struct {
 	Code string
+	TOTP bool
 }
 
 func readWitnessingAsCertificateProviderForm(r *http.Request) *witnessingAsCertificateProviderForm {
@@ -110,7 +113,32 @@ func (w *witnessingAsCertificateProviderForm) Validate() validation.List {
 
 	errors.String("witness-code", "theCodeWeSentCertificateProvider", w.Code,
 		validation.Empty(),
-		validation.StringLength(4))
+		validation.StringLength(w.codeLength()))
 
 	return errors
 }
+
+func (w *witnessingAsCertificateProviderForm) codeLength() int {
+	if w.TOTP {
+		return totp.Digits
+	}
+
+	return 4
+}
+
+// findWitnessCode checks value against the time-based codes for the witness,
+// if they have been set up, otherwise against the codes sent by SMS. A
+// time-based code that is found is marked as used so it cannot be entered
+// again.
+func findWitnessCode(codes donordata.WitnessCodes, witnessTOTP *donordata.WitnessTOTP, value string, now time.Time) (donordata.WitnessCode, bool) {
+	if witnessTOTP.IsZero() {
+		return codes.Find(value, now)
+	}
+
+	code, found := witnessTOTP.Find(value, now)
+	if found {
+		witnessTOTP.Use(code)
+	}
+
+	return code, found
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/rate"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/totp"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -145,6 +146,89 @@ func TestPostWitnessingAsCertificateProvider(t *testing.T) {
 	assert.Equal(t, donor.PathYouHaveSubmittedYourLpa.Format("lpa-id"), resp.Header.Get("Location"))
 }
 
+func TestPostWitnessingAsCertificateProviderWithTOTP(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	code, _ := totp.Code(secret, testNow)
+
+	form := url.Values{
+		"witness-code": {code},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	provided := &donordata.Provided{
+		LpaID:                            "lpa-id",
+		LpaUID:                           "lpa-uid",
+		CertificateProviderCodes:         donordata.WitnessCodes{{Code: "1234", Created: testNow}},
+		CertificateProviderTOTP:          donordata.WitnessTOTP{Secret: secret, Created: testNow, UsedStep: totp.Step(testNow)},
+		WitnessedByCertificateProviderAt: testNow,
+		Tasks:                            donordata.Tasks{SignTheLpa: task.StateCompleted},
+	}
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Put(r.Context(), provided).
+		Return(nil)
+
+	lpaStoreClient := newMockLpaStoreClient(t)
+	lpaStoreClient.EXPECT().
+		SendLpa(r.Context(), provided.LpaUID, lpastore.CreateLpaFromDonorProvided(provided)).
+		Return(nil)
+
+	err := WitnessingAsCertificateProvider(nil, donorStore, nil, lpaStoreClient, nil, testNowFn)(testAppData, w, r, &donordata.Provided{
+		LpaID:                    "lpa-id",
+		LpaUID:                   "lpa-uid",
+		CertificateProviderCodes: donordata.WitnessCodes{{Code: "1234", Created: testNow}},
+		CertificateProviderTOTP:  donordata.WitnessTOTP{Secret: secret, Created: testNow},
+		WitnessCodeLimiter:       testLimiter(),
+	})
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, donor.PathYouHaveSubmittedYourLpa.Format("lpa-id"), resp.Header.Get("Location"))
+}
+
+func TestPostWitnessingAsCertificateProviderWithTOTPWhenSMSCodeEntered(t *testing.T) {
+	form := url.Values{
+		"witness-code": {"1234"},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	provided := &donordata.Provided{
+		LpaID:                    "lpa-id",
+		CertificateProviderCodes: donordata.WitnessCodes{{Code: "1234", Created: testNow}},
+		CertificateProviderTOTP:  donordata.WitnessTOTP{Secret: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", Created: testNow},
+		WitnessCodeLimiter:       testLimiter(),
+	}
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Put(r.Context(), provided).
+		Return(nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &witnessingAsCertificateProviderData{
+			App:    testAppData,
+			Donor:  provided,
+			Errors: validation.With("witness-code", validation.StringLengthError{Label: "theCodeWeSentCertificateProvider", Length: 6}),
+			Form:   &witnessingAsCertificateProviderForm{Code: "1234", TOTP: true},
+		}).
+		Return(nil)
+
+	err := WitnessingAsCertificateProvider(template.Execute, donorStore, nil, nil, nil, testNowFn)(testAppData, w, r, provided)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
 func TestPostWitnessingAsCertificateProviderWhenSendLpaErrors(t *testing.T) {
 	form := url.Values{
 		"witness-code": {"1234"},
@@ -461,6 +545,19 @@ func TestWitnessingAsCertificateProviderValidate(t *testing.T) {
 			},
 			errors: validation.With("witness-code", validation.StringLengthError{Label: "theCodeWeSentCertificateProvider", Length: 4}),
 		},
+		"valid time-based": {
+			form: &witnessingAsCertificateProviderForm{
+				Code: "123456",
+				TOTP: true,
+			},
+		},
+		"time-based too short": {
+			form: &witnessingAsCertificateProviderForm{
+				Code: "1234",
+				TOTP: true,
+			},
+			errors: validation.With("witness-code", validation.StringLengthError{Label: "theCodeWeSentCertificateProvider", Length: 6}),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -10,6 +10,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/rate"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/totp"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
 )
 
@@ -30,6 +31,7 @@ func WitnessingAsIndependentWitness(tmpl template.Template, donorStore DonorStor
 
 		if r.Method == http.MethodPost {
 			data.Form = readWitnessingAsIndependentWitnessForm(r)
+			data.Form.TOTP = !provided.IndependentWitnessTOTP.IsZero()
 			data.Errors = data.Form.Validate()
 
 			if provided.WitnessCodeLimiter == nil {
@@ -39,7 +41,7 @@ func WitnessingAsIndependentWitness(tmpl template.Template, donorStore DonorStor
 			if !provided.WitnessCodeLimiter.Allow(now()) {
 				data.Errors.Add("witness-code", validation.CustomError{Label: "tooManyWitnessCodeAttempts"})
 			} else {
-				code, found := provided.IndependentWitnessCodes.Find(data.Form.Code, now())
+				code, found := findWitnessCode(provided.IndependentWitnessCodes, &provided.IndependentWitnessTOTP, data.Form.Code, now())
 				if !found {
 					data.Errors.Add("witness-code", validation.CustomError{Label: "witnessCodeDoesNotMatch"})
 				} else if code.HasExpired(now()) {
@@ -67,6 +69,7 @@ func WitnessingAsIndependentWitness(tmpl template.Template, donorStore DonorStor
 
 type witnessingAsIndependentWitnessForm struct {
 	Code string
+	TOTP bool
 }
 
 func readWitnessingAsIndependentWitnessForm(r *http.Request) *witnessingAsIndependentWitnessForm {
@@ -80,7 +83,15 @@ func (w *witnessingAsIndependentWitnessForm) Validate() validation.List {
 
 	errors.String("witness-code", "theCodeWeSentIndependentWitness", w.Code,
 		validation.Empty(),
-		validation.StringLength(4))
+		validation.StringLength(w.codeLength()))
 
 	return errors
 }
+
+func (w *witnessingAsIndependentWitnessForm) codeLength() int {
+	if w.TOTP {
+		return totp.Digits
+	}
+
+	return 4
+}
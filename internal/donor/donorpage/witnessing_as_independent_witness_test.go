@@ -13,6 +13,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/identity"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/rate"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/totp"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -114,6 +115,80 @@ func TestPostWitnessingAsIndependentWitness(t *testing.T) {
 	assert.Equal(t, donor.PathWitnessingAsCertificateProvider.Format("lpa-id"), resp.Header.Get("Location"))
 }
 
+func TestPostWitnessingAsIndependentWitnessWithTOTP(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	now := time.Now()
+	code, _ := totp.Code(secret, now)
+
+	form := url.Values{
+		"witness-code": {code},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Put(r.Context(), &donordata.Provided{
+			LpaID:                           "lpa-id",
+			IndependentWitnessTOTP:          donordata.WitnessTOTP{Secret: secret, Created: now, UsedStep: totp.Step(now)},
+			WitnessedByIndependentWitnessAt: now,
+		}).
+		Return(nil)
+
+	err := WitnessingAsIndependentWitness(nil, donorStore, func() time.Time { return now })(testAppData, w, r, &donordata.Provided{
+		LpaID:                  "lpa-id",
+		IndependentWitnessTOTP: donordata.WitnessTOTP{Secret: secret, Created: now},
+	})
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, donor.PathWitnessingAsCertificateProvider.Format("lpa-id"), resp.Header.Get("Location"))
+}
+
+func TestPostWitnessingAsIndependentWitnessWithTOTPAlreadyUsed(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	now := time.Now()
+	code, _ := totp.Code(secret, now)
+
+	form := url.Values{
+		"witness-code": {code},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	provided := &donordata.Provided{
+		LpaID:                  "lpa-id",
+		IndependentWitnessTOTP: donordata.WitnessTOTP{Secret: secret, Created: now, UsedStep: totp.Step(now)},
+		WitnessCodeLimiter:     testLimiter(),
+	}
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Put(r.Context(), provided).
+		Return(nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &witnessingAsIndependentWitnessData{
+			App:    testAppData,
+			Donor:  provided,
+			Errors: validation.With("witness-code", validation.CustomError{Label: "witnessCodeDoesNotMatch"}),
+			Form:   &witnessingAsIndependentWitnessForm{Code: code, TOTP: true},
+		}).
+		Return(nil)
+
+	err := WitnessingAsIndependentWitness(template.Execute, donorStore, func() time.Time { return now })(testAppData, w, r, provided)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
 func TestPostWitnessingAsIndependentWitnessWhenDonorStoreErrors(t *testing.T) {
 	form := url.Values{
 		"witness-code": {"1234"},
@@ -352,6 +427,19 @@ func TestWitnessingAsIndependentWitnessValidate(t *testing.T) {
 			},
 			errors: validation.With("witness-code", validation.StringLengthError{Label: "theCodeWeSentIndependentWitness", Length: 4}),
 		},
+		"valid time-based": {
+			form: &witnessingAsIndependentWitnessForm{
+				Code: "123456",
+				TOTP: true,
+			},
+		},
+		"time-based too short": {
+			form: &witnessingAsIndependentWitnessForm{
+				Code: "1234",
+				TOTP: true,
+			},
+			errors: validation.With("witness-code", validation.StringLengthError{Label: "theCodeWeSentIndependentWitness", Length: 6}),
+		},
 	}
 
 	for name, tc := range testCases {
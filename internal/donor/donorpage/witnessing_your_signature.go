@@ -7,19 +7,34 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
 )
 
 type witnessingYourSignatureData struct {
-	App    appcontext.Data
-	Errors validation.List
-	Donor  *donordata.Provided
+	App                 appcontext.Data
+	Errors              validation.List
+	Donor               *donordata.Provided
+	CanUseAuthenticator bool
 }
 
 func WitnessingYourSignature(tmpl template.Template, witnessCodeSender WitnessCodeSender, donorStore DonorStore) Handler {
 	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, provided *donordata.Provided) error {
+		// A certificate provider acting on paper cannot sign in to get their
+		// witness code, so they must be sent it by text message.
+		canUseAuthenticator := !provided.CertificateProvider.CarryOutBy.IsPaper()
+
 		if r.Method == http.MethodPost {
-			if err := witnessCodeSender.SendToCertificateProvider(r.Context(), provided); err != nil {
+			useAuthenticator := canUseAuthenticator && page.PostFormString(r, "method") == "authenticator"
+
+			sendToCertificateProvider := witnessCodeSender.SendToCertificateProvider
+			sendToIndependentWitness := witnessCodeSender.SendToIndependentWitness
+			if useAuthenticator {
+				sendToCertificateProvider = witnessCodeSender.SetUpCertificateProviderTOTP
+				sendToIndependentWitness = witnessCodeSender.SetUpIndependentWitnessTOTP
+			}
+
+			if err := sendToCertificateProvider(r.Context(), provided); err != nil {
 				return err
 			}
 
@@ -31,7 +46,7 @@ func WitnessingYourSignature(tmpl template.Template, witnessCodeSender WitnessCo
 					return err
 				}
 
-				if err := witnessCodeSender.SendToIndependentWitness(r.Context(), lpa); err != nil {
+				if err := sendToIndependentWitness(r.Context(), lpa); err != nil {
 					return err
 				}
 
@@ -40,8 +55,9 @@ func WitnessingYourSignature(tmpl template.Template, witnessCodeSender WitnessCo
 		}
 
 		data := &witnessingYourSignatureData{
-			App:   appData,
-			Donor: provided,
+			App:                 appData,
+			Donor:               provided,
+			CanUseAuthenticator: canUseAuthenticator,
 		}
 
 		return tmpl(w, data)
@@ -3,12 +3,16 @@ package donorpage
 import (
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/form"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/identity"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -19,6 +23,24 @@ func TestGetWitnessingYourSignature(t *testing.T) {
 
 	donor := &donordata.Provided{CertificateProvider: donordata.CertificateProvider{Mobile: "07535111111"}}
 
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &witnessingYourSignatureData{App: testAppData, Donor: donor, CanUseAuthenticator: true}).
+		Return(nil)
+
+	err := WitnessingYourSignature(template.Execute, nil, nil)(testAppData, w, r, donor)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestGetWitnessingYourSignatureWhenCertificateProviderActingOnPaper(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	donor := &donordata.Provided{CertificateProvider: donordata.CertificateProvider{Mobile: "07535111111", CarryOutBy: lpadata.ChannelPaper}}
+
 	template := newMockTemplate(t)
 	template.EXPECT().
 		Execute(w, &witnessingYourSignatureData{App: testAppData, Donor: donor}).
@@ -39,7 +61,7 @@ func TestGetWitnessingYourSignatureWhenTemplateErrors(t *testing.T) {
 
 	template := newMockTemplate(t)
 	template.EXPECT().
-		Execute(w, &witnessingYourSignatureData{App: testAppData, Donor: donor}).
+		Execute(w, &witnessingYourSignatureData{App: testAppData, Donor: donor, CanUseAuthenticator: true}).
 		Return(expectedError)
 
 	err := WitnessingYourSignature(template.Execute, nil, nil)(testAppData, w, r, donor)
@@ -113,6 +135,97 @@ func TestPostWitnessingYourSignatureCannotSign(t *testing.T) {
 	assert.Equal(t, donor.PathWitnessingAsIndependentWitness.Format("lpa-id"), resp.Header.Get("Location"))
 }
 
+func TestPostWitnessingYourSignatureWithAuthenticator(t *testing.T) {
+	f := url.Values{
+		"method": {"authenticator"},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(f.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	provided := &donordata.Provided{
+		LpaID:               "lpa-id",
+		Donor:               donordata.Donor{CanSign: form.Yes},
+		CertificateProvider: donordata.CertificateProvider{Mobile: "07535111111"},
+	}
+
+	witnessCodeSender := newMockWitnessCodeSender(t)
+	witnessCodeSender.EXPECT().
+		SetUpCertificateProviderTOTP(r.Context(), provided).
+		Return(nil)
+
+	err := WitnessingYourSignature(nil, witnessCodeSender, nil)(testAppData, w, r, provided)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, donor.PathWitnessingAsCertificateProvider.Format("lpa-id"), resp.Header.Get("Location"))
+}
+
+func TestPostWitnessingYourSignatureWithAuthenticatorWhenCertificateProviderActingOnPaper(t *testing.T) {
+	f := url.Values{
+		"method": {"authenticator"},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(f.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	provided := &donordata.Provided{
+		LpaID:               "lpa-id",
+		Donor:               donordata.Donor{CanSign: form.Yes},
+		CertificateProvider: donordata.CertificateProvider{Mobile: "07535111111", CarryOutBy: lpadata.ChannelPaper},
+	}
+
+	witnessCodeSender := newMockWitnessCodeSender(t)
+	witnessCodeSender.EXPECT().
+		SendToCertificateProvider(r.Context(), provided).
+		Return(nil)
+
+	err := WitnessingYourSignature(nil, witnessCodeSender, nil)(testAppData, w, r, provided)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, donor.PathWitnessingAsCertificateProvider.Format("lpa-id"), resp.Header.Get("Location"))
+}
+
+func TestPostWitnessingYourSignatureWithAuthenticatorCannotSign(t *testing.T) {
+	f := url.Values{
+		"method": {"authenticator"},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(f.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	provided := &donordata.Provided{
+		LpaID: "lpa-id",
+		Donor: donordata.Donor{CanSign: form.No},
+	}
+
+	witnessCodeSender := newMockWitnessCodeSender(t)
+	witnessCodeSender.EXPECT().
+		SetUpCertificateProviderTOTP(r.Context(), provided).
+		Return(nil)
+	witnessCodeSender.EXPECT().
+		SetUpIndependentWitnessTOTP(r.Context(), &donordata.Provided{LpaID: "lpa-id", Donor: donordata.Donor{CanSign: form.No}}).
+		Return(nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(r.Context()).
+		Return(&donordata.Provided{LpaID: "lpa-id", Donor: donordata.Donor{CanSign: form.No}}, nil)
+
+	err := WitnessingYourSignature(nil, witnessCodeSender, donorStore)(testAppData, w, r, provided)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, donor.PathWitnessingAsIndependentWitness.Format("lpa-id"), resp.Header.Get("Location"))
+}
+
 func TestPostWitnessingYourSignatureWhenWitnessCodeSenderErrors(t *testing.T) {
 	donor := &donordata.Provided{Donor: donordata.Donor{CanSign: form.No}, CertificateProvider: donordata.CertificateProvider{Mobile: "07535111111"}}
 
@@ -565,6 +565,47 @@ func TestPostYourAddressLookupError(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestPostYourAddressLookupTimeout(t *testing.T) {
+	f := url.Values{
+		form.FieldNames.Address.Action: {"postcode-lookup"},
+		"lookup-postcode":              {"ng1 1aa"},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(f.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		WarnContext(r.Context(), "postcode lookup", slog.Any("err", place.ErrLookupTimeout))
+
+	addressClient := newMockAddressClient(t)
+	addressClient.EXPECT().
+		LookupPostcode(mock.Anything, "ng1 1aa").
+		Return(nil, place.ErrLookupTimeout)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &chooseAddressData{
+			App: testAppData,
+			Form: &form.AddressForm{
+				Action:         "manual",
+				LookupPostcode: "ng1 1aa",
+				Address:        &place.Address{Postcode: "NG1 1AA", Country: "GB"},
+				FieldNames:     form.FieldNames.Address,
+			},
+			TitleKeys:         testTitleKeys,
+			LookupUnavailable: true,
+		}).
+		Return(nil)
+
+	err := YourAddress(logger, template.Execute, addressClient, nil)(testAppData, w, r, &donordata.Provided{})
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
 func TestPostYourAddressInvalidPostcodeError(t *testing.T) {
 	w := httptest.NewRecorder()
 	invalidPostcodeErr := place.BadRequestError{
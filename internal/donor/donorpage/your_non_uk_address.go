@@ -18,6 +18,7 @@ type yourNonUKAddressData struct {
 	Errors           validation.List
 	Form             *yourNonUKAddressForm
 	Country          string
+	Format           place.AddressFormat
 	CanTaskList      bool
 	MakingAnotherLPA bool
 	WhatCountryLink  string
@@ -31,6 +32,7 @@ func YourNonUKAddress(tmpl template.Template, donorStore DonorStore) Handler {
 				Address: provided.Donor.InternationalAddress,
 			},
 			Country:          provided.Donor.InternationalAddress.Country,
+			Format:           place.AddressFormatFor(provided.Donor.InternationalAddress.Country),
 			CanTaskList:      !provided.Type.Empty(),
 			MakingAnotherLPA: r.FormValue("makingAnotherLPA") == "1",
 		}
@@ -46,7 +48,7 @@ func YourNonUKAddress(tmpl template.Template, donorStore DonorStore) Handler {
 
 		if r.Method == http.MethodPost {
 			data.Form = readYourNonUKAddressForm(r)
-			data.Errors = data.Form.Validate()
+			data.Errors = data.Form.Validate(data.Format)
 
 			if r.PostForm.Has("live-in-uk") {
 				provided.Donor.InternationalAddress = place.InternationalAddress{}
@@ -114,15 +116,21 @@ func readYourNonUKAddressForm(r *http.Request) *yourNonUKAddressForm {
 	}
 }
 
-func (f *yourNonUKAddressForm) Validate() validation.List {
+func (f *yourNonUKAddressForm) Validate(format place.AddressFormat) validation.List {
 	var errors validation.List
 
 	if f.Address.ApartmentNumber == "" && f.Address.BuildingNumber == "" && f.Address.BuildingName == "" {
 		errors.Add("buildingAddress", validation.EnterError{Label: "atLeastOneBuildingAddress"})
 	}
 
-	errors.String("town", "townSuburbOrCity", f.Address.Town,
-		validation.Empty())
+	for _, field := range format.Fields() {
+		checks := []validation.StringChecker{validation.AddressField(format, field)}
+		if field.IsPostalCode() {
+			checks = append(checks, validation.PostalCode(format))
+		}
+
+		errors.String(field.String(), format.Label(field), f.Address.Field(field), checks...)
+	}
 
 	return errors
 }
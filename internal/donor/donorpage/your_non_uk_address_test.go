@@ -26,6 +26,7 @@ func TestGetYourNonUKAddress(t *testing.T) {
 			expectedData: &yourNonUKAddressData{
 				App:             testAppData,
 				Form:            &yourNonUKAddressForm{},
+				Format:          place.AddressFormatFor(""),
 				WhatCountryLink: donor.PathWhatCountryDoYouLiveIn.Format("lpa-id"),
 			},
 		},
@@ -34,6 +35,7 @@ func TestGetYourNonUKAddress(t *testing.T) {
 			expectedData: &yourNonUKAddressData{
 				App:              testAppData,
 				Form:             &yourNonUKAddressForm{},
+				Format:           place.AddressFormatFor(""),
 				MakingAnotherLPA: true,
 				WhatCountryLink: donor.PathWhatCountryDoYouLiveIn.FormatQuery("lpa-id", url.Values{
 					"makingAnotherLPA": {"1"},
@@ -77,6 +79,7 @@ func TestGetYourNonUKAddressFromStore(t *testing.T) {
 				Address: address,
 			},
 			Country:         "DE",
+			Format:          place.AddressFormatFor("DE"),
 			WhatCountryLink: donor.PathWhatCountryDoYouLiveIn.Format("lpa-id"),
 		}).
 		Return(nil)
@@ -139,7 +142,7 @@ func TestPostYourNonUKAddress(t *testing.T) {
 				"buildingName":    {"b"},
 				"streetName":      {"c"},
 				"town":            {"d"},
-				"postalCode":      {"e"},
+				"postalCode":      {"75001"},
 			}
 
 			w := httptest.NewRecorder()
@@ -152,18 +155,18 @@ func TestPostYourNonUKAddress(t *testing.T) {
 					LpaID: "lpa-id",
 					Donor: donordata.Donor{
 						Address: place.Address{
-							Line1:    "a, b",
-							Line2:    "c",
-							Line3:    "d",
-							Postcode: "e",
-							Country:  "FR",
+							Line1:      "a, b",
+							Line2:      "c",
+							TownOrCity: "d",
+							Postcode:   "75001",
+							Country:    "FR",
 						},
 						InternationalAddress: place.InternationalAddress{
 							ApartmentNumber: "a",
 							BuildingName:    "b",
 							StreetName:      "c",
 							Town:            "d",
-							PostalCode:      "e",
+							PostalCode:      "75001",
 							Country:         "FR",
 						},
 					},
@@ -220,18 +223,18 @@ func TestPostYourNonUKAddressWhenLiveInUK(t *testing.T) {
 				LpaID: "lpa-id",
 				Donor: donordata.Donor{
 					Address: place.Address{
-						Line1:    "a, b",
-						Line2:    "c",
-						Line3:    "d",
-						Postcode: "e",
-						Country:  "FR",
+						Line1:      "a, b",
+						Line2:      "c",
+						TownOrCity: "d",
+						Postcode:   "75001",
+						Country:    "FR",
 					},
 					InternationalAddress: place.InternationalAddress{
 						ApartmentNumber: "a",
 						BuildingName:    "b",
 						StreetName:      "c",
 						Town:            "d",
-						PostalCode:      "e",
+						PostalCode:      "75001",
 						Country:         "FR",
 					},
 				},
@@ -267,7 +270,7 @@ func TestPostYourNonUKAddressWhenAddressNotChanged(t *testing.T) {
 				"buildingName":    {"b"},
 				"streetName":      {"c"},
 				"town":            {"d"},
-				"postalCode":      {"e"},
+				"postalCode":      {"75001"},
 			}
 
 			w := httptest.NewRecorder()
@@ -278,18 +281,18 @@ func TestPostYourNonUKAddressWhenAddressNotChanged(t *testing.T) {
 				LpaID: "lpa-id",
 				Donor: donordata.Donor{
 					Address: place.Address{
-						Line1:    "a, b",
-						Line2:    "c",
-						Line3:    "d",
-						Postcode: "e",
-						Country:  "FR",
+						Line1:      "a, b",
+						Line2:      "c",
+						TownOrCity: "d",
+						Postcode:   "75001",
+						Country:    "FR",
 					},
 					InternationalAddress: place.InternationalAddress{
 						ApartmentNumber: "a",
 						BuildingName:    "b",
 						StreetName:      "c",
 						Town:            "d",
-						PostalCode:      "e",
+						PostalCode:      "75001",
 						Country:         "FR",
 					},
 				},
@@ -386,8 +389,9 @@ func TestReadYourNonUkAddressForm(t *testing.T) {
 
 func TestYourNonUKAddressFormValidate(t *testing.T) {
 	testcases := map[string]struct {
-		form   *yourNonUKAddressForm
-		errors validation.List
+		form    *yourNonUKAddressForm
+		country string
+		errors  validation.List
 	}{
 		"valid with apartment number": {
 			form: &yourNonUKAddressForm{
@@ -413,16 +417,44 @@ func TestYourNonUKAddressFormValidate(t *testing.T) {
 				},
 			},
 		},
+		"valid with postal code": {
+			form: &yourNonUKAddressForm{
+				Address: place.InternationalAddress{
+					BuildingNumber: "10",
+					Town:           "Paris",
+					PostalCode:     "75008",
+				},
+			},
+			country: "FR",
+		},
 		"missing required": {
 			form: &yourNonUKAddressForm{},
 			errors: validation.With("buildingAddress", validation.EnterError{Label: "atLeastOneBuildingAddress"}).
 				With("town", validation.EnterError{Label: "townSuburbOrCity"}),
 		},
+		"missing required for country": {
+			form:    &yourNonUKAddressForm{Address: place.InternationalAddress{BuildingNumber: "1"}},
+			country: "US",
+			errors: validation.With("town", validation.EnterError{Label: "townSuburbOrCity"}).
+				With("region", validation.EnterError{Label: "addressField:state"}).
+				With("postalCode", validation.EnterError{Label: "addressField:zipCode"}),
+		},
+		"invalid postal code": {
+			form: &yourNonUKAddressForm{
+				Address: place.InternationalAddress{
+					BuildingNumber: "10",
+					Town:           "Paris",
+					PostalCode:     "750",
+				},
+			},
+			country: "FR",
+			errors:  validation.With("postalCode", validation.PostalCodeError{Label: "addressField:postalCode"}),
+		},
 	}
 
 	for name, tc := range testcases {
 		t.Run(name, func(t *testing.T) {
-			assert.Equal(t, tc.errors, tc.form.Validate())
+			assert.Equal(t, tc.errors, tc.form.Validate(place.AddressFormatFor(tc.country)))
 		})
 	}
 }
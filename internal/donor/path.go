@@ -11,6 +11,7 @@ import (
 
 const (
 	PathAboutPayment                                         = Path("/about-payment")
+	PathAccessCodes                                          = Path("/access-codes")
 	PathAddCorrespondent                                     = Path("/add-correspondent")
 	PathAreYouApplyingForFeeDiscountOrExemption              = Path("/are-you-applying-for-fee-discount-or-exemption")
 	PathAreYouSureYouNoLongerNeedVoucher                     = Path("/are-you-sure-you-no-longer-need-voucher")
@@ -28,6 +29,7 @@ const (
 	PathCheckYouCanSign                                      = Path("/check-you-can-sign")
 	PathCheckYourDetails                                     = Path("/check-your-details")
 	PathCheckYourLpa                                         = Path("/check-your-lpa")
+	PathCommunicationsSent                                   = Path("/communications-sent")
 	PathChooseAttorneys                                      = Path("/choose-attorneys")
 	PathChooseAttorneysAddress                               = Path("/choose-attorneys-address")
 	PathChooseAttorneysGuidance                              = Path("/choose-attorneys-guidance")
@@ -90,6 +92,7 @@ const (
 	PathMakeANewLPA                                          = Path("/make-a-new-lpa")
 	PathNeedHelpSigningConfirmation                          = Path("/need-help-signing-confirmation")
 	PathPayFee                                               = Path("/pay-fee")
+	PathPayForBothLpas                                       = Path("/pay-for-both-lpas")
 	PathPaymentConfirmation                                  = Path("/payment-confirmation")
 	PathPaymentSuccessful                                    = Path("/payment-successful")
 	PathPendingPayment                                       = Path("/pending-payment")
@@ -196,7 +199,7 @@ func (p Path) RedirectQuery(w http.ResponseWriter, r *http.Request, appData appc
 func (p Path) CanGoTo(donor *donordata.Provided) bool {
 	if !donor.SignedAt.IsZero() {
 		switch p {
-		case PathProgress, PathViewLPA, PathDeleteThisLpa, PathWithdrawThisLpa, PathYouHaveSubmittedYourLpa, PathIdentityDetails,
+		case PathProgress, PathViewLPA, PathCommunicationsSent, PathAccessCodes, PathDeleteThisLpa, PathWithdrawThisLpa, PathYouHaveSubmittedYourLpa, PathIdentityDetails,
 			PathContactDetails, PathYourMobile, PathYourEmail, PathAddCorrespondent, PathChooseCorrespondent, PathEnterCorrespondentDetails,
 			PathEnterCorrespondentAddress, PathRemoveCorrespondent, PathCorrespondentSummary:
 			return true
@@ -207,7 +210,7 @@ func (p Path) CanGoTo(donor *donordata.Provided) bool {
 		case PathAboutPayment, PathAreYouApplyingForFeeDiscountOrExemption, PathWhichFeeTypeAreYouApplyingFor,
 			PathPreviousApplicationNumber, PathPreviousFee, PathCostOfRepeatApplication, PathEvidenceRequired,
 			PathHowWouldYouLikeToSendEvidence, PathUploadEvidence, PathSendUsYourEvidenceByPost, PathPayFee,
-			PathPaymentConfirmation, PathPaymentSuccessful, PathEvidenceSuccessfullyUploaded,
+			PathPayForBothLpas, PathPaymentConfirmation, PathPaymentSuccessful, PathEvidenceSuccessfullyUploaded,
 			PathWhatHappensNextRepeatApplicationNoFee, PathPendingPayment, PathUploadEvidenceSSE:
 			return !donor.Tasks.PayForLpa.IsCompleted()
 
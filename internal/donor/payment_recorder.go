@@ -0,0 +1,151 @@
+package donor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/event"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
+)
+
+type PaymentDonorStore interface {
+	One(ctx context.Context, pk dynamo.LpaKeyType, sk dynamo.SK) (*donordata.Provided, error)
+	Put(ctx context.Context, provided *donordata.Provided) error
+}
+
+type PaymentAccessCodeSender interface {
+	SendCertificateProviderPrompt(ctx context.Context, appData appcontext.Data, provided *donordata.Provided) error
+	SendVoucherInvite(ctx context.Context, provided *donordata.Provided, appData appcontext.Data) error
+}
+
+type PaymentEventClient interface {
+	SendPaymentReceived(ctx context.Context, e event.PaymentReceived) error
+	SendCertificateProviderStarted(ctx context.Context, e event.CertificateProviderStarted) error
+}
+
+// A PaymentRecorder records successful payments against LPAs. It is used both
+// when the donor returns from GOV.UK Pay and when a payment is reconciled, so
+// that either route leaves the LPA in the same state.
+type PaymentRecorder struct {
+	donorStore       PaymentDonorStore
+	accessCodeSender PaymentAccessCodeSender
+	eventClient      PaymentEventClient
+	notifyClient     NotifyClient
+}
+
+func NewPaymentRecorder(donorStore PaymentDonorStore, accessCodeSender PaymentAccessCodeSender, eventClient PaymentEventClient, notifyClient NotifyClient) *PaymentRecorder {
+	return &PaymentRecorder{
+		donorStore:       donorStore,
+		accessCodeSender: accessCodeSender,
+		eventClient:      eventClient,
+		notifyClient:     notifyClient,
+	}
+}
+
+// Record adds the payment to the LPA, and to the LPA it is being paid for
+// together with, then moves the pay task on. A payment that has already been
+// added is not added again.
+func (r *PaymentRecorder) Record(ctx context.Context, appData appcontext.Data, provided *donordata.Provided, payment pay.GetPaymentResponse) error {
+	var other *donordata.Provided
+	if provided.PayTogetherWith != "" {
+		var err error
+		other, err = r.donorStore.One(ctx, provided.PayTogetherWith, provided.SK)
+		if err != nil {
+			return fmt.Errorf("error retrieving LPA paid for together: %w", err)
+		}
+
+		if !other.CanBePaidForWithAnotherLpa() {
+			other = nil
+		}
+	}
+
+	if !slices.ContainsFunc(provided.PaymentDetails, func(p donordata.Payment) bool { return p.PaymentID == payment.PaymentID }) {
+		lpas := []*donordata.Provided{provided}
+		if other != nil {
+			lpas = append(lpas, other)
+		}
+
+		for i, amount := range donordata.SplitPayment(payment.AmountPence.Pence(), lpas...) {
+			lpas[i].PaymentDetails = append(lpas[i].PaymentDetails, donordata.Payment{
+				PaymentReference: payment.Reference,
+				PaymentID:        payment.PaymentID,
+				Amount:           amount,
+				CreatedAt:        payment.CreatedDate,
+				FeeScheduleFrom:  lpas[i].FeeSchedule().From,
+			})
+
+			if err := r.eventClient.SendPaymentReceived(ctx, event.PaymentReceived{
+				UID:       lpas[i].LpaUID,
+				PaymentID: payment.PaymentID,
+				Amount:    amount,
+			}); err != nil {
+				return fmt.Errorf("error sending payment-received event: %w", err)
+			}
+		}
+	}
+
+	switch provided.Tasks.PayForLpa {
+	case task.PaymentStateInProgress:
+		if provided.FeeType.IsFullFee() && provided.FeeAmount() == 0 {
+			provided.Tasks.PayForLpa = task.PaymentStateCompleted
+		} else {
+			provided.Tasks.PayForLpa = task.PaymentStatePending
+		}
+	case task.PaymentStateApproved, task.PaymentStateDenied:
+		if provided.FeeAmount() == 0 {
+			provided.Tasks.PayForLpa = task.PaymentStateCompleted
+
+			if provided.Voucher.Allowed && provided.VoucherInvitedAt.IsZero() {
+				if err := r.accessCodeSender.SendVoucherInvite(ctx, provided, appData); err != nil {
+					return fmt.Errorf("error sending voucher invite: %w", err)
+				}
+			}
+
+			if provided.Tasks.SignTheLpa.IsCompleted() {
+				if err := r.accessCodeSender.SendCertificateProviderPrompt(ctx, appData, provided); err != nil {
+					return fmt.Errorf("failed to send share code to certificate provider: %w", err)
+				}
+
+				if err := r.eventClient.SendCertificateProviderStarted(ctx, event.CertificateProviderStarted{
+					UID: provided.LpaUID,
+				}); err != nil {
+					return fmt.Errorf("failed to send certificate-provider-started event: %w", err)
+				}
+
+				if provided.Donor.Mobile != "" {
+					if err := r.notifyClient.SendActorSMS(ctx, notify.ToDonor(provided), provided.LpaUID, notify.OnlineDonorLPASubmissionConfirmation{
+						LpaType:            appData.Localizer.T(provided.Type.String()),
+						LpaReferenceNumber: provided.LpaUID,
+					}); err != nil {
+						return fmt.Errorf("failed to send SMS to donor: %w", err)
+					}
+				}
+			}
+		}
+	}
+
+	if other != nil {
+		if other.FeeAmount() == 0 {
+			other.Tasks.PayForLpa = task.PaymentStateCompleted
+		} else {
+			other.Tasks.PayForLpa = task.PaymentStatePending
+		}
+
+		if err := r.donorStore.Put(ctx, other); err != nil {
+			return fmt.Errorf("error updating LPA paid for together: %w", err)
+		}
+	}
+
+	provided.PayTogetherWith = ""
+	if err := r.donorStore.Put(ctx, provided); err != nil {
+		return fmt.Errorf("error updating donor: %w", err)
+	}
+
+	return nil
+}
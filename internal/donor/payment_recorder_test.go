@@ -0,0 +1,486 @@
+package donor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/event"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testPayment = pay.GetPaymentResponse{
+	PaymentID:   "abc123",
+	Reference:   "123456789012",
+	AmountPence: pay.AmountPence(9200),
+	CreatedDate: testNow,
+}
+
+func TestNewPaymentRecorder(t *testing.T) {
+	donorStore := newMockPaymentDonorStore(t)
+	accessCodeSender := newMockPaymentAccessCodeSender(t)
+	eventClient := newMockPaymentEventClient(t)
+	notifyClient := newMockNotifyClient(t)
+
+	recorder := NewPaymentRecorder(donorStore, accessCodeSender, eventClient, notifyClient)
+
+	assert.Equal(t, donorStore, recorder.donorStore)
+	assert.Equal(t, accessCodeSender, recorder.accessCodeSender)
+	assert.Equal(t, eventClient, recorder.eventClient)
+	assert.Equal(t, notifyClient, recorder.notifyClient)
+}
+
+func TestPaymentRecorderRecord(t *testing.T) {
+	testcases := map[string]struct {
+		feeType   pay.FeeType
+		amount    int
+		taskState task.PaymentState
+	}{
+		"full fee": {
+			feeType:   pay.FullFee,
+			amount:    9200,
+			taskState: task.PaymentStateCompleted,
+		},
+		"half fee": {
+			feeType:   pay.HalfFee,
+			amount:    4100,
+			taskState: task.PaymentStatePending,
+		},
+		"repeat application fee": {
+			feeType:   pay.RepeatApplicationFee,
+			amount:    9200,
+			taskState: task.PaymentStatePending,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			payment := testPayment
+			payment.AmountPence = pay.AmountPence(tc.amount)
+
+			donorStore := newMockPaymentDonorStore(t)
+			donorStore.EXPECT().
+				Put(ctx, &donordata.Provided{
+					LpaUID:  "lpa-uid",
+					FeeType: tc.feeType,
+					PaymentDetails: []donordata.Payment{{
+						PaymentID:        "abc123",
+						PaymentReference: "123456789012",
+						Amount:           tc.amount,
+						CreatedAt:        testNow,
+						FeeScheduleFrom:  pay.FeeScheduleAt(time.Time{}).From,
+					}},
+					Tasks: donordata.Tasks{PayForLpa: tc.taskState},
+				}).
+				Return(nil)
+
+			eventClient := newMockPaymentEventClient(t)
+			eventClient.EXPECT().
+				SendPaymentReceived(ctx, event.PaymentReceived{
+					UID:       "lpa-uid",
+					PaymentID: "abc123",
+					Amount:    tc.amount,
+				}).
+				Return(nil)
+
+			recorder := &PaymentRecorder{donorStore: donorStore, eventClient: eventClient}
+			err := recorder.Record(ctx, testAppData, &donordata.Provided{
+				LpaUID:  "lpa-uid",
+				FeeType: tc.feeType,
+				Tasks:   donordata.Tasks{PayForLpa: task.PaymentStateInProgress},
+			}, payment)
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestPaymentRecorderRecordWhenAlreadyRecorded(t *testing.T) {
+	payment := donordata.Payment{
+		PaymentID:        "abc123",
+		PaymentReference: "123456789012",
+		Amount:           9200,
+		CreatedAt:        testNow,
+	}
+
+	donorStore := newMockPaymentDonorStore(t)
+	donorStore.EXPECT().
+		Put(ctx, &donordata.Provided{
+			LpaUID:         "lpa-uid",
+			PaymentDetails: []donordata.Payment{payment},
+			Tasks:          donordata.Tasks{PayForLpa: task.PaymentStateCompleted},
+		}).
+		Return(nil)
+
+	recorder := &PaymentRecorder{donorStore: donorStore}
+	err := recorder.Record(ctx, testAppData, &donordata.Provided{
+		LpaUID:         "lpa-uid",
+		PaymentDetails: []donordata.Payment{payment},
+		Tasks:          donordata.Tasks{PayForLpa: task.PaymentStateInProgress},
+	}, testPayment)
+
+	assert.Nil(t, err)
+}
+
+func TestPaymentRecorderRecordWhenApprovedOrDenied(t *testing.T) {
+	for _, taskState := range []task.PaymentState{task.PaymentStateApproved, task.PaymentStateDenied} {
+		t.Run(taskState.String(), func(t *testing.T) {
+			donorStore := newMockPaymentDonorStore(t)
+			donorStore.EXPECT().
+				Put(ctx, mock.MatchedBy(func(provided *donordata.Provided) bool {
+					return provided.Tasks.PayForLpa.IsCompleted()
+				})).
+				Return(nil)
+
+			eventClient := newMockPaymentEventClient(t)
+			eventClient.EXPECT().
+				SendPaymentReceived(ctx, mock.Anything).
+				Return(nil)
+
+			recorder := &PaymentRecorder{donorStore: donorStore, eventClient: eventClient}
+			err := recorder.Record(ctx, testAppData, &donordata.Provided{
+				LpaUID: "lpa-uid",
+				Tasks:  donordata.Tasks{PayForLpa: taskState},
+			}, testPayment)
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestPaymentRecorderRecordWhenApprovedOrDeniedAndSigned(t *testing.T) {
+	for _, taskState := range []task.PaymentState{task.PaymentStateApproved, task.PaymentStateDenied} {
+		t.Run(taskState.String(), func(t *testing.T) {
+			updated := &donordata.Provided{
+				LpaUID: "lpa-uid",
+				Type:   lpadata.LpaTypePersonalWelfare,
+				Donor:  donordata.Donor{Mobile: "07777"},
+				PaymentDetails: []donordata.Payment{{
+					PaymentID:        "abc123",
+					PaymentReference: "123456789012",
+					Amount:           9200,
+					CreatedAt:        testNow,
+					FeeScheduleFrom:  pay.FeeScheduleAt(time.Time{}).From,
+				}},
+				Tasks: donordata.Tasks{
+					PayForLpa:  task.PaymentStateCompleted,
+					SignTheLpa: task.StateCompleted,
+				},
+			}
+
+			localizer := newMockLocalizer(t)
+			localizer.EXPECT().
+				T("personal-welfare").
+				Return("translated type")
+
+			appData := appcontext.Data{Localizer: localizer}
+
+			donorStore := newMockPaymentDonorStore(t)
+			donorStore.EXPECT().
+				Put(ctx, updated).
+				Return(nil)
+
+			accessCodeSender := newMockPaymentAccessCodeSender(t)
+			accessCodeSender.EXPECT().
+				SendCertificateProviderPrompt(ctx, appData, updated).
+				Return(nil)
+
+			eventClient := newMockPaymentEventClient(t)
+			eventClient.EXPECT().
+				SendPaymentReceived(ctx, mock.Anything).
+				Return(nil)
+			eventClient.EXPECT().
+				SendCertificateProviderStarted(ctx, event.CertificateProviderStarted{UID: "lpa-uid"}).
+				Return(nil)
+
+			notifyClient := newMockNotifyClient(t)
+			notifyClient.EXPECT().
+				SendActorSMS(ctx, notify.ToDonor(updated), "lpa-uid", notify.OnlineDonorLPASubmissionConfirmation{
+					LpaType:            "translated type",
+					LpaReferenceNumber: "lpa-uid",
+				}).
+				Return(nil)
+
+			recorder := NewPaymentRecorder(donorStore, accessCodeSender, eventClient, notifyClient)
+			err := recorder.Record(ctx, appData, &donordata.Provided{
+				LpaUID: "lpa-uid",
+				Type:   lpadata.LpaTypePersonalWelfare,
+				Donor:  donordata.Donor{Mobile: "07777"},
+				Tasks: donordata.Tasks{
+					PayForLpa:  taskState,
+					SignTheLpa: task.StateCompleted,
+				},
+			}, testPayment)
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestPaymentRecorderRecordWhenApprovedOrDeniedAndVoucherAllowed(t *testing.T) {
+	provided := &donordata.Provided{
+		LpaUID:  "lpa-uid",
+		Voucher: donordata.Voucher{Allowed: true},
+		Tasks:   donordata.Tasks{PayForLpa: task.PaymentStateApproved},
+	}
+
+	donorStore := newMockPaymentDonorStore(t)
+	donorStore.EXPECT().
+		Put(ctx, provided).
+		Return(nil)
+
+	accessCodeSender := newMockPaymentAccessCodeSender(t)
+	accessCodeSender.EXPECT().
+		SendVoucherInvite(ctx, provided, testAppData).
+		Return(nil)
+
+	eventClient := newMockPaymentEventClient(t)
+	eventClient.EXPECT().
+		SendPaymentReceived(ctx, mock.Anything).
+		Return(nil)
+
+	recorder := &PaymentRecorder{donorStore: donorStore, accessCodeSender: accessCodeSender, eventClient: eventClient}
+	err := recorder.Record(ctx, testAppData, provided, testPayment)
+
+	assert.Nil(t, err)
+	assert.Equal(t, task.PaymentStateCompleted, provided.Tasks.PayForLpa)
+}
+
+func TestPaymentRecorderRecordWhenPayingTogether(t *testing.T) {
+	payment := testPayment
+	payment.AmountPence = pay.AmountPence(18400)
+
+	donorStore := newMockPaymentDonorStore(t)
+	donorStore.EXPECT().
+		One(ctx, dynamo.LpaKey("other-id"), dynamo.LpaOwnerKey(dynamo.DonorKey("donor"))).
+		Return(&donordata.Provided{
+			PK:     dynamo.LpaKey("other-id"),
+			LpaUID: "other-uid",
+			Tasks:  donordata.Tasks{CheckYourLpa: task.StateCompleted},
+		}, nil)
+	donorStore.EXPECT().
+		Put(ctx, &donordata.Provided{
+			PK:     dynamo.LpaKey("other-id"),
+			LpaUID: "other-uid",
+			PaymentDetails: []donordata.Payment{{
+				PaymentID:        "abc123",
+				PaymentReference: "123456789012",
+				Amount:           9200,
+				CreatedAt:        testNow,
+				FeeScheduleFrom:  pay.FeeScheduleAt(time.Time{}).From,
+			}},
+			Tasks: donordata.Tasks{CheckYourLpa: task.StateCompleted, PayForLpa: task.PaymentStateCompleted},
+		}).
+		Return(nil).
+		Once()
+	donorStore.EXPECT().
+		Put(ctx, &donordata.Provided{
+			PK:     dynamo.LpaKey("lpa-id"),
+			SK:     dynamo.LpaOwnerKey(dynamo.DonorKey("donor")),
+			LpaUID: "lpa-uid",
+			PaymentDetails: []donordata.Payment{{
+				PaymentID:        "abc123",
+				PaymentReference: "123456789012",
+				Amount:           9200,
+				CreatedAt:        testNow,
+				FeeScheduleFrom:  pay.FeeScheduleAt(time.Time{}).From,
+			}},
+			Tasks: donordata.Tasks{PayForLpa: task.PaymentStateCompleted},
+		}).
+		Return(nil).
+		Once()
+
+	eventClient := newMockPaymentEventClient(t)
+	eventClient.EXPECT().
+		SendPaymentReceived(ctx, event.PaymentReceived{UID: "lpa-uid", PaymentID: "abc123", Amount: 9200}).
+		Return(nil)
+	eventClient.EXPECT().
+		SendPaymentReceived(ctx, event.PaymentReceived{UID: "other-uid", PaymentID: "abc123", Amount: 9200}).
+		Return(nil)
+
+	recorder := &PaymentRecorder{donorStore: donorStore, eventClient: eventClient}
+	err := recorder.Record(ctx, testAppData, &donordata.Provided{
+		PK:              dynamo.LpaKey("lpa-id"),
+		SK:              dynamo.LpaOwnerKey(dynamo.DonorKey("donor")),
+		LpaUID:          "lpa-uid",
+		PayTogetherWith: dynamo.LpaKey("other-id"),
+		Tasks:           donordata.Tasks{PayForLpa: task.PaymentStateInProgress},
+	}, payment)
+
+	assert.Nil(t, err)
+}
+
+func TestPaymentRecorderRecordWhenPayingTogetherWithLpaThatCannotBePaidFor(t *testing.T) {
+	payment := testPayment
+	payment.AmountPence = pay.AmountPence(18400)
+
+	donorStore := newMockPaymentDonorStore(t)
+	donorStore.EXPECT().
+		One(ctx, dynamo.LpaKey("other-id"), mock.Anything).
+		Return(&donordata.Provided{
+			PK:      dynamo.LpaKey("other-id"),
+			FeeType: pay.HalfFee,
+			Tasks:   donordata.Tasks{CheckYourLpa: task.StateCompleted},
+		}, nil)
+	donorStore.EXPECT().
+		Put(ctx, mock.MatchedBy(func(provided *donordata.Provided) bool {
+			return provided.PK == dynamo.LpaKey("lpa-id") &&
+				provided.PaymentDetails[0].Amount == 18400 &&
+				provided.PayTogetherWith == ""
+		})).
+		Return(nil).
+		Once()
+
+	eventClient := newMockPaymentEventClient(t)
+	eventClient.EXPECT().
+		SendPaymentReceived(ctx, event.PaymentReceived{UID: "lpa-uid", PaymentID: "abc123", Amount: 18400}).
+		Return(nil)
+
+	recorder := &PaymentRecorder{donorStore: donorStore, eventClient: eventClient}
+	err := recorder.Record(ctx, testAppData, &donordata.Provided{
+		PK:              dynamo.LpaKey("lpa-id"),
+		LpaUID:          "lpa-uid",
+		PayTogetherWith: dynamo.LpaKey("other-id"),
+		Tasks:           donordata.Tasks{PayForLpa: task.PaymentStateInProgress},
+	}, payment)
+
+	assert.Nil(t, err)
+}
+
+func TestPaymentRecorderRecordWhenDonorStoreErrors(t *testing.T) {
+	testcases := map[string]func(*testing.T) *mockPaymentDonorStore{
+		"one": func(t *testing.T) *mockPaymentDonorStore {
+			s := newMockPaymentDonorStore(t)
+			s.EXPECT().One(ctx, mock.Anything, mock.Anything).Return(nil, expectedError)
+			return s
+		},
+		"put other": func(t *testing.T) *mockPaymentDonorStore {
+			s := newMockPaymentDonorStore(t)
+			s.EXPECT().One(ctx, mock.Anything, mock.Anything).Return(&donordata.Provided{Tasks: donordata.Tasks{CheckYourLpa: task.StateCompleted}}, nil)
+			s.EXPECT().Put(ctx, mock.Anything).Return(expectedError)
+			return s
+		},
+		"put": func(t *testing.T) *mockPaymentDonorStore {
+			s := newMockPaymentDonorStore(t)
+			s.EXPECT().One(ctx, mock.Anything, mock.Anything).Return(&donordata.Provided{}, nil)
+			s.EXPECT().Put(ctx, mock.Anything).Return(expectedError)
+			return s
+		},
+	}
+
+	for name, donorStore := range testcases {
+		t.Run(name, func(t *testing.T) {
+			eventClient := newMockPaymentEventClient(t)
+			eventClient.EXPECT().
+				SendPaymentReceived(ctx, mock.Anything).
+				Return(nil).
+				Maybe()
+
+			recorder := &PaymentRecorder{donorStore: donorStore(t), eventClient: eventClient}
+			err := recorder.Record(ctx, testAppData, &donordata.Provided{
+				PayTogetherWith: dynamo.LpaKey("other-id"),
+				Tasks:           donordata.Tasks{PayForLpa: task.PaymentStateInProgress},
+			}, testPayment)
+
+			assert.ErrorIs(t, err, expectedError)
+		})
+	}
+}
+
+func TestPaymentRecorderRecordWhenErrors(t *testing.T) {
+	testcases := map[string]struct {
+		provided         *donordata.Provided
+		accessCodeSender func(*testing.T) *mockPaymentAccessCodeSender
+		eventClient      func(*testing.T) *mockPaymentEventClient
+		notifyClient     func(*testing.T) *mockNotifyClient
+	}{
+		"payment received event": {
+			provided: &donordata.Provided{},
+			eventClient: func(t *testing.T) *mockPaymentEventClient {
+				c := newMockPaymentEventClient(t)
+				c.EXPECT().SendPaymentReceived(ctx, mock.Anything).Return(expectedError)
+				return c
+			},
+		},
+		"voucher invite": {
+			provided: &donordata.Provided{
+				Voucher: donordata.Voucher{Allowed: true},
+				Tasks:   donordata.Tasks{PayForLpa: task.PaymentStateDenied},
+			},
+			accessCodeSender: func(t *testing.T) *mockPaymentAccessCodeSender {
+				s := newMockPaymentAccessCodeSender(t)
+				s.EXPECT().SendVoucherInvite(ctx, mock.Anything, mock.Anything).Return(expectedError)
+				return s
+			},
+		},
+		"certificate provider prompt": {
+			provided: &donordata.Provided{
+				Tasks: donordata.Tasks{PayForLpa: task.PaymentStateApproved, SignTheLpa: task.StateCompleted},
+			},
+			accessCodeSender: func(t *testing.T) *mockPaymentAccessCodeSender {
+				s := newMockPaymentAccessCodeSender(t)
+				s.EXPECT().SendCertificateProviderPrompt(ctx, mock.Anything, mock.Anything).Return(expectedError)
+				return s
+			},
+		},
+		"certificate provider started event": {
+			provided: &donordata.Provided{
+				Tasks: donordata.Tasks{PayForLpa: task.PaymentStateApproved, SignTheLpa: task.StateCompleted},
+			},
+			eventClient: func(t *testing.T) *mockPaymentEventClient {
+				c := newMockPaymentEventClient(t)
+				c.EXPECT().SendPaymentReceived(ctx, mock.Anything).Return(nil)
+				c.EXPECT().SendCertificateProviderStarted(ctx, mock.Anything).Return(expectedError)
+				return c
+			},
+		},
+		"sms": {
+			provided: &donordata.Provided{
+				Donor: donordata.Donor{Mobile: "07777"},
+				Tasks: donordata.Tasks{PayForLpa: task.PaymentStateApproved, SignTheLpa: task.StateCompleted},
+			},
+			notifyClient: func(t *testing.T) *mockNotifyClient {
+				c := newMockNotifyClient(t)
+				c.EXPECT().SendActorSMS(ctx, mock.Anything, mock.Anything, mock.Anything).Return(expectedError)
+				return c
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			localizer := newMockLocalizer(t)
+			localizer.EXPECT().T(mock.Anything).Return("").Maybe()
+
+			accessCodeSender := newMockPaymentAccessCodeSender(t)
+			accessCodeSender.EXPECT().SendCertificateProviderPrompt(ctx, mock.Anything, mock.Anything).Return(nil).Maybe()
+			if tc.accessCodeSender != nil {
+				accessCodeSender = tc.accessCodeSender(t)
+			}
+
+			eventClient := newMockPaymentEventClient(t)
+			eventClient.EXPECT().SendPaymentReceived(ctx, mock.Anything).Return(nil).Maybe()
+			eventClient.EXPECT().SendCertificateProviderStarted(ctx, mock.Anything).Return(nil).Maybe()
+			if tc.eventClient != nil {
+				eventClient = tc.eventClient(t)
+			}
+
+			notifyClient := newMockNotifyClient(t)
+			if tc.notifyClient != nil {
+				notifyClient = tc.notifyClient(t)
+			}
+
+			recorder := NewPaymentRecorder(nil, accessCodeSender, eventClient, notifyClient)
+			err := recorder.Record(ctx, appcontext.Data{Localizer: localizer}, tc.provided, testPayment)
+
+			assert.ErrorIs(t, err, expectedError)
+		})
+	}
+}
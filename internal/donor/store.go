@@ -364,14 +364,7 @@ func (s *Store) Put(ctx context.Context, donor *donordata.Provided) error {
 	if donor.LpaUID != "" {
 		donor.UpdatedAt = s.now()
 
-		if err := s.searchClient.Index(ctx, search.Lpa{
-			PK: donor.PK.PK(),
-			SK: donor.SK.SK(),
-			Donor: search.LpaDonor{
-				FirstNames: donor.Donor.FirstNames,
-				LastName:   donor.Donor.LastName,
-			},
-		}); err != nil {
+		if err := s.searchClient.Index(ctx, search.LpaFromDonorProvided(donor)); err != nil {
 			s.logger.WarnContext(ctx, "donorStore index failed", slog.Any("err", err))
 		}
 	}
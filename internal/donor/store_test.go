@@ -424,7 +424,15 @@ func TestDonorStorePutWhenUIDSet(t *testing.T) {
 
 	searchClient := newMockSearchClient(t)
 	searchClient.EXPECT().
-		Index(ctx, search.Lpa{PK: dynamo.LpaKey("5").PK(), SK: dynamo.DonorKey("an-id").SK(), Donor: search.LpaDonor{FirstNames: "x", LastName: "y"}}).
+		Index(ctx, search.Lpa{
+			PK:        dynamo.LpaKey("5").PK(),
+			SK:        dynamo.DonorKey("an-id").SK(),
+			LpaUID:    "M",
+			LpaType:   "property-and-affairs",
+			Status:    search.StatusInProgress,
+			Donor:     search.LpaDonor{FirstNames: "x", LastName: "y"},
+			UpdatedAt: testNow,
+		}).
 		Return(nil)
 
 	eventClient := newMockEventClient(t)
@@ -10,10 +10,14 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/random"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/totp"
 )
 
+const witnessTOTPIssuer = "Office of the Public Guardian"
+
 var (
 	testWitnessCode               = "1234"
+	testWitnessTOTPSecret         = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
 	ErrTooManyWitnessCodeRequests = errors.New("too many witness code requests")
 )
 
@@ -42,6 +46,7 @@ type WitnessCodeSender struct {
 	notifyClient             NotifyClient
 	localizer                Localizer
 	randomCode               func(int) string
+	newSecret                func() string
 	now                      func() time.Time
 	useTestCode              bool
 }
@@ -53,6 +58,7 @@ func NewWitnessCodeSender(donorStore DonorStore, certificateProviderStore Certif
 		notifyClient:             notifyClient,
 		localizer:                localizer,
 		randomCode:               random.Numeric,
+		newSecret:                totp.NewSecret,
 		now:                      time.Now,
 		useTestCode:              useTestCode,
 	}
@@ -69,6 +75,7 @@ func (s *WitnessCodeSender) SendToCertificateProvider(ctx context.Context, donor
 	}
 
 	donor.CertificateProviderCodes = append(donor.CertificateProviderCodes, donordata.WitnessCode{Code: code, Created: s.now()})
+	donor.CertificateProviderTOTP = donordata.WitnessTOTP{}
 
 	if err := s.donorStore.Put(ctx, donor); err != nil {
 		return err
@@ -97,6 +104,7 @@ func (s *WitnessCodeSender) SendToIndependentWitness(ctx context.Context, donor
 	}
 
 	donor.IndependentWitnessCodes = append(donor.IndependentWitnessCodes, donordata.WitnessCode{Code: code, Created: s.now()})
+	donor.IndependentWitnessTOTP = donordata.WitnessTOTP{}
 
 	if err := s.donorStore.Put(ctx, donor); err != nil {
 		return err
@@ -108,3 +116,46 @@ func (s *WitnessCodeSender) SendToIndependentWitness(ctx context.Context, donor
 		LpaType:       localize.LowerFirst(s.localizer.T(donor.Type.String())),
 	})
 }
+
+// SetUpCertificateProviderTOTP switches the certificate provider to witnessing
+// with time-based codes, which they can get from an authenticator app or by
+// signing in to their own account.
+func (s *WitnessCodeSender) SetUpCertificateProviderTOTP(ctx context.Context, donor *donordata.Provided) error {
+	if !donor.CertificateProviderTOTP.CanRequest(s.now()) {
+		return ErrTooManyWitnessCodeRequests
+	}
+
+	donor.CertificateProviderTOTP = s.newTOTP()
+
+	return s.donorStore.Put(ctx, donor)
+}
+
+// SetUpIndependentWitnessTOTP switches the independent witness to witnessing
+// with time-based codes from an authenticator app. The setup link is sent to
+// the independent witness by SMS, so the key is never shown to the donor.
+func (s *WitnessCodeSender) SetUpIndependentWitnessTOTP(ctx context.Context, donor *donordata.Provided) error {
+	if !donor.IndependentWitnessTOTP.CanRequest(s.now()) {
+		return ErrTooManyWitnessCodeRequests
+	}
+
+	donor.IndependentWitnessTOTP = s.newTOTP()
+
+	if err := s.donorStore.Put(ctx, donor); err != nil {
+		return err
+	}
+
+	return s.notifyClient.SendActorSMS(ctx, notify.ToIndependentWitness(donor.IndependentWitness), donor.LpaUID, notify.WitnessAuthenticatorSetupSMS{
+		SetupURI:      totp.URI(donor.IndependentWitnessTOTP.Secret, witnessTOTPIssuer, donor.Donor.FullName()),
+		DonorFullName: s.localizer.Possessive(donor.Donor.FullName()),
+		LpaType:       localize.LowerFirst(s.localizer.T(donor.Type.String())),
+	})
+}
+
+func (s *WitnessCodeSender) newTOTP() donordata.WitnessTOTP {
+	secret := s.newSecret()
+	if s.useTestCode {
+		secret = testWitnessTOTPSecret
+	}
+
+	return donordata.WitnessTOTP{Secret: secret, Created: s.now()}
+}
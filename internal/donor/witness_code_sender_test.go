@@ -373,3 +373,199 @@ func TestWitnessCodeSenderSendToIndependentWitnessWhenDonorStoreErrors(t *testin
 
 	assert.Equal(t, expectedError, err)
 }
+
+func TestWitnessCodeSenderSetUpCertificateProviderTOTP(t *testing.T) {
+	testCases := map[string]struct {
+		useTestCode    bool
+		expectedSecret string
+	}{
+		"random secret": {
+			expectedSecret: "SECRET",
+		},
+		"test secret": {
+			useTestCode:    true,
+			expectedSecret: testWitnessTOTPSecret,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+
+			donorStore := newMockDonorStore(t)
+			donorStore.EXPECT().
+				Put(ctx, &donordata.Provided{
+					CertificateProviderCodes: donordata.WitnessCodes{{Code: "1234", Created: now.Add(-time.Hour)}},
+					CertificateProviderTOTP:  donordata.WitnessTOTP{Secret: tc.expectedSecret, Created: now},
+				}).
+				Return(nil)
+
+			sender := &WitnessCodeSender{
+				donorStore:  donorStore,
+				newSecret:   func() string { return "SECRET" },
+				now:         func() time.Time { return now },
+				useTestCode: tc.useTestCode,
+			}
+			err := sender.SetUpCertificateProviderTOTP(ctx, &donordata.Provided{
+				CertificateProviderCodes: donordata.WitnessCodes{{Code: "1234", Created: now.Add(-time.Hour)}},
+				CertificateProviderTOTP:  donordata.WitnessTOTP{Secret: "OLD", Created: now.Add(-time.Hour)},
+			})
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestWitnessCodeSenderSetUpCertificateProviderTOTPWhenOlderHashVersion(t *testing.T) {
+	provided := &donordata.Provided{HashVersion: 0, Hash: 0x796ad22efcc0fccb}
+	assert.False(t, provided.HashChanged())
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Put(ctx, mock.MatchedBy(func(donor *donordata.Provided) bool {
+			return donor.CertificateProviderTOTP.Secret == "SECRET" && donor.HashChanged()
+		})).
+		Return(nil)
+
+	sender := &WitnessCodeSender{
+		donorStore: donorStore,
+		newSecret:  func() string { return "SECRET" },
+		now:        time.Now,
+	}
+	err := sender.SetUpCertificateProviderTOTP(ctx, provided)
+
+	assert.Nil(t, err)
+}
+
+func TestWitnessCodeSenderSetUpCertificateProviderTOTPWhenTooRecentlyRequested(t *testing.T) {
+	now := time.Now()
+
+	sender := &WitnessCodeSender{now: func() time.Time { return now }}
+	err := sender.SetUpCertificateProviderTOTP(ctx, &donordata.Provided{
+		CertificateProviderTOTP: donordata.WitnessTOTP{Secret: "OLD", Created: now.Add(-time.Minute)},
+	})
+
+	assert.Equal(t, ErrTooManyWitnessCodeRequests, err)
+}
+
+func TestWitnessCodeSenderSetUpCertificateProviderTOTPWhenDonorStoreErrors(t *testing.T) {
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Put(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	sender := &WitnessCodeSender{
+		donorStore: donorStore,
+		newSecret:  func() string { return "SECRET" },
+		now:        time.Now,
+	}
+	err := sender.SetUpCertificateProviderTOTP(ctx, &donordata.Provided{})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestWitnessCodeSenderSetUpIndependentWitnessTOTP(t *testing.T) {
+	now := time.Now()
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Put(ctx, &donordata.Provided{
+			LpaUID:                 "lpa-uid",
+			Donor:                  donordata.Donor{FirstNames: "Joe", LastName: "Jones"},
+			IndependentWitness:     donordata.IndependentWitness{Mobile: "0777"},
+			Type:                   lpadata.LpaTypePropertyAndAffairs,
+			IndependentWitnessTOTP: donordata.WitnessTOTP{Secret: "SECRET", Created: now},
+		}).
+		Return(nil)
+
+	notifyClient := newMockNotifyClient(t)
+	notifyClient.EXPECT().
+		SendActorSMS(ctx, notify.ToIndependentWitness(donordata.IndependentWitness{Mobile: "0777"}), "lpa-uid", notify.WitnessAuthenticatorSetupSMS{
+			SetupURI:      "otpauth://totp/Office%20of%20the%20Public%20Guardian:Joe%20Jones?digits=6&issuer=Office+of+the+Public+Guardian&period=30&secret=SECRET",
+			DonorFullName: "Joe Jones’",
+			LpaType:       "property and affairs",
+		}).
+		Return(nil)
+
+	localizer := newMockLocalizer(t)
+	localizer.EXPECT().
+		T("property-and-affairs").
+		Return("property and affairs")
+	localizer.EXPECT().
+		Possessive("Joe Jones").
+		Return("Joe Jones’")
+
+	sender := &WitnessCodeSender{
+		donorStore:   donorStore,
+		notifyClient: notifyClient,
+		localizer:    localizer,
+		newSecret:    func() string { return "SECRET" },
+		now:          func() time.Time { return now },
+	}
+	err := sender.SetUpIndependentWitnessTOTP(ctx, &donordata.Provided{
+		LpaUID:             "lpa-uid",
+		Donor:              donordata.Donor{FirstNames: "Joe", LastName: "Jones"},
+		IndependentWitness: donordata.IndependentWitness{Mobile: "0777"},
+		Type:               lpadata.LpaTypePropertyAndAffairs,
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestWitnessCodeSenderSetUpIndependentWitnessTOTPWhenTooRecentlyRequested(t *testing.T) {
+	now := time.Now()
+
+	sender := &WitnessCodeSender{now: func() time.Time { return now }}
+	err := sender.SetUpIndependentWitnessTOTP(ctx, &donordata.Provided{
+		IndependentWitnessTOTP: donordata.WitnessTOTP{Secret: "OLD", Created: now.Add(-time.Minute)},
+	})
+
+	assert.Equal(t, ErrTooManyWitnessCodeRequests, err)
+}
+
+func TestWitnessCodeSenderSetUpIndependentWitnessTOTPWhenDonorStoreErrors(t *testing.T) {
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Put(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	sender := &WitnessCodeSender{
+		donorStore: donorStore,
+		newSecret:  func() string { return "SECRET" },
+		now:        time.Now,
+	}
+	err := sender.SetUpIndependentWitnessTOTP(ctx, &donordata.Provided{})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestWitnessCodeSenderSetUpIndependentWitnessTOTPWhenNotifyClientErrors(t *testing.T) {
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Put(mock.Anything, mock.Anything).
+		Return(nil)
+
+	notifyClient := newMockNotifyClient(t)
+	notifyClient.EXPECT().
+		SendActorSMS(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	localizer := newMockLocalizer(t)
+	localizer.EXPECT().
+		T(mock.Anything).
+		Return("property and affairs")
+	localizer.EXPECT().
+		Possessive(mock.Anything).
+		Return("Joe Jones’")
+
+	sender := &WitnessCodeSender{
+		donorStore:   donorStore,
+		notifyClient: notifyClient,
+		localizer:    localizer,
+		newSecret:    func() string { return "SECRET" },
+		now:          time.Now,
+	}
+	err := sender.SetUpIndependentWitnessTOTP(ctx, &donordata.Provided{Type: lpadata.LpaTypePropertyAndAffairs})
+
+	assert.Equal(t, expectedError, err)
+}
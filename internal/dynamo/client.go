@@ -301,6 +301,46 @@ func (c *Client) AllByPartialSK(ctx context.Context, pk PK, partialSk SK, v inte
 	return attributevalue.UnmarshalListOfMaps(response.Items, v)
 }
 
+// PageByPartialSK gets up to limit items for pk with a sort key beginning
+// partialSK, in descending order of sort key. When after is given the items
+// start after the item with that sort key. The sort key to get the next page is
+// returned, or an empty string when there are no more items.
+func (c *Client) PageByPartialSK(ctx context.Context, pk PK, partialSK SK, limit int32, after string, v interface{}) (string, error) {
+	input := &dynamodb.QueryInput{
+		TableName:                aws.String(c.table),
+		ExpressionAttributeNames: map[string]string{"#PK": "PK", "#SK": "SK"},
+		ExpressionAttributeValues: map[string]types.AttributeValue{
+			":PK": &types.AttributeValueMemberS{Value: pk.PK()},
+			":SK": &types.AttributeValueMemberS{Value: partialSK.SK()},
+		},
+		KeyConditionExpression: aws.String("#PK = :PK and begins_with(#SK, :SK)"),
+		ScanIndexForward:       aws.Bool(false),
+		Limit:                  aws.Int32(limit),
+	}
+
+	if after != "" {
+		input.ExclusiveStartKey = map[string]types.AttributeValue{
+			"PK": &types.AttributeValueMemberS{Value: pk.PK()},
+			"SK": &types.AttributeValueMemberS{Value: after},
+		}
+	}
+
+	response, err := c.svc.Query(ctx, input)
+	if err != nil {
+		return "", err
+	}
+
+	if err := attributevalue.UnmarshalListOfMaps(response.Items, v); err != nil {
+		return "", err
+	}
+
+	if sk, ok := response.LastEvaluatedKey["SK"].(*types.AttributeValueMemberS); ok {
+		return sk.Value, nil
+	}
+
+	return "", nil
+}
+
 func (c *Client) Put(ctx context.Context, v interface{}) error {
 	item, err := attributevalue.MarshalMap(v)
 	if err != nil {
@@ -300,6 +300,74 @@ func TestAllByPartialSKOnQueryError(t *testing.T) {
 	assert.Equal(t, expectedError, err)
 }
 
+func TestPageByPartialSK(t *testing.T) {
+	expected := []map[string]string{{"Col": "Val"}, {"Other": "Thing"}}
+	pkey, _ := attributevalue.Marshal("a-pk")
+	skey, _ := attributevalue.Marshal("a-partial-sk")
+	data, _ := attributevalue.MarshalMap(expected[0])
+	data2, _ := attributevalue.MarshalMap(expected[1])
+
+	testcases := map[string]struct {
+		after             string
+		exclusiveStartKey map[string]types.AttributeValue
+		lastEvaluatedKey  map[string]types.AttributeValue
+		next              string
+	}{
+		"first page": {
+			lastEvaluatedKey: map[string]types.AttributeValue{
+				"PK": &types.AttributeValueMemberS{Value: "a-pk"},
+				"SK": &types.AttributeValueMemberS{Value: "a-partial-sk#2"},
+			},
+			next: "a-partial-sk#2",
+		},
+		"last page": {
+			after: "a-partial-sk#2",
+			exclusiveStartKey: map[string]types.AttributeValue{
+				"PK": &types.AttributeValueMemberS{Value: "a-pk"},
+				"SK": &types.AttributeValueMemberS{Value: "a-partial-sk#2"},
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			dynamoDB := newMockDynamoDB(t)
+			dynamoDB.EXPECT().
+				Query(ctx, &dynamodb.QueryInput{
+					TableName:                 aws.String("this"),
+					ExpressionAttributeNames:  map[string]string{"#PK": "PK", "#SK": "SK"},
+					ExpressionAttributeValues: map[string]types.AttributeValue{":PK": pkey, ":SK": skey},
+					KeyConditionExpression:    aws.String("#PK = :PK and begins_with(#SK, :SK)"),
+					ScanIndexForward:          aws.Bool(false),
+					Limit:                     aws.Int32(2),
+					ExclusiveStartKey:         tc.exclusiveStartKey,
+				}).
+				Return(&dynamodb.QueryOutput{Items: []map[string]types.AttributeValue{data, data2}, LastEvaluatedKey: tc.lastEvaluatedKey}, nil)
+
+			c := &Client{table: "this", svc: dynamoDB}
+
+			var v []map[string]string
+			next, err := c.PageByPartialSK(ctx, testPK("a-pk"), testSK("a-partial-sk"), 2, tc.after, &v)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, v)
+			assert.Equal(t, tc.next, next)
+		})
+	}
+}
+
+func TestPageByPartialSKOnQueryError(t *testing.T) {
+	dynamoDB := newMockDynamoDB(t)
+	dynamoDB.EXPECT().
+		Query(ctx, mock.Anything).
+		Return(nil, expectedError)
+
+	c := &Client{table: "this", svc: dynamoDB}
+
+	var v []map[string]string
+	_, err := c.PageByPartialSK(ctx, testPK("a-pk"), testSK("a-partial-sk"), 2, "", &v)
+	assert.Equal(t, expectedError, err)
+}
+
 func TestAllForActor(t *testing.T) {
 	expected := map[string]string{"Col": "Val"}
 	skey, _ := attributevalue.Marshal("a-partial-sk")
@@ -16,6 +16,7 @@ set for an LPA is:
 	| LPA#...      | RESERVED#VOUCHER#              | Ensure an LPA only has one voucher                              |                                  |
 	| LPA#...      | DOCUMENT#...                   | A document uploaded as evidence for a reduced fee               | document.Document                |
 	| LPA#...      | EVIDENCE_RECEIVED#             | Marker to show paper evidence has been sent in to the OPG       |                                  |
+	| LPA#...      | NOTIFICATION#...               | An email, SMS or letter sent about the LPA                      | notify.Notification              |
 	| UID#...      | METADATA#                      | Ensure a UID is only set once                                   |                                  |
 
 For supporters there is data for the organisation, but also the LPA is stored against the donor differently:
@@ -38,9 +39,15 @@ For sharing an LPA with each actor we generate records like:
 	| CERTIFICATEPROVIDERACCESS#... | METADATA#...          | A share of the LPA to a certificate provider                   | accesscodedata.Link      |
 	| ATTORNEYACCESS#...            | METADATA#...          | A share of the LPA to an attorney (or replacement/trust corp.) | accesscodedata.Link      |
 	| ACTORACCESS#...               | METADATA#...          | Ensure an actor only has one access code                       |                          |
-	| ACCESSLIMITER#...             | METADATA#...          | Rate limit entering access codes                               | accesscode.accessLimiter |
 	| LPA#...                       | ORGANISATIONLINK#...  | When and who accessed a supported LPA                          | supporterdata.LpaLink    |
 
+Other records are used to support the service:
+
+	| PK              | SK           | Description                                       | Type                 |
+	| --------------- | ------------ | ------------------------------------------------- | -------------------- |
+	| RATELIMITER#... | METADATA#... | Rate limit requests covered by a policy for a key | rate.storedLimiter   |
+	| POSTCODE#...    | METADATA#... | Cache the addresses found for a postcode          | place.dynamoPostcode |
+
 The scheduler uses the following structure:
 
 	| PK               | SK            | Description                          | Type            |
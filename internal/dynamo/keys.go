@@ -33,11 +33,15 @@ const (
 	reservedPrefix                  = "RESERVED"
 	uidPrefix                       = "UID"
 	sessionPrefix                   = "SESSION"
+	loginPrefix                     = "LOGIN"
 	reusePrefix                     = "REUSE"
 	actorAccessPrefix               = "ACTORACCESS"
-	accessLimiterPrefix             = "ACCESSLIMITER"
+	rateLimiterPrefix               = "RATELIMITER"
 	organisationLinkPrefix          = "ORGANISATIONLINK"
 	skAsPKPrefix                    = "SKASPK"
+	notificationPrefix              = "NOTIFICATION"
+	postcodePrefix                  = "POSTCODE"
+	auditLogPrefix                  = "AUDITLOG"
 )
 
 func readKey(s string) (any, error) {
@@ -95,16 +99,24 @@ func readKey(s string) (any, error) {
 		return UIDKeyType(s), nil
 	case sessionPrefix:
 		return SessionKeyType(s), nil
+	case loginPrefix:
+		return LoginKeyType(s), nil
 	case reusePrefix:
 		return ReuseKeyType(s), nil
 	case actorAccessPrefix:
 		return ActorAccessKeyType(s), nil
-	case accessLimiterPrefix:
-		return AccessLimiterKeyType(s), nil
+	case rateLimiterPrefix:
+		return RateLimiterKeyType(s), nil
 	case organisationLinkPrefix:
 		return OrganisationLinkKeyType(s), nil
 	case skAsPKPrefix:
 		return skAsPKType(s), nil
+	case notificationPrefix:
+		return NotificationKeyType(s), nil
+	case postcodePrefix:
+		return PostcodeKeyType(s), nil
+	case auditLogPrefix:
+		return AuditLogKeyType(s), nil
 	default:
 		return nil, errors.New("unknown key prefix")
 	}
@@ -194,6 +206,34 @@ func EvidenceReceivedKey() EvidenceReceivedKeyType {
 	return EvidenceReceivedKeyType(evidenceReceivedPrefix + "#")
 }
 
+type NotificationKeyType string
+
+func (t NotificationKeyType) SK() string { return string(t) }
+
+// NotificationKey is used as the SK (with LpaKey as PK) to record an email, SMS
+// or letter sent about an Lpa.
+func NotificationKey(sentAt time.Time, notifyID string) NotificationKeyType {
+	return NotificationKeyType(notificationPrefix + "#" + sentAt.Format(time.RFC3339) + "#" + notifyID)
+}
+
+func PartialNotificationKey() NotificationKeyType {
+	return notificationPrefix + "#"
+}
+
+type AuditLogKeyType string
+
+func (t AuditLogKeyType) SK() string { return string(t) }
+
+// AuditLogKey is used as the SK (with OrganisationKey as PK) to record an action
+// taken in an organisation.
+func AuditLogKey(createdAt time.Time, id string) AuditLogKeyType {
+	return AuditLogKeyType(auditLogPrefix + "#" + createdAt.Format(time.RFC3339) + "#" + id)
+}
+
+func PartialAuditLogKey() AuditLogKeyType {
+	return auditLogPrefix + "#"
+}
+
 type OrganisationKeyType string
 
 func (t OrganisationKeyType) PK() string { return string(t) }
@@ -378,6 +418,26 @@ func SessionKey(uid string) SessionKeyType {
 	return SessionKeyType(sessionPrefix + "#" + uid)
 }
 
+type LoginKeyType string
+
+func (t LoginKeyType) PK() string { return string(t) }
+
+// LoginKey is used as the PK (with MetadataKey as SK) to record the sessions
+// created for a OneLogin user, so that they can all be revoked.
+func LoginKey(sessionID string) LoginKeyType {
+	return LoginKeyType(loginPrefix + "#" + sessionID)
+}
+
+type PostcodeKeyType string
+
+func (t PostcodeKeyType) PK() string { return string(t) }
+
+// PostcodeKey is used as the PK (with MetadataKey as SK) to cache the addresses
+// found for a postcode.
+func PostcodeKey(postcode string) PostcodeKeyType {
+	return PostcodeKeyType(postcodePrefix + "#" + postcode)
+}
+
 type ReuseKeyType string
 
 func (t ReuseKeyType) PK() string { return string(t) }
@@ -398,14 +458,15 @@ func ActorAccessKey(actorUID string) ActorAccessKeyType {
 	return ActorAccessKeyType(actorAccessPrefix + "#" + actorUID)
 }
 
-type AccessLimiterKeyType string
+type RateLimiterKeyType string
 
-func (t AccessLimiterKeyType) PK() string { return string(t) }
+func (t RateLimiterKeyType) PK() string { return string(t) }
 
-// AccessLimiterKey is used as the PK (with MetadataKey as SK) to limit the rate
-// at which a user is attempting to enter access codes.
-func AccessLimiterKey(s string) AccessLimiterKeyType {
-	return AccessLimiterKeyType(accessLimiterPrefix + "#" + s)
+// RateLimiterKey is used as the PK (with MetadataKey as SK) to limit the rate
+// at which requests covered by a policy can be made for a key, such as a
+// session ID.
+func RateLimiterKey(policy, key string) RateLimiterKeyType {
+	return RateLimiterKeyType(rateLimiterPrefix + "#" + policy + "#" + key)
 }
 
 type OrganisationLinkKeyType string
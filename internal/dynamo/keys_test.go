@@ -39,9 +39,11 @@ func TestPK(t *testing.T) {
 		"ScheduledDayKey":              {ScheduledDayKey(time.Date(2024, time.January, 2, 12, 13, 14, 15, time.UTC)), "SCHEDULEDDAY#2024-01-02"},
 		"UIDKey":                       {UIDKey("S"), "UID#S"},
 		"SessionKey":                   {SessionKey("S"), "SESSION#S"},
+		"LoginKey":                     {LoginKey("S"), "LOGIN#S"},
+		"PostcodeKey":                  {PostcodeKey("S"), "POSTCODE#S"},
 		"ReuseKey":                     {ReuseKey("S", "T"), "REUSE#S#T"},
 		"ActorAccessKey":               {ActorAccessKey("S"), "ACTORACCESS#S"},
-		"AccessLimiterKey":             {AccessLimiterKey("S"), "ACCESSLIMITER#S"},
+		"RateLimiterKey":               {RateLimiterKey("P", "S"), "RATELIMITER#P#S"},
 		"skAsPK":                       {skAsPK(SubKey("S")), "SKASPK#SUB#S"},
 	}
 
@@ -93,6 +95,10 @@ func TestSK(t *testing.T) {
 		"ReservedKey":            {ReservedKey(VoucherKey), "RESERVED#VOUCHER#"},
 		"PartialScheduledKey":    {PartialScheduledKey(), "SCHEDULED#"},
 		"OrganisationLinkKey":    {OrganisationLinkKey("S"), "ORGANISATIONLINK#S"},
+		"NotificationKey":        {NotificationKey(time.Date(2024, time.January, 2, 12, 13, 14, 15, time.UTC), "notify-id"), "NOTIFICATION#2024-01-02T12:13:14Z#notify-id"},
+		"PartialNotificationKey": {PartialNotificationKey(), "NOTIFICATION#"},
+		"AuditLogKey":            {AuditLogKey(time.Date(2024, time.January, 2, 12, 13, 14, 15, time.UTC), "some-id"), "AUDITLOG#2024-01-02T12:13:14Z#some-id"},
+		"PartialAuditLogKey":     {PartialAuditLogKey(), "AUDITLOG#"},
 	}
 
 	for name, tc := range testcases {
@@ -0,0 +1,105 @@
+package identity
+
+// nameGroups records which group(s) a name belongs to, names are equivalent
+// when they share a group.
+type nameGroups map[string][]int
+
+func newNameGroups(groups [][]string) nameGroups {
+	g := nameGroups{}
+	for i, names := range groups {
+		for _, name := range names {
+			g[name] = append(g[name], i)
+		}
+	}
+
+	return g
+}
+
+func (g nameGroups) equivalent(a, b string) bool {
+	for _, i := range g[a] {
+		for _, j := range g[b] {
+			if i == j {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// welshForms pairs English first names with their Welsh equivalents.
+var welshForms = newNameGroups([][]string{
+	{"JOHN", "SION", "IOAN"},
+	{"JANE", "SIAN"},
+	{"JANET", "SIONED"},
+	{"DAVID", "DAFYDD", "DEWI"},
+	{"EVAN", "IFAN", "IEUAN"},
+	{"MARY", "MAIR"},
+	{"MARGARET", "MARGED"},
+	{"CATHERINE", "CATRIN"},
+	{"ELIZABETH", "BETHAN"},
+	{"HUGH", "HUW"},
+	{"OWEN", "OWAIN"},
+	{"RICHARD", "RHISIART"},
+	{"ROBERT", "ROBAT"},
+	{"THOMAS", "TOMOS"},
+	{"WILLIAM", "GWILYM"},
+	{"EDWARD", "IORWERTH"},
+	{"HENRY", "HARRI"},
+	{"PETER", "PEDR"},
+	{"PAUL", "PAWL"},
+	{"JAMES", "IAGO"},
+	{"STEPHEN", "STEFFAN"},
+	{"LEWIS", "LLYWELYN"},
+	{"GEORGE", "SIOR"},
+	{"AGNES", "NEST"},
+})
+
+// nicknames groups first names with their common shortened forms.
+var nicknames = newNameGroups([][]string{
+	{"ALEXANDER", "ALEX", "ALEC", "SANDY"},
+	{"ALEXANDRA", "ALEX", "SANDRA"},
+	{"ALFRED", "ALF", "ALFIE"},
+	{"ANDREW", "ANDY", "DREW"},
+	{"ANTHONY", "TONY"},
+	{"BENJAMIN", "BEN", "BENNY"},
+	{"CATHERINE", "KATHERINE", "KATHRYN", "CATH", "CATHY", "KATE", "KATIE", "KATH", "KITTY"},
+	{"CHARLES", "CHARLIE", "CHAS", "CHUCK"},
+	{"CHRISTOPHER", "CHRIS", "KIT"},
+	{"DANIEL", "DAN", "DANNY"},
+	{"DAVID", "DAVE", "DAVY"},
+	{"DAFYDD", "DAI"},
+	{"DEBORAH", "DEBBIE", "DEB"},
+	{"EDWARD", "ED", "EDDIE", "TED", "NED"},
+	{"ELIZABETH", "LIZ", "LIZZIE", "BETH", "BETTY", "ELIZA", "LIBBY", "BESS"},
+	{"FREDERICK", "FRED", "FREDDIE"},
+	{"GERALD", "GERRY"},
+	{"HAROLD", "HARRY"},
+	{"HENRY", "HARRY", "HAL"},
+	{"JAMES", "JIM", "JIMMY", "JAMIE"},
+	{"JENNIFER", "JEN", "JENNY"},
+	{"JOHN", "JACK", "JOHNNY"},
+	{"JONATHAN", "JON", "JONNY"},
+	{"JOSEPH", "JOE", "JOEY"},
+	{"JOSEPHINE", "JO", "JOSIE"},
+	{"MARGARET", "MAGGIE", "MEG", "PEGGY", "MARGE", "MAISIE"},
+	{"MARY", "MOLLY", "POLLY"},
+	{"MATTHEW", "MATT"},
+	{"MICHAEL", "MIKE", "MICK", "MICKEY"},
+	{"NICHOLAS", "NICK", "NICKY"},
+	{"PATRICIA", "PAT", "PATSY", "TRISH"},
+	{"PETER", "PETE"},
+	{"PHILIP", "PHILLIP", "PHIL"},
+	{"REBECCA", "BECKY", "BECCA"},
+	{"RICHARD", "RICK", "RICH", "RICHIE", "DICK"},
+	{"ROBERT", "ROB", "ROBBIE", "BOB", "BOBBY", "BERT"},
+	{"SAMUEL", "SAM", "SAMMY"},
+	{"SAMANTHA", "SAM", "SAMMY"},
+	{"SARAH", "SALLY"},
+	{"STEPHEN", "STEVEN", "STEVE"},
+	{"SUSAN", "SUE", "SUSIE"},
+	{"THOMAS", "TOM", "TOMMY"},
+	{"TIMOTHY", "TIM"},
+	{"VICTORIA", "VICKY", "TORI"},
+	{"WILLIAM", "BILL", "BILLY", "WILL", "WILLIE", "LIAM"},
+})
@@ -0,0 +1,199 @@
+package identity
+
+import (
+	"slices"
+	"strings"
+)
+
+// nameMatchAcceptScore is the lowest score where a name is treated as matching,
+// anything lower is a mismatch that needs to be reviewed.
+const nameMatchAcceptScore = 70
+
+// nameMatchPenalties are taken from a perfect score of 100 for each reason
+// needed to make the names match.
+var nameMatchPenalties = map[NameMatchReason]int{
+	NameMatchReasonTransliterated:      5,
+	NameMatchReasonPunctuation:         5,
+	NameMatchReasonFirstNamesReordered: 5,
+	NameMatchReasonMiddleNameOmitted:   15,
+	NameMatchReasonNickname:            20,
+	NameMatchReasonWelshForm:           10,
+}
+
+// A NameMatch explains how a name given to us compares to the name returned by
+// an identity check.
+type NameMatch struct {
+	Score   int
+	Reasons []NameMatchReason
+}
+
+func (m NameMatch) Accepted() bool {
+	return m.Score >= nameMatchAcceptScore
+}
+
+// ScoreName compares the names with those returned by an identity check. The
+// score starts at 100 and is reduced for each difference that had to be
+// accounted for, any difference that can't be accounted for gives a score of 0.
+func (u UserData) ScoreName(firstNames, lastName string) NameMatch {
+	var reasons, mismatches []NameMatchReason
+
+	if r, ok := matchName(u.LastName, lastName, false); ok {
+		reasons = append(reasons, r...)
+	} else {
+		mismatches = append(mismatches, NameMatchReasonLastNameMismatch)
+	}
+
+	if r, ok := matchFirstNames(u.FirstNames, firstNames); ok {
+		reasons = append(reasons, r...)
+	} else {
+		mismatches = append(mismatches, NameMatchReasonFirstNameMismatch)
+	}
+
+	if len(mismatches) > 0 {
+		slices.Sort(mismatches)
+		return NameMatch{Score: 0, Reasons: mismatches}
+	}
+
+	slices.Sort(reasons)
+	reasons = slices.Compact(reasons)
+
+	score := 100
+	for _, reason := range reasons {
+		score -= nameMatchPenalties[reason]
+	}
+
+	return NameMatch{Score: score, Reasons: reasons}
+}
+
+type nameNormalisation struct {
+	reason NameMatchReason
+	fn     func(string) string
+}
+
+var nameNormalisations = []nameNormalisation{
+	{reason: NameMatchReasonTransliterated, fn: transliterate},
+	{reason: NameMatchReasonPunctuation, fn: removePunctuation},
+}
+
+// matchName compares a single name, applying each normalisation in turn until
+// they are equal. Welsh forms and nicknames are only considered when
+// allowEquivalents is set, as they only apply to first names.
+func matchName(verified, provided string, allowEquivalents bool) ([]NameMatchReason, bool) {
+	v := strings.Join(strings.Fields(strings.ToUpper(verified)), " ")
+	p := strings.Join(strings.Fields(strings.ToUpper(provided)), " ")
+	if v == p {
+		return nil, true
+	}
+
+	var reasons []NameMatchReason
+	for _, normalisation := range nameNormalisations {
+		nv, np := normalisation.fn(v), normalisation.fn(p)
+		if nv != v || np != p {
+			reasons = append(reasons, normalisation.reason)
+			v, p = nv, np
+		}
+
+		if v == p {
+			return reasons, true
+		}
+	}
+
+	if allowEquivalents {
+		if welshForms.equivalent(v, p) {
+			return append(reasons, NameMatchReasonWelshForm), true
+		}
+
+		if nicknames.equivalent(v, p) {
+			return append(reasons, NameMatchReasonNickname), true
+		}
+	}
+
+	return nil, false
+}
+
+type tokenMatch struct {
+	v, p    int
+	reasons []NameMatchReason
+}
+
+// matchFirstNames pairs up each of the first names, preferring the pairs that
+// need the fewest differences accounting for. Names that can't be paired are
+// treated as omitted middle names, so long as the first of each is paired.
+func matchFirstNames(verified, provided string) ([]NameMatchReason, bool) {
+	vTokens := splitFirstNames(verified)
+	pTokens := splitFirstNames(provided)
+	if len(vTokens) == 0 || len(pTokens) == 0 {
+		return nil, len(vTokens) == len(pTokens)
+	}
+
+	var candidates []tokenMatch
+	for i, v := range vTokens {
+		for j, p := range pTokens {
+			if reasons, ok := matchName(v, p, true); ok {
+				candidates = append(candidates, tokenMatch{v: i, p: j, reasons: reasons})
+			}
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b tokenMatch) int {
+		return penalty(a.reasons) - penalty(b.reasons)
+	})
+
+	vUsed := make([]bool, len(vTokens))
+	pUsed := make([]bool, len(pTokens))
+	var matches []tokenMatch
+	for _, c := range candidates {
+		if !vUsed[c.v] && !pUsed[c.p] {
+			vUsed[c.v], pUsed[c.p] = true, true
+			matches = append(matches, c)
+		}
+	}
+
+	if !vUsed[0] || !pUsed[0] {
+		return nil, false
+	}
+
+	var reasons []NameMatchReason
+	for _, m := range matches {
+		reasons = append(reasons, m.reasons...)
+	}
+
+	if strings.Count(verified, "-") != strings.Count(provided, "-") {
+		reasons = append(reasons, NameMatchReasonPunctuation)
+	}
+
+	if len(matches) < len(vTokens) || len(matches) < len(pTokens) {
+		reasons = append(reasons, NameMatchReasonMiddleNameOmitted)
+	}
+
+	slices.SortFunc(matches, func(a, b tokenMatch) int { return a.p - b.p })
+	if !slices.IsSortedFunc(matches, func(a, b tokenMatch) int { return a.v - b.v }) {
+		reasons = append(reasons, NameMatchReasonFirstNamesReordered)
+	}
+
+	return reasons, true
+}
+
+func splitFirstNames(s string) []string {
+	return strings.Fields(strings.ReplaceAll(s, "-", " "))
+}
+
+func removePunctuation(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '\'', '‘', '’', '.':
+			return -1
+		default:
+			return r
+		}
+	}, s)
+}
+
+func penalty(reasons []NameMatchReason) int {
+	total := 0
+	for _, reason := range reasons {
+		total += nameMatchPenalties[reason]
+	}
+
+	return total
+}
@@ -0,0 +1,15 @@
+package identity
+
+//go:generate go tool enumerator -type NameMatchReason --linecomment --trimprefix
+type NameMatchReason uint8
+
+const (
+	NameMatchReasonTransliterated      NameMatchReason = iota + 1 // transliterated
+	NameMatchReasonPunctuation                                    // punctuation
+	NameMatchReasonFirstNamesReordered                            // first-names-reordered
+	NameMatchReasonMiddleNameOmitted                              // middle-name-omitted
+	NameMatchReasonNickname                                       // nickname
+	NameMatchReasonWelshForm                                      // welsh-form
+	NameMatchReasonFirstNameMismatch                              // first-name-mismatch
+	NameMatchReasonLastNameMismatch                               // last-name-mismatch
+)
@@ -0,0 +1,143 @@
+package identity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserDataScoreName(t *testing.T) {
+	testcases := map[string]struct {
+		verified   UserData
+		firstNames string
+		lastName   string
+		expected   NameMatch
+	}{
+		"exact": {
+			verified:   UserData{FirstNames: "JOHN PAUL", LastName: "SMITH"},
+			firstNames: "John  Paul",
+			lastName:   "Smith",
+			expected:   NameMatch{Score: 100},
+		},
+		"transliterated": {
+			verified:   UserData{FirstNames: "SIAN", LastName: "PENNE"},
+			firstNames: "Siân",
+			lastName:   "Penné",
+			expected:   NameMatch{Score: 95, Reasons: []NameMatchReason{NameMatchReasonTransliterated}},
+		},
+		"apostrophe": {
+			verified:   UserData{FirstNames: "SEAN", LastName: "OBRIEN"},
+			firstNames: "Sean",
+			lastName:   "O’Brien",
+			expected:   NameMatch{Score: 95, Reasons: []NameMatchReason{NameMatchReasonPunctuation}},
+		},
+		"hyphenated last name": {
+			verified:   UserData{FirstNames: "SAM", LastName: "SMITH JONES"},
+			firstNames: "Sam",
+			lastName:   "Smith-Jones",
+			expected:   NameMatch{Score: 95, Reasons: []NameMatchReason{NameMatchReasonPunctuation}},
+		},
+		"hyphenated first names": {
+			verified:   UserData{FirstNames: "MARY JANE", LastName: "SMITH"},
+			firstNames: "Mary-Jane",
+			lastName:   "Smith",
+			expected:   NameMatch{Score: 95, Reasons: []NameMatchReason{NameMatchReasonPunctuation}},
+		},
+		"reordered": {
+			verified:   UserData{FirstNames: "BEE A", LastName: "SEA"},
+			firstNames: "A Bee",
+			lastName:   "Sea",
+			expected:   NameMatch{Score: 95, Reasons: []NameMatchReason{NameMatchReasonFirstNamesReordered}},
+		},
+		"middle name omitted": {
+			verified:   UserData{FirstNames: "JOHN PAUL", LastName: "SMITH"},
+			firstNames: "John",
+			lastName:   "Smith",
+			expected:   NameMatch{Score: 85, Reasons: []NameMatchReason{NameMatchReasonMiddleNameOmitted}},
+		},
+		"middle name not verified": {
+			verified:   UserData{FirstNames: "JOHN", LastName: "SMITH"},
+			firstNames: "John Paul",
+			lastName:   "Smith",
+			expected:   NameMatch{Score: 85, Reasons: []NameMatchReason{NameMatchReasonMiddleNameOmitted}},
+		},
+		"nickname": {
+			verified:   UserData{FirstNames: "ROBERT", LastName: "SMITH"},
+			firstNames: "Bob",
+			lastName:   "Smith",
+			expected:   NameMatch{Score: 80, Reasons: []NameMatchReason{NameMatchReasonNickname}},
+		},
+		"welsh form": {
+			verified:   UserData{FirstNames: "DAFYDD", LastName: "JONES"},
+			firstNames: "David",
+			lastName:   "Jones",
+			expected:   NameMatch{Score: 90, Reasons: []NameMatchReason{NameMatchReasonWelshForm}},
+		},
+		"welsh form transliterated": {
+			verified:   UserData{FirstNames: "JANE", LastName: "JONES"},
+			firstNames: "Siân",
+			lastName:   "Jones",
+			expected:   NameMatch{Score: 85, Reasons: []NameMatchReason{NameMatchReasonTransliterated, NameMatchReasonWelshForm}},
+		},
+		"too many differences": {
+			verified:   UserData{FirstNames: "ROBERT JAMES", LastName: "SMITH"},
+			firstNames: "Bob",
+			lastName:   "Smith",
+			expected:   NameMatch{Score: 65, Reasons: []NameMatchReason{NameMatchReasonMiddleNameOmitted, NameMatchReasonNickname}},
+		},
+		"first name mismatch": {
+			verified:   UserData{FirstNames: "JOHN", LastName: "SMITH"},
+			firstNames: "Paul",
+			lastName:   "Smith",
+			expected:   NameMatch{Score: 0, Reasons: []NameMatchReason{NameMatchReasonFirstNameMismatch}},
+		},
+		"only middle name given": {
+			verified:   UserData{FirstNames: "JOHN PAUL", LastName: "SMITH"},
+			firstNames: "Paul",
+			lastName:   "Smith",
+			expected:   NameMatch{Score: 0, Reasons: []NameMatchReason{NameMatchReasonFirstNameMismatch}},
+		},
+		"last name mismatch": {
+			verified:   UserData{FirstNames: "JOHN", LastName: "SMITH"},
+			firstNames: "John",
+			lastName:   "Smyth",
+			expected:   NameMatch{Score: 0, Reasons: []NameMatchReason{NameMatchReasonLastNameMismatch}},
+		},
+		"nickname not used for last name": {
+			verified:   UserData{FirstNames: "JOHN", LastName: "ROBERT"},
+			firstNames: "John",
+			lastName:   "Bob",
+			expected:   NameMatch{Score: 0, Reasons: []NameMatchReason{NameMatchReasonLastNameMismatch}},
+		},
+		"both mismatch": {
+			verified:   UserData{FirstNames: "JOHN", LastName: "SMITH"},
+			firstNames: "Paul",
+			lastName:   "Jones",
+			expected:   NameMatch{Score: 0, Reasons: []NameMatchReason{NameMatchReasonFirstNameMismatch, NameMatchReasonLastNameMismatch}},
+		},
+		"empty": {
+			verified:   UserData{},
+			firstNames: "",
+			lastName:   "",
+			expected:   NameMatch{Score: 100},
+		},
+		"empty verified": {
+			verified:   UserData{},
+			firstNames: "John",
+			lastName:   "Smith",
+			expected:   NameMatch{Score: 0, Reasons: []NameMatchReason{NameMatchReasonFirstNameMismatch, NameMatchReasonLastNameMismatch}},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.verified.ScoreName(tc.firstNames, tc.lastName))
+		})
+	}
+}
+
+func TestNameMatchAccepted(t *testing.T) {
+	assert.True(t, NameMatch{Score: 100}.Accepted())
+	assert.True(t, NameMatch{Score: 70}.Accepted())
+	assert.False(t, NameMatch{Score: 69}.Accepted())
+}
@@ -3,8 +3,6 @@
 package identity
 
 import (
-	"slices"
-	"strings"
 	"time"
 
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/date"
@@ -125,28 +123,10 @@ type UserData struct {
 }
 
 // MatchName returns true if the names should be considered equal to those
-// returned by an identity check. This uses common subsitutions found in
-// passports and ignores the ordering of first names. Though, for rare
-// situations it may still not provide the expected result as it does not
-// account for longer names being abbreviated on passports.
+// returned by an identity check. See ScoreName for the differences that are
+// allowed.
 func (u UserData) MatchName(firstNames, lastName string) bool {
-	expectedFirstNames := strings.ToUpper(u.FirstNames)
-	expectedLastName := strings.ToUpper(u.LastName)
-
-	firstNames = strings.ToUpper(firstNames)
-	lastName = strings.ToUpper(lastName)
-
-	if expectedFirstNames == firstNames && expectedLastName == lastName {
-		return true
-	}
-
-	expectedFirstNameParts := strings.Fields(expectedFirstNames)
-	firstNameParts := strings.Fields(transliterate(firstNames))
-
-	slices.Sort(expectedFirstNameParts)
-	slices.Sort(firstNameParts)
-
-	return slices.Equal(expectedFirstNameParts, firstNameParts) && expectedLastName == transliterate(lastName)
+	return u.ScoreName(firstNames, lastName).Accepted()
 }
 
 func transliterate(s string) string {
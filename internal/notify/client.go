@@ -8,8 +8,10 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"reflect"
 	"slices"
 	"strings"
 	"time"
@@ -18,6 +20,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/event"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/place"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -34,8 +37,17 @@ var (
 		"07700900111",
 		"07700900222",
 	}
+	// sentStatuses are, for each channel, the statuses that mean a notification
+	// with the same reference should not be sent again.
+	sentStatuses = map[Channel][]string{
+		ChannelEmail:  {"sending", "delivered"},
+		ChannelSMS:    {"sending", "pending", "sent", "delivered"},
+		ChannelLetter: {"pending-virus-check", "accepted", "received"},
+	}
 )
 
+var ErrLetterAddressTooShort = errors.New("letter address must have at least 3 lines")
+
 type Logger interface {
 	ErrorContext(ctx context.Context, msg string, args ...any)
 }
@@ -52,32 +64,38 @@ type Bundle interface {
 	For(lang localize.Lang) localize.Localizer
 }
 
+type NotificationStore interface {
+	Create(ctx context.Context, lpaUID string, notification Notification) error
+}
+
 type Client struct {
-	logger      Logger
-	baseURL     string
-	doer        Doer
-	issuer      string
-	secretKey   []byte
-	now         func() time.Time
-	eventClient EventClient
-	bundle      Bundle
+	logger            Logger
+	baseURL           string
+	doer              Doer
+	issuer            string
+	secretKey         []byte
+	now               func() time.Time
+	eventClient       EventClient
+	bundle            Bundle
+	notificationStore NotificationStore
 }
 
-func New(logger Logger, baseURL, apiKey string, httpClient Doer, eventClient EventClient, bundle Bundle) (*Client, error) {
+func New(logger Logger, baseURL, apiKey string, httpClient Doer, eventClient EventClient, bundle Bundle, notificationStore NotificationStore) (*Client, error) {
 	keyParts := strings.Split(apiKey, "-")
 	if len(keyParts) != 11 {
 		return nil, errors.New("invalid apiKey format")
 	}
 
 	return &Client{
-		logger:      logger,
-		baseURL:     baseURL,
-		doer:        httpClient,
-		issuer:      strings.Join(keyParts[1:6], "-"),
-		secretKey:   []byte(strings.Join(keyParts[6:11], "-")),
-		now:         time.Now,
-		eventClient: eventClient,
-		bundle:      bundle,
+		logger:            logger,
+		baseURL:           baseURL,
+		doer:              httpClient,
+		issuer:            strings.Join(keyParts[1:6], "-"),
+		secretKey:         []byte(strings.Join(keyParts[6:11], "-")),
+		now:               time.Now,
+		eventClient:       eventClient,
+		bundle:            bundle,
+		notificationStore: notificationStore,
 	}, nil
 }
 
@@ -110,6 +128,7 @@ type response struct {
 	StatusCode    int                    `json:"status_code,omitempty"`
 	Errors        errorsList             `json:"errors,omitempty"`
 	Notifications []responseNotification `json:"notifications"`
+	Templates     []Template             `json:"templates"`
 }
 
 type responseNotification struct {
@@ -181,7 +200,7 @@ func (c *Client) SendActorEmail(ctx context.Context, to ToEmail, lpaUID string,
 	ctx, span := newSpan(ctx, "Email", templateID, address)
 	defer span.End()
 
-	if ok, err := c.recentlySent(ctx, c.makeReference(lpaUID, address, templateID)); err != nil || ok {
+	if ok, err := c.recentlySent(ctx, ChannelEmail, c.makeReference(lpaUID, address, templateID)); err != nil || ok {
 		return err
 	}
 
@@ -202,6 +221,15 @@ func (c *Client) SendActorEmail(ctx context.Context, to ToEmail, lpaUID string,
 	}
 	span.SetAttributes(attribute.KeyValue{Key: "notify_id", Value: attribute.StringValue(resp.ID)})
 
+	c.recordNotification(ctx, lpaUID, Notification{
+		TemplateType:  templateType(email),
+		TemplateID:    templateID,
+		RecipientType: to.recipientType(ChannelEmail),
+		Channel:       ChannelEmail,
+		NotifyID:      resp.ID,
+		Status:        "created",
+	})
+
 	if !slices.Contains(simulatedEmails, address) {
 		if err := c.eventClient.SendNotificationSent(ctx, event.NotificationSent{
 			UID:            lpaUID,
@@ -247,6 +275,15 @@ func (c *Client) SendActorSMS(ctx context.Context, to ToMobile, lpaUID string, s
 	}
 	span.SetAttributes(attribute.KeyValue{Key: "notification_id", Value: attribute.StringValue(resp.ID)})
 
+	c.recordNotification(ctx, lpaUID, Notification{
+		TemplateType:  templateType(sms),
+		TemplateID:    templateID,
+		RecipientType: to.recipientType(ChannelSMS),
+		Channel:       ChannelSMS,
+		NotifyID:      resp.ID,
+		Status:        "created",
+	})
+
 	if !slices.Contains(simulatedPhones, number) {
 		if err := c.eventClient.SendNotificationSent(ctx, event.NotificationSent{
 			UID:            lpaUID,
@@ -259,7 +296,201 @@ func (c *Client) SendActorSMS(ctx context.Context, to ToMobile, lpaUID string, s
 	return nil
 }
 
-func (c *Client) recentlySent(ctx context.Context, ref string) (bool, error) {
+type letterWrapper struct {
+	TemplateID      string         `json:"template_id"`
+	Personalisation map[string]any `json:"personalisation"`
+	Reference       string         `json:"reference"`
+}
+
+func (c *Client) SendActorLetter(ctx context.Context, to ToLetter, lpaUID string, letter Letter) error {
+	if to.ignore() {
+		return nil
+	}
+
+	name, address, lang := to.toLetter()
+	templateID := letter.letterID(lang)
+
+	ctx, span := newSpan(ctx, "Letter", templateID, address.Postcode)
+	defer span.End()
+
+	lines := c.letterAddressLines(name, address)
+	if len(lines) < 3 {
+		return ErrLetterAddressTooShort
+	}
+
+	reference := c.makeReference(lpaUID, address.Encode(), templateID)
+	if ok, err := c.recentlySent(ctx, ChannelLetter, reference); err != nil || ok {
+		return err
+	}
+
+	personalisation, err := letterPersonalisation(lines, letter)
+	if err != nil {
+		return err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/v2/notifications/letter", letterWrapper{
+		TemplateID:      templateID,
+		Personalisation: personalisation,
+		Reference:       reference,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "letter send failed", slog.String("lpa_uid", lpaUID))
+		return err
+	}
+	span.SetAttributes(attribute.KeyValue{Key: "notify_id", Value: attribute.StringValue(resp.ID)})
+
+	c.recordNotification(ctx, lpaUID, Notification{
+		TemplateType:  templateType(letter),
+		TemplateID:    templateID,
+		RecipientType: to.recipientType(ChannelLetter),
+		Channel:       ChannelLetter,
+		NotifyID:      resp.ID,
+		Status:        "created",
+	})
+
+	if err := c.eventClient.SendNotificationSent(ctx, event.NotificationSent{
+		UID:            lpaUID,
+		NotificationID: resp.ID,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type precompiledLetterWrapper struct {
+	Reference string `json:"reference"`
+	Content   string `json:"content"`
+	Postage   string `json:"postage,omitempty"`
+}
+
+// SendActorPrecompiledLetter sends a PDF that already contains the recipient's
+// address in the position Notify expects. If postage is empty then Notify will
+// use the default set for the service.
+func (c *Client) SendActorPrecompiledLetter(ctx context.Context, lpaUID string, pdf []byte, postage Postage) error {
+	ctx, span := newSpan(ctx, "PrecompiledLetter", "", "")
+	defer span.End()
+
+	contentHash := sha256.Sum256(pdf)
+	reference := c.makeReference(lpaUID, base64.RawStdEncoding.EncodeToString(contentHash[:]), "precompiled")
+	if ok, err := c.recentlySent(ctx, ChannelLetter, reference); err != nil || ok {
+		return err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "/v2/notifications/letter", precompiledLetterWrapper{
+		Reference: reference,
+		Content:   base64.StdEncoding.EncodeToString(pdf),
+		Postage:   postage.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "precompiled letter send failed", slog.String("lpa_uid", lpaUID))
+		return err
+	}
+	span.SetAttributes(attribute.KeyValue{Key: "notify_id", Value: attribute.StringValue(resp.ID)})
+
+	c.recordNotification(ctx, lpaUID, Notification{
+		TemplateType: "PrecompiledLetter",
+		Channel:      ChannelLetter,
+		NotifyID:     resp.ID,
+		Status:       "pending-virus-check",
+	})
+
+	if err := c.eventClient.SendNotificationSent(ctx, event.NotificationSent{
+		UID:            lpaUID,
+		NotificationID: resp.ID,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Template is a template as configured in Notify.
+type Template struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// Templates returns the latest version of every template in Notify.
+func (c *Client) Templates(ctx context.Context) ([]Template, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/v2/templates", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Templates, nil
+}
+
+// recordNotification adds the notification to the LPA's history. As the
+// message has already been sent a failure is logged rather than returned, so
+// that callers do not retry and send it twice.
+func (c *Client) recordNotification(ctx context.Context, lpaUID string, notification Notification) {
+	notification.SentAt = c.now()
+
+	if err := c.notificationStore.Create(ctx, lpaUID, notification); err != nil {
+		c.logger.ErrorContext(ctx, "could not record notification", slog.String("lpa_uid", lpaUID), slog.Any("err", err))
+	}
+}
+
+func templateType(v any) string {
+	return reflect.TypeOf(v).Name()
+}
+
+// letterAddressLines returns the lines of an address in the format required by
+// Notify, where the first line is the recipient's name and the last line must
+// be a UK postcode or, for international addresses, the name of a country.
+func (c *Client) letterAddressLines(name string, address place.Address) []string {
+	var lines []string
+	if name != "" {
+		lines = append(lines, name)
+	}
+
+	lines = append(lines, address.Lines()...)
+
+	if address.Country != "" && address.Country != "GB" {
+		lines = append(lines, c.bundle.For(localize.En).T("country:"+address.Country))
+	}
+
+	return lines
+}
+
+func letterPersonalisation(lines []string, letter Letter) (map[string]any, error) {
+	data, err := json.Marshal(letter)
+	if err != nil {
+		return nil, err
+	}
+
+	personalisation := map[string]any{}
+	if err := json.Unmarshal(data, &personalisation); err != nil {
+		return nil, err
+	}
+
+	for i, line := range lines {
+		personalisation[fmt.Sprintf("address_line_%d", i+1)] = line
+	}
+
+	return personalisation, nil
+}
+
+func (c *Client) recentlySent(ctx context.Context, channel Channel, ref string) (bool, error) {
 	req, err := c.newRequest(ctx, http.MethodGet, "/v2/notifications?reference="+ref, nil)
 	if err != nil {
 		return false, err
@@ -271,7 +502,7 @@ func (c *Client) recentlySent(ctx context.Context, ref string) (bool, error) {
 	}
 
 	for _, notification := range resp.Notifications {
-		if notification.Status == "sending" || notification.Status == "delivered" {
+		if slices.Contains(sentStatuses[channel], notification.Status) {
 			return true, nil
 		}
 	}
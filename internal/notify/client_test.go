@@ -12,20 +12,26 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/event"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/place"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.opentelemetry.io/otel"
 )
 
-var expectedError = errors.New("err")
+var (
+	expectedError = errors.New("err")
+	testUKAddress = place.Address{Line1: "1 Road", TownOrCity: "Town", Postcode: "A1 1AA", Country: "GB"}
+	testNow       = time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC)
+)
 
 func TestNew(t *testing.T) {
 	bundle := &localize.Bundle{}
 
-	client, err := New(nil, "http://base", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", http.DefaultClient, newMockEventClient(t), bundle)
+	client, err := New(nil, "http://base", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", http.DefaultClient, newMockEventClient(t), bundle, nil)
 
 	assert.Nil(t, err)
 	assert.Equal(t, "http://base", client.baseURL)
@@ -36,7 +42,7 @@ func TestNew(t *testing.T) {
 }
 
 func TestNewWithInvalidApiKey(t *testing.T) {
-	_, err := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f", http.DefaultClient, nil, nil)
+	_, err := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f", http.DefaultClient, nil, nil, nil)
 
 	assert.NotNil(t, err)
 }
@@ -143,7 +149,7 @@ func TestSendEmail(t *testing.T) {
 		}, nil).
 		Once()
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
 	client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
 	err := client.SendEmail(ctx, to{lang: localize.En, email: "me@example.com"}, testEmail{A: "value"})
@@ -154,7 +160,7 @@ func TestSendEmailWhenIgnored(t *testing.T) {
 	assert := assert.New(t)
 	ctx := context.Background()
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", nil, nil, nil)
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", nil, nil, nil, nil)
 
 	err := client.SendEmail(ctx, to{ignored: true}, testEmail{A: "value"})
 	assert.Nil(err)
@@ -177,7 +183,7 @@ func TestSendEmailWhenError(t *testing.T) {
 		}, nil).
 		Once()
 
-	client, _ := New(logger, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+	client, _ := New(logger, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
 
 	err := client.SendEmail(ctx, to{lang: localize.En, email: "me@example.com"}, testEmail{})
 	assert.Equal(`error sending message: This happened: Plus this`, err.Error())
@@ -187,6 +193,7 @@ func TestSendActorEmail(t *testing.T) {
 	testcases := map[string]string{
 		"not previously created": `{"notifications":[]}`,
 		"previously failed":      `{"notifications":[{"status":"temporary-failure","created_at":"2020-01-02T02:57:06Z"}]}`,
+		"letter status":          `{"notifications":[{"status":"accepted","created_at":"2020-01-02T02:57:06Z"}]}`,
 	}
 
 	for name, responseBody := range testcases {
@@ -234,10 +241,23 @@ func TestSendActorEmail(t *testing.T) {
 				SendNotificationSent(innerCtx, event.NotificationSent{UID: "lpa-uid", NotificationID: "xyz"}).
 				Return(nil)
 
-			client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, eventClient, nil)
+			notificationStore := newMockNotificationStore(t)
+			notificationStore.EXPECT().
+				Create(innerCtx, "lpa-uid", Notification{
+					TemplateType:  "testEmail",
+					TemplateID:    "template-id",
+					RecipientType: actor.TypeDonor,
+					Channel:       ChannelEmail,
+					NotifyID:      "xyz",
+					Status:        "created",
+					SentAt:        testNow,
+				}).
+				Return(nil)
+
+			client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, eventClient, nil, notificationStore)
 			client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
-			err := client.SendActorEmail(ctx, to{lang: localize.En, email: "me@example.com"}, "lpa-uid", testEmail{A: "value"})
+			err := client.SendActorEmail(ctx, to{lang: localize.En, email: "me@example.com", actorType: actor.TypeDonor}, "lpa-uid", testEmail{A: "value"})
 			assert.Nil(err)
 		})
 	}
@@ -247,7 +267,7 @@ func TestSendActorEmailWhenIgnored(t *testing.T) {
 	assert := assert.New(t)
 	ctx := context.Background()
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", nil, nil, nil)
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", nil, nil, nil, nil)
 
 	err := client.SendActorEmail(ctx, to{ignored: true}, "lpa-uid", testEmail{A: "value"})
 	assert.Nil(err)
@@ -272,7 +292,12 @@ func TestSendActorEmailWhenToSimulated(t *testing.T) {
 			}, nil).
 			Once()
 
-		client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+		notificationStore := newMockNotificationStore(t)
+		notificationStore.EXPECT().
+			Create(mock.Anything, mock.Anything, mock.Anything).
+			Return(nil)
+
+		client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, notificationStore)
 		client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
 		err := client.SendActorEmail(ctx, to{lang: localize.En, email: email}, "lpa-uid", testEmail{A: "value"})
@@ -308,7 +333,7 @@ func TestSendActorEmailWhenAlreadyRecentlyCreated(t *testing.T) {
 				}, nil).
 				Once()
 
-			client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+			client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
 			client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
 			err := client.SendActorEmail(ctx, to{lang: localize.En, email: "me@example.com"}, "lpa-uid", testEmail{A: "value"})
@@ -323,7 +348,7 @@ func TestSendActorEmailWhenReferenceExistsError(t *testing.T) {
 		Do(mock.Anything).
 		Return(nil, expectedError)
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
 
 	err := client.SendActorEmail(context.Background(), to{lang: localize.En, email: "me@example.com"}, "lpa-uid", testEmail{A: "value"})
 	assert.Equal(t, expectedError, err)
@@ -348,7 +373,7 @@ func TestSendActorEmailWhenError(t *testing.T) {
 		}, nil).
 		Once()
 
-	client, _ := New(logger, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+	client, _ := New(logger, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
 
 	err := client.SendActorEmail(context.Background(), to{lang: localize.En, email: "me@example.com"}, "lpa-uid", testEmail{})
 	assert.Equal(t, "error sending message: This happened: Plus this", err.Error())
@@ -374,7 +399,12 @@ func TestSendActorEmailWhenEventError(t *testing.T) {
 		SendNotificationSent(mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, eventClient, nil)
+	notificationStore := newMockNotificationStore(t)
+	notificationStore.EXPECT().
+		Create(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, eventClient, nil, notificationStore)
 	client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
 	err := client.SendActorEmail(context.Background(), to{lang: localize.En, email: "me@example.com"}, "lpa-uid", testEmail{A: "value"})
@@ -386,7 +416,7 @@ func TestNewRequest(t *testing.T) {
 	ctx := context.Background()
 	doer := newMockDoer(t)
 
-	client, _ := New(nil, "http://base", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+	client, _ := New(nil, "http://base", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
 	client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
 	req, err := client.newRequest(ctx, http.MethodPost, "/an/url", map[string]string{"some": "json"})
@@ -402,7 +432,7 @@ func TestNewRequestWhenNewRequestError(t *testing.T) {
 	assert := assert.New(t)
 	doer := newMockDoer(t)
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
 	client.now = func() time.Time { return time.Now().Add(-time.Minute) }
 
 	_, err := client.newRequest(nil, http.MethodPost, "/an/url", map[string]string{"some": "json"})
@@ -423,7 +453,7 @@ func TestDo(t *testing.T) {
 	var jsonBody bytes.Buffer
 	jsonBody.WriteString(jsonString)
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
 	client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
 	req, _ := client.newRequest(context.Background(), http.MethodPost, "/an/url", &jsonBody)
@@ -448,7 +478,7 @@ func TestDoWhenContainsErrorList(t *testing.T) {
 	var jsonBody bytes.Buffer
 	jsonBody.WriteString(jsonString)
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
 	client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
 	req, _ := client.newRequest(context.Background(), http.MethodPost, "/an/url", &jsonBody)
@@ -488,7 +518,7 @@ func TestDoRequestWhenRequestError(t *testing.T) {
 	var jsonBody bytes.Buffer
 	jsonBody.WriteString(`{"id": "123"}`)
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
 	client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
 	req, _ := client.newRequest(context.Background(), http.MethodPost, "/an/url", &jsonBody)
@@ -510,7 +540,7 @@ func TestDoRequestWhenJsonDecodeFails(t *testing.T) {
 	var jsonBody bytes.Buffer
 	jsonBody.WriteString(`not json`)
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
 	client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
 	req, _ := client.newRequest(context.Background(), http.MethodPost, "/an/url", &jsonBody)
@@ -552,10 +582,23 @@ func TestSendActorSMS(t *testing.T) {
 		SendNotificationSent(innerCtx, event.NotificationSent{UID: "lpa-uid", NotificationID: "xyz"}).
 		Return(nil)
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, eventClient, nil)
+	notificationStore := newMockNotificationStore(t)
+	notificationStore.EXPECT().
+		Create(innerCtx, "lpa-uid", Notification{
+			TemplateType:  "testSMS",
+			TemplateID:    "template-id",
+			RecipientType: actor.TypeCertificateProvider,
+			Channel:       ChannelSMS,
+			NotifyID:      "xyz",
+			Status:        "created",
+			SentAt:        testNow,
+		}).
+		Return(nil)
+
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, eventClient, nil, notificationStore)
 	client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
-	err := client.SendActorSMS(ctx, to{lang: localize.En, mobile: "+447535111111"}, "lpa-uid", testSMS{A: "value"})
+	err := client.SendActorSMS(ctx, to{lang: localize.En, mobile: "+447535111111", actorType: actor.TypeCertificateProvider}, "lpa-uid", testSMS{A: "value"})
 	assert.Nil(err)
 }
 
@@ -563,7 +606,7 @@ func TestSendActorSMSWhenIgnored(t *testing.T) {
 	assert := assert.New(t)
 	ctx := context.Background()
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", nil, nil, nil)
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", nil, nil, nil, nil)
 
 	err := client.SendActorSMS(ctx, to{ignored: true}, "lpa-uid", testSMS{A: "value"})
 	assert.Nil(err)
@@ -578,7 +621,12 @@ func TestSendActorSMSWhenToSimulated(t *testing.T) {
 				Body: io.NopCloser(strings.NewReader(`{"id":"xyz"}`)),
 			}, nil)
 
-		client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+		notificationStore := newMockNotificationStore(t)
+		notificationStore.EXPECT().
+			Create(mock.Anything, mock.Anything, mock.Anything).
+			Return(nil)
+
+		client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, notificationStore)
 		client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
 		err := client.SendActorSMS(context.Background(), to{lang: localize.En, mobile: phone}, "lpa-uid", testSMS{A: "value"})
@@ -594,7 +642,7 @@ func TestSendActorSMSWhenError(t *testing.T) {
 			Body: io.NopCloser(strings.NewReader(`{"errors":[{"error":"SomeError","message":"This happened"}, {"error":"AndError","message":"Plus this"}]}`)),
 		}, nil)
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil)
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
 
 	err := client.SendActorSMS(context.Background(), to{lang: localize.En, mobile: "+447535111111"}, "lpa-uid", testSMS{})
 	assert.Equal(t, "error sending message: This happened: Plus this", err.Error())
@@ -611,7 +659,12 @@ func TestSendActorSMSWhenEventError(t *testing.T) {
 		SendNotificationSent(mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, eventClient, nil)
+	notificationStore := newMockNotificationStore(t)
+	notificationStore.EXPECT().
+		Create(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, eventClient, nil, notificationStore)
 	client.now = func() time.Time { return time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC) }
 
 	err := client.SendActorSMS(context.Background(), to{lang: localize.En, mobile: "+447535111111"}, "lpa-uid", testSMS{A: "value"})
@@ -624,3 +677,353 @@ func readBody(req *http.Request) *bytes.Buffer {
 	req.Body = io.NopCloser(&buf)
 	return &buf
 }
+
+type testLetter struct {
+	A string
+}
+
+func (e testLetter) letterID(localize.Lang) string { return "template-id" }
+
+func TestSendActorLetter(t *testing.T) {
+	testcases := map[string]struct {
+		address        place.Address
+		expectedLines  map[string]any
+		recentResponse string
+	}{
+		"uk address": {
+			address: place.Address{Line1: "1 Road", TownOrCity: "Town", Postcode: "A1 1AA", Country: "GB"},
+			expectedLines: map[string]any{
+				"address_line_1": "John Smith",
+				"address_line_2": "1 Road",
+				"address_line_3": "Town",
+				"address_line_4": "A1 1AA",
+			},
+			recentResponse: `{"notifications":[]}`,
+		},
+		"international address": {
+			address: place.Address{Line1: "1 Rue", Line2: "Paris", Postcode: "75001", Country: "FR"},
+			expectedLines: map[string]any{
+				"address_line_1": "John Smith",
+				"address_line_2": "1 Rue",
+				"address_line_3": "Paris",
+				"address_line_4": "75001",
+				"address_line_5": "France",
+			},
+			recentResponse: `{"notifications":[{"status":"validation-failed","created_at":"2020-01-02T02:57:06Z"}]}`,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			ctx := context.Background()
+			innerCtx, _ := otel.GetTracerProvider().Tracer("mlpab").Start(ctx, "")
+
+			bundle, _ := localize.NewBundle("testdata/en.json", "testdata/cy.json")
+			client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", nil, nil, bundle, nil)
+			reference := client.makeReference("lpa-uid", tc.address.Encode(), "template-id")
+
+			doer := newMockDoer(t)
+			doer.EXPECT().
+				Do(mock.MatchedBy(func(req *http.Request) bool {
+					return req.Method == http.MethodGet &&
+						assert.Equal("/v2/notifications?reference="+reference, req.URL.String())
+				})).
+				Return(&http.Response{
+					Body: io.NopCloser(strings.NewReader(tc.recentResponse)),
+				}, nil).
+				Once()
+			doer.EXPECT().
+				Do(mock.MatchedBy(func(req *http.Request) bool {
+					if req.Method != http.MethodPost {
+						return false
+					}
+
+					var v map[string]any
+					json.Unmarshal(readBody(req).Bytes(), &v)
+
+					expectedPersonalisation := map[string]any{"A": "value"}
+					for k, line := range tc.expectedLines {
+						expectedPersonalisation[k] = line
+					}
+
+					return assert.Equal("/v2/notifications/letter", req.URL.String()) &&
+						assert.Equal("template-id", v["template_id"].(string)) &&
+						assert.Equal(expectedPersonalisation, v["personalisation"].(map[string]any)) &&
+						assert.Equal(reference, v["reference"].(string))
+				})).
+				Return(&http.Response{
+					Body: io.NopCloser(strings.NewReader(`{"id":"xyz"}`)),
+				}, nil).
+				Once()
+
+			eventClient := newMockEventClient(t)
+			eventClient.EXPECT().
+				SendNotificationSent(innerCtx, event.NotificationSent{UID: "lpa-uid", NotificationID: "xyz"}).
+				Return(nil)
+
+			notificationStore := newMockNotificationStore(t)
+			notificationStore.EXPECT().
+				Create(innerCtx, "lpa-uid", Notification{
+					TemplateType:  "testLetter",
+					TemplateID:    "template-id",
+					RecipientType: actor.TypeAttorney,
+					Channel:       ChannelLetter,
+					NotifyID:      "xyz",
+					Status:        "created",
+					SentAt:        testNow,
+				}).
+				Return(nil)
+
+			client.doer = doer
+			client.eventClient = eventClient
+			client.notificationStore = notificationStore
+			client.now = func() time.Time { return testNow }
+
+			err := client.SendActorLetter(ctx, to{lang: localize.En, name: "John Smith", address: tc.address, actorType: actor.TypeAttorney}, "lpa-uid", testLetter{A: "value"})
+			assert.Nil(err)
+		})
+	}
+}
+
+func TestSendActorLetterWhenIgnored(t *testing.T) {
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", nil, nil, nil, nil)
+
+	err := client.SendActorLetter(context.Background(), to{ignored: true}, "lpa-uid", testLetter{A: "value"})
+	assert.Nil(t, err)
+}
+
+func TestSendActorLetterWhenAddressTooShort(t *testing.T) {
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", nil, nil, nil, nil)
+
+	err := client.SendActorLetter(context.Background(), to{address: place.Address{Line1: "1 Road"}, name: "John Smith"}, "lpa-uid", testLetter{A: "value"})
+	assert.Equal(t, ErrLetterAddressTooShort, err)
+}
+
+func TestSendActorLetterWhenAlreadyRecentlyCreated(t *testing.T) {
+	for _, status := range []string{"accepted", "received", "pending-virus-check"} {
+		t.Run(status, func(t *testing.T) {
+			doer := newMockDoer(t)
+			doer.EXPECT().
+				Do(mock.Anything).
+				Return(&http.Response{
+					Body: io.NopCloser(strings.NewReader(`{"notifications":[{"status":"` + status + `","created_at":"2020-01-02T02:57:06Z"}]}`)),
+				}, nil).
+				Once()
+
+			client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
+
+			err := client.SendActorLetter(context.Background(), to{address: testUKAddress}, "lpa-uid", testLetter{A: "value"})
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestSendActorLetterWhenError(t *testing.T) {
+	ctx := context.Background()
+	innerCtx, _ := otel.GetTracerProvider().Tracer("mlpab").Start(ctx, "")
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		ErrorContext(innerCtx, "letter send failed", slog.String("lpa_uid", "lpa-uid"))
+
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{Body: io.NopCloser(strings.NewReader(`{"notifications":[]}`))}, nil).
+		Once()
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{
+			Body: io.NopCloser(strings.NewReader(`{"errors":[{"error":"BadRequestError","message":"Must be a real UK postcode"}]}`)),
+		}, nil).
+		Once()
+
+	client, _ := New(logger, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
+
+	err := client.SendActorLetter(ctx, to{address: testUKAddress}, "lpa-uid", testLetter{A: "value"})
+	assert.Equal(t, "error sending message: Must be a real UK postcode", err.Error())
+}
+
+func TestSendActorLetterWhenEventError(t *testing.T) {
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{Body: io.NopCloser(strings.NewReader(`{"notifications":[]}`))}, nil).
+		Once()
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{Body: io.NopCloser(strings.NewReader(`{"id":"xyz"}`))}, nil).
+		Once()
+
+	eventClient := newMockEventClient(t)
+	eventClient.EXPECT().
+		SendNotificationSent(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	notificationStore := newMockNotificationStore(t)
+	notificationStore.EXPECT().
+		Create(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil)
+
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, eventClient, nil, notificationStore)
+
+	err := client.SendActorLetter(context.Background(), to{address: testUKAddress}, "lpa-uid", testLetter{A: "value"})
+	assert.Equal(t, expectedError, err)
+}
+
+func TestSendActorPrecompiledLetter(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	innerCtx, _ := otel.GetTracerProvider().Tracer("mlpab").Start(ctx, "")
+
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.MatchedBy(func(req *http.Request) bool {
+			return req.Method == http.MethodGet
+		})).
+		Return(&http.Response{Body: io.NopCloser(strings.NewReader(`{"notifications":[]}`))}, nil).
+		Once()
+	doer.EXPECT().
+		Do(mock.MatchedBy(func(req *http.Request) bool {
+			if req.Method != http.MethodPost {
+				return false
+			}
+
+			var v map[string]any
+			json.Unmarshal(readBody(req).Bytes(), &v)
+
+			return assert.Equal("/v2/notifications/letter", req.URL.String()) &&
+				assert.Equal("JVBERi0=", v["content"].(string)) &&
+				assert.Equal("first", v["postage"].(string)) &&
+				assert.NotEmpty(v["reference"])
+		})).
+		Return(&http.Response{
+			Body: io.NopCloser(strings.NewReader(`{"id":"xyz","reference":"ref","postage":"first"}`)),
+		}, nil).
+		Once()
+
+	eventClient := newMockEventClient(t)
+	eventClient.EXPECT().
+		SendNotificationSent(innerCtx, event.NotificationSent{UID: "lpa-uid", NotificationID: "xyz"}).
+		Return(nil)
+
+	notificationStore := newMockNotificationStore(t)
+	notificationStore.EXPECT().
+		Create(innerCtx, "lpa-uid", mock.MatchedBy(func(n Notification) bool {
+			return n.TemplateType == "PrecompiledLetter" && n.Channel == ChannelLetter && n.NotifyID == "xyz" && n.Status == "pending-virus-check"
+		})).
+		Return(nil)
+
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, eventClient, nil, notificationStore)
+
+	err := client.SendActorPrecompiledLetter(ctx, "lpa-uid", []byte("%PDF-"), PostageFirst)
+	assert.Nil(err)
+}
+
+func TestSendActorPrecompiledLetterWhenAlreadyRecentlyCreated(t *testing.T) {
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{
+			Body: io.NopCloser(strings.NewReader(`{"notifications":[{"status":"pending-virus-check","created_at":"2020-01-02T02:57:06Z"}]}`)),
+		}, nil).
+		Once()
+
+	client, _ := New(nil, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
+
+	err := client.SendActorPrecompiledLetter(context.Background(), "lpa-uid", []byte("%PDF-"), PostageFirst)
+	assert.Nil(t, err)
+}
+
+func TestSendActorPrecompiledLetterWhenError(t *testing.T) {
+	ctx := context.Background()
+	innerCtx, _ := otel.GetTracerProvider().Tracer("mlpab").Start(ctx, "")
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		ErrorContext(innerCtx, "precompiled letter send failed", slog.String("lpa_uid", "lpa-uid"))
+
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{Body: io.NopCloser(strings.NewReader(`{"notifications":[]}`))}, nil).
+		Once()
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{
+			Body: io.NopCloser(strings.NewReader(`{"errors":[{"error":"ValidationError","message":"Letter content is not a valid PDF"}]}`)),
+		}, nil).
+		Once()
+
+	client, _ := New(logger, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
+
+	err := client.SendActorPrecompiledLetter(ctx, "lpa-uid", []byte("%PDF-"), PostageFirst)
+	assert.Equal(t, "error sending message: Letter content is not a valid PDF", err.Error())
+}
+
+func TestSendActorEmailWhenRecordNotificationError(t *testing.T) {
+	ctx := context.Background()
+	innerCtx, _ := otel.GetTracerProvider().Tracer("mlpab").Start(ctx, "")
+
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{Body: io.NopCloser(strings.NewReader(`{"notifications":[]}`))}, nil).
+		Once()
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{Body: io.NopCloser(strings.NewReader(`{"id":"xyz"}`))}, nil).
+		Once()
+
+	eventClient := newMockEventClient(t)
+	eventClient.EXPECT().
+		SendNotificationSent(mock.Anything, mock.Anything).
+		Return(nil)
+
+	notificationStore := newMockNotificationStore(t)
+	notificationStore.EXPECT().
+		Create(mock.Anything, mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		ErrorContext(innerCtx, "could not record notification", slog.String("lpa_uid", "lpa-uid"), slog.Any("err", expectedError))
+
+	client, _ := New(logger, "", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, eventClient, nil, notificationStore)
+
+	err := client.SendActorEmail(ctx, to{lang: localize.En, email: "me@example.com"}, "lpa-uid", testEmail{A: "value"})
+	assert.Nil(t, err)
+}
+
+func TestTemplates(t *testing.T) {
+	ctx := context.Background()
+
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.MatchedBy(func(req *http.Request) bool {
+			return assert.Equal(t, http.MethodGet, req.Method) &&
+				assert.Equal(t, "http://base/v2/templates", req.URL.String())
+		})).
+		Return(&http.Response{
+			Body: io.NopCloser(strings.NewReader(`{"templates":[{"id":"template-id","name":"A template","type":"email","subject":"Hello ((Name))","body":"Body"}]}`)),
+		}, nil)
+
+	client, _ := New(nil, "http://base", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
+
+	templates, err := client.Templates(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []Template{{ID: "template-id", Name: "A template", Type: "email", Subject: "Hello ((Name))", Body: "Body"}}, templates)
+}
+
+func TestTemplatesWhenError(t *testing.T) {
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(nil, expectedError)
+
+	client, _ := New(nil, "http://base", "my_client-f33517ff-2a88-4f6e-b855-c550268ce08a-740e5834-3a29-46b4-9a6f-16142fde533a", doer, nil, nil, nil)
+
+	_, err := client.Templates(context.Background())
+	assert.Equal(t, expectedError, err)
+}
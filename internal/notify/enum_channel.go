@@ -0,0 +1,89 @@
+// Code generated by "enumerator -type Channel -linecomment -trimprefix -empty"; DO NOT EDIT.
+
+package notify
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ChannelEmail-1]
+	_ = x[ChannelSMS-2]
+	_ = x[ChannelLetter-3]
+}
+
+const _Channel_name = "emailsmsletter"
+
+var _Channel_index = [...]uint8{0, 5, 8, 14}
+
+func (i Channel) String() string {
+	if i == 0 {
+		return ""
+	}
+	i -= 1
+	if i >= Channel(len(_Channel_index)-1) {
+		return "Channel(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _Channel_name[_Channel_index[i]:_Channel_index[i+1]]
+}
+
+func (i Channel) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *Channel) UnmarshalText(text []byte) error {
+	val, err := ParseChannel(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
+
+func (i Channel) IsEmail() bool {
+	return i == ChannelEmail
+}
+
+func (i Channel) IsSMS() bool {
+	return i == ChannelSMS
+}
+
+func (i Channel) IsLetter() bool {
+	return i == ChannelLetter
+}
+
+func ParseChannel(s string) (Channel, error) {
+	switch s {
+	case "":
+		return Channel(0), nil
+	case "email":
+		return ChannelEmail, nil
+	case "sms":
+		return ChannelSMS, nil
+	case "letter":
+		return ChannelLetter, nil
+	default:
+		return Channel(0), fmt.Errorf("invalid Channel '%s'", s)
+	}
+}
+
+type ChannelOptions struct {
+	Email  Channel
+	SMS    Channel
+	Letter Channel
+}
+
+var ChannelValues = ChannelOptions{
+	Email:  ChannelEmail,
+	SMS:    ChannelSMS,
+	Letter: ChannelLetter,
+}
+
+func (i Channel) Empty() bool {
+	return i == Channel(0)
+}
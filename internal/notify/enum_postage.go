@@ -0,0 +1,107 @@
+// Code generated by "enumerator -type Postage -linecomment -empty"; DO NOT EDIT.
+
+package notify
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[PostageFirst-1]
+	_ = x[PostageSecond-2]
+	_ = x[PostageEconomy-3]
+	_ = x[PostageEurope-4]
+	_ = x[PostageRestOfWorld-5]
+}
+
+const _Postage_name = "firstsecondeconomyeuroperest-of-world"
+
+var _Postage_index = [...]uint8{0, 5, 11, 18, 24, 37}
+
+func (i Postage) String() string {
+	if i == 0 {
+		return ""
+	}
+	i -= 1
+	if i >= Postage(len(_Postage_index)-1) {
+		return "Postage(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _Postage_name[_Postage_index[i]:_Postage_index[i+1]]
+}
+
+func (i Postage) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *Postage) UnmarshalText(text []byte) error {
+	val, err := ParsePostage(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
+
+func (i Postage) IsPostageFirst() bool {
+	return i == PostageFirst
+}
+
+func (i Postage) IsPostageSecond() bool {
+	return i == PostageSecond
+}
+
+func (i Postage) IsPostageEconomy() bool {
+	return i == PostageEconomy
+}
+
+func (i Postage) IsPostageEurope() bool {
+	return i == PostageEurope
+}
+
+func (i Postage) IsPostageRestOfWorld() bool {
+	return i == PostageRestOfWorld
+}
+
+func ParsePostage(s string) (Postage, error) {
+	switch s {
+	case "":
+		return Postage(0), nil
+	case "first":
+		return PostageFirst, nil
+	case "second":
+		return PostageSecond, nil
+	case "economy":
+		return PostageEconomy, nil
+	case "europe":
+		return PostageEurope, nil
+	case "rest-of-world":
+		return PostageRestOfWorld, nil
+	default:
+		return Postage(0), fmt.Errorf("invalid Postage '%s'", s)
+	}
+}
+
+type PostageOptions struct {
+	PostageFirst       Postage
+	PostageSecond      Postage
+	PostageEconomy     Postage
+	PostageEurope      Postage
+	PostageRestOfWorld Postage
+}
+
+var PostageValues = PostageOptions{
+	PostageFirst:       PostageFirst,
+	PostageSecond:      PostageSecond,
+	PostageEconomy:     PostageEconomy,
+	PostageEurope:      PostageEurope,
+	PostageRestOfWorld: PostageRestOfWorld,
+}
+
+func (i Postage) Empty() bool {
+	return i == Postage(0)
+}
@@ -0,0 +1,35 @@
+package notify
+
+import "github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
+
+type Letter interface {
+	letterID(localize.Lang) string
+}
+
+//go:generate go tool enumerator -type Postage -linecomment -empty
+type Postage uint8
+
+const (
+	PostageFirst       Postage = iota + 1 // first
+	PostageSecond                         // second
+	PostageEconomy                        // economy
+	PostageEurope                         // europe
+	PostageRestOfWorld                    // rest-of-world
+)
+
+type AdviseAttorneyToSignOrOptOutLetter struct {
+	DonorFullName           string
+	DonorFullNamePossessive string
+	LpaType                 string
+	AttorneyFullName        string
+	InvitedDate             string
+	DeadlineDate            string
+}
+
+func (l AdviseAttorneyToSignOrOptOutLetter) letterID(lang localize.Lang) string {
+	if lang.IsCy() {
+		return "b6f8e4a1-8e64-4d5f-9c37-2f1f0c8a3d52"
+	}
+
+	return "5d2c6e0b-3a7f-4b8e-a1c9-7e4f2d9b0a13"
+}
@@ -3,7 +3,9 @@
 package notify
 
 import (
+	actor "github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
 	localize "github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -65,6 +67,52 @@ func (_c *mockToMobile_ignore_Call) RunAndReturn(run func() bool) *mockToMobile_
 	return _c
 }
 
+// recipientType provides a mock function with given fields: _a0
+func (_m *mockToMobile) recipientType(_a0 Channel) actor.Type {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for recipientType")
+	}
+
+	var r0 actor.Type
+	if rf, ok := ret.Get(0).(func(Channel) actor.Type); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(actor.Type)
+	}
+
+	return r0
+}
+
+// mockToMobile_recipientType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'recipientType'
+type mockToMobile_recipientType_Call struct {
+	*mock.Call
+}
+
+// recipientType is a helper method to define mock.On call
+//   - _a0 Channel
+func (_e *mockToMobile_Expecter) recipientType(_a0 interface{}) *mockToMobile_recipientType_Call {
+	return &mockToMobile_recipientType_Call{Call: _e.mock.On("recipientType", _a0)}
+}
+
+func (_c *mockToMobile_recipientType_Call) Run(run func(_a0 Channel)) *mockToMobile_recipientType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(Channel))
+	})
+	return _c
+}
+
+func (_c *mockToMobile_recipientType_Call) Return(_a0 actor.Type) *mockToMobile_recipientType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockToMobile_recipientType_Call) RunAndReturn(run func(Channel) actor.Type) *mockToMobile_recipientType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // toMobile provides a mock function with no fields
 func (_m *mockToMobile) toMobile() (string, localize.Lang) {
 	ret := _m.Called()
@@ -3,8 +3,12 @@
 package notify
 
 import (
+	actor "github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
 	localize "github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
+
 	mock "github.com/stretchr/testify/mock"
+
+	place "github.com/ministryofjustice/opg-modernising-lpa/internal/place"
 )
 
 // mockTo is an autogenerated mock type for the To type
@@ -65,6 +69,52 @@ func (_c *mockTo_ignore_Call) RunAndReturn(run func() bool) *mockTo_ignore_Call
 	return _c
 }
 
+// recipientType provides a mock function with given fields: _a0
+func (_m *mockTo) recipientType(_a0 Channel) actor.Type {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for recipientType")
+	}
+
+	var r0 actor.Type
+	if rf, ok := ret.Get(0).(func(Channel) actor.Type); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(actor.Type)
+	}
+
+	return r0
+}
+
+// mockTo_recipientType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'recipientType'
+type mockTo_recipientType_Call struct {
+	*mock.Call
+}
+
+// recipientType is a helper method to define mock.On call
+//   - _a0 Channel
+func (_e *mockTo_Expecter) recipientType(_a0 interface{}) *mockTo_recipientType_Call {
+	return &mockTo_recipientType_Call{Call: _e.mock.On("recipientType", _a0)}
+}
+
+func (_c *mockTo_recipientType_Call) Run(run func(_a0 Channel)) *mockTo_recipientType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(Channel))
+	})
+	return _c
+}
+
+func (_c *mockTo_recipientType_Call) Return(_a0 actor.Type) *mockTo_recipientType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockTo_recipientType_Call) RunAndReturn(run func(Channel) actor.Type) *mockTo_recipientType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // toEmail provides a mock function with no fields
 func (_m *mockTo) toEmail() (string, localize.Lang) {
 	ret := _m.Called()
@@ -120,6 +170,61 @@ func (_c *mockTo_toEmail_Call) RunAndReturn(run func() (string, localize.Lang))
 	return _c
 }
 
+// toLetter provides a mock function with no fields
+func (_m *mockTo) toLetter() (place.Address, localize.Lang) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for toLetter")
+	}
+
+	var r0 place.Address
+	var r1 localize.Lang
+	if rf, ok := ret.Get(0).(func() (place.Address, localize.Lang)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() place.Address); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(place.Address)
+	}
+
+	if rf, ok := ret.Get(1).(func() localize.Lang); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(localize.Lang)
+	}
+
+	return r0, r1
+}
+
+// mockTo_toLetter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'toLetter'
+type mockTo_toLetter_Call struct {
+	*mock.Call
+}
+
+// toLetter is a helper method to define mock.On call
+func (_e *mockTo_Expecter) toLetter() *mockTo_toLetter_Call {
+	return &mockTo_toLetter_Call{Call: _e.mock.On("toLetter")}
+}
+
+func (_c *mockTo_toLetter_Call) Run(run func()) *mockTo_toLetter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockTo_toLetter_Call) Return(_a0 place.Address, _a1 localize.Lang) *mockTo_toLetter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockTo_toLetter_Call) RunAndReturn(run func() (place.Address, localize.Lang)) *mockTo_toLetter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // toMobile provides a mock function with no fields
 func (_m *mockTo) toMobile() (string, localize.Lang) {
 	ret := _m.Called()
@@ -0,0 +1,36 @@
+package notify
+
+import (
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+)
+
+//go:generate go tool enumerator -type Channel -linecomment -trimprefix -empty
+type Channel uint8
+
+const (
+	ChannelEmail  Channel = iota + 1 // email
+	ChannelSMS                       // sms
+	ChannelLetter                    // letter
+)
+
+// A Notification records an email, SMS or letter that has been sent about an
+// LPA.
+type Notification struct {
+	PK dynamo.LpaKeyType
+	SK dynamo.NotificationKeyType
+	// TemplateType is the name of the Email, SMS or Letter that was sent, for
+	// example "CertificateProviderInviteEmail"
+	TemplateType string
+	TemplateID   string
+	// RecipientType is the actor the notification was addressed to, it may be
+	// empty when sent to a custom address
+	RecipientType actor.Type
+	Channel       Channel
+	NotifyID      string
+	// Status is the Notify status at the time of sending
+	Status string
+	SentAt time.Time
+}
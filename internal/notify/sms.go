@@ -65,6 +65,20 @@ func (s WitnessCodeSMS) smsID(lang localize.Lang) string {
 	return "e39849c0-ecab-4e16-87ec-6b22afb9d535"
 }
 
+type WitnessAuthenticatorSetupSMS struct {
+	SetupURI      string
+	DonorFullName string
+	LpaType       string
+}
+
+func (s WitnessAuthenticatorSetupSMS) smsID(lang localize.Lang) string {
+	if lang.IsCy() {
+		return "8d3f6a2e-51c4-4b7e-9a0d-2e6c1f47b953"
+	}
+
+	return "c27e94b1-0a6d-4f85-b3e2-7d19a5c8e604"
+}
+
 type VouchingAccessCodeSMS struct {
 	AccessCode                string
 	DonorFullNamePossessive   string
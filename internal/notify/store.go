@@ -0,0 +1,67 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+)
+
+type DynamoClient interface {
+	AllByPartialSK(ctx context.Context, pk dynamo.PK, partialSK dynamo.SK, v interface{}) error
+	Create(ctx context.Context, v interface{}) error
+	OneByUID(ctx context.Context, uid string) (dynamo.Keys, error)
+}
+
+// Store records the notifications sent about an LPA.
+type Store struct {
+	dynamoClient DynamoClient
+}
+
+func NewStore(dynamoClient DynamoClient) *Store {
+	return &Store{dynamoClient: dynamoClient}
+}
+
+// Create records a notification against the LPA with the given UID.
+func (s *Store) Create(ctx context.Context, lpaUID string, notification Notification) error {
+	keys, err := s.dynamoClient.OneByUID(ctx, lpaUID)
+	if err != nil {
+		return err
+	}
+
+	lpaKey, ok := keys.PK.(dynamo.LpaKeyType)
+	if !ok {
+		return errors.New("notifyStore.Create could not resolve LPA key")
+	}
+
+	notification.PK = lpaKey
+	notification.SK = dynamo.NotificationKey(notification.SentAt, notification.NotifyID)
+
+	return s.dynamoClient.Create(ctx, notification)
+}
+
+// GetAll returns the notifications sent about the LPA in the session, most
+// recent first.
+func (s *Store) GetAll(ctx context.Context) ([]Notification, error) {
+	data, err := appcontext.SessionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if data.LpaID == "" {
+		return nil, errors.New("notifyStore.GetAll requires LpaID")
+	}
+
+	var notifications []Notification
+	if err := s.dynamoClient.AllByPartialSK(ctx, dynamo.LpaKey(data.LpaID), dynamo.PartialNotificationKey(), &notifications); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(notifications, func(a, b Notification) int {
+		return b.SentAt.Compare(a.SentAt)
+	})
+
+	return notifications, nil
+}
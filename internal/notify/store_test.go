@@ -0,0 +1,117 @@
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStoreCreate(t *testing.T) {
+	ctx := context.Background()
+	sentAt := time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC)
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneByUID(ctx, "lpa-uid").
+		Return(dynamo.Keys{PK: dynamo.LpaKey("lpa-id"), SK: dynamo.DonorKey("session-id")}, nil)
+	dynamoClient.EXPECT().
+		Create(ctx, Notification{
+			PK:       dynamo.LpaKey("lpa-id"),
+			SK:       dynamo.NotificationKey(sentAt, "notify-id"),
+			Channel:  ChannelEmail,
+			NotifyID: "notify-id",
+			SentAt:   sentAt,
+		}).
+		Return(nil)
+
+	store := NewStore(dynamoClient)
+	err := store.Create(ctx, "lpa-uid", Notification{Channel: ChannelEmail, NotifyID: "notify-id", SentAt: sentAt})
+	assert.Nil(t, err)
+}
+
+func TestStoreCreateWhenOneByUIDErrors(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneByUID(mock.Anything, mock.Anything).
+		Return(dynamo.Keys{}, expectedError)
+
+	store := NewStore(dynamoClient)
+	err := store.Create(context.Background(), "lpa-uid", Notification{})
+	assert.Equal(t, expectedError, err)
+}
+
+func TestStoreCreateWhenNotLpaKey(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneByUID(mock.Anything, mock.Anything).
+		Return(dynamo.Keys{PK: dynamo.OrganisationKey("org-id")}, nil)
+
+	store := NewStore(dynamoClient)
+	err := store.Create(context.Background(), "lpa-uid", Notification{})
+	assert.Error(t, err)
+}
+
+func TestStoreCreateWhenCreateErrors(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneByUID(mock.Anything, mock.Anything).
+		Return(dynamo.Keys{PK: dynamo.LpaKey("lpa-id")}, nil)
+	dynamoClient.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	store := NewStore(dynamoClient)
+	err := store.Create(context.Background(), "lpa-uid", Notification{})
+	assert.Equal(t, expectedError, err)
+}
+
+func TestStoreGetAll(t *testing.T) {
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{LpaID: "lpa-id"})
+	older := Notification{NotifyID: "a", SentAt: time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC)}
+	newer := Notification{NotifyID: "b", SentAt: time.Date(2020, time.January, 3, 3, 4, 5, 6, time.UTC)}
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		AllByPartialSK(ctx, dynamo.LpaKey("lpa-id"), dynamo.PartialNotificationKey(), mock.Anything).
+		Return(nil).
+		Run(func(_ context.Context, _ dynamo.PK, _ dynamo.SK, v interface{}) {
+			*v.(*[]Notification) = []Notification{older, newer}
+		})
+
+	store := NewStore(dynamoClient)
+	notifications, err := store.GetAll(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []Notification{newer, older}, notifications)
+}
+
+func TestStoreGetAllWhenSessionMissing(t *testing.T) {
+	store := NewStore(nil)
+	_, err := store.GetAll(context.Background())
+	assert.Equal(t, appcontext.SessionMissingError{}, err)
+}
+
+func TestStoreGetAllWhenLpaIDMissing(t *testing.T) {
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{})
+
+	store := NewStore(nil)
+	_, err := store.GetAll(ctx)
+	assert.Error(t, err)
+}
+
+func TestStoreGetAllWhenDynamoErrors(t *testing.T) {
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{LpaID: "lpa-id"})
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		AllByPartialSK(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	store := NewStore(dynamoClient)
+	_, err := store.GetAll(ctx)
+	assert.Equal(t, expectedError, err)
+}
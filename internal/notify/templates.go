@@ -0,0 +1,138 @@
+package notify
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
+)
+
+// A TemplateDefinition describes the Notify templates used for an email, SMS
+// or letter, along with the personalisation fields that will be sent.
+type TemplateDefinition struct {
+	Name      string
+	Channel   Channel
+	EnglishID string
+	WelshID   string
+	Fields    []string
+}
+
+// HasWelsh returns true when a separate template is used for Welsh.
+func (d TemplateDefinition) HasWelsh() bool {
+	return d.WelshID != d.EnglishID
+}
+
+// The lists below should contain every Email, SMS and Letter that can be sent,
+// so that their templates can be checked against Notify.
+
+var emails = []Email{
+	InitialOriginalAttorneyEmail{},
+	InitialReplacementAttorneyEmail{},
+	CertificateProviderCertificateProvidedEmail{},
+	CertificateProviderInviteEmail{},
+	CertificateProviderProvideCertificatePromptEmail{},
+	CertificateProviderProvideCertificatePromptEmailAccessCodeUsed{},
+	OrganisationMemberInviteEmail{},
+	DonorAccessEmail{},
+	CertificateProviderOptedOutPreWitnessingEmail{},
+	CertificateProviderOptedOutPostWitnessingEmail{},
+	CertificateProviderFailedIdentityCheckEmail{},
+	PaymentConfirmationEmail{},
+	AttorneyOptedOutEmail{},
+	DonorIdentityCheckExpiredEmail{},
+	VouchingAccessCodeEmail{},
+	VoucherInviteEmail{},
+	VouchingFailedAttemptEmail{},
+	VoucherHasConfirmedDonorIdentityEmail{},
+	VoucherHasConfirmedDonorIdentityOnSignedLpaEmail{},
+	VoucherInformedTheyAreNoLongerNeededToVouchEmail{},
+	AdviseCertificateProviderToSignOrOptOutEmail{},
+	AdviseCertificateProviderToSignOrOptOutEmailAccessCodeUsed{},
+	InformDonorCertificateProviderHasNotActedEmail{},
+	AdviseCertificateProviderToConfirmIdentityEmail{},
+	InformDonorCertificateProviderHasNotConfirmedIdentityEmail{},
+	InformDonorAttorneyHasNotActedEmail{},
+	InformDonorPaperAttorneyHasNotActedEmail{},
+	AdviseAttorneyToSignOrOptOutEmail{},
+	AdviseAttorneyToSignOrOptOutEmailAccessCodeUsed{},
+	DigitalDonorLpaSubmittedEmail{},
+	DigitalDonorCertificateProvidedEmail{},
+	InformDonorPaperCertificateProviderHasNotActedEmail{},
+	InformDonorPaperCertificateProviderHasNotConfirmedIdentityEmail{},
+	VoucherLpaDeleted{},
+	VoucherLpaRevoked{},
+	AttorneyLpaRevoked{},
+	InformCertificateProviderLPAHasBeenDeleted{},
+	InformCertificateProviderLPAHasBeenRevoked{},
+	InformDonorPaperCertificateProviderIdentityCheckFailed{},
+	CorrespondentInformedVouchingInProgress{},
+	CertificateProviderRemoved{},
+}
+
+var smses = []SMS{
+	CertificateProviderActingDigitallyHasConfirmedPersonalDetailsLPADetailsChangedPromptSMS{},
+	CertificateProviderActingDigitallyHasNotConfirmedPersonalDetailsLPADetailsChangedPromptSMS{},
+	CertificateProviderActingOnPaperDetailsChangedSMS{},
+	CertificateProviderActingOnPaperMeetingPromptSMS{},
+	WitnessCodeSMS{},
+	WitnessAuthenticatorSetupSMS{},
+	VouchingAccessCodeSMS{},
+	VoucherHasConfirmedDonorIdentitySMS{},
+	VoucherHasConfirmedDonorIdentityOnSignedLpaSMS{},
+	PaperDonorLpaSubmittedSMS{},
+	PaperDonorCertificateProvidedSMS{},
+	OnlineDonorLPASubmissionConfirmation{},
+}
+
+var letters = []Letter{
+	AdviseAttorneyToSignOrOptOutLetter{},
+}
+
+// TemplateDefinitions returns the definitions for every email, SMS and letter
+// that can be sent.
+func TemplateDefinitions() []TemplateDefinition {
+	var definitions []TemplateDefinition
+
+	for _, email := range emails {
+		definitions = append(definitions, newTemplateDefinition(email, ChannelEmail, email.emailID))
+	}
+
+	for _, sms := range smses {
+		definitions = append(definitions, newTemplateDefinition(sms, ChannelSMS, sms.smsID))
+	}
+
+	for _, letter := range letters {
+		definitions = append(definitions, newTemplateDefinition(letter, ChannelLetter, letter.letterID))
+	}
+
+	return definitions
+}
+
+func newTemplateDefinition(v any, channel Channel, id func(localize.Lang) string) TemplateDefinition {
+	t := reflect.TypeOf(v)
+
+	var fields []string
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+
+		fields = append(fields, name)
+	}
+
+	return TemplateDefinition{
+		Name:      t.Name(),
+		Channel:   channel,
+		EnglishID: id(localize.En),
+		WelshID:   id(localize.Cy),
+		Fields:    fields,
+	}
+}
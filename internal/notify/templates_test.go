@@ -0,0 +1,70 @@
+package notify
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateDefinitions(t *testing.T) {
+	definitions := TemplateDefinitions()
+
+	assert.Len(t, definitions, len(emails)+len(smses)+len(letters))
+	assert.Contains(t, definitions, TemplateDefinition{
+		Name:      "CertificateProviderCertificateProvidedEmail",
+		Channel:   ChannelEmail,
+		EnglishID: "64d7d56b-966b-464f-8084-1ac5d91c3d58",
+		WelshID:   "3a52508e-b8f1-4192-b9f4-e912964db3e7",
+		Fields:    []string{"DonorFullNamePossessive", "LpaType", "CertificateProviderFullName", "CertificateProvidedDateTime", "DonorFirstNamesPossessive"},
+	})
+	assert.Contains(t, definitions, TemplateDefinition{
+		Name:      "WitnessCodeSMS",
+		Channel:   ChannelSMS,
+		EnglishID: "e39849c0-ecab-4e16-87ec-6b22afb9d535",
+		WelshID:   "5ae6190d-0610-45a2-be4f-1cdcab6e579c",
+		Fields:    []string{"WitnessCode", "DonorFullName", "LpaType"},
+	})
+}
+
+func TestTemplateDefinitionHasWelsh(t *testing.T) {
+	assert.True(t, TemplateDefinition{EnglishID: "a", WelshID: "b"}.HasWelsh())
+	assert.False(t, TemplateDefinition{EnglishID: "a", WelshID: "a"}.HasWelsh())
+}
+
+func TestTemplateDefinitionsIncludesAllTypes(t *testing.T) {
+	registered := map[string]bool{}
+	for _, v := range emails {
+		registered[reflect.TypeOf(v).Name()] = true
+	}
+	for _, v := range smses {
+		registered[reflect.TypeOf(v).Name()] = true
+	}
+	for _, v := range letters {
+		registered[reflect.TypeOf(v).Name()] = true
+	}
+
+	fset := token.NewFileSet()
+	for _, filename := range []string{"email.go", "sms.go", "letter.go"} {
+		file, err := parser.ParseFile(fset, filename, nil, 0)
+		if !assert.Nil(t, err) {
+			return
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil {
+				continue
+			}
+
+			switch fn.Name.Name {
+			case "emailID", "smsID", "letterID":
+				name := fn.Recv.List[0].Type.(*ast.Ident).Name
+				assert.True(t, registered[name], "%s is not included in TemplateDefinitions", name)
+			}
+		}
+	}
+}
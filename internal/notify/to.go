@@ -1,46 +1,81 @@
 package notify
 
 import (
+	"slices"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/certificateprovider/certificateproviderdata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/place"
 )
 
 type To interface {
 	ToEmail
 	ToMobile
+	ToLetter
 }
 
 type ToEmail interface {
 	toEmail() (string, localize.Lang)
+	recipientType(Channel) actor.Type
 	ignore() bool
 }
 
 type ToMobile interface {
 	toMobile() (string, localize.Lang)
+	recipientType(Channel) actor.Type
+	ignore() bool
+}
+
+type ToLetter interface {
+	toLetter() (string, place.Address, localize.Lang)
+	recipientType(Channel) actor.Type
 	ignore() bool
 }
 
 type to struct {
-	email   string
-	mobile  string
-	lang    localize.Lang
-	ignored bool
+	// name is used as the first line of a letter's address
+	name      string
+	email     string
+	mobile    string
+	address   place.Address
+	lang      localize.Lang
+	ignored   bool
+	actorType actor.Type
+	// correspondentChannels lists the channels where the correspondent's
+	// details have been used in place of the donor's
+	correspondentChannels []Channel
 }
 
 func (t to) toEmail() (string, localize.Lang)  { return t.email, t.lang }
 func (t to) toMobile() (string, localize.Lang) { return t.mobile, t.lang }
 func (t to) ignore() bool                      { return t.ignored }
 
+func (t to) toLetter() (string, place.Address, localize.Lang) {
+	return t.name, t.address, t.lang
+}
+
+func (t to) recipientType(channel Channel) actor.Type {
+	if slices.Contains(t.correspondentChannels, channel) {
+		return actor.TypeCorrespondent
+	}
+
+	return t.actorType
+}
+
 // ToDonorOnly is only needed when we won't want the email to go to the
 // correspondent, normally we will use ToDonor.
 func ToDonorOnly(donor *donordata.Provided) To {
 	return to{
-		mobile: donor.Donor.Mobile,
-		email:  donor.Donor.Email,
-		lang:   donor.Donor.ContactLanguagePreference,
+		name:      donor.Donor.FullName(),
+		mobile:    donor.Donor.Mobile,
+		email:     donor.Donor.Email,
+		address:   donor.Donor.Address,
+		lang:      donor.Donor.ContactLanguagePreference,
+		actorType: actor.TypeDonor,
 	}
 }
 
@@ -48,24 +83,38 @@ func ToDonorOnly(donor *donordata.Provided) To {
 // normally we will use ToDonor.
 func ToCorrespondent(donor *donordata.Provided) To {
 	return to{
-		mobile: donor.Correspondent.Phone,
-		email:  donor.Correspondent.Email,
-		lang:   donor.Donor.ContactLanguagePreference,
+		name:      donor.Correspondent.FullName(),
+		mobile:    donor.Correspondent.Phone,
+		email:     donor.Correspondent.Email,
+		address:   donor.Correspondent.Address,
+		lang:      donor.Donor.ContactLanguagePreference,
+		actorType: actor.TypeCorrespondent,
 	}
 }
 
 func ToDonor(donor *donordata.Provided) To {
 	to := to{
-		mobile: donor.Donor.Mobile,
-		email:  donor.Donor.Email,
-		lang:   donor.Donor.ContactLanguagePreference,
+		name:      donor.Donor.FullName(),
+		mobile:    donor.Donor.Mobile,
+		email:     donor.Donor.Email,
+		address:   donor.Donor.Address,
+		lang:      donor.Donor.ContactLanguagePreference,
+		actorType: actor.TypeDonor,
 	}
 
 	if donor.HasCorrespondent() {
 		to.email = donor.Correspondent.Email
+		to.correspondentChannels = append(to.correspondentChannels, ChannelEmail)
 
 		if donor.Correspondent.Phone != "" {
 			to.mobile = donor.Correspondent.Phone
+			to.correspondentChannels = append(to.correspondentChannels, ChannelSMS)
+		}
+
+		if donor.Correspondent.Address.Line1 != "" {
+			to.name = donor.Correspondent.FullName()
+			to.address = donor.Correspondent.Address
+			to.correspondentChannels = append(to.correspondentChannels, ChannelLetter)
 		}
 	}
 
@@ -74,16 +123,26 @@ func ToDonor(donor *donordata.Provided) To {
 
 func ToLpaDonor(lpa *lpadata.Lpa) To {
 	to := to{
-		mobile: lpa.Donor.Mobile,
-		email:  lpa.Donor.Email,
-		lang:   lpa.Donor.ContactLanguagePreference,
+		name:      lpa.Donor.FullName(),
+		mobile:    lpa.Donor.Mobile,
+		email:     lpa.Donor.Email,
+		address:   lpa.Donor.Address,
+		lang:      lpa.Donor.ContactLanguagePreference,
+		actorType: actor.TypeDonor,
 	}
 
 	if lpa.Correspondent.Email != "" {
 		to.email = lpa.Correspondent.Email
+		to.correspondentChannels = append(to.correspondentChannels, ChannelEmail)
 	}
 	if lpa.Correspondent.Phone != "" {
 		to.mobile = lpa.Correspondent.Phone
+		to.correspondentChannels = append(to.correspondentChannels, ChannelSMS)
+	}
+	if lpa.Correspondent.Address.Line1 != "" {
+		to.name = lpa.Correspondent.FullName()
+		to.address = lpa.Correspondent.Address
+		to.correspondentChannels = append(to.correspondentChannels, ChannelLetter)
 	}
 
 	return to
@@ -94,17 +153,23 @@ func ToLpaDonor(lpa *lpadata.Lpa) To {
 // have entered so only use this as a fallback.
 func ToCertificateProvider(certificateProvider donordata.CertificateProvider) To {
 	return to{
-		mobile: certificateProvider.Mobile,
-		email:  certificateProvider.Email,
-		lang:   localize.En,
+		name:      certificateProvider.FullName(),
+		mobile:    certificateProvider.Mobile,
+		email:     certificateProvider.Email,
+		address:   certificateProvider.Address,
+		lang:      localize.En,
+		actorType: actor.TypeCertificateProvider,
 	}
 }
 
 func ToProvidedCertificateProvider(provided *certificateproviderdata.Provided, certificateProvider donordata.CertificateProvider) To {
 	return to{
-		mobile: certificateProvider.Mobile,
-		email:  provided.Email,
-		lang:   provided.ContactLanguagePreference,
+		name:      certificateProvider.FullName(),
+		mobile:    certificateProvider.Mobile,
+		email:     provided.Email,
+		address:   certificateProvider.Address,
+		lang:      provided.ContactLanguagePreference,
+		actorType: actor.TypeCertificateProvider,
 	}
 }
 
@@ -115,41 +180,52 @@ func ToLpaCertificateProvider(provided *certificateproviderdata.Provided, lpa *l
 	}
 
 	return to{
-		mobile: lpa.CertificateProvider.Phone,
-		email:  lpa.CertificateProvider.Email,
-		lang:   lang,
+		name:      lpa.CertificateProvider.FullName(),
+		mobile:    lpa.CertificateProvider.Phone,
+		email:     lpa.CertificateProvider.Email,
+		address:   lpa.CertificateProvider.Address,
+		lang:      lang,
+		actorType: actor.TypeCertificateProvider,
 	}
 }
 
 func ToLpaAttorney(attorney lpadata.Attorney) To {
 	return to{
-		mobile:  attorney.Mobile,
-		email:   attorney.Email,
-		lang:    attorney.ContactLanguagePreference,
-		ignored: attorney.Removed,
+		name:      attorney.FullName(),
+		mobile:    attorney.Mobile,
+		email:     attorney.Email,
+		address:   attorney.Address,
+		lang:      attorney.ContactLanguagePreference,
+		ignored:   attorney.Removed,
+		actorType: actor.TypeAttorney,
 	}
 }
 
 func ToLpaTrustCorporation(trustCorporation lpadata.TrustCorporation) To {
 	return to{
-		mobile:  trustCorporation.Mobile,
-		email:   trustCorporation.Email,
-		lang:    trustCorporation.ContactLanguagePreference,
-		ignored: trustCorporation.Removed,
+		name:      trustCorporation.Name,
+		mobile:    trustCorporation.Mobile,
+		email:     trustCorporation.Email,
+		address:   trustCorporation.Address,
+		lang:      trustCorporation.ContactLanguagePreference,
+		ignored:   trustCorporation.Removed,
+		actorType: actor.TypeTrustCorporation,
 	}
 }
 
 func ToIndependentWitness(independentWitness donordata.IndependentWitness) ToMobile {
 	return to{
-		mobile: independentWitness.Mobile,
-		lang:   localize.En,
+		mobile:    independentWitness.Mobile,
+		lang:      localize.En,
+		actorType: actor.TypeIndependentWitness,
 	}
 }
 
 func ToVoucher(voucher donordata.Voucher) ToEmail {
 	return to{
-		email: voucher.Email,
-		lang:  localize.En,
+		email:     voucher.Email,
+		lang:      localize.En,
+		actorType: actor.TypeVoucher,
 	}
 }
 
@@ -3,22 +3,29 @@ package notify
 import (
 	"testing"
 
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor/actoruid"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/certificateprovider/certificateproviderdata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/place"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestToDonorOnly(t *testing.T) {
 	to := ToDonorOnly(&donordata.Provided{
-		Donor:         donordata.Donor{Mobile: "0777", Email: "a@b.c", ContactLanguagePreference: localize.Cy},
-		Correspondent: donordata.Correspondent{Phone: "0779", Email: "d@e.f"},
+		Donor:         donordata.Donor{FirstNames: "John", LastName: "Smith", Mobile: "0777", Email: "a@b.c", Address: place.Address{Line1: "a"}, ContactLanguagePreference: localize.Cy},
+		Correspondent: donordata.Correspondent{FirstNames: "Jane", LastName: "Doe", Phone: "0779", Email: "d@e.f", Address: place.Address{Line1: "b"}},
 	})
 
+	name, address, lang := to.toLetter()
+	assert.Equal(t, "John Smith", name)
+	assert.Equal(t, place.Address{Line1: "a"}, address)
+	assert.Equal(t, localize.Cy, lang)
+
 	email, lang := to.toEmail()
 	assert.Equal(t, "a@b.c", email)
 	assert.Equal(t, localize.Cy, lang)
@@ -32,10 +39,15 @@ func TestToDonorOnly(t *testing.T) {
 
 func TestToCorrespondent(t *testing.T) {
 	to := ToCorrespondent(&donordata.Provided{
-		Donor:         donordata.Donor{Mobile: "0777", Email: "a@b.c", ContactLanguagePreference: localize.Cy},
-		Correspondent: donordata.Correspondent{Phone: "0779", Email: "d@e.f"},
+		Donor:         donordata.Donor{FirstNames: "John", LastName: "Smith", Mobile: "0777", Email: "a@b.c", Address: place.Address{Line1: "a"}, ContactLanguagePreference: localize.Cy},
+		Correspondent: donordata.Correspondent{FirstNames: "Jane", LastName: "Doe", Phone: "0779", Email: "d@e.f", Address: place.Address{Line1: "b"}},
 	})
 
+	name, address, lang := to.toLetter()
+	assert.Equal(t, "Jane Doe", name)
+	assert.Equal(t, place.Address{Line1: "b"}, address)
+	assert.Equal(t, localize.Cy, lang)
+
 	email, lang := to.toEmail()
 	assert.Equal(t, "d@e.f", email)
 	assert.Equal(t, localize.Cy, lang)
@@ -49,9 +61,14 @@ func TestToCorrespondent(t *testing.T) {
 
 func TestToDonor(t *testing.T) {
 	to := ToDonor(&donordata.Provided{
-		Donor: donordata.Donor{Mobile: "0777", Email: "a@b.c", ContactLanguagePreference: localize.Cy},
+		Donor: donordata.Donor{FirstNames: "John", LastName: "Smith", Mobile: "0777", Email: "a@b.c", Address: place.Address{Line1: "a"}, ContactLanguagePreference: localize.Cy},
 	})
 
+	name, address, lang := to.toLetter()
+	assert.Equal(t, "John Smith", name)
+	assert.Equal(t, place.Address{Line1: "a"}, address)
+	assert.Equal(t, localize.Cy, lang)
+
 	email, lang := to.toEmail()
 	assert.Equal(t, "a@b.c", email)
 	assert.Equal(t, localize.Cy, lang)
@@ -65,11 +82,16 @@ func TestToDonor(t *testing.T) {
 
 func TestToDonorWhenCorrespondent(t *testing.T) {
 	to := ToDonor(&donordata.Provided{
-		Donor:         donordata.Donor{Mobile: "0777", Email: "a@b.c", ContactLanguagePreference: localize.Cy},
-		Correspondent: donordata.Correspondent{UID: actoruid.New(), Phone: "0779", Email: "d@e.f"},
+		Donor:         donordata.Donor{FirstNames: "John", LastName: "Smith", Mobile: "0777", Email: "a@b.c", Address: place.Address{Line1: "a"}, ContactLanguagePreference: localize.Cy},
+		Correspondent: donordata.Correspondent{UID: actoruid.New(), FirstNames: "Jane", LastName: "Doe", Phone: "0779", Email: "d@e.f", Address: place.Address{Line1: "b"}},
 		Tasks:         donordata.Tasks{AddCorrespondent: task.StateCompleted},
 	})
 
+	name, address, lang := to.toLetter()
+	assert.Equal(t, "Jane Doe", name)
+	assert.Equal(t, place.Address{Line1: "b"}, address)
+	assert.Equal(t, localize.Cy, lang)
+
 	email, lang := to.toEmail()
 	assert.Equal(t, "d@e.f", email)
 	assert.Equal(t, localize.Cy, lang)
@@ -79,13 +101,33 @@ func TestToDonorWhenCorrespondent(t *testing.T) {
 	assert.Equal(t, localize.Cy, lang)
 
 	assert.False(t, to.ignore())
+	assert.Equal(t, actor.TypeCorrespondent, to.recipientType(ChannelLetter))
+}
+
+func TestToDonorWhenCorrespondentWithoutAddress(t *testing.T) {
+	to := ToDonor(&donordata.Provided{
+		Donor:         donordata.Donor{FirstNames: "John", LastName: "Smith", Email: "a@b.c", Address: place.Address{Line1: "a"}, ContactLanguagePreference: localize.Cy},
+		Correspondent: donordata.Correspondent{UID: actoruid.New(), FirstNames: "Jane", LastName: "Doe", Email: "d@e.f"},
+		Tasks:         donordata.Tasks{AddCorrespondent: task.StateCompleted},
+	})
+
+	name, address, lang := to.toLetter()
+	assert.Equal(t, "John Smith", name)
+	assert.Equal(t, place.Address{Line1: "a"}, address)
+	assert.Equal(t, localize.Cy, lang)
+	assert.Equal(t, actor.TypeDonor, to.recipientType(ChannelLetter))
 }
 
 func TestToLpaDonor(t *testing.T) {
 	to := ToLpaDonor(&lpadata.Lpa{
-		Donor: lpadata.Donor{Mobile: "0777", Email: "a@b.c", ContactLanguagePreference: localize.Cy},
+		Donor: lpadata.Donor{FirstNames: "John", LastName: "Smith", Mobile: "0777", Email: "a@b.c", Address: place.Address{Line1: "a"}, ContactLanguagePreference: localize.Cy},
 	})
 
+	name, address, lang := to.toLetter()
+	assert.Equal(t, "John Smith", name)
+	assert.Equal(t, place.Address{Line1: "a"}, address)
+	assert.Equal(t, localize.Cy, lang)
+
 	email, lang := to.toEmail()
 	assert.Equal(t, "a@b.c", email)
 	assert.Equal(t, localize.Cy, lang)
@@ -99,10 +141,15 @@ func TestToLpaDonor(t *testing.T) {
 
 func TestToLpaDonorWhenCorrespondent(t *testing.T) {
 	to := ToLpaDonor(&lpadata.Lpa{
-		Donor:         lpadata.Donor{Mobile: "0777", Email: "a@b.c", ContactLanguagePreference: localize.Cy},
-		Correspondent: lpadata.Correspondent{Phone: "0779", Email: "d@e.f"},
+		Donor:         lpadata.Donor{Mobile: "0777", Email: "a@b.c", Address: place.Address{Line1: "a"}, ContactLanguagePreference: localize.Cy},
+		Correspondent: lpadata.Correspondent{FirstNames: "Jane", LastName: "Doe", Phone: "0779", Email: "d@e.f", Address: place.Address{Line1: "b"}},
 	})
 
+	name, address, lang := to.toLetter()
+	assert.Equal(t, "Jane Doe", name)
+	assert.Equal(t, place.Address{Line1: "b"}, address)
+	assert.Equal(t, localize.Cy, lang)
+
 	email, lang := to.toEmail()
 	assert.Equal(t, "d@e.f", email)
 	assert.Equal(t, localize.Cy, lang)
@@ -116,10 +163,18 @@ func TestToLpaDonorWhenCorrespondent(t *testing.T) {
 
 func TestToCertificateProvider(t *testing.T) {
 	to := ToCertificateProvider(donordata.CertificateProvider{
-		Mobile: "0777",
-		Email:  "a@b.c",
+		FirstNames: "John",
+		LastName:   "Smith",
+		Mobile:     "0777",
+		Email:      "a@b.c",
+		Address:    place.Address{Line1: "a"},
 	})
 
+	name, address, lang := to.toLetter()
+	assert.Equal(t, "John Smith", name)
+	assert.Equal(t, place.Address{Line1: "a"}, address)
+	assert.Equal(t, localize.En, lang)
+
 	email, lang := to.toEmail()
 	assert.Equal(t, "a@b.c", email)
 	assert.Equal(t, localize.En, lang)
@@ -136,10 +191,18 @@ func TestToProvidedCertificateProvider(t *testing.T) {
 		Email:                     "d@e.f",
 		ContactLanguagePreference: localize.Cy,
 	}, donordata.CertificateProvider{
-		Mobile: "0777",
-		Email:  "a@b.c",
+		FirstNames: "John",
+		LastName:   "Smith",
+		Mobile:     "0777",
+		Email:      "a@b.c",
+		Address:    place.Address{Line1: "a"},
 	})
 
+	name, address, lang := to.toLetter()
+	assert.Equal(t, "John Smith", name)
+	assert.Equal(t, place.Address{Line1: "a"}, address)
+	assert.Equal(t, localize.Cy, lang)
+
 	email, lang := to.toEmail()
 	assert.Equal(t, "d@e.f", email)
 	assert.Equal(t, localize.Cy, lang)
@@ -157,11 +220,19 @@ func TestToLpaCertificateProvider(t *testing.T) {
 		ContactLanguagePreference: localize.Cy,
 	}, &lpadata.Lpa{
 		CertificateProvider: lpadata.CertificateProvider{
-			Phone: "0777",
-			Email: "a@b.c",
+			FirstNames: "John",
+			LastName:   "Smith",
+			Phone:      "0777",
+			Email:      "a@b.c",
+			Address:    place.Address{Line1: "a"},
 		},
 	})
 
+	name, address, lang := to.toLetter()
+	assert.Equal(t, "John Smith", name)
+	assert.Equal(t, place.Address{Line1: "a"}, address)
+	assert.Equal(t, localize.Cy, lang)
+
 	email, lang := to.toEmail()
 	assert.Equal(t, "a@b.c", email)
 	assert.Equal(t, localize.Cy, lang)
@@ -175,12 +246,20 @@ func TestToLpaCertificateProvider(t *testing.T) {
 
 func TestToLpaAttorney(t *testing.T) {
 	to := ToLpaAttorney(lpadata.Attorney{
+		FirstNames:                "John",
+		LastName:                  "Smith",
 		Mobile:                    "0777",
 		Email:                     "a@b.c",
+		Address:                   place.Address{Line1: "a"},
 		ContactLanguagePreference: localize.Cy,
 		Removed:                   true,
 	})
 
+	name, address, lang := to.toLetter()
+	assert.Equal(t, "John Smith", name)
+	assert.Equal(t, place.Address{Line1: "a"}, address)
+	assert.Equal(t, localize.Cy, lang)
+
 	email, lang := to.toEmail()
 	assert.Equal(t, "a@b.c", email)
 	assert.Equal(t, localize.Cy, lang)
@@ -194,12 +273,19 @@ func TestToLpaAttorney(t *testing.T) {
 
 func TestToLpaTrustCorporation(t *testing.T) {
 	to := ToLpaTrustCorporation(lpadata.TrustCorporation{
+		Name:                      "Trusty",
 		Mobile:                    "0777",
 		Email:                     "a@b.c",
+		Address:                   place.Address{Line1: "a"},
 		ContactLanguagePreference: localize.Cy,
 		Removed:                   true,
 	})
 
+	name, address, lang := to.toLetter()
+	assert.Equal(t, "Trusty", name)
+	assert.Equal(t, place.Address{Line1: "a"}, address)
+	assert.Equal(t, localize.Cy, lang)
+
 	email, lang := to.toEmail()
 	assert.Equal(t, "a@b.c", email)
 	assert.Equal(t, localize.Cy, lang)
@@ -256,3 +342,90 @@ func TestToCustomEmail(t *testing.T) {
 
 	assert.False(t, to.ignore())
 }
+
+func TestToRecipientType(t *testing.T) {
+	testcases := map[string]struct {
+		to       to
+		expected map[Channel]actor.Type
+	}{
+		"donor": {
+			to: ToDonor(&donordata.Provided{}).(to),
+			expected: map[Channel]actor.Type{
+				ChannelEmail:  actor.TypeDonor,
+				ChannelSMS:    actor.TypeDonor,
+				ChannelLetter: actor.TypeDonor,
+			},
+		},
+		"donor with correspondent": {
+			to: ToDonor(&donordata.Provided{
+				Correspondent: donordata.Correspondent{UID: actoruid.New(), Email: "d@e.f"},
+				Tasks:         donordata.Tasks{AddCorrespondent: task.StateCompleted},
+			}).(to),
+			expected: map[Channel]actor.Type{
+				ChannelEmail:  actor.TypeCorrespondent,
+				ChannelSMS:    actor.TypeDonor,
+				ChannelLetter: actor.TypeDonor,
+			},
+		},
+		"lpa donor with correspondent": {
+			to: ToLpaDonor(&lpadata.Lpa{
+				Correspondent: lpadata.Correspondent{Phone: "0777", Address: place.Address{Line1: "a"}},
+			}).(to),
+			expected: map[Channel]actor.Type{
+				ChannelEmail:  actor.TypeDonor,
+				ChannelSMS:    actor.TypeCorrespondent,
+				ChannelLetter: actor.TypeCorrespondent,
+			},
+		},
+		"correspondent": {
+			to: ToCorrespondent(&donordata.Provided{}).(to),
+			expected: map[Channel]actor.Type{
+				ChannelEmail: actor.TypeCorrespondent,
+			},
+		},
+		"certificate provider": {
+			to: ToLpaCertificateProvider(nil, &lpadata.Lpa{}).(to),
+			expected: map[Channel]actor.Type{
+				ChannelEmail: actor.TypeCertificateProvider,
+			},
+		},
+		"attorney": {
+			to: ToLpaAttorney(lpadata.Attorney{}).(to),
+			expected: map[Channel]actor.Type{
+				ChannelLetter: actor.TypeAttorney,
+			},
+		},
+		"trust corporation": {
+			to: ToLpaTrustCorporation(lpadata.TrustCorporation{}).(to),
+			expected: map[Channel]actor.Type{
+				ChannelLetter: actor.TypeTrustCorporation,
+			},
+		},
+		"independent witness": {
+			to: ToIndependentWitness(donordata.IndependentWitness{}).(to),
+			expected: map[Channel]actor.Type{
+				ChannelSMS: actor.TypeIndependentWitness,
+			},
+		},
+		"voucher": {
+			to: ToVoucher(donordata.Voucher{}).(to),
+			expected: map[Channel]actor.Type{
+				ChannelEmail: actor.TypeVoucher,
+			},
+		},
+		"custom": {
+			to: ToCustomEmail(localize.En, "a@b.c").(to),
+			expected: map[Channel]actor.Type{
+				ChannelEmail: actor.TypeNone,
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			for channel, expected := range tc.expected {
+				assert.Equal(t, expected, tc.to.recipientType(channel), channel.String())
+			}
+		})
+	}
+}
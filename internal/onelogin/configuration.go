@@ -100,6 +100,15 @@ func (c *configurationClient) ForExchange() (tokenEndpoint string, keyfunc jwt.K
 	return c.currentConfiguration.TokenEndpoint, c.currentJwks.Keyfunc, c.currentConfiguration.Issuer, nil
 }
 
+func (c *configurationClient) ForLogout() (keyfunc jwt.Keyfunc, issuer string, err error) {
+	if c.currentConfiguration == nil || c.currentJwks == nil {
+		c.requestRefresh()
+		return nil, "", ErrConfigurationMissing
+	}
+
+	return c.currentJwks.Keyfunc, c.currentConfiguration.Issuer, nil
+}
+
 // requestRefresh will request that the configuration is refreshed, if no other request is waiting
 func (c *configurationClient) requestRefresh() {
 	select {
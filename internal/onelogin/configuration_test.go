@@ -147,6 +147,34 @@ func TestConfigurationClientForExchangeWhenMissing(t *testing.T) {
 	}
 }
 
+func TestConfigurationClientForLogout(t *testing.T) {
+	client := &configurationClient{
+		currentConfiguration: &openidConfiguration{
+			Issuer: "Issuer",
+		},
+		currentJwks: &mockKeyfunc{},
+	}
+
+	keyfunc, issuer, err := client.ForLogout()
+	assert.Nil(t, err)
+	assert.NotNil(t, keyfunc)
+	assert.Equal(t, "Issuer", issuer)
+}
+
+func TestConfigurationClientForLogoutWhenMissing(t *testing.T) {
+	ch := make(chan struct{}, 1)
+	client := &configurationClient{refreshRequest: ch}
+
+	_, _, err := client.ForLogout()
+	assert.Equal(t, ErrConfigurationMissing, err)
+
+	select {
+	case <-ch:
+	default:
+		t.Fail()
+	}
+}
+
 func TestConfigurationClientBackgroundRefresh(t *testing.T) {
 	ch := make(chan struct{}, 1)
 	ctx, cancel := context.WithCancel(context.Background())
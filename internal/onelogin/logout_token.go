@@ -0,0 +1,49 @@
+package onelogin
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+
+type logoutTokenClaims struct {
+	jwt.RegisteredClaims
+	Events map[string]any `json:"events"`
+	Nonce  string         `json:"nonce"`
+}
+
+// ParseLogoutToken validates a logout token sent by One Login to the
+// back-channel logout endpoint, returning the sub of the user that has signed
+// out.
+func (c *Client) ParseLogoutToken(logoutToken string) (string, error) {
+	keyfunc, issuer, err := c.openidConfiguration.ForLogout()
+	if err != nil {
+		return "", err
+	}
+
+	var claims logoutTokenClaims
+	if _, err := jwt.ParseWithClaims(logoutToken, &claims, keyfunc,
+		jwt.WithIssuer(issuer),
+		jwt.WithAudience(c.clientID),
+		jwt.WithIssuedAt(),
+		jwt.WithExpirationRequired()); err != nil {
+		return "", fmt.Errorf("logout token not valid: %w", err)
+	}
+
+	if claims.Subject == "" {
+		return "", errors.New("logout token missing sub")
+	}
+
+	if _, ok := claims.Events[backChannelLogoutEvent]; !ok {
+		return "", errors.New("logout token missing back-channel logout event")
+	}
+
+	if claims.Nonce != "" {
+		return "", errors.New("logout token must not contain nonce")
+	}
+
+	return claims.Subject, nil
+}
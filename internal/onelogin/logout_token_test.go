@@ -0,0 +1,87 @@
+package onelogin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func signLogoutToken(claims jwt.MapClaims) string {
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("my-key"))
+	return token
+}
+
+func TestParseLogoutToken(t *testing.T) {
+	client := &Client{
+		openidConfiguration: &configurationClient{
+			currentConfiguration: &openidConfiguration{Issuer: "http://issuer"},
+			currentJwks:          &mockKeyfunc{},
+		},
+		clientID: "client-id",
+	}
+
+	sub, err := client.ParseLogoutToken(signLogoutToken(jwt.MapClaims{
+		"iss":    "http://issuer",
+		"aud":    "client-id",
+		"sub":    "a-sub",
+		"iat":    time.Now().Unix(),
+		"exp":    time.Now().Add(time.Minute).Unix(),
+		"jti":    "an-id",
+		"events": map[string]any{backChannelLogoutEvent: map[string]any{}},
+	}))
+	assert.Nil(t, err)
+	assert.Equal(t, "a-sub", sub)
+}
+
+func TestParseLogoutTokenWhenConfigurationMissing(t *testing.T) {
+	client := &Client{
+		openidConfiguration: &configurationClient{refreshRequest: make(chan struct{}, 1)},
+	}
+
+	_, err := client.ParseLogoutToken("a-token")
+	assert.Equal(t, ErrConfigurationMissing, err)
+}
+
+func TestParseLogoutTokenWhenInvalid(t *testing.T) {
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":    "http://issuer",
+			"aud":    "client-id",
+			"sub":    "a-sub",
+			"iat":    time.Now().Unix(),
+			"exp":    time.Now().Add(time.Minute).Unix(),
+			"events": map[string]any{backChannelLogoutEvent: map[string]any{}},
+		}
+	}
+
+	testcases := map[string]func(jwt.MapClaims){
+		"wrong issuer":     func(c jwt.MapClaims) { c["iss"] = "http://other" },
+		"wrong audience":   func(c jwt.MapClaims) { c["aud"] = "other" },
+		"expired":          func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+		"missing exp":      func(c jwt.MapClaims) { delete(c, "exp") },
+		"future issued at": func(c jwt.MapClaims) { c["iat"] = time.Now().Add(time.Minute).Unix() },
+		"missing sub":      func(c jwt.MapClaims) { delete(c, "sub") },
+		"missing event":    func(c jwt.MapClaims) { c["events"] = map[string]any{"other": map[string]any{}} },
+		"with nonce":       func(c jwt.MapClaims) { c["nonce"] = "a-nonce" },
+	}
+
+	for name, modify := range testcases {
+		t.Run(name, func(t *testing.T) {
+			client := &Client{
+				openidConfiguration: &configurationClient{
+					currentConfiguration: &openidConfiguration{Issuer: "http://issuer"},
+					currentJwks:          &mockKeyfunc{},
+				},
+				clientID: "client-id",
+			}
+
+			claims := validClaims()
+			modify(claims)
+
+			_, err := client.ParseLogoutToken(signLogoutToken(claims))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package page
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/sesh"
+)
+
+type BackChannelLogoutOneLoginClient interface {
+	ParseLogoutToken(logoutToken string) (string, error)
+}
+
+type RevokeLoginsSessionStore interface {
+	RevokeLogins(ctx context.Context, sessionID string) error
+}
+
+// BackChannelLogout is called by One Login when a user signs out of One Login
+// elsewhere, so that all of their sessions with this service are ended too.
+func BackChannelLogout(logger Logger, oneLoginClient BackChannelLogoutOneLoginClient, sessionStore RevokeLoginsSessionStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		sub, err := oneLoginClient.ParseLogoutToken(r.PostFormValue("logout_token"))
+		if err != nil {
+			logger.InfoContext(r.Context(), "invalid logout token", slog.Any("err", err))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		sessionID := sesh.LoginSession{Sub: sub}.SessionID()
+
+		if err := sessionStore.RevokeLogins(r.Context(), sessionID); err != nil {
+			logger.ErrorContext(r.Context(), "unable to revoke sessions", slog.Any("err", err))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		logger.InfoContext(r.Context(), "back-channel logout", slog.String("session_id", sessionID))
+	}
+}
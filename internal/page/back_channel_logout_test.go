@@ -0,0 +1,94 @@
+package page
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/sesh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newLogoutTokenRequest() *http.Request {
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(url.Values{"logout_token": {"a-token"}}.Encode()))
+	r.Header.Add("Content-Type", FormUrlEncoded)
+	return r
+}
+
+func TestBackChannelLogout(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := newLogoutTokenRequest()
+	sessionID := sesh.LoginSession{Sub: "a-sub"}.SessionID()
+
+	oneLoginClient := newMockBackChannelLogoutOneLoginClient(t)
+	oneLoginClient.EXPECT().
+		ParseLogoutToken("a-token").
+		Return("a-sub", nil)
+
+	sessionStore := newMockRevokeLoginsSessionStore(t)
+	sessionStore.EXPECT().
+		RevokeLogins(r.Context(), sessionID).
+		Return(nil)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		InfoContext(r.Context(), "back-channel logout", slog.String("session_id", sessionID))
+
+	BackChannelLogout(logger, oneLoginClient, sessionStore)(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+}
+
+func TestBackChannelLogoutWhenNotPost(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	BackChannelLogout(nil, nil, nil)(w, r)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Result().StatusCode)
+}
+
+func TestBackChannelLogoutWhenTokenInvalid(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := newLogoutTokenRequest()
+
+	oneLoginClient := newMockBackChannelLogoutOneLoginClient(t)
+	oneLoginClient.EXPECT().
+		ParseLogoutToken(mock.Anything).
+		Return("", expectedError)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		InfoContext(r.Context(), "invalid logout token", slog.Any("err", expectedError))
+
+	BackChannelLogout(logger, oneLoginClient, nil)(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+func TestBackChannelLogoutWhenRevokeLoginsErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := newLogoutTokenRequest()
+
+	oneLoginClient := newMockBackChannelLogoutOneLoginClient(t)
+	oneLoginClient.EXPECT().
+		ParseLogoutToken(mock.Anything).
+		Return("a-sub", nil)
+
+	sessionStore := newMockRevokeLoginsSessionStore(t)
+	sessionStore.EXPECT().
+		RevokeLogins(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		ErrorContext(mock.Anything, "unable to revoke sessions", slog.Any("err", expectedError))
+
+	BackChannelLogout(logger, oneLoginClient, sessionStore)(w, r)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+}
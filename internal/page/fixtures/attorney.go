@@ -265,7 +265,9 @@ func Attorney(
 			createFn := donorStore.Create
 			createSession := &appcontext.Session{SessionID: donorSessionID}
 			if isSupported {
-				createFn = organisationStore.CreateLPA
+				createFn = func(ctx context.Context) (*donordata.Provided, error) {
+					return organisationStore.CreateLPA(ctx, "")
+				}
 
 				supporterCtx := appcontext.ContextWithSession(r.Context(), &appcontext.Session{SessionID: donorSessionID, Email: testEmail})
 
@@ -274,7 +274,7 @@ func CertificateProvider(
 			}
 
 			orgSession := &appcontext.Session{SessionID: donorSessionID, OrganisationID: org.ID}
-			donorDetails, err = organisationStore.CreateLPA(appcontext.ContextWithSession(r.Context(), orgSession))
+			donorDetails, err = organisationStore.CreateLPA(appcontext.ContextWithSession(r.Context(), orgSession), "")
 			if err != nil {
 				return err
 			}
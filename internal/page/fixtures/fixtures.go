@@ -28,7 +28,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/place"
-	"github.com/ministryofjustice/opg-modernising-lpa/internal/random"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/uid"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/voucher"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/voucher/voucherdata"
@@ -225,7 +225,7 @@ func makeVoucher(name Name) donordata.Voucher {
 }
 
 func makeUID() string {
-	return strings.ToUpper("M-" + "FAKE" + "-" + random.AlphaNumeric(4) + "-" + random.AlphaNumeric(4))
+	return uid.Generate("FAKE")
 }
 
 func acceptCookiesConsent(w http.ResponseWriter) {
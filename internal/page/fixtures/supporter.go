@@ -36,7 +36,7 @@ import (
 
 type OrganisationStore interface {
 	Create(context.Context, *supporterdata.Member, string) (*supporterdata.Organisation, error)
-	CreateLPA(context.Context) (*donordata.Provided, error)
+	CreateLPA(ctx context.Context, assignedMemberID string) (*donordata.Provided, error)
 }
 
 type AccessCodeStore interface {
@@ -51,7 +51,7 @@ func Supporter(
 	donorStore *donor.Store,
 	memberStore *supporter.MemberStore,
 	dynamoClient DynamoClient,
-	searchClient *search.Client,
+	searchClient search.Searcher,
 	accessCodeStore *accesscode.Store,
 	certificateProviderStore CertificateProviderStore,
 	attorneyStore AttorneyStore,
@@ -116,7 +116,7 @@ func Supporter(
 			}
 
 			for _, invite := range invites {
-				if err := memberStore.DeleteMemberInvite(context.Background(), invite.OrganisationID, invite.Email); err != nil {
+				if err := memberStore.DeleteMemberInvite(emailCtx, invite.OrganisationID, invite.Email); err != nil {
 					return fmt.Errorf("clearInvites delete invite: %w", err)
 				}
 			}
@@ -135,6 +135,8 @@ func Supporter(
 
 			loginSession.OrganisationID = org.ID
 			loginSession.OrganisationName = org.Name
+			loginSession.Permission = supporterdata.PermissionAdmin
+			loginSession.MemberID = member.ID
 
 			organisationCtx := appcontext.ContextWithSession(r.Context(), &appcontext.Session{OrganisationID: org.ID})
 
@@ -147,7 +149,7 @@ func Supporter(
 			}
 
 			if accessCode != "" {
-				donor, err := organisationStore.CreateLPA(organisationCtx)
+				donor, err := organisationStore.CreateLPA(organisationCtx, member.ID)
 				if err != nil {
 					return fmt.Errorf("error creating organisation: %w", err)
 				}
@@ -176,7 +178,7 @@ func Supporter(
 				}
 
 				hashedCode := accesscodedata.HashedFromString(accessCode, donor.Donor.LastName)
-				if err := accessCodeStore.PutDonorAccess(r.Context(), hashedCode, accessCodeData, "email@example.com"); err != nil {
+				if err := accessCodeStore.PutDonorAccess(organisationCtx, hashedCode, accessCodeData, "email@example.com"); err != nil {
 					return fmt.Errorf("error putting accesscode for donor: %w", err)
 				}
 
@@ -197,7 +199,7 @@ func Supporter(
 				donorFixtureData := setFixtureData(r)
 
 				for range lpaCount {
-					donor, err := organisationStore.CreateLPA(organisationCtx)
+					donor, err := organisationStore.CreateLPA(organisationCtx, member.ID)
 					if err != nil {
 						return fmt.Errorf("error creating lpa for organisation: %w", err)
 					}
@@ -327,6 +329,7 @@ func Supporter(
 				if sub, found := memberEmailSub[asMember]; found {
 					loginSession.Email = asMember
 					loginSession.Sub = sub
+					loginSession.Permission = permission
 				}
 			}
 		}
@@ -346,7 +349,7 @@ func Supporter(
 	}
 }
 
-func waitForLPAIndex(searchClient *search.Client, organisationCtx context.Context) {
+func waitForLPAIndex(searchClient search.Searcher, organisationCtx context.Context) {
 	count := 0
 
 	for range time.Tick(time.Second) {
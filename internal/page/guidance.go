@@ -2,9 +2,11 @@ package page
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/ministryofjustice/opg-go-common/template"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
 )
 
@@ -14,6 +16,7 @@ type guidanceData struct {
 	App      appcontext.Data
 	Errors   validation.List
 	Alphabet []string
+	Fees     pay.FeeSchedule
 }
 
 func Guidance(tmpl template.Template) Handler {
@@ -21,6 +24,7 @@ func Guidance(tmpl template.Template) Handler {
 		data := &guidanceData{
 			App:      appData,
 			Alphabet: alphabet,
+			Fees:     pay.FeeScheduleAt(time.Now()),
 		}
 
 		return tmpl(w, data)
@@ -4,7 +4,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,7 +16,7 @@ func TestGuidance(t *testing.T) {
 
 	template := newMockTemplate(t)
 	template.EXPECT().
-		Execute(w, &guidanceData{App: testAppData, Alphabet: alphabet}).
+		Execute(w, &guidanceData{App: testAppData, Alphabet: alphabet, Fees: pay.FeeScheduleAt(time.Now())}).
 		Return(nil)
 
 	err := Guidance(template.Execute)(testAppData, w, r)
@@ -30,7 +32,7 @@ func TestGuidanceWhenTemplateErrors(t *testing.T) {
 
 	template := newMockTemplate(t)
 	template.EXPECT().
-		Execute(w, &guidanceData{App: testAppData, Alphabet: alphabet}).
+		Execute(w, &guidanceData{App: testAppData, Alphabet: alphabet, Fees: pay.FeeScheduleAt(time.Now())}).
 		Return(expectedError)
 
 	err := Guidance(template.Execute)(testAppData, w, r)
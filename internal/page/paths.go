@@ -46,6 +46,7 @@ const (
 	PathAttorneyFixtures            = Path("/fixtures/attorney")
 	PathAddAnLPA                    = Path("/add-an-lpa")
 	PathAuthRedirect                = Path("/auth/redirect")
+	PathBackChannelLogout           = Path("/auth/back-channel-logout")
 	PathCertificateProviderFixtures = Path("/fixtures/certificate-provider")
 	PathCertificateProviderStart    = Path("/certificate-provider-start")
 	PathCookiesConsent              = Path("/cookies-consent")
@@ -62,6 +63,7 @@ const (
 	PathPrivacyNotice               = Path("/privacy-notice")
 	PathRoot                        = Path("/")
 	PathSignOut                     = Path("/sign-out")
+	PathSignOutAllDevices           = Path("/sign-out-all-devices")
 	PathStart                       = Path("/start")
 	PathSupporterFixtures           = Path("/fixtures/supporter")
 	PathTermsOfUse                  = Path("/terms-of-use")
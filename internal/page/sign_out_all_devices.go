@@ -0,0 +1,29 @@
+package page
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+)
+
+type SignOutAllDevicesSessionStore interface {
+	SessionStore
+	RevokeLogins(ctx context.Context, sessionID string) error
+}
+
+// SignOutAllDevices ends every session the user has with this service, before
+// signing them out in the same way as SignOut.
+func SignOutAllDevices(logger Logger, sessionStore SignOutAllDevicesSessionStore, oneLoginClient OneLoginClient, donorStartURL string) Handler {
+	signOut := SignOut(logger, sessionStore, oneLoginClient, donorStartURL)
+
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request) error {
+		if session, err := sessionStore.Login(r); err == nil && session != nil {
+			if err := sessionStore.RevokeLogins(r.Context(), session.SessionID()); err != nil {
+				return err
+			}
+		}
+
+		return signOut(appData, w, r)
+	}
+}
@@ -0,0 +1,61 @@
+package page
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/sesh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSignOutAllDevices(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+	loginSession := &sesh.LoginSession{IDToken: "id-token", Sub: "abc"}
+
+	sessionStore := newMockSignOutAllDevicesSessionStore(t)
+	sessionStore.EXPECT().
+		Login(r).
+		Return(loginSession, nil)
+	sessionStore.EXPECT().
+		RevokeLogins(r.Context(), loginSession.SessionID()).
+		Return(nil)
+	sessionStore.EXPECT().
+		ClearLogin(r, w).
+		Return(nil)
+
+	oneLoginClient := newMockOneLoginClient(t)
+	oneLoginClient.EXPECT().
+		EndSessionURL("id-token", "http://public").
+		Return("http://end-session", nil)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		InfoContext(r.Context(), "logout")
+
+	err := SignOutAllDevices(logger, sessionStore, oneLoginClient, "http://public")(testAppData, w, r)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, "http://end-session", resp.Header.Get("Location"))
+}
+
+func TestSignOutAllDevicesWhenRevokeLoginsErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	sessionStore := newMockSignOutAllDevicesSessionStore(t)
+	sessionStore.EXPECT().
+		Login(r).
+		Return(&sesh.LoginSession{Sub: "abc"}, nil)
+	sessionStore.EXPECT().
+		RevokeLogins(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	err := SignOutAllDevices(nil, sessionStore, nil, "http://public")(testAppData, w, r)
+
+	assert.Equal(t, expectedError, err)
+}
@@ -108,6 +108,82 @@ func (c *Client) GetPayment(ctx context.Context, paymentID string) (GetPaymentRe
 	return getPaymentResponse, nil
 }
 
+// CreateRefund requests a refund of part, or all, of a payment. The
+// refundAmountAvailable in the body must match the amount GOV.UK Pay has
+// available to refund, otherwise the refund will be rejected; this stops the
+// same refund being made twice.
+func (c *Client) CreateRefund(ctx context.Context, paymentID string, body CreateRefundBody) (RefundResponse, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return RefundResponse{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/v1/payments/"+paymentID+"/refunds", &buf)
+	if err != nil {
+		return RefundResponse{}, err
+	}
+
+	req.Header.Add("Authorization", "Bearer "+c.apiKey)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return RefundResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		data, _ := io.ReadAll(resp.Body)
+		c.logger.ErrorContext(ctx, "create refund failed",
+			slog.String("body", string(data)),
+			slog.Int("status_code", resp.StatusCode))
+
+		return RefundResponse{}, fmt.Errorf("expected 202 got %d", resp.StatusCode)
+	}
+
+	var refundResponse RefundResponse
+	if err := json.NewDecoder(resp.Body).Decode(&refundResponse); err != nil {
+		return RefundResponse{}, err
+	}
+
+	return refundResponse, nil
+}
+
+// GetRefund returns the current state of a refund, so that a refund that has
+// been submitted can be checked for success.
+func (c *Client) GetRefund(ctx context.Context, paymentID, refundID string) (RefundResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/v1/payments/"+paymentID+"/refunds/"+refundID, nil)
+	if err != nil {
+		return RefundResponse{}, err
+	}
+
+	req.Header.Add("Authorization", "Bearer "+c.apiKey)
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return RefundResponse{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		data, _ := io.ReadAll(resp.Body)
+		c.logger.ErrorContext(ctx, "get refund failed",
+			slog.String("body", string(data)),
+			slog.Int("status_code", resp.StatusCode))
+
+		return RefundResponse{}, fmt.Errorf("expected 200 got %d", resp.StatusCode)
+	}
+
+	var refundResponse RefundResponse
+	if err := json.NewDecoder(resp.Body).Decode(&refundResponse); err != nil {
+		return RefundResponse{}, err
+	}
+
+	return refundResponse, nil
+}
+
 func (c *Client) CanRedirect(url string) bool {
 	return paymentsURLRe.MatchString(url)
 }
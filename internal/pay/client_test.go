@@ -355,6 +355,184 @@ func TestGetPaymentWhenJsonError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestCreateRefund(t *testing.T) {
+	var reqBody []byte
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.MatchedBy(func(req *http.Request) bool {
+			if reqBody == nil {
+				reqBody, _ = io.ReadAll(req.Body)
+			}
+
+			return assert.Equal(t, ctx, req.Context()) &&
+				assert.Equal(t, http.MethodPost, req.Method) &&
+				assert.Equal(t, "http://pay/v1/payments/payment-id/refunds", req.URL.String()) &&
+				assert.Equal(t, "Bearer fake-token", req.Header.Get("Authorization")) &&
+				assert.Equal(t, "application/json", req.Header.Get("Content-Type")) &&
+				assert.JSONEq(t, `{"amount": 4600, "refund_amount_available": 9200}`, string(reqBody))
+		})).
+		Return(&http.Response{
+			StatusCode: http.StatusAccepted,
+			Body: io.NopCloser(strings.NewReader(fmt.Sprintf(`{
+	"refund_id": "refund-id",
+	"created_date": "%s",
+	"amount": 4600,
+	"status": "submitted",
+	"settlement_summary": {}
+}`, created.Format(time.RFC3339Nano)))),
+		}, nil)
+
+	payClient := New(nil, doer, "http://pay", apiToken)
+
+	resp, err := payClient.CreateRefund(ctx, "payment-id", CreateRefundBody{Amount: 4600, RefundAmountAvailable: 9200})
+	assert.Nil(t, err)
+	assert.Equal(t, RefundResponse{
+		RefundID:    "refund-id",
+		CreatedDate: created,
+		AmountPence: 4600,
+		Status:      RefundStatusSubmitted,
+	}, resp)
+}
+
+func TestCreateRefundWhenNewRequestErrors(t *testing.T) {
+	payClient := Client{baseURL: "http://pay`invalid-url-format", apiKey: apiToken}
+
+	_, err := payClient.CreateRefund(ctx, "payment-id", CreateRefundBody{})
+	assert.Error(t, err)
+}
+
+func TestCreateRefundWhenDoerErrors(t *testing.T) {
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(nil, expectedError)
+
+	payClient := Client{doer: doer}
+
+	_, err := payClient.CreateRefund(ctx, "payment-id", CreateRefundBody{})
+	assert.Equal(t, expectedError, err)
+}
+
+func TestCreateRefundWhenResponseError(t *testing.T) {
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{
+			StatusCode: http.StatusPreconditionFailed,
+			Body:       io.NopCloser(strings.NewReader("hey")),
+		}, nil)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		ErrorContext(ctx, "create refund failed", slog.String("body", "hey"), slog.Int("status_code", http.StatusPreconditionFailed))
+
+	payClient := Client{doer: doer, logger: logger}
+
+	_, err := payClient.CreateRefund(ctx, "payment-id", CreateRefundBody{})
+	assert.Error(t, err)
+}
+
+func TestCreateRefundWhenJsonError(t *testing.T) {
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{
+			StatusCode: http.StatusAccepted,
+			Body:       io.NopCloser(strings.NewReader("hey")),
+		}, nil)
+
+	payClient := Client{doer: doer}
+
+	_, err := payClient.CreateRefund(ctx, "payment-id", CreateRefundBody{})
+	assert.IsType(t, (*json.SyntaxError)(nil), err)
+}
+
+func TestGetRefund(t *testing.T) {
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.MatchedBy(func(req *http.Request) bool {
+			return assert.Equal(t, ctx, req.Context()) &&
+				assert.Equal(t, http.MethodGet, req.Method) &&
+				assert.Equal(t, "http://pay/v1/payments/payment-id/refunds/refund-id", req.URL.String()) &&
+				assert.Equal(t, "Bearer fake-token", req.Header.Get("Authorization"))
+		})).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(fmt.Sprintf(`{
+	"refund_id": "refund-id",
+	"created_date": "%s",
+	"amount": 4600,
+	"status": "success",
+	"settlement_summary": {"settled_date": "2022-10-01"}
+}`, created.Format(time.RFC3339Nano)))),
+		}, nil)
+
+	payClient := New(nil, doer, "http://pay", apiToken)
+
+	resp, err := payClient.GetRefund(ctx, "payment-id", "refund-id")
+	assert.Nil(t, err)
+	assert.Equal(t, RefundResponse{
+		RefundID:          "refund-id",
+		CreatedDate:       created,
+		AmountPence:       4600,
+		Status:            RefundStatusSuccess,
+		SettlementSummary: RefundSettlementSummary{SettledDate: date.New("2022", "10", "01")},
+	}, resp)
+}
+
+func TestGetRefundWhenNewRequestErrors(t *testing.T) {
+	payClient := Client{baseURL: "http://pay`invalid-url-format", apiKey: apiToken}
+
+	_, err := payClient.GetRefund(ctx, "payment-id", "refund-id")
+	assert.Error(t, err)
+}
+
+func TestGetRefundWhenDoerErrors(t *testing.T) {
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(nil, expectedError)
+
+	payClient := Client{doer: doer}
+
+	_, err := payClient.GetRefund(ctx, "payment-id", "refund-id")
+	assert.Equal(t, expectedError, err)
+}
+
+func TestGetRefundWhenResponseError(t *testing.T) {
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader("hey")),
+		}, nil)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		ErrorContext(ctx, "get refund failed", slog.String("body", "hey"), slog.Int("status_code", http.StatusNotFound))
+
+	payClient := Client{doer: doer, logger: logger}
+
+	_, err := payClient.GetRefund(ctx, "payment-id", "refund-id")
+	assert.Error(t, err)
+}
+
+func TestGetRefundWhenJsonError(t *testing.T) {
+	doer := newMockDoer(t)
+	doer.EXPECT().
+		Do(mock.Anything).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("hey")),
+		}, nil)
+
+	payClient := Client{doer: doer}
+
+	_, err := payClient.GetRefund(ctx, "payment-id", "refund-id")
+	assert.Error(t, err)
+}
+
 func TestCanRedirect(t *testing.T) {
 	c := &Client{}
 	assert.True(t, c.CanRedirect("https://www.payments.service.gov.uk/whatever?hey"))
@@ -71,6 +71,23 @@ type RefundSummary struct {
 	AmountAvailable AmountPence `json:"amount_available"`
 }
 
+type CreateRefundBody struct {
+	Amount                int `json:"amount"`
+	RefundAmountAvailable int `json:"refund_amount_available"`
+}
+
+type RefundResponse struct {
+	RefundID          string                  `json:"refund_id"`
+	CreatedDate       time.Time               `json:"created_date"`
+	AmountPence       AmountPence             `json:"amount"`
+	Status            RefundStatus            `json:"status"`
+	SettlementSummary RefundSettlementSummary `json:"settlement_summary"`
+}
+
+type RefundSettlementSummary struct {
+	SettledDate date.Date `json:"settled_date"`
+}
+
 type SettlementSummary struct {
 	CaptureSubmitTime time.Time `json:"capture_submit_time"`
 	CapturedDate      date.Date `json:"captured_date"`
@@ -100,12 +117,13 @@ func (a AmountPence) Pence() int {
 }
 
 type GetPaymentResponse struct {
-	CreatedDate time.Time   `json:"created_date"`
-	AmountPence AmountPence `json:"amount"`
-	State       State       `json:"State"`
-	Description string      `json:"description"`
-	Reference   string      `json:"reference"`
-	Language    string      `json:"language"`
+	CreatedDate time.Time       `json:"created_date"`
+	AmountPence AmountPence     `json:"amount"`
+	State       State           `json:"State"`
+	Links       map[string]Link `json:"_links"`
+	Description string          `json:"description"`
+	Reference   string          `json:"reference"`
+	Language    string          `json:"language"`
 	//May be useful but until we define if/what we send in CreatePayment we can't marshal the response
 	//
 	//Metadata    struct {
@@ -0,0 +1,89 @@
+// Code generated by "enumerator -type RefundStatus -linecomment -trimprefix -empty"; DO NOT EDIT.
+
+package pay
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[RefundStatusSubmitted-1]
+	_ = x[RefundStatusSuccess-2]
+	_ = x[RefundStatusError-3]
+}
+
+const _RefundStatus_name = "submittedsuccesserror"
+
+var _RefundStatus_index = [...]uint8{0, 9, 16, 21}
+
+func (i RefundStatus) String() string {
+	if i == 0 {
+		return ""
+	}
+	i -= 1
+	if i >= RefundStatus(len(_RefundStatus_index)-1) {
+		return "RefundStatus(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _RefundStatus_name[_RefundStatus_index[i]:_RefundStatus_index[i+1]]
+}
+
+func (i RefundStatus) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *RefundStatus) UnmarshalText(text []byte) error {
+	val, err := ParseRefundStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
+
+func (i RefundStatus) IsSubmitted() bool {
+	return i == RefundStatusSubmitted
+}
+
+func (i RefundStatus) IsSuccess() bool {
+	return i == RefundStatusSuccess
+}
+
+func (i RefundStatus) IsError() bool {
+	return i == RefundStatusError
+}
+
+func ParseRefundStatus(s string) (RefundStatus, error) {
+	switch s {
+	case "":
+		return RefundStatus(0), nil
+	case "submitted":
+		return RefundStatusSubmitted, nil
+	case "success":
+		return RefundStatusSuccess, nil
+	case "error":
+		return RefundStatusError, nil
+	default:
+		return RefundStatus(0), fmt.Errorf("invalid RefundStatus '%s'", s)
+	}
+}
+
+type RefundStatusOptions struct {
+	Submitted RefundStatus
+	Success   RefundStatus
+	Error     RefundStatus
+}
+
+var RefundStatusValues = RefundStatusOptions{
+	Submitted: RefundStatusSubmitted,
+	Success:   RefundStatusSuccess,
+	Error:     RefundStatusError,
+}
+
+func (i RefundStatus) Empty() bool {
+	return i == RefundStatus(0)
+}
@@ -1,11 +1,118 @@
 package pay
 
-const (
-	FeeFull    = 9200
-	FeeHalf    = 4600
-	FeeQuarter = 2300
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 )
 
+// defaultFeeSchedulesJSON is used until SetFeeSchedules is called, so only
+// applies to tests. When running, the fees are configured by the environment.
+//
+//go:embed fees.json
+var defaultFeeSchedulesJSON []byte
+
+var feeSchedules = mustParseFeeSchedules(defaultFeeSchedulesJSON)
+
+// A FeeSchedule gives the amounts, in pence, charged for LPAs applied for on or
+// after From.
+type FeeSchedule struct {
+	From    time.Time `json:"from"`
+	Full    int       `json:"full"`
+	Half    int       `json:"half"`
+	Quarter int       `json:"quarter"`
+}
+
+// FullAmount returns the full fee, so that it can be shown.
+func (s FeeSchedule) FullAmount() AmountPence {
+	return AmountPence(s.Full)
+}
+
+// HalfAmount returns the half fee, so that it can be shown.
+func (s FeeSchedule) HalfAmount() AmountPence {
+	return AmountPence(s.Half)
+}
+
+// QuarterAmount returns the quarter fee, so that it can be shown.
+func (s FeeSchedule) QuarterAmount() AmountPence {
+	return AmountPence(s.Quarter)
+}
+
+// FeeSchedules are ordered by the time they take effect.
+type FeeSchedules []FeeSchedule
+
+func ParseFeeSchedules(data []byte) (FeeSchedules, error) {
+	var schedules FeeSchedules
+	if err := json.Unmarshal(data, &schedules); err != nil {
+		return nil, err
+	}
+
+	if len(schedules) == 0 {
+		return nil, errors.New("no fee schedules")
+	}
+
+	slices.SortFunc(schedules, func(a, b FeeSchedule) int {
+		return a.From.Compare(b.From)
+	})
+
+	for i, schedule := range schedules {
+		if schedule.From.IsZero() {
+			return nil, fmt.Errorf("fee schedule %d missing from", i)
+		}
+
+		if i > 0 && schedule.From.Equal(schedules[i-1].From) {
+			return nil, fmt.Errorf("fee schedules with same from %s", schedule.From.Format(time.RFC3339))
+		}
+
+		if schedule.Full <= 0 || schedule.Half <= 0 || schedule.Quarter <= 0 {
+			return nil, fmt.Errorf("fee schedule from %s missing amounts", schedule.From.Format(time.RFC3339))
+		}
+	}
+
+	return schedules, nil
+}
+
+func mustParseFeeSchedules(data []byte) FeeSchedules {
+	schedules, err := ParseFeeSchedules(data)
+	if err != nil {
+		panic(fmt.Errorf("invalid fee schedules: %w", err))
+	}
+
+	return schedules
+}
+
+// At returns the schedule in effect at t. The first schedule is used for any
+// time before it took effect.
+func (s FeeSchedules) At(t time.Time) FeeSchedule {
+	schedule := s[0]
+	for _, next := range s[1:] {
+		if t.Before(next.From) {
+			break
+		}
+
+		schedule = next
+	}
+
+	return schedule
+}
+
+// SetFeeSchedules sets the fees charged over time. When the statutory fee
+// changes a new schedule should be added with the date it takes effect, existing
+// schedules must not be changed as they are used for LPAs already applied for.
+//
+// It must only be called on start up, before any fees are calculated.
+func SetFeeSchedules(schedules FeeSchedules) {
+	feeSchedules = schedules
+}
+
+// FeeScheduleAt returns the configured schedule in effect at t.
+func FeeScheduleAt(t time.Time) FeeSchedule {
+	return feeSchedules.At(t)
+}
+
 //go:generate go tool enumerator -type FeeType
 type FeeType uint8
 
@@ -36,14 +143,15 @@ const (
 	CostOfRepeatApplicationHalfFee
 )
 
-func Cost(feeType FeeType, previousFee PreviousFee, costOfRepeatApplication CostOfRepeatApplication) int {
+// Cost returns the amount, in pence, to pay under the schedule.
+func (s FeeSchedule) Cost(feeType FeeType, previousFee PreviousFee, costOfRepeatApplication CostOfRepeatApplication) int {
 	switch feeType {
 	case FullFee:
-		return FeeFull
+		return s.Full
 	case HalfFee:
-		return FeeHalf
+		return s.Half
 	case QuarterFee:
-		return FeeQuarter
+		return s.Quarter
 	case RepeatApplicationFee:
 		if costOfRepeatApplication.IsNoFee() {
 			return 0
@@ -51,9 +159,9 @@ func Cost(feeType FeeType, previousFee PreviousFee, costOfRepeatApplication Cost
 
 		switch previousFee {
 		case PreviousFeeFull:
-			return FeeHalf
+			return s.Half
 		case PreviousFeeHalf:
-			return FeeQuarter
+			return s.Quarter
 		default:
 			return 0
 		}
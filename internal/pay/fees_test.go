@@ -2,11 +2,86 @@ package pay
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
-func TestCost(t *testing.T) {
+var testFeeSchedule = FeeSchedule{Full: 100, Half: 50, Quarter: 25}
+
+func TestParseFeeSchedules(t *testing.T) {
+	schedules, err := ParseFeeSchedules([]byte(`[
+		{"from": "2025-04-01T00:00:00+01:00", "full": 9500, "half": 4750, "quarter": 2375},
+		{"from": "2023-11-20T00:00:00Z", "full": 9200, "half": 4600, "quarter": 2300}
+	]`))
+
+	assert.Nil(t, err)
+	assert.Equal(t, FeeSchedules{
+		{From: time.Date(2023, time.November, 20, 0, 0, 0, 0, time.UTC), Full: 9200, Half: 4600, Quarter: 2300},
+		{From: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.FixedZone("", 3600)), Full: 9500, Half: 4750, Quarter: 2375},
+	}, schedules)
+}
+
+func TestParseFeeSchedulesWhenInvalid(t *testing.T) {
+	testcases := map[string]string{
+		"not json":     `{`,
+		"empty":        `[]`,
+		"missing from": `[{"full": 9200, "half": 4600, "quarter": 2300}]`,
+		"missing fee":  `[{"from": "2023-11-20T00:00:00Z", "full": 9200, "half": 4600}]`,
+		"same from": `[
+			{"from": "2023-11-20T00:00:00Z", "full": 9200, "half": 4600, "quarter": 2300},
+			{"from": "2023-11-20T00:00:00Z", "full": 9500, "half": 4750, "quarter": 2375}
+		]`,
+	}
+
+	for name, data := range testcases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseFeeSchedules([]byte(data))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFeeSchedulesAt(t *testing.T) {
+	first := FeeSchedule{From: time.Date(2023, time.November, 20, 0, 0, 0, 0, time.UTC), Full: 1}
+	second := FeeSchedule{From: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC), Full: 2}
+	schedules := FeeSchedules{first, second}
+
+	assert.Equal(t, first, schedules.At(time.Time{}))
+	assert.Equal(t, first, schedules.At(first.From))
+	assert.Equal(t, first, schedules.At(second.From.Add(-time.Nanosecond)))
+	assert.Equal(t, second, schedules.At(second.From))
+	assert.Equal(t, second, schedules.At(second.From.AddDate(1, 0, 0)))
+}
+
+func TestFeeScheduleAt(t *testing.T) {
+	assert.Equal(t, feeSchedules[0], FeeScheduleAt(time.Time{}))
+	assert.Equal(t, feeSchedules[len(feeSchedules)-1], FeeScheduleAt(time.Now()))
+}
+
+func TestSetFeeSchedules(t *testing.T) {
+	defaultSchedules := feeSchedules
+	defer SetFeeSchedules(defaultSchedules)
+
+	schedule := FeeSchedule{From: time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC), Full: 9500, Half: 4750, Quarter: 2375}
+	SetFeeSchedules(FeeSchedules{schedule})
+
+	assert.Equal(t, schedule, FeeScheduleAt(time.Now()))
+}
+
+func TestFeeScheduleAmounts(t *testing.T) {
+	schedule := FeeSchedule{Full: 9200, Half: 4600, Quarter: 2300}
+
+	assert.Equal(t, "£92", schedule.FullAmount().String())
+	assert.Equal(t, "£46", schedule.HalfAmount().String())
+	assert.Equal(t, "£23", schedule.QuarterAmount().String())
+}
+
+func TestMustParseFeeSchedulesWhenInvalid(t *testing.T) {
+	assert.Panics(t, func() { mustParseFeeSchedules([]byte(`[]`)) })
+}
+
+func TestFeeScheduleCost(t *testing.T) {
 	testCases := map[string]struct {
 		feeType                 FeeType
 		previousFee             PreviousFee
@@ -15,15 +90,15 @@ func TestCost(t *testing.T) {
 	}{
 		"full": {
 			feeType:  FullFee,
-			expected: FeeFull,
+			expected: 100,
 		},
 		"half": {
 			feeType:  HalfFee,
-			expected: FeeHalf,
+			expected: 50,
 		},
 		"quarter": {
 			feeType:  QuarterFee,
-			expected: FeeQuarter,
+			expected: 25,
 		},
 		"no fee": {
 			feeType:  NoFee,
@@ -36,12 +111,12 @@ func TestCost(t *testing.T) {
 		"previous full": {
 			feeType:     RepeatApplicationFee,
 			previousFee: PreviousFeeFull,
-			expected:    FeeHalf,
+			expected:    50,
 		},
 		"previous half": {
 			feeType:     RepeatApplicationFee,
 			previousFee: PreviousFeeHalf,
-			expected:    FeeQuarter,
+			expected:    25,
 		},
 		"previous exemption": {
 			feeType:     RepeatApplicationFee,
@@ -57,7 +132,7 @@ func TestCost(t *testing.T) {
 			feeType:                 RepeatApplicationFee,
 			costOfRepeatApplication: CostOfRepeatApplicationHalfFee,
 			previousFee:             PreviousFeeFull,
-			expected:                FeeHalf,
+			expected:                50,
 		},
 		"repeat entitled to no": {
 			feeType:                 RepeatApplicationFee,
@@ -68,7 +143,7 @@ func TestCost(t *testing.T) {
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			assert.Equal(t, tc.expected, Cost(tc.feeType, tc.previousFee, tc.costOfRepeatApplication))
+			assert.Equal(t, tc.expected, testFeeSchedule.Cost(tc.feeType, tc.previousFee, tc.costOfRepeatApplication))
 		})
 	}
 }
@@ -0,0 +1,10 @@
+package pay
+
+//go:generate go tool enumerator -type RefundStatus -linecomment -trimprefix -empty
+type RefundStatus uint8
+
+const (
+	RefundStatusSubmitted RefundStatus = iota + 1 // submitted
+	RefundStatusSuccess                           // success
+	RefundStatusError                             // error
+)
@@ -19,26 +19,10 @@ func (a Address) Encode() string {
 	return string(x)
 }
 
+// Lines gives the address as it would be written in its country, excluding the
+// country itself.
 func (a Address) Lines() []string {
-	var parts []string
-
-	if a.Line1 != "" {
-		parts = append(parts, a.Line1)
-	}
-	if a.Line2 != "" {
-		parts = append(parts, a.Line2)
-	}
-	if a.Line3 != "" {
-		parts = append(parts, a.Line3)
-	}
-	if a.TownOrCity != "" {
-		parts = append(parts, a.TownOrCity)
-	}
-	if a.Postcode != "" {
-		parts = append(parts, a.Postcode)
-	}
-
-	return parts
+	return AddressFormatFor(a.Country).Lines(a)
 }
 
 func (a Address) String() string {
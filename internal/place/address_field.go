@@ -0,0 +1,11 @@
+package place
+
+//go:generate go tool enumerator -type AddressField --linecomment --trimprefix
+type AddressField uint8
+
+const (
+	AddressFieldStreetName AddressField = iota + 1 // streetName
+	AddressFieldTown                               // town
+	AddressFieldRegion                             // region
+	AddressFieldPostalCode                         // postalCode
+)
@@ -0,0 +1,158 @@
+package place
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type localityLayout uint8
+
+const (
+	// localityOnSeparateLines writes the town and postal code on their own
+	// lines, as in the UK.
+	localityOnSeparateLines localityLayout = iota
+	// localityPostalCodeFirst writes the postal code before the town, as in
+	// most of Europe, e.g. "75008 Paris".
+	localityPostalCodeFirst
+	// localityOnOneLine writes the town, region and postal code together, as in
+	// North America and Australia, e.g. "Springfield, IL 62701".
+	localityOnOneLine
+)
+
+// An AddressFormat describes how addresses are written in a country, so that
+// they can be collected and shown in the way that is expected there.
+type AddressFormat struct {
+	// PostalCode is the pattern that an upper-cased postal code must match, it
+	// is nil when the country does not use postal codes.
+	PostalCode *regexp.Regexp
+	// PostalCodeOptional is set when a postal code is not needed for delivery.
+	PostalCodeOptional bool
+	// PostalCodeLabel is what postal codes are called, used to pick the label.
+	PostalCodeLabel string
+	// RegionRequired is set when addresses must include the region.
+	RegionRequired bool
+	// RegionLabel is what regions are called, used to pick the label.
+	RegionLabel string
+	// NumberAfterStreet is set when the building number follows the street name.
+	NumberAfterStreet bool
+
+	locality localityLayout
+}
+
+// AddressFormatFor returns the format for a country code, falling back to a
+// format that accepts most addresses when the country is not known.
+func AddressFormatFor(country string) AddressFormat {
+	format := addressFormats[country]
+
+	if format.PostalCodeLabel == "" {
+		format.PostalCodeLabel = "postalCode"
+	}
+
+	if format.RegionLabel == "" {
+		format.RegionLabel = "region"
+	}
+
+	return format
+}
+
+// Fields lists the fields after the building, in the order they should be
+// asked for.
+func (f AddressFormat) Fields() []AddressField {
+	var fields []AddressField
+	switch f.locality {
+	case localityPostalCodeFirst:
+		fields = []AddressField{AddressFieldStreetName, AddressFieldPostalCode, AddressFieldTown, AddressFieldRegion}
+	case localityOnOneLine:
+		fields = []AddressField{AddressFieldStreetName, AddressFieldTown, AddressFieldRegion, AddressFieldPostalCode}
+	default:
+		fields = []AddressField{AddressFieldStreetName, AddressFieldTown, AddressFieldPostalCode, AddressFieldRegion}
+	}
+
+	if f.PostalCode == nil {
+		return slices.DeleteFunc(fields, AddressField.IsPostalCode)
+	}
+
+	return fields
+}
+
+// Required reports whether the field must be given.
+func (f AddressFormat) Required(field AddressField) bool {
+	switch field {
+	case AddressFieldTown:
+		return true
+	case AddressFieldRegion:
+		return f.RegionRequired
+	case AddressFieldPostalCode:
+		return f.PostalCode != nil && !f.PostalCodeOptional
+	default:
+		return false
+	}
+}
+
+// Label returns the translation key to use for a field.
+func (f AddressFormat) Label(field AddressField) string {
+	var label string
+	switch field {
+	case AddressFieldTown:
+		return "townSuburbOrCity"
+	case AddressFieldStreetName:
+		label = "streetName"
+	case AddressFieldRegion:
+		label = f.RegionLabel
+	case AddressFieldPostalCode:
+		label = f.PostalCodeLabel
+	}
+
+	if !f.Required(field) {
+		label += "Optional"
+	}
+
+	return fmt.Sprintf("addressField:%s", label)
+}
+
+// ValidPostalCode reports whether the postal code is written correctly for the
+// country.
+func (f AddressFormat) ValidPostalCode(s string) bool {
+	return f.PostalCode == nil || f.PostalCode.MatchString(strings.ToUpper(s))
+}
+
+// Lines gives the address as it would be written in the country, excluding the
+// country itself.
+func (f AddressFormat) Lines(a Address) []string {
+	var lines []string
+	for _, line := range []string{a.Line1, a.Line2, a.Line3} {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	switch f.locality {
+	case localityPostalCodeFirst:
+		if s := strings.TrimSpace(a.Postcode + " " + a.TownOrCity); s != "" {
+			lines = append(lines, s)
+		}
+	case localityOnOneLine:
+		if s := strings.TrimSpace(a.TownOrCity + " " + a.Postcode); s != "" {
+			lines = append(lines, s)
+		}
+	default:
+		if a.TownOrCity != "" {
+			lines = append(lines, a.TownOrCity)
+		}
+		if a.Postcode != "" {
+			lines = append(lines, a.Postcode)
+		}
+	}
+
+	return lines
+}
+
+func (f AddressFormat) street(a InternationalAddress) string {
+	if f.NumberAfterStreet {
+		return strings.TrimSpace(a.StreetName + " " + a.BuildingNumber)
+	}
+
+	return strings.TrimSpace(a.BuildingNumber + " " + a.StreetName)
+}
@@ -0,0 +1,123 @@
+package place
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddressFormatsCoverCountries(t *testing.T) {
+	for _, country := range Countries {
+		_, ok := addressFormats[country]
+		assert.True(t, ok, country)
+	}
+}
+
+func TestAddressFormatFor(t *testing.T) {
+	assert.Equal(t, AddressFormat{PostalCodeLabel: "postalCode", RegionLabel: "region"}, AddressFormatFor("AO"))
+	assert.Equal(t, AddressFormat{PostalCodeLabel: "postalCode", RegionLabel: "region"}, AddressFormatFor("XX"))
+
+	fr := AddressFormatFor("FR")
+	assert.Equal(t, "postalCode", fr.PostalCodeLabel)
+	assert.Equal(t, "region", fr.RegionLabel)
+
+	us := AddressFormatFor("US")
+	assert.Equal(t, "zipCode", us.PostalCodeLabel)
+	assert.Equal(t, "state", us.RegionLabel)
+}
+
+func TestAddressFormatFields(t *testing.T) {
+	testcases := map[string][]AddressField{
+		"GB": {AddressFieldStreetName, AddressFieldTown, AddressFieldPostalCode, AddressFieldRegion},
+		"FR": {AddressFieldStreetName, AddressFieldPostalCode, AddressFieldTown, AddressFieldRegion},
+		"US": {AddressFieldStreetName, AddressFieldTown, AddressFieldRegion, AddressFieldPostalCode},
+		"AE": {AddressFieldStreetName, AddressFieldTown, AddressFieldRegion},
+	}
+
+	for country, fields := range testcases {
+		t.Run(country, func(t *testing.T) {
+			assert.Equal(t, fields, AddressFormatFor(country).Fields())
+		})
+	}
+}
+
+func TestAddressFormatRequiredAndLabel(t *testing.T) {
+	testcases := map[string]struct {
+		country  string
+		field    AddressField
+		required bool
+		label    string
+	}{
+		"street":                  {country: "FR", field: AddressFieldStreetName, label: "addressField:streetNameOptional"},
+		"town":                    {country: "FR", field: AddressFieldTown, required: true, label: "townSuburbOrCity"},
+		"region":                  {country: "FR", field: AddressFieldRegion, label: "addressField:regionOptional"},
+		"required region":         {country: "US", field: AddressFieldRegion, required: true, label: "addressField:state"},
+		"postal code":             {country: "FR", field: AddressFieldPostalCode, required: true, label: "addressField:postalCode"},
+		"optional postal code":    {country: "IE", field: AddressFieldPostalCode, label: "addressField:eircodeOptional"},
+		"no postal code":          {country: "AO", field: AddressFieldPostalCode, label: "addressField:postalCodeOptional"},
+		"renamed postal code":     {country: "US", field: AddressFieldPostalCode, required: true, label: "addressField:zipCode"},
+		"renamed optional region": {country: "ES", field: AddressFieldRegion, label: "addressField:provinceOptional"},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			format := AddressFormatFor(tc.country)
+			assert.Equal(t, tc.required, format.Required(tc.field))
+			assert.Equal(t, tc.label, format.Label(tc.field))
+		})
+	}
+}
+
+func TestAddressFormatValidPostalCode(t *testing.T) {
+	testcases := map[string]struct {
+		country    string
+		postalCode string
+		valid      bool
+	}{
+		"FR":             {country: "FR", postalCode: "75008", valid: true},
+		"FR with space":  {country: "FR", postalCode: "75 008", valid: true},
+		"FR too short":   {country: "FR", postalCode: "7500"},
+		"NL lowercase":   {country: "NL", postalCode: "1012 ab", valid: true},
+		"NL wrong":       {country: "NL", postalCode: "AB 1012"},
+		"CA":             {country: "CA", postalCode: "K1A 0B1", valid: true},
+		"US plus four":   {country: "US", postalCode: "62701-1234", valid: true},
+		"US letters":     {country: "US", postalCode: "ABCDE"},
+		"no postal code": {country: "AO", postalCode: "anything", valid: true},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.valid, AddressFormatFor(tc.country).ValidPostalCode(tc.postalCode))
+		})
+	}
+}
+
+func TestAddressLines(t *testing.T) {
+	testcases := map[string]struct {
+		address Address
+		lines   []string
+	}{
+		"uk": {
+			address: Address{Line1: "1 Road", Line3: "Area", TownOrCity: "Town", Postcode: "A1 1AA"},
+			lines:   []string{"1 Road", "Area", "Town", "A1 1AA"},
+		},
+		"postal code first": {
+			address: Address{Line1: "10 Rue Royale", TownOrCity: "Paris", Postcode: "75008", Country: "FR"},
+			lines:   []string{"10 Rue Royale", "75008 Paris"},
+		},
+		"on one line": {
+			address: Address{Line1: "1 Main St", TownOrCity: "Springfield, IL", Postcode: "62701", Country: "US"},
+			lines:   []string{"1 Main St", "Springfield, IL 62701"},
+		},
+		"no postal code": {
+			address: Address{Line1: "1 Road", TownOrCity: "Luanda", Country: "AO"},
+			lines:   []string{"1 Road", "Luanda"},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.lines, tc.address.Lines())
+		})
+	}
+}
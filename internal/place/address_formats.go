@@ -0,0 +1,208 @@
+package place
+
+import "regexp"
+
+func postalCode(pattern string) *regexp.Regexp {
+	return regexp.MustCompile(`^(?:` + pattern + `)$`)
+}
+
+// addressFormats has an entry for each of the Countries, based on the formats
+// used by Google's libaddressinput. An empty entry means the default format is
+// correct for the country.
+var addressFormats = map[string]AddressFormat{
+	"AF": {PostalCode: postalCode(`\d{4}`)},
+	"AL": {PostalCode: postalCode(`\d{4}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"DZ": {PostalCode: postalCode(`\d{5}`), locality: localityPostalCodeFirst},
+	"AD": {PostalCode: postalCode(`AD[1-7]0\d`), locality: localityPostalCodeFirst},
+	"AO": {},
+	"AG": {},
+	"AR": {PostalCode: postalCode(`[A-HJ-NP-Z]?\d{4}(?:[A-Z]{3})?`), RegionLabel: "province", NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"AM": {PostalCode: postalCode(`(?:37)?\d{4}`), locality: localityPostalCodeFirst},
+	"AU": {PostalCode: postalCode(`\d{4}`), RegionRequired: true, RegionLabel: "state", locality: localityOnOneLine},
+	"AT": {PostalCode: postalCode(`\d{4}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"AZ": {PostalCode: postalCode(`(?:AZ ?)?\d{4}`), locality: localityPostalCodeFirst},
+	"BH": {PostalCode: postalCode(`(?:1[0-2]|[1-9])\d{2}`)},
+	"BD": {PostalCode: postalCode(`\d{4}`)},
+	"BB": {PostalCode: postalCode(`BB\d{5}`), PostalCodeOptional: true, RegionLabel: "parish"},
+	"BY": {PostalCode: postalCode(`\d{6}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"BE": {PostalCode: postalCode(`\d{4}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"BZ": {},
+	"BJ": {},
+	"BT": {PostalCode: postalCode(`\d{5}`)},
+	"BO": {},
+	"BA": {PostalCode: postalCode(`\d{5}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"BW": {},
+	"BR": {PostalCode: postalCode(`\d{5}-?\d{3}`), RegionRequired: true, RegionLabel: "state", NumberAfterStreet: true},
+	"BN": {PostalCode: postalCode(`[A-Z]{2} ?\d{4}`)},
+	"BG": {PostalCode: postalCode(`\d{4}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"BF": {},
+	"BI": {},
+	"KH": {PostalCode: postalCode(`\d{5}`)},
+	"CM": {},
+	"CA": {PostalCode: postalCode(`[ABCEGHJKLMNPRSTVXY]\d[ABCEGHJ-NPRSTV-Z] ?\d[ABCEGHJ-NPRSTV-Z]\d`), RegionRequired: true, RegionLabel: "province", locality: localityOnOneLine},
+	"CV": {PostalCode: postalCode(`\d{4}`), locality: localityPostalCodeFirst},
+	"CF": {},
+	"TD": {},
+	"CL": {PostalCode: postalCode(`\d{7}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"CN": {PostalCode: postalCode(`\d{6}`), RegionRequired: true, RegionLabel: "province"},
+	"CO": {PostalCode: postalCode(`\d{6}`), NumberAfterStreet: true},
+	"KM": {},
+	"CG": {},
+	"CD": {},
+	"CR": {PostalCode: postalCode(`\d{4,5}|\d{3}-\d{4}`), RegionLabel: "province"},
+	"HR": {PostalCode: postalCode(`\d{5}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"CU": {PostalCode: postalCode(`\d{5}`), RegionLabel: "province"},
+	"CY": {PostalCode: postalCode(`\d{4}`), locality: localityPostalCodeFirst},
+	"CZ": {PostalCode: postalCode(`\d{3} ?\d{2}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"DK": {PostalCode: postalCode(`\d{4}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"DJ": {},
+	"DM": {},
+	"DO": {PostalCode: postalCode(`\d{5}`), locality: localityPostalCodeFirst},
+	"TL": {},
+	"EC": {PostalCode: postalCode(`\d{6}`)},
+	"EG": {PostalCode: postalCode(`\d{5}`), RegionLabel: "province"},
+	"SV": {PostalCode: postalCode(`(?:CP )?\d{4}`)},
+	"GQ": {},
+	"ER": {},
+	"EE": {PostalCode: postalCode(`\d{5}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"SZ": {PostalCode: postalCode(`[HLMS]\d{3}`)},
+	"ET": {PostalCode: postalCode(`\d{4}`), locality: localityPostalCodeFirst},
+	"FJ": {},
+	"FI": {PostalCode: postalCode(`\d{5}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"FR": {PostalCode: postalCode(`\d{2} ?\d{3}`), locality: localityPostalCodeFirst},
+	"GA": {},
+	"GE": {PostalCode: postalCode(`\d{4}`), locality: localityPostalCodeFirst},
+	"DE": {PostalCode: postalCode(`\d{5}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"GH": {},
+	"GR": {PostalCode: postalCode(`\d{3} ?\d{2}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"GD": {},
+	"GT": {PostalCode: postalCode(`\d{5}`), locality: localityPostalCodeFirst},
+	"GN": {PostalCode: postalCode(`\d{3}`)},
+	"GW": {PostalCode: postalCode(`\d{4}`), locality: localityPostalCodeFirst},
+	"GY": {},
+	"HT": {PostalCode: postalCode(`\d{4}`), locality: localityPostalCodeFirst},
+	"HN": {PostalCode: postalCode(`\d{5}`)},
+	"HU": {PostalCode: postalCode(`\d{4}`), NumberAfterStreet: true},
+	"IS": {PostalCode: postalCode(`\d{3}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"IN": {PostalCode: postalCode(`\d{3} ?\d{3}`), PostalCodeLabel: "pinCode", RegionRequired: true, RegionLabel: "state"},
+	"ID": {PostalCode: postalCode(`\d{5}`), RegionLabel: "province"},
+	"IR": {PostalCode: postalCode(`\d{5}-?\d{5}`), RegionLabel: "province"},
+	"IQ": {PostalCode: postalCode(`\d{5}`), RegionLabel: "province"},
+	"IE": {PostalCode: postalCode(`[\dA-Z]{3} ?[\dA-Z]{4}`), PostalCodeOptional: true, PostalCodeLabel: "eircode", RegionLabel: "county"},
+	"IL": {PostalCode: postalCode(`\d{5}(?:\d{2})?`), NumberAfterStreet: true, locality: localityOnOneLine},
+	"IT": {PostalCode: postalCode(`\d{5}`), RegionRequired: true, RegionLabel: "province", locality: localityPostalCodeFirst},
+	"CI": {},
+	"JM": {RegionRequired: true, RegionLabel: "parish"},
+	"JP": {PostalCode: postalCode(`\d{3}-?\d{4}`), RegionRequired: true, RegionLabel: "prefecture"},
+	"JO": {PostalCode: postalCode(`\d{5}`)},
+	"KZ": {PostalCode: postalCode(`\d{6}`), locality: localityPostalCodeFirst},
+	"KE": {PostalCode: postalCode(`\d{5}`)},
+	"KI": {},
+	"XK": {PostalCode: postalCode(`\d{5}`), locality: localityPostalCodeFirst},
+	"KW": {PostalCode: postalCode(`\d{5}`), locality: localityPostalCodeFirst},
+	"KG": {PostalCode: postalCode(`\d{6}`), locality: localityPostalCodeFirst},
+	"LA": {PostalCode: postalCode(`\d{5}`), locality: localityPostalCodeFirst},
+	"LV": {PostalCode: postalCode(`LV-?\d{4}`), NumberAfterStreet: true},
+	"LB": {PostalCode: postalCode(`\d{4}(?: ?\d{4})?`), PostalCodeOptional: true},
+	"LS": {PostalCode: postalCode(`\d{3}`)},
+	"LR": {PostalCode: postalCode(`\d{4}`), locality: localityPostalCodeFirst},
+	"LY": {},
+	"LI": {PostalCode: postalCode(`948[5-9]|949[0-8]`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"LT": {PostalCode: postalCode(`(?:LT-?)?\d{5}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"LU": {PostalCode: postalCode(`(?:L-?)?\d{4}`), locality: localityPostalCodeFirst},
+	"MG": {PostalCode: postalCode(`\d{3}`), locality: localityPostalCodeFirst},
+	"MW": {},
+	"MY": {PostalCode: postalCode(`\d{5}`), RegionRequired: true, RegionLabel: "state", locality: localityPostalCodeFirst},
+	"MV": {PostalCode: postalCode(`\d{5}`)},
+	"ML": {},
+	"MT": {PostalCode: postalCode(`[A-Z]{3} ?\d{2,4}`)},
+	"MH": {PostalCode: postalCode(`969[67]\d(?:-\d{4})?`), PostalCodeLabel: "zipCode", RegionLabel: "state", locality: localityOnOneLine},
+	"MR": {},
+	"MU": {PostalCode: postalCode(`\d{3}(?:\d{2}|[A-Z]{2}\d{3})`)},
+	"MX": {PostalCode: postalCode(`\d{5}`), RegionRequired: true, RegionLabel: "state", NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"FM": {PostalCode: postalCode(`9694[1-4](?:-\d{4})?`), PostalCodeLabel: "zipCode", RegionLabel: "state", locality: localityOnOneLine},
+	"MD": {PostalCode: postalCode(`(?:MD-?)?\d{4}`), locality: localityPostalCodeFirst},
+	"MC": {PostalCode: postalCode(`980\d{2}`), locality: localityPostalCodeFirst},
+	"MN": {PostalCode: postalCode(`\d{5}`), RegionLabel: "province"},
+	"ME": {PostalCode: postalCode(`8\d{4}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"MA": {PostalCode: postalCode(`\d{5}`), locality: localityPostalCodeFirst},
+	"MZ": {PostalCode: postalCode(`\d{4}`), RegionLabel: "province", locality: localityPostalCodeFirst},
+	"MM": {PostalCode: postalCode(`\d{5}`)},
+	"NA": {PostalCode: postalCode(`\d{5}`)},
+	"NR": {},
+	"NP": {PostalCode: postalCode(`\d{5}`)},
+	"NL": {PostalCode: postalCode(`\d{4} ?[A-Z]{2}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"NZ": {PostalCode: postalCode(`\d{4}`), locality: localityOnOneLine},
+	"NI": {PostalCode: postalCode(`\d{5}`), locality: localityPostalCodeFirst},
+	"NE": {PostalCode: postalCode(`\d{4}`), locality: localityPostalCodeFirst},
+	"NG": {PostalCode: postalCode(`\d{6}`), RegionRequired: true, RegionLabel: "state", locality: localityOnOneLine},
+	"KP": {},
+	"MK": {PostalCode: postalCode(`\d{4}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"NO": {PostalCode: postalCode(`\d{4}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"OM": {PostalCode: postalCode(`(?:PC )?\d{3}`), locality: localityPostalCodeFirst},
+	"PK": {PostalCode: postalCode(`\d{5}`), locality: localityOnOneLine},
+	"PW": {PostalCode: postalCode(`969(?:39|40)(?:-\d{4})?`), PostalCodeLabel: "zipCode", RegionLabel: "state", locality: localityOnOneLine},
+	"PA": {RegionLabel: "province"},
+	"PG": {PostalCode: postalCode(`\d{3}`), RegionLabel: "province", locality: localityOnOneLine},
+	"PY": {PostalCode: postalCode(`\d{4}`), locality: localityPostalCodeFirst},
+	"PE": {PostalCode: postalCode(`\d{5}`), NumberAfterStreet: true},
+	"PH": {PostalCode: postalCode(`\d{4}`), RegionLabel: "province", locality: localityOnOneLine},
+	"PL": {PostalCode: postalCode(`\d{2}-\d{3}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"PT": {PostalCode: postalCode(`\d{4}-\d{3}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"QA": {},
+	"RO": {PostalCode: postalCode(`\d{6}`), NumberAfterStreet: true, RegionLabel: "county", locality: localityPostalCodeFirst},
+	"RU": {PostalCode: postalCode(`\d{6}`), NumberAfterStreet: true},
+	"RW": {},
+	"KN": {RegionLabel: "island"},
+	"LC": {},
+	"VC": {PostalCode: postalCode(`VC\d{4}`), PostalCodeOptional: true},
+	"WS": {},
+	"SM": {PostalCode: postalCode(`4789\d`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"ST": {},
+	"SA": {PostalCode: postalCode(`\d{5}(?:-\d{4})?`), locality: localityOnOneLine},
+	"SN": {PostalCode: postalCode(`\d{5}`), locality: localityPostalCodeFirst},
+	"RS": {PostalCode: postalCode(`\d{5,6}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"SC": {RegionLabel: "island"},
+	"SL": {},
+	"SG": {PostalCode: postalCode(`\d{6}`), locality: localityOnOneLine},
+	"SK": {PostalCode: postalCode(`\d{3} ?\d{2}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"SI": {PostalCode: postalCode(`(?:SI-)?\d{4}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"SB": {RegionLabel: "province"},
+	"SO": {PostalCode: postalCode(`[A-Z]{2} ?\d{5}`), locality: localityOnOneLine},
+	"ZA": {PostalCode: postalCode(`\d{4}`), RegionLabel: "province"},
+	"KR": {PostalCode: postalCode(`\d{5}`), RegionLabel: "province"},
+	"SS": {},
+	"ES": {PostalCode: postalCode(`\d{5}`), RegionLabel: "province", NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"LK": {PostalCode: postalCode(`\d{5}`)},
+	"SD": {PostalCode: postalCode(`\d{5}`), RegionLabel: "state"},
+	"SR": {},
+	"SE": {PostalCode: postalCode(`\d{3} ?\d{2}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"CH": {PostalCode: postalCode(`\d{4}`), RegionLabel: "canton", NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"SY": {},
+	"TJ": {PostalCode: postalCode(`\d{6}`), locality: localityPostalCodeFirst},
+	"TZ": {PostalCode: postalCode(`\d{4,5}`)},
+	"TH": {PostalCode: postalCode(`\d{5}`), RegionLabel: "province", locality: localityOnOneLine},
+	"BS": {RegionLabel: "island"},
+	"GM": {},
+	"TG": {},
+	"TO": {},
+	"TT": {PostalCode: postalCode(`\d{6}`), PostalCodeOptional: true},
+	"TN": {PostalCode: postalCode(`\d{4}`), locality: localityPostalCodeFirst},
+	"TR": {PostalCode: postalCode(`\d{5}`), RegionLabel: "province", NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"TM": {PostalCode: postalCode(`\d{6}`), locality: localityPostalCodeFirst},
+	"TV": {},
+	"UG": {},
+	"UA": {PostalCode: postalCode(`\d{5}`), NumberAfterStreet: true},
+	"AE": {RegionRequired: true, RegionLabel: "emirate"},
+	"GB": {PostalCode: postalCode(`GIR ?0AA|[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}`), PostalCodeLabel: "postcode", RegionLabel: "county"},
+	"US": {PostalCode: postalCode(`\d{5}(?:[ -]\d{4})?`), PostalCodeLabel: "zipCode", RegionRequired: true, RegionLabel: "state", locality: localityOnOneLine},
+	"UY": {PostalCode: postalCode(`\d{5}`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"UZ": {PostalCode: postalCode(`\d{6}`), locality: localityPostalCodeFirst},
+	"VU": {},
+	"VA": {PostalCode: postalCode(`00120`), NumberAfterStreet: true, locality: localityPostalCodeFirst},
+	"VE": {PostalCode: postalCode(`\d{4}`), RegionLabel: "state", locality: localityOnOneLine},
+	"VN": {PostalCode: postalCode(`\d{5,6}`), RegionLabel: "province"},
+	"YE": {},
+	"ZM": {PostalCode: postalCode(`\d{5}`), locality: localityPostalCodeFirst},
+	"ZW": {},
+}
@@ -0,0 +1,16 @@
+package place
+
+import "context"
+
+// An AddressProvider finds the addresses for a UK postcode. A postcode that is
+// not valid returns a BadRequestError, and a postcode with no addresses returns
+// no results.
+type AddressProvider interface {
+	LookupPostcode(ctx context.Context, postcode string) ([]Address, error)
+}
+
+var (
+	_ AddressProvider = (*Client)(nil)
+	_ AddressProvider = (*FileProvider)(nil)
+	_ AddressProvider = (*CachedClient)(nil)
+)
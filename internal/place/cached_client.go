@@ -0,0 +1,273 @@
+package place
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+// ErrLookupTimeout is returned when the Places API takes too long to respond,
+// so that the address can be entered manually instead.
+var ErrLookupTimeout = errors.New("postcode lookup timed out")
+
+// maxCachedPostcodes limits how many postcodes are held in memory.
+const maxCachedPostcodes = 10_000
+
+type Logger interface {
+	WarnContext(ctx context.Context, msg string, args ...any)
+}
+
+// A PostcodeCache is a shared cache used when a postcode is not held in memory.
+type PostcodeCache interface {
+	Get(ctx context.Context, postcode string) ([]Address, bool, error)
+	Put(ctx context.Context, postcode string, addresses []Address, expiresAt time.Time) error
+}
+
+type MetricsClient interface {
+	PutMetrics(ctx context.Context, input *cloudwatch.PutMetricDataInput) error
+}
+
+type cachedPostcode struct {
+	addresses []Address
+	expiresAt time.Time
+}
+
+type postcodeLookup struct {
+	done      chan struct{}
+	addresses []Address
+	err       error
+}
+
+type cacheMetrics struct {
+	hits, misses, errors, timeouts int
+	upstreamCalls                  int
+	upstreamTime                   time.Duration
+}
+
+// A CachedClient looks up postcodes, remembering the results for a time.
+// Concurrent lookups of the same postcode share a single request to the Places
+// API.
+type CachedClient struct {
+	logger  Logger
+	client  AddressProvider
+	cache   PostcodeCache
+	ttl     time.Duration
+	timeout time.Duration
+	now     func() time.Time
+
+	mu        sync.Mutex
+	postcodes map[string]cachedPostcode
+	lookups   map[string]*postcodeLookup
+	metrics   cacheMetrics
+}
+
+// NewCachedClient wraps client so that results are cached for ttl, and requests
+// taking longer than timeout return ErrLookupTimeout. The cache is optional.
+func NewCachedClient(logger Logger, client AddressProvider, cache PostcodeCache, ttl, timeout time.Duration) *CachedClient {
+	return &CachedClient{
+		logger:    logger,
+		client:    client,
+		cache:     cache,
+		ttl:       ttl,
+		timeout:   timeout,
+		now:       time.Now,
+		postcodes: map[string]cachedPostcode{},
+		lookups:   map[string]*postcodeLookup{},
+	}
+}
+
+func (c *CachedClient) LookupPostcode(ctx context.Context, postcode string) ([]Address, error) {
+	key := normalisePostcode(postcode)
+
+	c.mu.Lock()
+	if cached, ok := c.postcodes[key]; ok && c.now().Before(cached.expiresAt) {
+		c.metrics.hits++
+		c.mu.Unlock()
+		return cached.addresses, nil
+	}
+
+	if lookup, ok := c.lookups[key]; ok {
+		c.mu.Unlock()
+
+		select {
+		case <-lookup.done:
+			return lookup.addresses, lookup.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	lookup := &postcodeLookup{done: make(chan struct{})}
+	c.lookups[key] = lookup
+	c.mu.Unlock()
+
+	lookup.addresses, lookup.err = c.lookup(ctx, key)
+
+	c.mu.Lock()
+	delete(c.lookups, key)
+	if lookup.err == nil {
+		c.remember(key, lookup.addresses)
+	}
+	c.mu.Unlock()
+
+	close(lookup.done)
+	return lookup.addresses, lookup.err
+}
+
+func (c *CachedClient) lookup(ctx context.Context, postcode string) ([]Address, error) {
+	if c.cache != nil {
+		addresses, ok, err := c.cache.Get(ctx, postcode)
+		if err != nil {
+			c.logger.WarnContext(ctx, "postcode cache get", slog.Any("err", err))
+		} else if ok {
+			c.record(func(m *cacheMetrics) { m.hits++ })
+			return addresses, nil
+		}
+	}
+
+	// the request is detached from ctx, so that other lookups waiting on the
+	// same postcode are not cancelled with it
+	upstreamCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.timeout)
+	defer cancel()
+
+	start := c.now()
+	addresses, err := c.client.LookupPostcode(upstreamCtx, postcode)
+	elapsed := c.now().Sub(start)
+
+	timedOut := errors.Is(upstreamCtx.Err(), context.DeadlineExceeded)
+	c.record(func(m *cacheMetrics) {
+		m.misses++
+		m.upstreamCalls++
+		m.upstreamTime += elapsed
+		if timedOut {
+			m.timeouts++
+		} else if err != nil {
+			m.errors++
+		}
+	})
+
+	if timedOut {
+		return nil, ErrLookupTimeout
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if c.cache != nil {
+		if err := c.cache.Put(ctx, postcode, addresses, c.now().Add(c.ttl)); err != nil {
+			c.logger.WarnContext(ctx, "postcode cache put", slog.Any("err", err))
+		}
+	}
+
+	return addresses, nil
+}
+
+// remember must be called with mu held.
+func (c *CachedClient) remember(postcode string, addresses []Address) {
+	now := c.now()
+
+	if len(c.postcodes) >= maxCachedPostcodes {
+		for k, v := range c.postcodes {
+			if !now.Before(v.expiresAt) {
+				delete(c.postcodes, k)
+			}
+		}
+	}
+
+	if len(c.postcodes) >= maxCachedPostcodes {
+		for k := range c.postcodes {
+			delete(c.postcodes, k)
+			break
+		}
+	}
+
+	c.postcodes[postcode] = cachedPostcode{addresses: addresses, expiresAt: now.Add(c.ttl)}
+}
+
+func (c *CachedClient) record(fn func(*cacheMetrics)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fn(&c.metrics)
+}
+
+// Metrics returns the hit rate and upstream latency since Metrics was last
+// called.
+func (c *CachedClient) Metrics() cloudwatch.PutMetricDataInput {
+	c.mu.Lock()
+	m := c.metrics
+	c.metrics = cacheMetrics{}
+	c.mu.Unlock()
+
+	var hitRate, latency float64
+	if total := m.hits + m.misses; total > 0 {
+		hitRate = 100 * float64(m.hits) / float64(total)
+	}
+	if m.upstreamCalls > 0 {
+		latency = float64(m.upstreamTime.Milliseconds()) / float64(m.upstreamCalls)
+	}
+
+	return cloudwatch.PutMetricDataInput{
+		Namespace: aws.String("postcode-lookup"),
+		MetricData: []types.MetricDatum{
+			{
+				MetricName: aws.String("CacheHits"),
+				Unit:       types.StandardUnitCount,
+				Value:      aws.Float64(float64(m.hits)),
+			},
+			{
+				MetricName: aws.String("CacheMisses"),
+				Unit:       types.StandardUnitCount,
+				Value:      aws.Float64(float64(m.misses)),
+			},
+			{
+				MetricName: aws.String("CacheHitRate"),
+				Unit:       types.StandardUnitPercent,
+				Value:      aws.Float64(hitRate),
+			},
+			{
+				MetricName: aws.String("UpstreamLatency"),
+				Unit:       types.StandardUnitMilliseconds,
+				Value:      aws.Float64(latency),
+			},
+			{
+				MetricName: aws.String("UpstreamErrors"),
+				Unit:       types.StandardUnitCount,
+				Value:      aws.Float64(float64(m.errors)),
+			},
+			{
+				MetricName: aws.String("UpstreamTimeouts"),
+				Unit:       types.StandardUnitCount,
+				Value:      aws.Float64(float64(m.timeouts)),
+			},
+		},
+	}
+}
+
+// ReportMetrics sends Metrics at each interval, until ctx is done.
+func (c *CachedClient) ReportMetrics(ctx context.Context, metricsClient MetricsClient, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			metrics := c.Metrics()
+			if err := metricsClient.PutMetrics(ctx, &metrics); err != nil {
+				c.logger.WarnContext(ctx, "error putting postcode lookup metrics", slog.Any("err", err))
+			}
+		}
+	}
+}
+
+func normalisePostcode(postcode string) string {
+	return strings.ToUpper(strings.ReplaceAll(postcode, " ", ""))
+}
@@ -0,0 +1,304 @@
+package place
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	ctx           = context.WithValue(context.Background(), "a", "b")
+	expectedError = errors.New("err")
+	testNow       = time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC)
+	testNowFn     = func() time.Time { return testNow }
+)
+
+func TestCachedClientLookupPostcode(t *testing.T) {
+	addresses := []Address{{Line1: "1 Road", Postcode: "A1 1AA"}}
+
+	lookupClient := newMockAddressProvider(t)
+	lookupClient.EXPECT().
+		LookupPostcode(mock.Anything, "A11AA").
+		Return(addresses, nil).
+		Once()
+
+	client := NewCachedClient(nil, lookupClient, nil, time.Hour, time.Second)
+	client.now = testNowFn
+
+	result, err := client.LookupPostcode(ctx, "a1 1aa")
+	assert.Nil(t, err)
+	assert.Equal(t, addresses, result)
+
+	result, err = client.LookupPostcode(ctx, "A11AA")
+	assert.Nil(t, err)
+	assert.Equal(t, addresses, result)
+
+	metrics := client.Metrics()
+	assert.Equal(t, float64(1), *metrics.MetricData[0].Value)
+	assert.Equal(t, float64(1), *metrics.MetricData[1].Value)
+	assert.Equal(t, float64(50), *metrics.MetricData[2].Value)
+}
+
+func TestCachedClientLookupPostcodeWhenExpired(t *testing.T) {
+	lookupClient := newMockAddressProvider(t)
+	lookupClient.EXPECT().
+		LookupPostcode(mock.Anything, "A11AA").
+		Return([]Address{{Line1: "1 Road"}}, nil).
+		Once()
+	lookupClient.EXPECT().
+		LookupPostcode(mock.Anything, "A11AA").
+		Return([]Address{{Line1: "2 Road"}}, nil).
+		Once()
+
+	client := NewCachedClient(nil, lookupClient, nil, time.Hour, time.Second)
+	client.now = testNowFn
+
+	_, _ = client.LookupPostcode(ctx, "A11AA")
+
+	client.now = func() time.Time { return testNow.Add(time.Hour) }
+	result, err := client.LookupPostcode(ctx, "A11AA")
+	assert.Nil(t, err)
+	assert.Equal(t, []Address{{Line1: "2 Road"}}, result)
+}
+
+func TestCachedClientLookupPostcodeFromCache(t *testing.T) {
+	addresses := []Address{{Line1: "1 Road"}}
+
+	cache := newMockPostcodeCache(t)
+	cache.EXPECT().
+		Get(ctx, "A11AA").
+		Return(addresses, true, nil).
+		Once()
+
+	client := NewCachedClient(nil, nil, cache, time.Hour, time.Second)
+	client.now = testNowFn
+
+	result, err := client.LookupPostcode(ctx, "A1 1AA")
+	assert.Nil(t, err)
+	assert.Equal(t, addresses, result)
+
+	result, err = client.LookupPostcode(ctx, "A1 1AA")
+	assert.Nil(t, err)
+	assert.Equal(t, addresses, result)
+}
+
+func TestCachedClientLookupPostcodeWhenNotInCache(t *testing.T) {
+	addresses := []Address{{Line1: "1 Road"}}
+
+	cache := newMockPostcodeCache(t)
+	cache.EXPECT().
+		Get(ctx, "A11AA").
+		Return(nil, false, nil)
+	cache.EXPECT().
+		Put(ctx, "A11AA", addresses, testNow.Add(time.Hour)).
+		Return(nil)
+
+	lookupClient := newMockAddressProvider(t)
+	lookupClient.EXPECT().
+		LookupPostcode(mock.Anything, "A11AA").
+		Return(addresses, nil)
+
+	client := NewCachedClient(nil, lookupClient, cache, time.Hour, time.Second)
+	client.now = testNowFn
+
+	result, err := client.LookupPostcode(ctx, "A1 1AA")
+	assert.Nil(t, err)
+	assert.Equal(t, addresses, result)
+}
+
+func TestCachedClientLookupPostcodeWhenCacheErrors(t *testing.T) {
+	addresses := []Address{{Line1: "1 Road"}}
+
+	cache := newMockPostcodeCache(t)
+	cache.EXPECT().
+		Get(ctx, "A11AA").
+		Return(nil, false, expectedError)
+	cache.EXPECT().
+		Put(ctx, "A11AA", addresses, testNow.Add(time.Hour)).
+		Return(expectedError)
+
+	lookupClient := newMockAddressProvider(t)
+	lookupClient.EXPECT().
+		LookupPostcode(mock.Anything, "A11AA").
+		Return(addresses, nil)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		WarnContext(ctx, "postcode cache get", mock.Anything)
+	logger.EXPECT().
+		WarnContext(ctx, "postcode cache put", mock.Anything)
+
+	client := NewCachedClient(logger, lookupClient, cache, time.Hour, time.Second)
+	client.now = testNowFn
+
+	result, err := client.LookupPostcode(ctx, "A1 1AA")
+	assert.Nil(t, err)
+	assert.Equal(t, addresses, result)
+}
+
+func TestCachedClientLookupPostcodeWhenErrors(t *testing.T) {
+	lookupClient := newMockAddressProvider(t)
+	lookupClient.EXPECT().
+		LookupPostcode(mock.Anything, "A11AA").
+		Return(nil, expectedError).
+		Twice()
+
+	client := NewCachedClient(nil, lookupClient, nil, time.Hour, time.Second)
+	client.now = testNowFn
+
+	_, err := client.LookupPostcode(ctx, "A11AA")
+	assert.Equal(t, expectedError, err)
+
+	_, err = client.LookupPostcode(ctx, "A11AA")
+	assert.Equal(t, expectedError, err)
+
+	metrics := client.Metrics()
+	assert.Equal(t, float64(2), *metrics.MetricData[4].Value)
+}
+
+func TestCachedClientLookupPostcodeWhenTimeout(t *testing.T) {
+	lookupClient := newMockAddressProvider(t)
+	lookupClient.EXPECT().
+		LookupPostcode(mock.Anything, "A11AA").
+		RunAndReturn(func(ctx context.Context, _ string) ([]Address, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+	client := NewCachedClient(nil, lookupClient, nil, time.Hour, time.Millisecond)
+
+	_, err := client.LookupPostcode(ctx, "A11AA")
+	assert.Equal(t, ErrLookupTimeout, err)
+
+	metrics := client.Metrics()
+	assert.Equal(t, float64(1), *metrics.MetricData[5].Value)
+}
+
+func TestCachedClientLookupPostcodeCoalescesRequests(t *testing.T) {
+	addresses := []Address{{Line1: "1 Road"}}
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	lookupClient := newMockAddressProvider(t)
+	lookupClient.EXPECT().
+		LookupPostcode(mock.Anything, "A11AA").
+		RunAndReturn(func(context.Context, string) ([]Address, error) {
+			close(started)
+			<-release
+			return addresses, nil
+		}).
+		Once()
+
+	client := NewCachedClient(nil, lookupClient, nil, time.Hour, time.Second)
+
+	var wg sync.WaitGroup
+	results := make([][]Address, 3)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[0], _ = client.LookupPostcode(ctx, "A11AA")
+	}()
+	<-started
+
+	for i := 1; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = client.LookupPostcode(ctx, "a1 1aa")
+		}()
+	}
+
+	assert.Eventually(t, func() bool {
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		return len(client.lookups) == 1
+	}, time.Second, time.Millisecond)
+
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, [][]Address{addresses, addresses, addresses}, results)
+}
+
+func TestCachedClientLookupPostcodeWhenWaitingCancelled(t *testing.T) {
+	client := NewCachedClient(nil, nil, nil, time.Hour, time.Second)
+	client.lookups["A11AA"] = &postcodeLookup{done: make(chan struct{})}
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err := client.LookupPostcode(cancelledCtx, "A11AA")
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestCachedClientRememberWhenFull(t *testing.T) {
+	client := NewCachedClient(nil, nil, nil, time.Hour, time.Second)
+	client.now = testNowFn
+
+	for i := range maxCachedPostcodes {
+		client.postcodes[string(rune(i))] = cachedPostcode{expiresAt: testNow.Add(time.Minute)}
+	}
+	client.postcodes["expired"] = cachedPostcode{expiresAt: testNow}
+
+	client.remember("new", nil)
+
+	assert.Len(t, client.postcodes, maxCachedPostcodes)
+	assert.NotContains(t, client.postcodes, "expired")
+	assert.Contains(t, client.postcodes, "new")
+}
+
+func TestCachedClientMetrics(t *testing.T) {
+	client := NewCachedClient(nil, nil, nil, time.Hour, time.Second)
+	client.metrics = cacheMetrics{
+		hits:          3,
+		misses:        1,
+		errors:        1,
+		upstreamCalls: 2,
+		upstreamTime:  300 * time.Millisecond,
+	}
+
+	metrics := client.Metrics()
+	assert.Equal(t, aws.String("postcode-lookup"), metrics.Namespace)
+
+	values := map[string]float64{}
+	for _, datum := range metrics.MetricData {
+		values[*datum.MetricName] = *datum.Value
+	}
+
+	assert.Equal(t, map[string]float64{
+		"CacheHits":        3,
+		"CacheMisses":      1,
+		"CacheHitRate":     75,
+		"UpstreamLatency":  150,
+		"UpstreamErrors":   1,
+		"UpstreamTimeouts": 0,
+	}, values)
+	assert.Equal(t, cacheMetrics{}, client.metrics)
+}
+
+func TestCachedClientReportMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	metricsClient := newMockMetricsClient(t)
+	metricsClient.EXPECT().
+		PutMetrics(ctx, mock.Anything).
+		RunAndReturn(func(context.Context, *cloudwatch.PutMetricDataInput) error {
+			cancel()
+			return expectedError
+		})
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		WarnContext(ctx, "error putting postcode lookup metrics", mock.Anything)
+
+	client := NewCachedClient(logger, nil, nil, time.Hour, time.Second)
+	client.ReportMetrics(ctx, metricsClient, time.Millisecond)
+}
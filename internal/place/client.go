@@ -1,4 +1,5 @@
-// Package place provides a client for Ordnance Survey's Places API.
+// Package place looks up addresses, using Ordnance Survey's Places API or a
+// local dataset.
 package place
 
 import (
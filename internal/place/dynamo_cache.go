@@ -0,0 +1,54 @@
+package place
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+)
+
+type DynamoClient interface {
+	OneActive(ctx context.Context, pk dynamo.PK, sk dynamo.SK, now time.Time, v any) error
+	Put(ctx context.Context, v any) error
+}
+
+type dynamoPostcode struct {
+	PK        dynamo.PostcodeKeyType
+	SK        dynamo.MetadataKeyType
+	Addresses []Address
+	ExpiresAt time.Time `dynamodbav:",unixtime"`
+}
+
+// A DynamoCache shares postcode lookups between instances of the app, items are
+// removed by the table's TTL.
+type DynamoCache struct {
+	dynamoClient DynamoClient
+	now          func() time.Time
+}
+
+func NewDynamoCache(dynamoClient DynamoClient) *DynamoCache {
+	return &DynamoCache{dynamoClient: dynamoClient, now: time.Now}
+}
+
+func (c *DynamoCache) Get(ctx context.Context, postcode string) ([]Address, bool, error) {
+	var v dynamoPostcode
+	if err := c.dynamoClient.OneActive(ctx, dynamo.PostcodeKey(postcode), dynamo.MetadataKey(postcode), c.now(), &v); err != nil {
+		if errors.Is(err, dynamo.NotFoundError{}) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return v.Addresses, true, nil
+}
+
+func (c *DynamoCache) Put(ctx context.Context, postcode string, addresses []Address, expiresAt time.Time) error {
+	return c.dynamoClient.Put(ctx, dynamoPostcode{
+		PK:        dynamo.PostcodeKey(postcode),
+		SK:        dynamo.MetadataKey(postcode),
+		Addresses: addresses,
+		ExpiresAt: expiresAt,
+	})
+}
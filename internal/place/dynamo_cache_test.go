@@ -0,0 +1,81 @@
+package place
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (c *mockDynamoClient_OneActive_Call) SetData(data any) {
+	c.Run(func(ctx context.Context, pk dynamo.PK, sk dynamo.SK, now time.Time, v any) {
+		b, _ := attributevalue.Marshal(data)
+		attributevalue.Unmarshal(b, v)
+	})
+}
+
+func TestDynamoCacheGet(t *testing.T) {
+	addresses := []Address{{Line1: "1 Road"}}
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneActive(ctx, dynamo.PostcodeKey("A11AA"), dynamo.MetadataKey("A11AA"), testNow, mock.Anything).
+		Return(nil).
+		SetData(dynamoPostcode{Addresses: addresses})
+
+	cache := &DynamoCache{dynamoClient: dynamoClient, now: testNowFn}
+
+	result, ok, err := cache.Get(ctx, "A11AA")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, addresses, result)
+}
+
+func TestDynamoCacheGetWhenNotFound(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneActive(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(dynamo.NotFoundError{})
+
+	cache := &DynamoCache{dynamoClient: dynamoClient, now: testNowFn}
+
+	_, ok, err := cache.Get(ctx, "A11AA")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestDynamoCacheGetWhenErrors(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneActive(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	cache := &DynamoCache{dynamoClient: dynamoClient, now: testNowFn}
+
+	_, ok, err := cache.Get(ctx, "A11AA")
+	assert.Equal(t, expectedError, err)
+	assert.False(t, ok)
+}
+
+func TestDynamoCachePut(t *testing.T) {
+	addresses := []Address{{Line1: "1 Road"}}
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		Put(ctx, dynamoPostcode{
+			PK:        dynamo.PostcodeKey("A11AA"),
+			SK:        dynamo.MetadataKey("A11AA"),
+			Addresses: addresses,
+			ExpiresAt: testNow.Add(time.Hour),
+		}).
+		Return(expectedError)
+
+	cache := NewDynamoCache(dynamoClient)
+
+	err := cache.Put(ctx, "A11AA", addresses, testNow.Add(time.Hour))
+	assert.Equal(t, expectedError, err)
+}
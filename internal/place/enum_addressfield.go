@@ -0,0 +1,89 @@
+// Code generated by "enumerator -type AddressField --linecomment --trimprefix"; DO NOT EDIT.
+
+package place
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[AddressFieldStreetName-1]
+	_ = x[AddressFieldTown-2]
+	_ = x[AddressFieldRegion-3]
+	_ = x[AddressFieldPostalCode-4]
+}
+
+const _AddressField_name = "streetNametownregionpostalCode"
+
+var _AddressField_index = [...]uint8{0, 10, 14, 20, 30}
+
+func (i AddressField) String() string {
+	i -= 1
+	if i >= AddressField(len(_AddressField_index)-1) {
+		return "AddressField(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _AddressField_name[_AddressField_index[i]:_AddressField_index[i+1]]
+}
+
+func (i AddressField) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *AddressField) UnmarshalText(text []byte) error {
+	val, err := ParseAddressField(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
+
+func (i AddressField) IsStreetName() bool {
+	return i == AddressFieldStreetName
+}
+
+func (i AddressField) IsTown() bool {
+	return i == AddressFieldTown
+}
+
+func (i AddressField) IsRegion() bool {
+	return i == AddressFieldRegion
+}
+
+func (i AddressField) IsPostalCode() bool {
+	return i == AddressFieldPostalCode
+}
+
+func ParseAddressField(s string) (AddressField, error) {
+	switch s {
+	case "streetName":
+		return AddressFieldStreetName, nil
+	case "town":
+		return AddressFieldTown, nil
+	case "region":
+		return AddressFieldRegion, nil
+	case "postalCode":
+		return AddressFieldPostalCode, nil
+	default:
+		return AddressField(0), fmt.Errorf("invalid AddressField '%s'", s)
+	}
+}
+
+type AddressFieldOptions struct {
+	StreetName AddressField
+	Town       AddressField
+	Region     AddressField
+	PostalCode AddressField
+}
+
+var AddressFieldValues = AddressFieldOptions{
+	StreetName: AddressFieldStreetName,
+	Town:       AddressFieldTown,
+	Region:     AddressFieldRegion,
+	PostalCode: AddressFieldPostalCode,
+}
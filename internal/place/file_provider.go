@@ -0,0 +1,156 @@
+package place
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+)
+
+var (
+	fullPostcodeRe    = regexp.MustCompile(`^[A-Z]{1,2}[0-9][0-9A-Z]?[0-9][A-Z]{2}$`)
+	partialPostcodeRe = regexp.MustCompile(`^[A-Z]{1,2}[0-9][0-9A-Z]?[0-9]?$`)
+)
+
+// A FileProvider looks up postcodes in a local dataset of addresses, so that
+// realistic addresses can be used without calling the Places API. The dataset
+// is either a JSON array of AddressDetails, or a CSV file with a header row
+// using the same names as the Places API (SUB_BUILDING_NAME, BUILDING_NAME,
+// BUILDING_NUMBER, THOROUGHFARE_NAME, DEPENDENT_LOCALITY, POST_TOWN and
+// POSTCODE).
+type FileProvider struct {
+	postcodes map[string][]AddressDetails
+}
+
+// NewFileProvider reads the dataset at path, choosing the format by its
+// extension.
+func NewFileProvider(path string) (*FileProvider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var details []AddressDetails
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+		err = json.NewDecoder(f).Decode(&details)
+	case ".csv":
+		details, err = readAddressDetailsCSV(f)
+	default:
+		return nil, fmt.Errorf("address dataset has unsupported extension %q", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading address dataset %s: %w", path, err)
+	}
+
+	return newFileProvider(details)
+}
+
+func newFileProvider(details []AddressDetails) (*FileProvider, error) {
+	postcodes := map[string][]AddressDetails{}
+	for _, d := range details {
+		key := normalisePostcode(d.Postcode)
+		if !fullPostcodeRe.MatchString(key) {
+			return nil, fmt.Errorf("address dataset contains invalid postcode %q", d.Postcode)
+		}
+
+		postcodes[key] = append(postcodes[key], d)
+	}
+
+	return &FileProvider{postcodes: postcodes}, nil
+}
+
+func (p *FileProvider) LookupPostcode(ctx context.Context, postcode string) ([]Address, error) {
+	details, err := p.LookupDetails(postcode)
+	if err != nil {
+		return []Address{}, err
+	}
+
+	var addresses []Address
+	for _, d := range details {
+		addresses = append(addresses, d.TransformToAddress())
+	}
+
+	return addresses, nil
+}
+
+// LookupDetails returns the addresses for postcode as the Places API would.
+// Like the Places API, a partial postcode matches on its district or sector,
+// so "B14" and "B14 7" both match "B14 7ED" (and "B14" also matches the B1 4
+// sector).
+func (p *FileProvider) LookupDetails(postcode string) ([]AddressDetails, error) {
+	key := normalisePostcode(postcode)
+
+	if fullPostcodeRe.MatchString(key) {
+		return p.postcodes[key], nil
+	}
+
+	if !partialPostcodeRe.MatchString(key) {
+		return nil, BadRequestError{
+			Statuscode: 400,
+			Message:    "Requested postcode must contain a minimum of the sector plus 1 digit of the district e.g. SO1. Requested postcode was " + key,
+		}
+	}
+
+	var matched []string
+	for k := range p.postcodes {
+		outward, inward := k[:len(k)-3], k[len(k)-3:]
+		if key == outward || key == outward+inward[:1] {
+			matched = append(matched, k)
+		}
+	}
+	sort.Strings(matched)
+
+	var details []AddressDetails
+	for _, k := range matched {
+		details = append(details, p.postcodes[k]...)
+	}
+
+	return details, nil
+}
+
+func readAddressDetailsCSV(r io.Reader) ([]AddressDetails, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[name] = i
+	}
+	if _, ok := columns["POSTCODE"]; !ok {
+		return nil, fmt.Errorf("missing POSTCODE column")
+	}
+
+	get := func(record []string, name string) string {
+		if i, ok := columns[name]; ok {
+			return record[i]
+		}
+		return ""
+	}
+
+	details := make([]AddressDetails, len(records)-1)
+	for i, record := range records[1:] {
+		details[i] = AddressDetails{
+			SubBuildingName:   get(record, "SUB_BUILDING_NAME"),
+			BuildingName:      get(record, "BUILDING_NAME"),
+			BuildingNumber:    get(record, "BUILDING_NUMBER"),
+			ThoroughFareName:  get(record, "THOROUGHFARE_NAME"),
+			DependentLocality: get(record, "DEPENDENT_LOCALITY"),
+			Town:              get(record, "POST_TOWN"),
+			Postcode:          get(record, "POSTCODE"),
+		}
+	}
+
+	return details, nil
+}
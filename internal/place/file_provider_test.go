@@ -0,0 +1,103 @@
+package place
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testMeltonRoad = Address{
+		Line1:      "123 MELTON ROAD",
+		TownOrCity: "BIRMINGHAM",
+		Postcode:   "B14 7ET",
+		Country:    "GB",
+	}
+	testKingsHeath = Address{
+		Line1:      "87A",
+		Line2:      "MELTON ROAD",
+		Line3:      "KINGS HEATH",
+		TownOrCity: "BIRMINGHAM",
+		Postcode:   "B14 7ET",
+		Country:    "GB",
+	}
+)
+
+func TestNewFileProvider(t *testing.T) {
+	for _, path := range []string{"testdata/addresses.csv", "testdata/addresses.json"} {
+		t.Run(path, func(t *testing.T) {
+			provider, err := NewFileProvider(path)
+			assert.Nil(t, err)
+
+			addresses, err := provider.LookupPostcode(ctx, "b14 7et")
+			assert.Nil(t, err)
+			assert.Equal(t, []Address{testMeltonRoad, testKingsHeath}, addresses)
+		})
+	}
+}
+
+func TestNewFileProviderWhenErrors(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, data string) string {
+		path := filepath.Join(dir, name)
+		_ = os.WriteFile(path, []byte(data), 0o600)
+		return path
+	}
+
+	testcases := map[string]string{
+		"missing":          filepath.Join(dir, "missing.csv"),
+		"unsupported":      write("addresses.txt", ""),
+		"bad json":         write("bad.json", "{"),
+		"missing postcode": write("no-postcode.csv", "POST_TOWN\nBIRMINGHAM\n"),
+		"invalid postcode": write("invalid.csv", "POSTCODE\nB14\n"),
+	}
+
+	for name, path := range testcases {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewFileProvider(path)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFileProviderLookupPostcode(t *testing.T) {
+	provider, _ := NewFileProvider("testdata/addresses.csv")
+
+	b14Other := Address{Line1: "1 OTHER ROAD", TownOrCity: "BIRMINGHAM", Postcode: "B14 8AB", Country: "GB"}
+	b1Central := Address{Line1: "2 CENTRAL ROAD", TownOrCity: "BIRMINGHAM", Postcode: "B1 4AA", Country: "GB"}
+
+	testcases := map[string][]Address{
+		"B147ET":   {testMeltonRoad, testKingsHeath},
+		"B14 7ET ": {testMeltonRoad, testKingsHeath},
+		"B14":      {testMeltonRoad, testKingsHeath, b14Other, b1Central},
+		"B14 8":    {b14Other},
+		"B1":       {b1Central},
+		"B14 7EE":  nil,
+		"NE23 4EE": nil,
+	}
+
+	for postcode, expected := range testcases {
+		t.Run(postcode, func(t *testing.T) {
+			addresses, err := provider.LookupPostcode(ctx, postcode)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, addresses)
+		})
+	}
+}
+
+func TestFileProviderLookupPostcodeWhenInvalid(t *testing.T) {
+	provider, _ := NewFileProvider("testdata/addresses.csv")
+
+	for _, postcode := range []string{"INVALID", "", "B", "1AB", "B14 7E"} {
+		t.Run(postcode, func(t *testing.T) {
+			addresses, err := provider.LookupPostcode(ctx, postcode)
+			assert.Equal(t, []Address{}, addresses)
+			assert.Equal(t, BadRequestError{
+				Statuscode: 400,
+				Message:    "Requested postcode must contain a minimum of the sector plus 1 digit of the district e.g. SO1. Requested postcode was " + normalisePostcode(postcode),
+			}, err)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package place
 
-import "strings"
+import (
+	"slices"
+	"strings"
+)
 
 type InternationalAddress struct {
 	ApartmentNumber string `json:"apartmentNumber"`
@@ -13,38 +16,46 @@ type InternationalAddress struct {
 	Country         string `json:"country"`
 }
 
+// ToAddress fits the address into the fields of an Address, writing the street
+// as is usual for the country. The region is kept with the town, as there is no
+// separate field for it.
 func (a InternationalAddress) ToAddress() Address {
-	var line1, line2, line3 string
-	if a.BuildingName != "" {
-		if a.ApartmentNumber != "" {
-			line1 = strings.TrimSpace(a.ApartmentNumber + ", " + a.BuildingName)
-		} else {
-			line1 = a.BuildingName
-		}
-
-		if a.BuildingNumber != "" {
-			line2 = strings.TrimSpace(a.BuildingNumber + " " + a.StreetName)
-		} else {
-			line2 = a.StreetName
-		}
+	format := AddressFormatFor(a.Country)
+	street := format.street(a)
 
-		line3 = a.Town
+	var line1, line2 string
+	if a.BuildingName != "" {
+		line1 = joinNonEmpty(", ", a.ApartmentNumber, a.BuildingName)
+		line2 = street
 	} else {
-		line1 = strings.TrimSpace(a.BuildingNumber + " " + a.StreetName)
-		if a.ApartmentNumber != "" {
-			line1 = a.ApartmentNumber + ", " + line1
-		}
-		line2 = a.Town
+		line1 = joinNonEmpty(", ", a.ApartmentNumber, street)
 	}
 
 	return Address{
-		Line1: line1,
-		Line2: line2,
-		Line3: line3,
-		// putting region here is weird, but don't really have a better place to
-		// store in the current structure
-		TownOrCity: a.Region,
+		Line1:      line1,
+		Line2:      line2,
+		TownOrCity: joinNonEmpty(", ", a.Town, a.Region),
 		Postcode:   a.PostalCode,
 		Country:    a.Country,
 	}
 }
+
+// Field returns the value given for one of the fields of an AddressFormat.
+func (a InternationalAddress) Field(field AddressField) string {
+	switch field {
+	case AddressFieldStreetName:
+		return a.StreetName
+	case AddressFieldTown:
+		return a.Town
+	case AddressFieldRegion:
+		return a.Region
+	case AddressFieldPostalCode:
+		return a.PostalCode
+	default:
+		return ""
+	}
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	return strings.Join(slices.DeleteFunc(parts, func(s string) bool { return s == "" }), sep)
+}
@@ -22,8 +22,7 @@ func TestInternationalAddressToAddress(t *testing.T) {
 			},
 			out: Address{
 				Line1:      "123 Cool St",
-				Line2:      "Cooltown",
-				TownOrCity: "Coolshire",
+				TownOrCity: "Cooltown, Coolshire",
 				Postcode:   "ABC",
 				Country:    "What",
 			},
@@ -40,8 +39,7 @@ func TestInternationalAddressToAddress(t *testing.T) {
 			out: Address{
 				Line1:      "Cool Building",
 				Line2:      "Cool St",
-				Line3:      "Cooltown",
-				TownOrCity: "Coolshire",
+				TownOrCity: "Cooltown, Coolshire",
 				Postcode:   "ABC",
 				Country:    "What",
 			},
@@ -57,8 +55,7 @@ func TestInternationalAddressToAddress(t *testing.T) {
 			},
 			out: Address{
 				Line1:      "Flat 123a, Cool St",
-				Line2:      "Cooltown",
-				TownOrCity: "Coolshire",
+				TownOrCity: "Cooltown, Coolshire",
 				Postcode:   "ABC",
 				Country:    "What",
 			},
@@ -75,8 +72,7 @@ func TestInternationalAddressToAddress(t *testing.T) {
 			},
 			out: Address{
 				Line1:      "Flat 123a, 5 Cool St",
-				Line2:      "Cooltown",
-				TownOrCity: "Coolshire",
+				TownOrCity: "Cooltown, Coolshire",
 				Postcode:   "ABC",
 				Country:    "What",
 			},
@@ -94,8 +90,7 @@ func TestInternationalAddressToAddress(t *testing.T) {
 			out: Address{
 				Line1:      "Flat 123a, Flathouse",
 				Line2:      "Cool St",
-				Line3:      "Cooltown",
-				TownOrCity: "Coolshire",
+				TownOrCity: "Cooltown, Coolshire",
 				Postcode:   "ABC",
 				Country:    "What",
 			},
@@ -113,8 +108,7 @@ func TestInternationalAddressToAddress(t *testing.T) {
 			out: Address{
 				Line1:      "Flathouse",
 				Line2:      "5 Cool St",
-				Line3:      "Cooltown",
-				TownOrCity: "Coolshire",
+				TownOrCity: "Cooltown, Coolshire",
 				Postcode:   "ABC",
 				Country:    "What",
 			},
@@ -133,12 +127,26 @@ func TestInternationalAddressToAddress(t *testing.T) {
 			out: Address{
 				Line1:      "Flat 123a, Flathouse",
 				Line2:      "5 Cool St",
-				Line3:      "Cooltown",
-				TownOrCity: "Coolshire",
+				TownOrCity: "Cooltown, Coolshire",
 				Postcode:   "ABC",
 				Country:    "What",
 			},
 		},
+		"number after street": {
+			in: InternationalAddress{
+				BuildingNumber: "5",
+				StreetName:     "Coolstraße",
+				Town:           "Coolstadt",
+				PostalCode:     "12345",
+				Country:        "DE",
+			},
+			out: Address{
+				Line1:      "Coolstraße 5",
+				TownOrCity: "Coolstadt",
+				Postcode:   "12345",
+				Country:    "DE",
+			},
+		},
 	}
 
 	for name, tc := range testcases {
@@ -147,3 +155,18 @@ func TestInternationalAddressToAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestInternationalAddressField(t *testing.T) {
+	address := InternationalAddress{
+		StreetName: "a",
+		Town:       "b",
+		Region:     "c",
+		PostalCode: "d",
+	}
+
+	assert.Equal(t, "a", address.Field(AddressFieldStreetName))
+	assert.Equal(t, "b", address.Field(AddressFieldTown))
+	assert.Equal(t, "c", address.Field(AddressFieldRegion))
+	assert.Equal(t, "d", address.Field(AddressFieldPostalCode))
+	assert.Equal(t, "", address.Field(AddressField(99)))
+}
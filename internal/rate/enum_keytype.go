@@ -0,0 +1,80 @@
+// Code generated by "enumerator -type KeyType --linecomment --trimprefix"; DO NOT EDIT.
+
+package rate
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[KeyTypeSession-1]
+	_ = x[KeyTypeIP-2]
+	_ = x[KeyTypeLpa-3]
+}
+
+const _KeyType_name = "sessioniplpa"
+
+var _KeyType_index = [...]uint8{0, 7, 9, 12}
+
+func (i KeyType) String() string {
+	i -= 1
+	if i >= KeyType(len(_KeyType_index)-1) {
+		return "KeyType(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _KeyType_name[_KeyType_index[i]:_KeyType_index[i+1]]
+}
+
+func (i KeyType) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *KeyType) UnmarshalText(text []byte) error {
+	val, err := ParseKeyType(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
+
+func (i KeyType) IsSession() bool {
+	return i == KeyTypeSession
+}
+
+func (i KeyType) IsIP() bool {
+	return i == KeyTypeIP
+}
+
+func (i KeyType) IsLpa() bool {
+	return i == KeyTypeLpa
+}
+
+func ParseKeyType(s string) (KeyType, error) {
+	switch s {
+	case "session":
+		return KeyTypeSession, nil
+	case "ip":
+		return KeyTypeIP, nil
+	case "lpa":
+		return KeyTypeLpa, nil
+	default:
+		return KeyType(0), fmt.Errorf("invalid KeyType '%s'", s)
+	}
+}
+
+type KeyTypeOptions struct {
+	Session KeyType
+	IP      KeyType
+	Lpa     KeyType
+}
+
+var KeyTypeValues = KeyTypeOptions{
+	Session: KeyTypeSession,
+	IP:      KeyTypeIP,
+	Lpa:     KeyTypeLpa,
+}
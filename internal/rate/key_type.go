@@ -0,0 +1,10 @@
+package rate
+
+//go:generate go tool enumerator -type KeyType --linecomment --trimprefix
+type KeyType uint8
+
+const (
+	KeyTypeSession KeyType = iota + 1 // session
+	KeyTypeIP                         // ip
+	KeyTypeLpa                        // lpa
+)
@@ -1,4 +1,5 @@
-// Package rate provides a serialisable rate limiter.
+// Package rate provides serialisable rate limiters, and policies that combine
+// them.
 package rate
 
 import (
@@ -32,6 +33,18 @@ func (l *Limiter) Allow(now time.Time) bool {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
+	if !l.available(now) {
+		return false
+	}
+
+	l.Tokens--
+	return true
+}
+
+// available adds the tokens gained since TokensAt, then reports whether there
+// is a token to take. Unlike Allow it, and take, do not lock so should only be
+// used when the caller has the only reference to l.
+func (l *Limiter) available(now time.Time) bool {
 	elapsed := now.Sub(l.TokensAt)
 	l.Tokens += elapsed.Seconds() / l.TokenPer.Seconds()
 	l.TokensAt = now
@@ -40,10 +53,15 @@ func (l *Limiter) Allow(now time.Time) bool {
 		l.Tokens = l.MaxTokens
 	}
 
-	if l.Tokens >= 1 {
-		l.Tokens--
-		return true
-	}
+	return l.Tokens >= 1
+}
+
+func (l *Limiter) take() {
+	l.Tokens--
+}
 
-	return false
+// refilledAt is when the limiter will have MaxTokens again.
+func (l *Limiter) refilledAt() time.Time {
+	missing := l.MaxTokens - l.Tokens
+	return l.TokensAt.Add(time.Duration(missing * float64(l.TokenPer)))
 }
@@ -90,3 +90,10 @@ func TestLimiterMax(t *testing.T) {
 	assert.True(t, limiter.Allow(now))
 	assert.False(t, limiter.Allow(now))
 }
+
+func TestLimiterRefilledAt(t *testing.T) {
+	now := time.Now()
+	limiter := &Limiter{TokenPer: time.Minute, MaxTokens: 5, Tokens: 1.5, TokensAt: now}
+
+	assert.Equal(t, now.Add(3*time.Minute+30*time.Second), limiter.refilledAt())
+}
@@ -0,0 +1,209 @@
+package rate
+
+import "time"
+
+// A Policy describes how often requests of a kind are allowed. Each distinct
+// key, of the given KeyType, has its own State.
+//
+// A request is only allowed when the token bucket, and every window, allow it.
+type Policy struct {
+	Name string
+	Key  KeyType
+	// TokenPer is how long it takes for another request to be allowed. If zero
+	// the policy has no token bucket.
+	TokenPer time.Duration
+	// Burst is how many requests can be made together, before they are limited
+	// to one per TokenPer.
+	Burst float64
+	// Windows are further limits, such as a daily maximum.
+	Windows []Tier
+	// Lockout, if set, refuses all requests for a time once a request has been
+	// limited.
+	Lockout Lockout
+}
+
+// A Tier allows at most Max requests in any Period.
+type Tier struct {
+	Period time.Duration
+	Max    int
+}
+
+// A Lockout refuses requests for Cooldown after a request is limited. Each time
+// this happens again the cooldown doubles, up to MaxCooldown. Once MaxCooldown
+// has passed without a request being limited the cooldown is reset.
+type Lockout struct {
+	Cooldown    time.Duration
+	MaxCooldown time.Duration
+}
+
+func (l Lockout) maxCooldown() time.Duration {
+	return max(l.Cooldown, l.MaxCooldown)
+}
+
+// State records the requests made for a Policy and key. It can be serialised,
+// and when stored in DynamoDB should be wrapped in a struct that sets Version.
+type State struct {
+	Limiter     *Limiter
+	Windows     []*Window
+	LockedUntil time.Time
+	Lockouts    int
+}
+
+// allow records a request in s, returning false if it should be refused.
+func (p Policy) allow(s *State, now time.Time) bool {
+	p.sync(s, now)
+
+	if now.Before(s.LockedUntil) {
+		return false
+	}
+
+	if s.Lockouts > 0 && now.Sub(s.LockedUntil) > p.Lockout.maxCooldown() {
+		s.Lockouts = 0
+	}
+
+	available := s.Limiter == nil || s.Limiter.available(now)
+	for _, w := range s.Windows {
+		if !w.available(now) {
+			available = false
+		}
+	}
+
+	if !available {
+		p.lockout(s, now)
+		return false
+	}
+
+	if s.Limiter != nil {
+		s.Limiter.take()
+	}
+	for _, w := range s.Windows {
+		w.take(now)
+	}
+
+	return true
+}
+
+func (p Policy) lockout(s *State, now time.Time) {
+	if p.Lockout.Cooldown <= 0 {
+		return
+	}
+
+	cooldown := p.Lockout.Cooldown
+	for range s.Lockouts {
+		cooldown *= 2
+		if cooldown >= p.Lockout.maxCooldown() {
+			cooldown = p.Lockout.maxCooldown()
+			break
+		}
+	}
+
+	s.LockedUntil = now.Add(cooldown)
+	s.Lockouts++
+}
+
+// sync changes s to match p, so that changes to a policy apply to any State
+// that has already been stored.
+func (p Policy) sync(s *State, now time.Time) {
+	if p.TokenPer <= 0 {
+		s.Limiter = nil
+	} else if s.Limiter == nil {
+		s.Limiter = NewLimiter(now, p.TokenPer, p.Burst, p.Burst)
+	} else {
+		s.Limiter.TokenPer = p.TokenPer
+		s.Limiter.MaxTokens = p.Burst
+	}
+
+	var windows []*Window
+	for _, tier := range p.Windows {
+		window := NewWindow(tier.Period, tier.Max)
+		for _, w := range s.Windows {
+			if w.Period == tier.Period {
+				window.Requests = w.Requests
+			}
+		}
+
+		windows = append(windows, window)
+	}
+	s.Windows = windows
+}
+
+// expiresAt is when s will be no different to a new State, so that it no
+// longer needs to be stored.
+func (p Policy) expiresAt(s *State, now time.Time) time.Time {
+	expiresAt := now
+
+	if s.Limiter != nil {
+		expiresAt = maxTime(expiresAt, s.Limiter.refilledAt())
+	}
+
+	for _, w := range s.Windows {
+		expiresAt = maxTime(expiresAt, w.resetAt())
+	}
+
+	if s.Lockouts > 0 {
+		expiresAt = maxTime(expiresAt, s.LockedUntil.Add(p.Lockout.maxCooldown()))
+	}
+
+	return expiresAt
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+
+	return a
+}
+
+var (
+	AccessCode = Policy{
+		Name:     "access-code",
+		Key:      KeyTypeSession,
+		TokenPer: 5 * time.Minute,
+		Burst:    10,
+		Windows:  []Tier{{Period: 24 * time.Hour, Max: 50}},
+		Lockout:  Lockout{Cooldown: 5 * time.Minute, MaxCooldown: 24 * time.Hour},
+	}
+	PostcodeLookup = Policy{
+		Name:     "postcode-lookup",
+		Key:      KeyTypeSession,
+		TokenPer: 10 * time.Second,
+		Burst:    30,
+	}
+	WitnessCode = Policy{
+		Name:     "witness-code",
+		Key:      KeyTypeLpa,
+		TokenPer: 2 * time.Minute,
+		Burst:    10,
+	}
+	InviteMember = Policy{
+		Name:     "invite-member",
+		Key:      KeyTypeSession,
+		TokenPer: time.Minute,
+		Burst:    20,
+	}
+	// BulkInviteMembers is applied to both uploading and confirming a file,
+	// each of which may contain many invites.
+	BulkInviteMembers = Policy{
+		Name:     "bulk-invite-members",
+		Key:      KeyTypeSession,
+		TokenPer: 30 * time.Minute,
+		Burst:    4,
+		Windows:  []Tier{{Period: 24 * time.Hour, Max: 10}},
+	}
+	// ResendAccessCode allows each actor on an LPA to be sent a new code, but
+	// stops codes being sent repeatedly.
+	ResendAccessCode = Policy{
+		Name:     "resend-access-code",
+		Key:      KeyTypeLpa,
+		TokenPer: 10 * time.Minute,
+		Burst:    20,
+		Windows:  []Tier{{Period: 24 * time.Hour, Max: 30}},
+	}
+	Voucher = Policy{
+		Name:     "voucher",
+		Key:      KeyTypeLpa,
+		TokenPer: 5 * time.Minute,
+		Burst:    10,
+	}
+)
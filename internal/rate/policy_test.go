@@ -0,0 +1,131 @@
+package rate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyAllow(t *testing.T) {
+	policy := Policy{
+		TokenPer: time.Minute,
+		Burst:    2,
+		Windows:  []Tier{{Period: time.Hour, Max: 3}},
+	}
+
+	state := &State{}
+	now := testNow
+
+	assert.True(t, policy.allow(state, now))
+	assert.True(t, policy.allow(state, now))
+	assert.False(t, policy.allow(state, now), "limited by bucket")
+
+	now = now.Add(time.Minute)
+	assert.True(t, policy.allow(state, now))
+
+	now = now.Add(time.Minute)
+	assert.False(t, policy.allow(state, now), "limited by window")
+
+	now = testNow.Add(time.Hour)
+	assert.True(t, policy.allow(state, now))
+}
+
+func TestPolicyAllowWithOnlyWindows(t *testing.T) {
+	policy := Policy{Windows: []Tier{{Period: time.Minute, Max: 1}}}
+	state := &State{}
+
+	assert.True(t, policy.allow(state, testNow))
+	assert.False(t, policy.allow(state, testNow))
+	assert.Nil(t, state.Limiter)
+}
+
+func TestPolicyAllowWithLockout(t *testing.T) {
+	policy := Policy{
+		Windows: []Tier{{Period: time.Minute, Max: 1}},
+		Lockout: Lockout{Cooldown: 10 * time.Minute, MaxCooldown: 30 * time.Minute},
+	}
+
+	state := &State{}
+	now := testNow
+
+	assert.True(t, policy.allow(state, now))
+	assert.False(t, policy.allow(state, now))
+	assert.Equal(t, now.Add(10*time.Minute), state.LockedUntil)
+
+	now = now.Add(5 * time.Minute)
+	assert.False(t, policy.allow(state, now), "locked")
+
+	now = now.Add(5 * time.Minute)
+	assert.True(t, policy.allow(state, now))
+	assert.False(t, policy.allow(state, now))
+	assert.Equal(t, now.Add(20*time.Minute), state.LockedUntil, "doubles")
+
+	now = now.Add(20 * time.Minute)
+	assert.True(t, policy.allow(state, now))
+	assert.False(t, policy.allow(state, now))
+	assert.Equal(t, now.Add(30*time.Minute), state.LockedUntil, "capped")
+	assert.Equal(t, 3, state.Lockouts)
+
+	now = state.LockedUntil.Add(31 * time.Minute)
+	assert.True(t, policy.allow(state, now))
+	assert.Equal(t, 0, state.Lockouts, "reset")
+}
+
+func TestPolicyAllowWhenPolicyChanged(t *testing.T) {
+	state := &State{
+		Limiter: &Limiter{TokenPer: time.Hour, MaxTokens: 1, TokensAt: testNow},
+		Windows: []*Window{
+			{Period: time.Minute, Max: 1, Requests: []time.Time{testNow}},
+			{Period: time.Hour, Max: 10, Requests: []time.Time{testNow}},
+		},
+	}
+
+	policy := Policy{
+		TokenPer: time.Second,
+		Burst:    5,
+		Windows:  []Tier{{Period: time.Hour, Max: 20}, {Period: 24 * time.Hour, Max: 50}},
+	}
+
+	now := testNow.Add(time.Second)
+	assert.True(t, policy.allow(state, now))
+	assert.Equal(t, &State{
+		Limiter: &Limiter{TokenPer: time.Second, MaxTokens: 5, Tokens: 0, TokensAt: now},
+		Windows: []*Window{
+			{Period: time.Hour, Max: 20, Requests: []time.Time{testNow, now}},
+			{Period: 24 * time.Hour, Max: 50, Requests: []time.Time{now}},
+		},
+	}, state)
+}
+
+func TestPolicyExpiresAt(t *testing.T) {
+	policy := Policy{Lockout: Lockout{Cooldown: time.Minute, MaxCooldown: time.Hour}}
+
+	testcases := map[string]struct {
+		state    *State
+		expected time.Time
+	}{
+		"empty": {
+			state:    &State{},
+			expected: testNow,
+		},
+		"limiter": {
+			state:    &State{Limiter: &Limiter{TokenPer: time.Minute, MaxTokens: 5, Tokens: 2, TokensAt: testNow}},
+			expected: testNow.Add(3 * time.Minute),
+		},
+		"window": {
+			state:    &State{Windows: []*Window{{Period: time.Hour, Requests: []time.Time{testNow.Add(-time.Minute)}}}},
+			expected: testNow.Add(59 * time.Minute),
+		},
+		"lockout": {
+			state:    &State{LockedUntil: testNow.Add(time.Minute), Lockouts: 1},
+			expected: testNow.Add(61 * time.Minute),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, policy.expiresAt(tc.state, testNow))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package rate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+)
+
+type DynamoClient interface {
+	OneByPK(ctx context.Context, pk dynamo.PK, v any) error
+	Create(ctx context.Context, v any) error
+	Put(ctx context.Context, v any) error
+}
+
+type storedLimiter struct {
+	PK        dynamo.RateLimiterKeyType
+	SK        dynamo.MetadataKeyType
+	Version   int
+	ExpiresAt time.Time `dynamodbav:",unixtime"`
+	State
+}
+
+// A Store keeps a Limiter in DynamoDB for each policy and key, so that limits
+// apply across all instances of the app.
+type Store struct {
+	dynamoClient DynamoClient
+	now          func() time.Time
+}
+
+func NewStore(dynamoClient DynamoClient) *Store {
+	return &Store{dynamoClient: dynamoClient, now: time.Now}
+}
+
+// maxAttempts is how many times Allow will try to record a request, when
+// another request for the same key is recorded concurrently.
+const maxAttempts = 3
+
+// Allow returns dynamo.ErrTooManyRequests if the request for key should not be
+// allowed by policy.
+func (s *Store) Allow(ctx context.Context, policy Policy, key string) error {
+	for range maxAttempts - 1 {
+		if err := s.allow(ctx, policy, key); !isConflict(err) {
+			return err
+		}
+	}
+
+	return s.allow(ctx, policy, key)
+}
+
+func (s *Store) allow(ctx context.Context, policy Policy, key string) error {
+	now := s.now()
+
+	var v storedLimiter
+	fresh := false
+	if err := s.dynamoClient.OneByPK(ctx, dynamo.RateLimiterKey(policy.Name, key), &v); err != nil {
+		if errors.Is(err, dynamo.NotFoundError{}) {
+			fresh = true
+			v = storedLimiter{
+				PK:      dynamo.RateLimiterKey(policy.Name, key),
+				SK:      dynamo.MetadataKey(key),
+				Version: 1,
+			}
+		} else {
+			return fmt.Errorf("retrieve rate limiter: %w", err)
+		}
+	}
+
+	allowed := policy.allow(&v.State, now)
+	v.ExpiresAt = policy.expiresAt(&v.State, now)
+
+	if fresh {
+		if err := s.dynamoClient.Create(ctx, v); err != nil {
+			return fmt.Errorf("create rate limiter: %w", err)
+		}
+	} else {
+		if err := s.dynamoClient.Put(ctx, v); err != nil {
+			return fmt.Errorf("update rate limiter: %w", err)
+		}
+	}
+
+	if !allowed {
+		return dynamo.ErrTooManyRequests
+	}
+
+	return nil
+}
+
+// isConflict returns true when err is caused by the Version, or existence, of
+// the stored limiter having changed since it was read.
+func isConflict(err error) bool {
+	var exception *types.ConditionalCheckFailedException
+	return errors.Is(err, dynamo.ConditionalCheckFailedError{}) || errors.As(err, &exception)
+}
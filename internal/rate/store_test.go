@@ -0,0 +1,215 @@
+package rate
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dynamodb/attributevalue"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	ctx           = context.Background()
+	expectedError = errors.New("err")
+	testNow       = time.Date(2023, time.April, 2, 3, 4, 5, 6, time.UTC)
+	testNowFn     = func() time.Time { return testNow }
+	testPolicy    = Policy{Name: "test", Key: KeyTypeSession, TokenPer: time.Minute, Burst: 5}
+)
+
+func (c *mockDynamoClient_OneByPK_Call) SetData(data any) *mockDynamoClient_OneByPK_Call {
+	return c.Run(func(_ context.Context, _ dynamo.PK, v any) {
+		b, _ := attributevalue.MarshalMap(data)
+		attributevalue.UnmarshalMap(b, v)
+	})
+}
+
+func TestNewStore(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	store := NewStore(dynamoClient)
+
+	assert.Equal(t, dynamoClient, store.dynamoClient)
+	assert.NotNil(t, store.now)
+}
+
+func TestStoreAllow(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneByPK(ctx, dynamo.RateLimiterKey("test", "session-id"), mock.Anything).
+		Return(nil).
+		SetData(storedLimiter{
+			PK:      dynamo.RateLimiterKey("test", "session-id"),
+			SK:      dynamo.MetadataKey("session-id"),
+			Version: 2,
+			State:   State{Limiter: &Limiter{TokenPer: time.Minute, MaxTokens: 5, Tokens: 3, TokensAt: testNow}},
+		})
+	dynamoClient.EXPECT().
+		Put(ctx, storedLimiter{
+			PK:        dynamo.RateLimiterKey("test", "session-id"),
+			SK:        dynamo.MetadataKey("session-id"),
+			Version:   2,
+			State:     State{Limiter: &Limiter{TokenPer: time.Minute, MaxTokens: 5, Tokens: 2, TokensAt: testNow}},
+			ExpiresAt: testNow.Add(3 * time.Minute),
+		}).
+		Return(nil)
+
+	store := &Store{dynamoClient: dynamoClient, now: testNowFn}
+
+	err := store.Allow(ctx, testPolicy, "session-id")
+	assert.Nil(t, err)
+}
+
+func TestStoreAllowWhenNotFound(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneByPK(ctx, dynamo.RateLimiterKey("test", "session-id"), mock.Anything).
+		Return(dynamo.NotFoundError{})
+	dynamoClient.EXPECT().
+		Create(ctx, storedLimiter{
+			PK:        dynamo.RateLimiterKey("test", "session-id"),
+			SK:        dynamo.MetadataKey("session-id"),
+			Version:   1,
+			State:     State{Limiter: &Limiter{TokenPer: time.Minute, MaxTokens: 5, Tokens: 4, TokensAt: testNow}},
+			ExpiresAt: testNow.Add(time.Minute),
+		}).
+		Return(nil)
+
+	store := &Store{dynamoClient: dynamoClient, now: testNowFn}
+
+	err := store.Allow(ctx, testPolicy, "session-id")
+	assert.Nil(t, err)
+}
+
+func TestStoreAllowWhenLimited(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneByPK(ctx, mock.Anything, mock.Anything).
+		Return(nil).
+		SetData(storedLimiter{
+			State: State{Limiter: &Limiter{TokenPer: time.Minute, MaxTokens: 5, Tokens: -1, TokensAt: testNow.Add(-time.Minute)}},
+		})
+	dynamoClient.EXPECT().
+		Put(ctx, storedLimiter{
+			State:     State{Limiter: &Limiter{TokenPer: time.Minute, MaxTokens: 5, Tokens: 0, TokensAt: testNow}},
+			ExpiresAt: testNow.Add(5 * time.Minute),
+		}).
+		Return(nil)
+
+	store := &Store{dynamoClient: dynamoClient, now: testNowFn}
+
+	err := store.Allow(ctx, testPolicy, "session-id")
+	assert.Equal(t, dynamo.ErrTooManyRequests, err)
+}
+
+func TestStoreAllowWhenOneByPKErrors(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneByPK(mock.Anything, mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	store := &Store{dynamoClient: dynamoClient, now: testNowFn}
+
+	err := store.Allow(ctx, testPolicy, "session-id")
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestStoreAllowWhenCreateErrors(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneByPK(mock.Anything, mock.Anything, mock.Anything).
+		Return(dynamo.NotFoundError{})
+	dynamoClient.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	store := &Store{dynamoClient: dynamoClient, now: testNowFn}
+
+	err := store.Allow(ctx, testPolicy, "session-id")
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestStoreAllowWhenPutErrors(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneByPK(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		SetData(storedLimiter{
+			State: State{Limiter: &Limiter{TokenPer: time.Minute, MaxTokens: 5, Tokens: 3, TokensAt: testNow}},
+		})
+	dynamoClient.EXPECT().
+		Put(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	store := &Store{dynamoClient: dynamoClient, now: testNowFn}
+
+	err := store.Allow(ctx, testPolicy, "session-id")
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestStoreAllowWhenConflict(t *testing.T) {
+	testcases := map[string]func(*mockDynamoClient){
+		"create": func(dynamoClient *mockDynamoClient) {
+			dynamoClient.EXPECT().
+				OneByPK(mock.Anything, mock.Anything, mock.Anything).
+				Return(dynamo.NotFoundError{}).
+				Once()
+			dynamoClient.EXPECT().
+				Create(mock.Anything, mock.Anything).
+				Return(&types.ConditionalCheckFailedException{})
+		},
+		"put": func(dynamoClient *mockDynamoClient) {
+			dynamoClient.EXPECT().
+				OneByPK(mock.Anything, mock.Anything, mock.Anything).
+				Return(nil).
+				SetData(storedLimiter{Version: 1}).
+				Once()
+			dynamoClient.EXPECT().
+				Put(mock.Anything, mock.Anything).
+				Return(dynamo.ConditionalCheckFailedError{}).
+				Once()
+		},
+	}
+
+	for name, setup := range testcases {
+		t.Run(name, func(t *testing.T) {
+			dynamoClient := newMockDynamoClient(t)
+			setup(dynamoClient)
+			dynamoClient.EXPECT().
+				OneByPK(mock.Anything, mock.Anything, mock.Anything).
+				Return(nil).
+				SetData(storedLimiter{Version: 2}).
+				Once()
+			dynamoClient.EXPECT().
+				Put(mock.Anything, mock.Anything).
+				Return(nil).
+				Once()
+
+			store := &Store{dynamoClient: dynamoClient, now: testNowFn}
+
+			err := store.Allow(ctx, testPolicy, "session-id")
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestStoreAllowWhenConflictRepeats(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		OneByPK(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		SetData(storedLimiter{Version: 1}).
+		Times(maxAttempts)
+	dynamoClient.EXPECT().
+		Put(mock.Anything, mock.Anything).
+		Return(dynamo.ConditionalCheckFailedError{}).
+		Times(maxAttempts)
+
+	store := &Store{dynamoClient: dynamoClient, now: testNowFn}
+
+	err := store.Allow(ctx, testPolicy, "session-id")
+	assert.ErrorIs(t, err, dynamo.ConditionalCheckFailedError{})
+}
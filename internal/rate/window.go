@@ -0,0 +1,55 @@
+package rate
+
+import "time"
+
+// Window is a sliding window log rate limiter, it allows at most Max requests
+// in any Period. Unlike Limiter it does not allow a burst of requests at the
+// boundary between two periods.
+//
+// As with Limiter it can be serialised, and when stored in DynamoDB should be
+// wrapped in a struct that sets Version.
+type Window struct {
+	Period   time.Duration
+	Max      int
+	Requests []time.Time
+}
+
+func NewWindow(period time.Duration, max int) *Window {
+	return &Window{Period: period, Max: max}
+}
+
+func (w *Window) Allow(now time.Time) bool {
+	if !w.available(now) {
+		return false
+	}
+
+	w.take(now)
+	return true
+}
+
+// available forgets requests that have left the window, then reports whether
+// another request would be allowed.
+func (w *Window) available(now time.Time) bool {
+	start := now.Add(-w.Period)
+
+	i := 0
+	for i < len(w.Requests) && !w.Requests[i].After(start) {
+		i++
+	}
+	w.Requests = w.Requests[i:]
+
+	return len(w.Requests) < w.Max
+}
+
+func (w *Window) take(now time.Time) {
+	w.Requests = append(w.Requests, now)
+}
+
+// resetAt is when the window will no longer contain any requests.
+func (w *Window) resetAt() time.Time {
+	if len(w.Requests) == 0 {
+		return time.Time{}
+	}
+
+	return w.Requests[len(w.Requests)-1].Add(w.Period)
+}
@@ -0,0 +1,57 @@
+package rate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWindow(t *testing.T) {
+	assert.Equal(t, &Window{Period: time.Minute, Max: 5}, NewWindow(time.Minute, 5))
+}
+
+func TestWindow(t *testing.T) {
+	now := time.Now()
+
+	testcases := map[string]struct {
+		window   *Window
+		allowed  bool
+		requests []time.Time
+	}{
+		"empty": {
+			window:   &Window{Period: time.Minute, Max: 2},
+			allowed:  true,
+			requests: []time.Time{now},
+		},
+		"has space": {
+			window:   &Window{Period: time.Minute, Max: 2, Requests: []time.Time{now.Add(-30 * time.Second)}},
+			allowed:  true,
+			requests: []time.Time{now.Add(-30 * time.Second), now},
+		},
+		"full": {
+			window:   &Window{Period: time.Minute, Max: 2, Requests: []time.Time{now.Add(-30 * time.Second), now.Add(-10 * time.Second)}},
+			allowed:  false,
+			requests: []time.Time{now.Add(-30 * time.Second), now.Add(-10 * time.Second)},
+		},
+		"request leaves window": {
+			window:   &Window{Period: time.Minute, Max: 2, Requests: []time.Time{now.Add(-time.Minute), now.Add(-10 * time.Second)}},
+			allowed:  true,
+			requests: []time.Time{now.Add(-10 * time.Second), now},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.allowed, tc.window.Allow(now))
+			assert.Equal(t, tc.requests, tc.window.Requests)
+		})
+	}
+}
+
+func TestWindowResetAt(t *testing.T) {
+	now := time.Now()
+
+	assert.True(t, (&Window{Period: time.Minute}).resetAt().IsZero())
+	assert.Equal(t, now.Add(time.Minute), (&Window{Period: time.Minute, Requests: []time.Time{now.Add(-time.Second), now}}).resetAt())
+}
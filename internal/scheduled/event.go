@@ -23,4 +23,6 @@ type Event struct {
 	TargetLpaOwnerKey dynamo.LpaOwnerKeyType
 	// LpaUID is the LPA UID the action target relates to
 	LpaUID string
+	// PaymentID is the GOV.UK Pay payment the action relates to, if any
+	PaymentID string
 }
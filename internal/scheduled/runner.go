@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/attorney/attorneydata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/certificateprovider/certificateproviderdata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
@@ -18,6 +19,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/scheduled/scheduleddata"
 )
 
@@ -74,6 +76,14 @@ type EventClient interface {
 	SendLetterRequested(ctx context.Context, event event.LetterRequested) error
 }
 
+type PayClient interface {
+	GetPayment(ctx context.Context, id string) (pay.GetPaymentResponse, error)
+}
+
+type PaymentRecorder interface {
+	Record(ctx context.Context, appData appcontext.Data, provided *donordata.Provided, payment pay.GetPaymentResponse) error
+}
+
 type LpaStoreResolvingService interface {
 	Resolve(ctx context.Context, provided *donordata.Provided) (*lpadata.Lpa, error)
 }
@@ -89,6 +99,8 @@ type Runner struct {
 	lpaStoreResolvingService     LpaStoreResolvingService
 	notifyClient                 NotifyClient
 	eventClient                  EventClient
+	payClient                    PayClient
+	paymentRecorder              PaymentRecorder
 	bundle                       Bundle
 	actions                      map[scheduleddata.Action]ActionFunc
 	waiter                       Waiter
@@ -114,6 +126,8 @@ func NewRunner(
 	lpaStoreResolvingService LpaStoreResolvingService,
 	notifyClient NotifyClient,
 	eventClient EventClient,
+	payClient PayClient,
+	paymentRecorder PaymentRecorder,
 	bundle Bundle,
 	metricsClient MetricsClient,
 	metricsEnabled bool,
@@ -132,6 +146,8 @@ func NewRunner(
 		lpaStoreResolvingService:     lpaStoreResolvingService,
 		notifyClient:                 notifyClient,
 		eventClient:                  eventClient,
+		payClient:                    payClient,
+		paymentRecorder:              paymentRecorder,
 		bundle:                       bundle,
 		waiter:                       &waiter{backoff: time.Second, sleep: time.Sleep, maxRetries: 10},
 		metricsClient:                metricsClient,
@@ -147,6 +163,7 @@ func NewRunner(
 		scheduleddata.ActionRemindCertificateProviderToComplete:        r.stepRemindCertificateProviderToComplete,
 		scheduleddata.ActionRemindCertificateProviderToConfirmIdentity: r.stepRemindCertificateProviderToConfirmIdentity,
 		scheduleddata.ActionRemindAttorneyToComplete:                   r.stepRemindAttorneyToComplete,
+		scheduleddata.ActionReconcilePayment:                           r.stepReconcilePayment,
 	}
 
 	return r
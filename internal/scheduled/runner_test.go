@@ -57,10 +57,12 @@ func TestNewRunner(t *testing.T) {
 	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
 	notifyClient := newMockNotifyClient(t)
 	eventClient := newMockEventClient(t)
+	payClient := newMockPayClient(t)
+	paymentRecorder := newMockPaymentRecorder(t)
 	metricsClient := newMockMetricsClient(t)
 	bundle := newMockBundle(t)
 
-	runner := NewRunner(logger, store, donorStore, certificateProviderStore, attorneyStore, lpaStoreResolvingService, notifyClient, eventClient, bundle, metricsClient, true, "certificateProviderStartURL", "attorneyStartURL", "appPublicURL")
+	runner := NewRunner(logger, store, donorStore, certificateProviderStore, attorneyStore, lpaStoreResolvingService, notifyClient, eventClient, payClient, paymentRecorder, bundle, metricsClient, true, "certificateProviderStartURL", "attorneyStartURL", "appPublicURL")
 
 	assert.Equal(t, logger, runner.logger)
 	assert.Equal(t, store, runner.store)
@@ -69,6 +71,8 @@ func TestNewRunner(t *testing.T) {
 	assert.Equal(t, attorneyStore, runner.attorneyStore)
 	assert.Equal(t, lpaStoreResolvingService, runner.lpaStoreResolvingService)
 	assert.Equal(t, notifyClient, runner.notifyClient)
+	assert.Equal(t, payClient, runner.payClient)
+	assert.Equal(t, paymentRecorder, runner.paymentRecorder)
 	assert.Equal(t, metricsClient, runner.metricsClient)
 	assert.Equal(t, true, runner.metricsEnabled)
 	assert.Equal(t, "appPublicURL", runner.appPublicURL)
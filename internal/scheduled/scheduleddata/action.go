@@ -25,4 +25,9 @@ const (
 	// neither signed nor opted-out, and if so send them a reminder email or
 	// letter, plus another to the donor (or correspondent, if set).
 	ActionRemindAttorneyToComplete
+
+	// ActionReconcilePayment will check that the target payment has been
+	// recorded against the LPA, and if not, but GOV.UK Pay reports it as
+	// successful, record it and update the pay task.
+	ActionReconcilePayment
 )
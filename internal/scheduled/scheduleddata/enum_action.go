@@ -15,11 +15,12 @@ func _() {
 	_ = x[ActionRemindCertificateProviderToComplete-2]
 	_ = x[ActionRemindCertificateProviderToConfirmIdentity-3]
 	_ = x[ActionRemindAttorneyToComplete-4]
+	_ = x[ActionReconcilePayment-5]
 }
 
-const _Action_name = "ExpireDonorIdentityRemindCertificateProviderToCompleteRemindCertificateProviderToConfirmIdentityRemindAttorneyToComplete"
+const _Action_name = "ExpireDonorIdentityRemindCertificateProviderToCompleteRemindCertificateProviderToConfirmIdentityRemindAttorneyToCompleteReconcilePayment"
 
-var _Action_index = [...]uint8{0, 19, 54, 96, 120}
+var _Action_index = [...]uint8{0, 19, 54, 96, 120, 136}
 
 func (i Action) String() string {
 	i -= 1
@@ -59,6 +60,10 @@ func (i Action) IsRemindAttorneyToComplete() bool {
 	return i == ActionRemindAttorneyToComplete
 }
 
+func (i Action) IsReconcilePayment() bool {
+	return i == ActionReconcilePayment
+}
+
 func ParseAction(s string) (Action, error) {
 	switch s {
 	case "ExpireDonorIdentity":
@@ -69,6 +74,8 @@ func ParseAction(s string) (Action, error) {
 		return ActionRemindCertificateProviderToConfirmIdentity, nil
 	case "RemindAttorneyToComplete":
 		return ActionRemindAttorneyToComplete, nil
+	case "ReconcilePayment":
+		return ActionReconcilePayment, nil
 	default:
 		return Action(0), fmt.Errorf("invalid Action '%s'", s)
 	}
@@ -79,6 +86,7 @@ type ActionOptions struct {
 	RemindCertificateProviderToComplete        Action
 	RemindCertificateProviderToConfirmIdentity Action
 	RemindAttorneyToComplete                   Action
+	ReconcilePayment                           Action
 }
 
 var ActionValues = ActionOptions{
@@ -86,4 +94,5 @@ var ActionValues = ActionOptions{
 	RemindCertificateProviderToComplete:        ActionRemindCertificateProviderToComplete,
 	RemindCertificateProviderToConfirmIdentity: ActionRemindCertificateProviderToConfirmIdentity,
 	RemindAttorneyToComplete:                   ActionRemindAttorneyToComplete,
+	ReconcilePayment:                           ActionReconcilePayment,
 }
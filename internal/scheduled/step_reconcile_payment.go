@@ -0,0 +1,48 @@
+package scheduled
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+)
+
+func (r *Runner) stepReconcilePayment(ctx context.Context, row *Event) error {
+	provided, err := r.donorStore.One(ctx, row.TargetLpaKey, row.TargetLpaOwnerKey)
+	if err != nil {
+		return fmt.Errorf("error retrieving donor: %w", err)
+	}
+
+	if slices.ContainsFunc(provided.PaymentDetails, func(p donordata.Payment) bool { return p.PaymentID == row.PaymentID }) {
+		return errStepIgnored
+	}
+
+	payment, err := r.payClient.GetPayment(ctx, row.PaymentID)
+	if err != nil {
+		return fmt.Errorf("error retrieving payment: %w", err)
+	}
+
+	if !payment.State.Finished {
+		return fmt.Errorf("payment has not finished: %s", payment.State.Status)
+	}
+
+	if payment.State.Status != "success" {
+		return errStepIgnored
+	}
+
+	r.logger.InfoContext(ctx, "reconciled payment missing from LPA",
+		slog.String("lpa_uid", provided.LpaUID),
+		slog.String("payment_id", payment.PaymentID),
+		slog.Int("amount", payment.AmountPence.Pence()))
+
+	appData := appcontext.Data{Localizer: r.bundle.For(provided.Donor.ContactLanguagePreference)}
+
+	if err := r.paymentRecorder.Record(ctx, appData, provided, payment); err != nil {
+		return fmt.Errorf("error recording payment: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package scheduled
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/pay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRunnerReconcilePayment(t *testing.T) {
+	lpaKey := dynamo.LpaKey("an-lpa")
+	donorKey := dynamo.LpaOwnerKey(dynamo.DonorKey("a-donor"))
+	provided := &donordata.Provided{
+		LpaUID: "lpa-uid",
+		Donor:  donordata.Donor{ContactLanguagePreference: localize.Cy},
+	}
+	payment := pay.GetPaymentResponse{
+		PaymentID:   "payment-id",
+		Reference:   "ref",
+		AmountPence: 9200,
+		State:       pay.State{Status: "success", Finished: true},
+	}
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		One(ctx, lpaKey, donorKey).
+		Return(provided, nil)
+
+	payClient := newMockPayClient(t)
+	payClient.EXPECT().
+		GetPayment(ctx, "payment-id").
+		Return(payment, nil)
+
+	localizer := newMockLocalizer(t)
+
+	bundle := newMockBundle(t)
+	bundle.EXPECT().
+		For(localize.Cy).
+		Return(localizer)
+
+	paymentRecorder := newMockPaymentRecorder(t)
+	paymentRecorder.EXPECT().
+		Record(ctx, appcontext.Data{Localizer: localizer}, provided, payment).
+		Return(nil)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		InfoContext(ctx, "reconciled payment missing from LPA", slog.String("lpa_uid", "lpa-uid"), slog.String("payment_id", "payment-id"), slog.Int("amount", 9200))
+
+	runner := &Runner{
+		logger:          logger,
+		donorStore:      donorStore,
+		payClient:       payClient,
+		paymentRecorder: paymentRecorder,
+		bundle:          bundle,
+	}
+	err := runner.stepReconcilePayment(ctx, &Event{
+		TargetLpaKey:      lpaKey,
+		TargetLpaOwnerKey: donorKey,
+		PaymentID:         "payment-id",
+	})
+
+	assert.Nil(t, err)
+}
+
+func TestRunnerReconcilePaymentWhenStepIgnored(t *testing.T) {
+	testcases := map[string]struct {
+		provided *donordata.Provided
+		status   string
+	}{
+		"already recorded": {
+			provided: &donordata.Provided{PaymentDetails: []donordata.Payment{{PaymentID: "payment-id"}}},
+		},
+		"failed": {
+			provided: &donordata.Provided{},
+			status:   "failed",
+		},
+		"cancelled": {
+			provided: &donordata.Provided{},
+			status:   "cancelled",
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			donorStore := newMockDonorStore(t)
+			donorStore.EXPECT().
+				One(mock.Anything, mock.Anything, mock.Anything).
+				Return(tc.provided, nil)
+
+			payClient := newMockPayClient(t)
+			if tc.status != "" {
+				payClient.EXPECT().
+					GetPayment(mock.Anything, mock.Anything).
+					Return(pay.GetPaymentResponse{State: pay.State{Status: tc.status, Finished: true}}, nil)
+			}
+
+			runner := &Runner{
+				donorStore: donorStore,
+				payClient:  payClient,
+			}
+			err := runner.stepReconcilePayment(ctx, &Event{PaymentID: "payment-id"})
+
+			assert.Equal(t, errStepIgnored, err)
+		})
+	}
+}
+
+func TestRunnerReconcilePaymentWhenPaymentNotFinished(t *testing.T) {
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		One(mock.Anything, mock.Anything, mock.Anything).
+		Return(&donordata.Provided{}, nil)
+
+	payClient := newMockPayClient(t)
+	payClient.EXPECT().
+		GetPayment(mock.Anything, mock.Anything).
+		Return(pay.GetPaymentResponse{State: pay.State{Status: "started"}}, nil)
+
+	runner := &Runner{
+		donorStore: donorStore,
+		payClient:  payClient,
+	}
+	err := runner.stepReconcilePayment(ctx, &Event{PaymentID: "payment-id"})
+
+	assert.EqualError(t, err, "payment has not finished: started")
+}
+
+func TestRunnerReconcilePaymentWhenErrors(t *testing.T) {
+	testcases := map[string]struct {
+		donorStore      func(*testing.T) *mockDonorStore
+		payClient       func(*testing.T) *mockPayClient
+		paymentRecorder func(*testing.T) *mockPaymentRecorder
+		err             string
+	}{
+		"donor store one": {
+			donorStore: func(t *testing.T) *mockDonorStore {
+				s := newMockDonorStore(t)
+				s.EXPECT().One(mock.Anything, mock.Anything, mock.Anything).Return(nil, expectedError)
+				return s
+			},
+			err: "error retrieving donor: hey",
+		},
+		"pay client": {
+			donorStore: func(t *testing.T) *mockDonorStore {
+				s := newMockDonorStore(t)
+				s.EXPECT().One(mock.Anything, mock.Anything, mock.Anything).Return(&donordata.Provided{}, nil)
+				return s
+			},
+			payClient: func(t *testing.T) *mockPayClient {
+				c := newMockPayClient(t)
+				c.EXPECT().GetPayment(mock.Anything, mock.Anything).Return(pay.GetPaymentResponse{}, expectedError)
+				return c
+			},
+			err: "error retrieving payment: hey",
+		},
+		"payment recorder": {
+			donorStore: func(t *testing.T) *mockDonorStore {
+				s := newMockDonorStore(t)
+				s.EXPECT().One(mock.Anything, mock.Anything, mock.Anything).Return(&donordata.Provided{}, nil)
+				return s
+			},
+			payClient: func(t *testing.T) *mockPayClient {
+				c := newMockPayClient(t)
+				c.EXPECT().GetPayment(mock.Anything, mock.Anything).Return(pay.GetPaymentResponse{State: pay.State{Status: "success", Finished: true}}, nil)
+				return c
+			},
+			paymentRecorder: func(t *testing.T) *mockPaymentRecorder {
+				r := newMockPaymentRecorder(t)
+				r.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(expectedError)
+				return r
+			},
+			err: "error recording payment: hey",
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			logger := newMockLogger(t)
+			logger.EXPECT().
+				InfoContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Maybe()
+
+			bundle := newMockBundle(t)
+			bundle.EXPECT().
+				For(mock.Anything).
+				Return(nil).
+				Maybe()
+
+			runner := &Runner{
+				logger:     logger,
+				donorStore: tc.donorStore(t),
+				bundle:     bundle,
+			}
+			if tc.payClient != nil {
+				runner.payClient = tc.payClient(t)
+			}
+			if tc.paymentRecorder != nil {
+				runner.paymentRecorder = tc.paymentRecorder(t)
+			}
+
+			err := runner.stepReconcilePayment(ctx, &Event{PaymentID: "payment-id"})
+
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
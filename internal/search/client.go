@@ -6,16 +6,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/opensearch-project/opensearch-go/v4"
 	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
 	requestsigner "github.com/opensearch-project/opensearch-go/v4/signer/awsv2"
 )
 
+// textField is not part of the indexed document, instead the fields that can be
+// searched by free-text are copied to it.
+const textField = "Text"
+
 var indexDefinition = map[string]any{
 	"settings": map[string]any{
 		"index": map[string]any{
@@ -25,10 +31,21 @@ var indexDefinition = map[string]any{
 	},
 	"mappings": map[string]any{
 		"properties": map[string]any{
-			"PK":               map[string]any{"type": "keyword"},
-			"SK":               map[string]any{"type": "keyword"},
-			"Donor.FirstNames": map[string]any{"type": "keyword"},
-			"Donor.LastName":   map[string]any{"type": "keyword"},
+			"PK":                  map[string]any{"type": "keyword"},
+			"SK":                  map[string]any{"type": "keyword"},
+			"LpaUID":              map[string]any{"type": "keyword", "copy_to": textField},
+			"LpaType":             map[string]any{"type": "keyword"},
+			"Status":              map[string]any{"type": "keyword"},
+			"Donor.FirstNames":    map[string]any{"type": "keyword", "copy_to": textField},
+			"Donor.LastName":      map[string]any{"type": "keyword", "copy_to": textField},
+			"Attorneys":           map[string]any{"type": "keyword", "copy_to": textField},
+			"CertificateProvider": map[string]any{"type": "keyword", "copy_to": textField},
+			"UpdatedAt":           map[string]any{"type": "date"},
+			"SignedAt":            map[string]any{"type": "date"},
+			"WithdrawnAt":         map[string]any{"type": "date"},
+			"DeadlineAt":          map[string]any{"type": "date"},
+			"AssignedMemberID":    map[string]any{"type": "keyword"},
+			textField:             map[string]any{"type": "text"},
 		},
 	},
 }
@@ -36,6 +53,8 @@ var indexDefinition = map[string]any{
 type opensearchapiClient interface {
 	Search(ctx context.Context, req *opensearchapi.SearchReq) (*opensearchapi.SearchResp, error)
 	Index(ctx context.Context, req opensearchapi.IndexReq) (*opensearchapi.IndexResp, error)
+	Bulk(ctx context.Context, req opensearchapi.BulkReq) (*opensearchapi.BulkResp, error)
+	Aliases(ctx context.Context, req opensearchapi.AliasesReq) (*opensearchapi.AliasesResp, error)
 }
 
 type indicesClient interface {
@@ -43,34 +62,47 @@ type indicesClient interface {
 	Create(ctx context.Context, req opensearchapi.IndicesCreateReq) (*opensearchapi.IndicesCreateResp, error)
 }
 
+type aliasClient interface {
+	Get(ctx context.Context, req opensearchapi.AliasGetReq) (*opensearchapi.AliasGetResp, error)
+}
+
 type documentClient interface {
 	Delete(ctx context.Context, req opensearchapi.DocumentDeleteReq) (*opensearchapi.DocumentDeleteResp, error)
 }
 
+// Searcher is implemented by Client, for OpenSearch, and MemoryClient.
+type Searcher interface {
+	CreateIndices(ctx context.Context) error
+	Index(ctx context.Context, lpa Lpa) error
+	Query(ctx context.Context, req QueryRequest) (*QueryResponse, error)
+	CountWithQuery(ctx context.Context, req CountWithQueryReq) (int, error)
+	Delete(ctx context.Context, lpa Lpa) error
+}
+
 type QueryResponse struct {
 	Pagination *Pagination
 	Keys       []dynamo.Keys
 }
 
-type Lpa struct {
-	PK    string
-	SK    string
-	Donor LpaDonor
-}
-
-type LpaDonor struct {
-	FirstNames string
-	LastName   string
-}
-
 type QueryRequest struct {
 	Page     int
 	PageSize int
+	// Text matches against the reference number and the names of the donor,
+	// attorneys and certificate provider.
+	Text     string
+	Statuses []Status
+	LpaTypes []lpadata.LpaType
+	// AssignedMemberID matches LPAs assigned to the member.
+	AssignedMemberID string
+	// Unassigned matches LPAs that have not been assigned to a member.
+	Unassigned bool
+	Sort       Sort
 }
 
 type Client struct {
 	svc             opensearchapiClient
 	indices         indicesClient
+	alias           aliasClient
 	document        documentClient
 	endpoint        string
 	indexName       string
@@ -95,6 +127,7 @@ func NewClient(cfg aws.Config, endpoint, indexName string, indexingEnabled bool)
 
 	return &Client{
 		indices:         svc.Indices,
+		alias:           svc.Indices.Alias,
 		document:        svc.Document,
 		svc:             svc,
 		endpoint:        endpoint,
@@ -132,7 +165,7 @@ func (c *Client) Index(ctx context.Context, lpa Lpa) error {
 
 	_, err = c.svc.Index(ctx, opensearchapi.IndexReq{
 		Index:      c.indexName,
-		DocumentID: strings.ReplaceAll(lpa.PK, "#", "--"),
+		DocumentID: documentID(lpa),
 		Body:       bytes.NewReader(body),
 	})
 
@@ -145,8 +178,42 @@ func (c *Client) Query(ctx context.Context, req QueryRequest) (*QueryResponse, e
 		return nil, err
 	}
 
+	query := baseQuery(sk.SK())
+
+	if req.Text != "" {
+		query["bool"]["must"] = append(query["bool"]["must"].([]map[string]any), map[string]any{
+			"match": map[string]any{
+				textField: map[string]any{
+					"query":    req.Text,
+					"operator": "and",
+				},
+			},
+		})
+	}
+
+	var filter []map[string]any
+	if len(req.Statuses) > 0 {
+		filter = append(filter, map[string]any{"terms": map[string]any{"Status": req.Statuses}})
+	}
+	if len(req.LpaTypes) > 0 {
+		filter = append(filter, map[string]any{"terms": map[string]any{"LpaType": req.LpaTypes}})
+	}
+	if req.AssignedMemberID != "" {
+		filter = append(filter, map[string]any{"term": map[string]any{"AssignedMemberID": req.AssignedMemberID}})
+	}
+	if len(filter) > 0 {
+		query["bool"]["filter"] = filter
+	}
+	if req.Unassigned {
+		query["bool"]["must_not"] = map[string]any{
+			"exists": map[string]any{
+				"field": "AssignedMemberID",
+			},
+		}
+	}
+
 	body, err := json.Marshal(map[string]any{
-		"query": baseQuery(sk.SK()),
+		"query": query,
 	})
 	if err != nil {
 		return nil, err
@@ -158,7 +225,7 @@ func (c *Client) Query(ctx context.Context, req QueryRequest) (*QueryResponse, e
 		Params: opensearchapi.SearchParams{
 			From: aws.Int((req.Page - 1) * req.PageSize),
 			Size: aws.Int(req.PageSize),
-			Sort: []string{"Donor.FirstNames", "Donor.LastName"},
+			Sort: sortFields(req.Sort),
 		},
 	})
 	if err != nil {
@@ -266,6 +333,118 @@ func (c *Client) Delete(ctx context.Context, lpa Lpa) error {
 	return err
 }
 
+// CreateIndex creates a new index, with the current definition, for use with
+// BulkIndex and SwapAlias.
+func (c *Client) CreateIndex(ctx context.Context, name string) error {
+	body, err := json.Marshal(indexDefinition)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.indices.Create(ctx, opensearchapi.IndicesCreateReq{Index: name, Body: bytes.NewReader(body)}); err != nil {
+		return fmt.Errorf("search could not create index %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// A BulkIndexFailure is an LPA that could not be indexed by BulkIndex.
+type BulkIndexFailure struct {
+	PK     string
+	Reason string
+}
+
+// BulkIndex adds the LPAs to the named index in a single request. LPAs that
+// could not be indexed are returned rather than causing an error.
+func (c *Client) BulkIndex(ctx context.Context, index string, lpas []Lpa) ([]BulkIndexFailure, error) {
+	if len(lpas) == 0 {
+		return nil, nil
+	}
+
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, lpa := range lpas {
+		if err := enc.Encode(map[string]any{"index": map[string]string{"_id": documentID(lpa)}}); err != nil {
+			return nil, err
+		}
+		if err := enc.Encode(lpa); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := c.svc.Bulk(ctx, opensearchapi.BulkReq{
+		Index: index,
+		Body:  &body,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search could not bulk index: %w", err)
+	}
+
+	if !resp.Errors {
+		return nil, nil
+	}
+
+	var failures []BulkIndexFailure
+	for i, item := range resp.Items {
+		if result, ok := item["index"]; ok && result.Error != nil && i < len(lpas) {
+			failures = append(failures, BulkIndexFailure{PK: lpas[i].PK, Reason: result.Error.Type + ": " + result.Error.Reason})
+		}
+	}
+
+	return failures, nil
+}
+
+// SwapAlias atomically points alias to index, removing it from any indices it
+// previously pointed to. If an index already exists with the name of the alias,
+// as it will before the first reindex, that index is deleted. The names of the
+// indices that were replaced are returned.
+func (c *Client) SwapAlias(ctx context.Context, alias, index string) ([]string, error) {
+	actions := []map[string]any{
+		{"add": map[string]string{"index": index, "alias": alias}},
+	}
+
+	var previous []string
+	if resp, err := c.alias.Get(ctx, opensearchapi.AliasGetReq{Alias: []string{alias}}); err == nil {
+		for name := range resp.Indices {
+			if name != index {
+				previous = append(previous, name)
+				actions = append(actions, map[string]any{"remove": map[string]string{"index": name, "alias": alias}})
+			}
+		}
+	} else if _, err := c.indices.Exists(ctx, opensearchapi.IndicesExistsReq{Indices: []string{alias}}); err == nil {
+		previous = append(previous, alias)
+		actions = append(actions, map[string]any{"remove_index": map[string]string{"index": alias}})
+	}
+
+	slices.Sort(previous)
+
+	body, err := json.Marshal(map[string]any{"actions": actions})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.svc.Aliases(ctx, opensearchapi.AliasesReq{Body: bytes.NewReader(body)}); err != nil {
+		return nil, fmt.Errorf("search could not swap alias: %w", err)
+	}
+
+	return previous, nil
+}
+
+func documentID(lpa Lpa) string {
+	return strings.ReplaceAll(lpa.PK, "#", "--")
+}
+
+func sortFields(sort Sort) []string {
+	switch sort {
+	case SortLastUpdated:
+		return []string{"UpdatedAt:desc"}
+	case SortDeadline:
+		return []string{"DeadlineAt:asc", "Donor.FirstNames", "Donor.LastName"}
+	default:
+		return []string{"Donor.FirstNames", "Donor.LastName"}
+	}
+}
+
 func baseQuery(sk string) map[string]map[string]any {
 	return map[string]map[string]any{
 		"bool": {
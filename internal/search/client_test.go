@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/opensearch-project/opensearch-go/v4/opensearchapi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -195,6 +196,74 @@ func TestClientQuery(t *testing.T) {
 	}
 }
 
+func TestClientQueryWithFiltersAndSort(t *testing.T) {
+	testcases := map[string]struct {
+		req  QueryRequest
+		body string
+		sort []string
+	}{
+		"text": {
+			req:  QueryRequest{Text: "john smith"},
+			body: `{"query":{"bool":{"must":[{"match":{"SK":"ORGANISATION#xyz"}},{"prefix":{"PK":"LPA#"}},{"match":{"Text":{"operator":"and","query":"john smith"}}}]}}}`,
+			sort: []string{"Donor.FirstNames", "Donor.LastName"},
+		},
+		"statuses": {
+			req:  QueryRequest{Statuses: []Status{StatusPaid, StatusSigned}, Sort: SortLastUpdated},
+			body: `{"query":{"bool":{"filter":[{"terms":{"Status":["paid","signed"]}}],"must":[{"match":{"SK":"ORGANISATION#xyz"}},{"prefix":{"PK":"LPA#"}}]}}}`,
+			sort: []string{"UpdatedAt:desc"},
+		},
+		"types": {
+			req:  QueryRequest{LpaTypes: []lpadata.LpaType{lpadata.LpaTypePersonalWelfare}, Sort: SortDeadline},
+			body: `{"query":{"bool":{"filter":[{"terms":{"LpaType":["personal-welfare"]}}],"must":[{"match":{"SK":"ORGANISATION#xyz"}},{"prefix":{"PK":"LPA#"}}]}}}`,
+			sort: []string{"DeadlineAt:asc", "Donor.FirstNames", "Donor.LastName"},
+		},
+		"assigned member": {
+			req:  QueryRequest{AssignedMemberID: "member-id"},
+			body: `{"query":{"bool":{"filter":[{"term":{"AssignedMemberID":"member-id"}}],"must":[{"match":{"SK":"ORGANISATION#xyz"}},{"prefix":{"PK":"LPA#"}}]}}}`,
+			sort: []string{"Donor.FirstNames", "Donor.LastName"},
+		},
+		"unassigned": {
+			req:  QueryRequest{Unassigned: true},
+			body: `{"query":{"bool":{"must":[{"match":{"SK":"ORGANISATION#xyz"}},{"prefix":{"PK":"LPA#"}}],"must_not":{"exists":{"field":"AssignedMemberID"}}}}}`,
+			sort: []string{"Donor.FirstNames", "Donor.LastName"},
+		},
+		"all": {
+			req:  QueryRequest{Text: "M-1234", Statuses: []Status{StatusInProgress}, LpaTypes: []lpadata.LpaType{lpadata.LpaTypePropertyAndAffairs}, Sort: SortDonorName},
+			body: `{"query":{"bool":{"filter":[{"terms":{"Status":["in-progress"]}},{"terms":{"LpaType":["property-and-affairs"]}}],"must":[{"match":{"SK":"ORGANISATION#xyz"}},{"prefix":{"PK":"LPA#"}},{"match":{"Text":{"operator":"and","query":"M-1234"}}}]}}}`,
+			sort: []string{"Donor.FirstNames", "Donor.LastName"},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			ctx := appcontext.ContextWithSession(ctx, &appcontext.Session{SessionID: "abc", OrganisationID: "xyz"})
+
+			resp := &opensearchapi.SearchResp{}
+			resp.Hits.Total.Value = 0
+
+			svc := newMockOpensearchapiClient(t)
+			svc.EXPECT().
+				Search(ctx, &opensearchapi.SearchReq{
+					Indices: []string{testIndexName},
+					Body:    bytes.NewReader([]byte(tc.body)),
+					Params: opensearchapi.SearchParams{
+						From: aws.Int(0),
+						Size: aws.Int(10),
+						Sort: tc.sort,
+					},
+				}).
+				Return(resp, nil)
+
+			tc.req.Page = 1
+			tc.req.PageSize = 10
+
+			client := &Client{svc: svc, indexName: testIndexName}
+			_, err := client.Query(ctx, tc.req)
+			assert.Nil(t, err)
+		})
+	}
+}
+
 func TestClientQueryWhenResponseInvalid(t *testing.T) {
 	ctx := appcontext.ContextWithSession(ctx, &appcontext.Session{SessionID: "abc"})
 
@@ -363,3 +432,174 @@ func TestClientDeleteWhenAlreadyDeleted(t *testing.T) {
 	err := client.Delete(ctx, Lpa{PK: "a-pk", SK: "an-sk"})
 	assert.Nil(t, err)
 }
+
+func TestClientCreateIndex(t *testing.T) {
+	data, _ := json.Marshal(indexDefinition)
+
+	indices := newMockIndicesClient(t)
+	indices.EXPECT().
+		Create(ctx, opensearchapi.IndicesCreateReq{Index: "lpas_v2", Body: bytes.NewReader(data)}).
+		Return(nil, nil)
+
+	client := &Client{indices: indices}
+	err := client.CreateIndex(ctx, "lpas_v2")
+	assert.Nil(t, err)
+}
+
+func TestClientCreateIndexWhenCreateErrors(t *testing.T) {
+	indices := newMockIndicesClient(t)
+	indices.EXPECT().
+		Create(ctx, mock.Anything).
+		Return(nil, expectedError)
+
+	client := &Client{indices: indices}
+	err := client.CreateIndex(ctx, "lpas_v2")
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestClientBulkIndex(t *testing.T) {
+	svc := newMockOpensearchapiClient(t)
+	svc.EXPECT().
+		Bulk(ctx, opensearchapi.BulkReq{
+			Index: "lpas_v2",
+			Body: bytes.NewBufferString(`{"index":{"_id":"LPA--1"}}
+{"PK":"LPA#1","SK":"DONOR#a","Donor":{"FirstNames":"","LastName":""}}
+{"index":{"_id":"LPA--2"}}
+{"PK":"LPA#2","SK":"DONOR#b","Donor":{"FirstNames":"","LastName":""}}
+`),
+		}).
+		Return(&opensearchapi.BulkResp{}, nil)
+
+	client := &Client{svc: svc}
+	failures, err := client.BulkIndex(ctx, "lpas_v2", []Lpa{{PK: "LPA#1", SK: "DONOR#a"}, {PK: "LPA#2", SK: "DONOR#b"}})
+	assert.Nil(t, err)
+	assert.Nil(t, failures)
+}
+
+func TestClientBulkIndexWhenSomeFail(t *testing.T) {
+	var resp opensearchapi.BulkResp
+	_ = json.Unmarshal([]byte(`{"errors":true,"items":[{"index":{"_id":"LPA--1","status":201}},{"index":{"_id":"LPA--2","status":400,"error":{"type":"mapper_parsing_exception","reason":"failed to parse"}}}]}`), &resp)
+
+	svc := newMockOpensearchapiClient(t)
+	svc.EXPECT().
+		Bulk(ctx, mock.Anything).
+		Return(&resp, nil)
+
+	client := &Client{svc: svc}
+	failures, err := client.BulkIndex(ctx, "lpas_v2", []Lpa{{PK: "LPA#1"}, {PK: "LPA#2"}})
+	assert.Nil(t, err)
+	assert.Equal(t, []BulkIndexFailure{{PK: "LPA#2", Reason: "mapper_parsing_exception: failed to parse"}}, failures)
+}
+
+func TestClientBulkIndexWhenEmpty(t *testing.T) {
+	client := &Client{}
+	failures, err := client.BulkIndex(ctx, "lpas_v2", nil)
+	assert.Nil(t, err)
+	assert.Nil(t, failures)
+}
+
+func TestClientBulkIndexWhenBulkErrors(t *testing.T) {
+	svc := newMockOpensearchapiClient(t)
+	svc.EXPECT().
+		Bulk(ctx, mock.Anything).
+		Return(nil, expectedError)
+
+	client := &Client{svc: svc}
+	_, err := client.BulkIndex(ctx, "lpas_v2", []Lpa{{PK: "LPA#1"}})
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestClientSwapAlias(t *testing.T) {
+	var aliasResp opensearchapi.AliasGetResp
+	_ = json.Unmarshal([]byte(`{"lpas_v1":{"aliases":{"lpas":{}}},"lpas_v2":{"aliases":{"lpas":{}}}}`), &aliasResp.Indices)
+
+	alias := newMockAliasClient(t)
+	alias.EXPECT().
+		Get(ctx, opensearchapi.AliasGetReq{Alias: []string{"lpas"}}).
+		Return(&aliasResp, nil)
+
+	svc := newMockOpensearchapiClient(t)
+	svc.EXPECT().
+		Aliases(ctx, mock.MatchedBy(func(req opensearchapi.AliasesReq) bool {
+			var body struct {
+				Actions []map[string]map[string]string `json:"actions"`
+			}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+
+			return assert.Equal(t, map[string]map[string]string{"add": {"index": "lpas_v3", "alias": "lpas"}}, body.Actions[0]) &&
+				assert.ElementsMatch(t, []map[string]map[string]string{
+					{"remove": {"index": "lpas_v1", "alias": "lpas"}},
+					{"remove": {"index": "lpas_v2", "alias": "lpas"}},
+				}, body.Actions[1:])
+		})).
+		Return(nil, nil)
+
+	client := &Client{svc: svc, alias: alias}
+	previous, err := client.SwapAlias(ctx, "lpas", "lpas_v3")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"lpas_v1", "lpas_v2"}, previous)
+}
+
+func TestClientSwapAliasWhenIndexHasAliasName(t *testing.T) {
+	alias := newMockAliasClient(t)
+	alias.EXPECT().
+		Get(ctx, mock.Anything).
+		Return(nil, expectedError)
+
+	indices := newMockIndicesClient(t)
+	indices.EXPECT().
+		Exists(ctx, opensearchapi.IndicesExistsReq{Indices: []string{"lpas"}}).
+		Return(nil, nil)
+
+	svc := newMockOpensearchapiClient(t)
+	svc.EXPECT().
+		Aliases(ctx, opensearchapi.AliasesReq{
+			Body: bytes.NewReader([]byte(`{"actions":[{"add":{"alias":"lpas","index":"lpas_v1"}},{"remove_index":{"index":"lpas"}}]}`)),
+		}).
+		Return(nil, nil)
+
+	client := &Client{svc: svc, alias: alias, indices: indices}
+	previous, err := client.SwapAlias(ctx, "lpas", "lpas_v1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"lpas"}, previous)
+}
+
+func TestClientSwapAliasWhenNothingExists(t *testing.T) {
+	alias := newMockAliasClient(t)
+	alias.EXPECT().
+		Get(ctx, mock.Anything).
+		Return(nil, expectedError)
+
+	indices := newMockIndicesClient(t)
+	indices.EXPECT().
+		Exists(ctx, mock.Anything).
+		Return(nil, expectedError)
+
+	svc := newMockOpensearchapiClient(t)
+	svc.EXPECT().
+		Aliases(ctx, opensearchapi.AliasesReq{
+			Body: bytes.NewReader([]byte(`{"actions":[{"add":{"alias":"lpas","index":"lpas_v1"}}]}`)),
+		}).
+		Return(nil, nil)
+
+	client := &Client{svc: svc, alias: alias, indices: indices}
+	previous, err := client.SwapAlias(ctx, "lpas", "lpas_v1")
+	assert.Nil(t, err)
+	assert.Nil(t, previous)
+}
+
+func TestClientSwapAliasWhenAliasesErrors(t *testing.T) {
+	alias := newMockAliasClient(t)
+	alias.EXPECT().
+		Get(ctx, mock.Anything).
+		Return(&opensearchapi.AliasGetResp{}, nil)
+
+	svc := newMockOpensearchapiClient(t)
+	svc.EXPECT().
+		Aliases(ctx, mock.Anything).
+		Return(nil, expectedError)
+
+	client := &Client{svc: svc, alias: alias}
+	_, err := client.SwapAlias(ctx, "lpas", "lpas_v1")
+	assert.ErrorIs(t, err, expectedError)
+}
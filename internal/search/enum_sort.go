@@ -0,0 +1,89 @@
+// Code generated by "enumerator -type Sort -linecomment -trimprefix -empty"; DO NOT EDIT.
+
+package search
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SortDonorName-1]
+	_ = x[SortLastUpdated-2]
+	_ = x[SortDeadline-3]
+}
+
+const _Sort_name = "donor-namelast-updateddeadline"
+
+var _Sort_index = [...]uint8{0, 10, 22, 30}
+
+func (i Sort) String() string {
+	if i == 0 {
+		return ""
+	}
+	i -= 1
+	if i >= Sort(len(_Sort_index)-1) {
+		return "Sort(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _Sort_name[_Sort_index[i]:_Sort_index[i+1]]
+}
+
+func (i Sort) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *Sort) UnmarshalText(text []byte) error {
+	val, err := ParseSort(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
+
+func (i Sort) IsDonorName() bool {
+	return i == SortDonorName
+}
+
+func (i Sort) IsLastUpdated() bool {
+	return i == SortLastUpdated
+}
+
+func (i Sort) IsDeadline() bool {
+	return i == SortDeadline
+}
+
+func ParseSort(s string) (Sort, error) {
+	switch s {
+	case "":
+		return Sort(0), nil
+	case "donor-name":
+		return SortDonorName, nil
+	case "last-updated":
+		return SortLastUpdated, nil
+	case "deadline":
+		return SortDeadline, nil
+	default:
+		return Sort(0), fmt.Errorf("invalid Sort '%s'", s)
+	}
+}
+
+type SortOptions struct {
+	DonorName   Sort
+	LastUpdated Sort
+	Deadline    Sort
+}
+
+var SortValues = SortOptions{
+	DonorName:   SortDonorName,
+	LastUpdated: SortLastUpdated,
+	Deadline:    SortDeadline,
+}
+
+func (i Sort) Empty() bool {
+	return i == Sort(0)
+}
@@ -0,0 +1,98 @@
+// Code generated by "enumerator -type Status -linecomment -trimprefix -empty"; DO NOT EDIT.
+
+package search
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[StatusInProgress-1]
+	_ = x[StatusPaid-2]
+	_ = x[StatusSigned-3]
+	_ = x[StatusWithdrawn-4]
+}
+
+const _Status_name = "in-progresspaidsignedwithdrawn"
+
+var _Status_index = [...]uint8{0, 11, 15, 21, 30}
+
+func (i Status) String() string {
+	if i == 0 {
+		return ""
+	}
+	i -= 1
+	if i >= Status(len(_Status_index)-1) {
+		return "Status(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _Status_name[_Status_index[i]:_Status_index[i+1]]
+}
+
+func (i Status) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *Status) UnmarshalText(text []byte) error {
+	val, err := ParseStatus(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
+
+func (i Status) IsInProgress() bool {
+	return i == StatusInProgress
+}
+
+func (i Status) IsPaid() bool {
+	return i == StatusPaid
+}
+
+func (i Status) IsSigned() bool {
+	return i == StatusSigned
+}
+
+func (i Status) IsWithdrawn() bool {
+	return i == StatusWithdrawn
+}
+
+func ParseStatus(s string) (Status, error) {
+	switch s {
+	case "":
+		return Status(0), nil
+	case "in-progress":
+		return StatusInProgress, nil
+	case "paid":
+		return StatusPaid, nil
+	case "signed":
+		return StatusSigned, nil
+	case "withdrawn":
+		return StatusWithdrawn, nil
+	default:
+		return Status(0), fmt.Errorf("invalid Status '%s'", s)
+	}
+}
+
+type StatusOptions struct {
+	InProgress Status
+	Paid       Status
+	Signed     Status
+	Withdrawn  Status
+}
+
+var StatusValues = StatusOptions{
+	InProgress: StatusInProgress,
+	Paid:       StatusPaid,
+	Signed:     StatusSigned,
+	Withdrawn:  StatusWithdrawn,
+}
+
+func (i Status) Empty() bool {
+	return i == Status(0)
+}
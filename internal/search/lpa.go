@@ -0,0 +1,98 @@
+package search
+
+import (
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+)
+
+//go:generate go tool enumerator -type Status -linecomment -trimprefix -empty
+type Status uint8
+
+const (
+	StatusInProgress Status = iota + 1 // in-progress
+	StatusPaid                         // paid
+	StatusSigned                       // signed
+	StatusWithdrawn                    // withdrawn
+)
+
+//go:generate go tool enumerator -type Sort -linecomment -trimprefix -empty
+type Sort uint8
+
+const (
+	SortDonorName   Sort = iota + 1 // donor-name
+	SortLastUpdated                 // last-updated
+	SortDeadline                    // deadline
+)
+
+// Lpa is the document that is indexed for each LPA.
+type Lpa struct {
+	PK                  string
+	SK                  string
+	LpaUID              string `json:",omitempty"`
+	LpaType             string `json:",omitempty"`
+	Status              Status `json:",omitzero"`
+	Donor               LpaDonor
+	Attorneys           []string  `json:",omitempty"`
+	CertificateProvider string    `json:",omitempty"`
+	UpdatedAt           time.Time `json:",omitzero"`
+	SignedAt            time.Time `json:",omitzero"`
+	WithdrawnAt         time.Time `json:",omitzero"`
+	DeadlineAt          time.Time `json:",omitzero"`
+	AssignedMemberID    string    `json:",omitempty"`
+}
+
+type LpaDonor struct {
+	FirstNames string
+	LastName   string
+}
+
+// LpaFromDonorProvided creates the document to index for an LPA.
+func LpaFromDonorProvided(provided *donordata.Provided) Lpa {
+	attorneys := provided.AllLayAttorneysFullNames()
+	if provided.HasTrustCorporation() {
+		attorneys = append(attorneys, provided.TrustCorporation().Name)
+	}
+
+	status := StatusInProgress
+	switch {
+	case !provided.WithdrawnAt.IsZero():
+		status = StatusWithdrawn
+	case !provided.SignedAt.IsZero():
+		status = StatusSigned
+	case provided.Tasks.PayForLpa.IsCompleted():
+		status = StatusPaid
+	}
+
+	var certificateProvider string
+	if provided.CertificateProvider.FirstNames != "" || provided.CertificateProvider.LastName != "" {
+		certificateProvider = provided.CertificateProvider.FullName()
+	}
+
+	deadline := provided.DonorSigningDeadline()
+	if !provided.SignedAt.IsZero() {
+		deadline = provided.SigningDeadline()
+	}
+	if !provided.WithdrawnAt.IsZero() {
+		deadline = time.Time{}
+	}
+
+	return Lpa{
+		PK:      provided.PK.PK(),
+		SK:      provided.SK.SK(),
+		LpaUID:  provided.LpaUID,
+		LpaType: provided.Type.String(),
+		Status:  status,
+		Donor: LpaDonor{
+			FirstNames: provided.Donor.FirstNames,
+			LastName:   provided.Donor.LastName,
+		},
+		Attorneys:           attorneys,
+		CertificateProvider: certificateProvider,
+		UpdatedAt:           provided.UpdatedAt,
+		SignedAt:            provided.SignedAt,
+		WithdrawnAt:         provided.WithdrawnAt,
+		DeadlineAt:          deadline,
+		AssignedMemberID:    provided.AssignedMemberID,
+	}
+}
@@ -0,0 +1,132 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/identity"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLpaFromDonorProvided(t *testing.T) {
+	updatedAt := time.Date(2024, time.January, 2, 3, 4, 5, 6, time.UTC)
+	checkedAt := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	signedAt := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	withdrawnAt := time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+	base := donordata.Provided{
+		PK:        dynamo.LpaKey("lpa-id"),
+		SK:        dynamo.LpaOwnerKey(dynamo.OrganisationKey("org-id")),
+		LpaUID:    "M-1111-2222-3333",
+		Type:      lpadata.LpaTypePersonalWelfare,
+		UpdatedAt: updatedAt,
+		Donor:     donordata.Donor{FirstNames: "John", LastName: "Smith"},
+		Attorneys: donordata.Attorneys{
+			Attorneys:        []donordata.Attorney{{FirstNames: "Amy", LastName: "Jones"}},
+			TrustCorporation: donordata.TrustCorporation{Name: "Trusty"},
+		},
+		ReplacementAttorneys: donordata.Attorneys{
+			Attorneys: []donordata.Attorney{{FirstNames: "Bob", LastName: "Brown"}},
+		},
+		CertificateProvider: donordata.CertificateProvider{FirstNames: "Cat", LastName: "Green"},
+	}
+
+	expected := Lpa{
+		PK:                  "LPA#lpa-id",
+		SK:                  "ORGANISATION#org-id",
+		LpaUID:              "M-1111-2222-3333",
+		LpaType:             "personal-welfare",
+		Donor:               LpaDonor{FirstNames: "John", LastName: "Smith"},
+		Attorneys:           []string{"Amy Jones", "Bob Brown", "Trusty"},
+		CertificateProvider: "Cat Green",
+		UpdatedAt:           updatedAt,
+	}
+
+	testcases := map[string]struct {
+		provided func(donordata.Provided) donordata.Provided
+		expected func(Lpa) Lpa
+	}{
+		"in progress": {
+			provided: func(p donordata.Provided) donordata.Provided { return p },
+			expected: func(l Lpa) Lpa {
+				l.Status = StatusInProgress
+				return l
+			},
+		},
+		"identity confirmed": {
+			provided: func(p donordata.Provided) donordata.Provided {
+				p.IdentityUserData = identity.UserData{Status: identity.StatusConfirmed, CheckedAt: checkedAt}
+				return p
+			},
+			expected: func(l Lpa) Lpa {
+				l.Status = StatusInProgress
+				l.DeadlineAt = checkedAt.AddDate(0, 6, 0)
+				return l
+			},
+		},
+		"paid": {
+			provided: func(p donordata.Provided) donordata.Provided {
+				p.Tasks.PayForLpa = task.PaymentStateCompleted
+				return p
+			},
+			expected: func(l Lpa) Lpa {
+				l.Status = StatusPaid
+				return l
+			},
+		},
+		"signed": {
+			provided: func(p donordata.Provided) donordata.Provided {
+				p.Tasks.PayForLpa = task.PaymentStateCompleted
+				p.SignedAt = signedAt
+				return p
+			},
+			expected: func(l Lpa) Lpa {
+				l.Status = StatusSigned
+				l.SignedAt = signedAt
+				l.DeadlineAt = signedAt.AddDate(2, 0, -1)
+				return l
+			},
+		},
+		"assigned": {
+			provided: func(p donordata.Provided) donordata.Provided {
+				p.AssignedMemberID = "member-id"
+				return p
+			},
+			expected: func(l Lpa) Lpa {
+				l.Status = StatusInProgress
+				l.AssignedMemberID = "member-id"
+				return l
+			},
+		},
+		"withdrawn": {
+			provided: func(p donordata.Provided) donordata.Provided {
+				p.SignedAt = signedAt
+				p.WithdrawnAt = withdrawnAt
+				return p
+			},
+			expected: func(l Lpa) Lpa {
+				l.Status = StatusWithdrawn
+				l.SignedAt = signedAt
+				l.WithdrawnAt = withdrawnAt
+				return l
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			provided := tc.provided(base)
+			assert.Equal(t, tc.expected(expected), LpaFromDonorProvided(&provided))
+		})
+	}
+}
+
+func TestLpaFromDonorProvidedWhenMissingCertificateProvider(t *testing.T) {
+	lpa := LpaFromDonorProvided(&donordata.Provided{PK: dynamo.LpaKey("a"), SK: dynamo.LpaOwnerKey(dynamo.DonorKey("b"))})
+
+	assert.Equal(t, "", lpa.CertificateProvider)
+}
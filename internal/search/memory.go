@@ -0,0 +1,240 @@
+package search
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+)
+
+// MemoryClient is a Searcher that keeps the index in memory, so can be used
+// when an OpenSearch service is not available. As the index is not shared
+// between processes, only LPAs indexed by the app itself can be found.
+type MemoryClient struct {
+	mu              sync.RWMutex
+	documents       map[string]Lpa
+	indexingEnabled bool
+}
+
+func NewMemoryClient(indexingEnabled bool) *MemoryClient {
+	return &MemoryClient{
+		documents:       map[string]Lpa{},
+		indexingEnabled: indexingEnabled,
+	}
+}
+
+func (c *MemoryClient) CreateIndices(ctx context.Context) error {
+	return nil
+}
+
+func (c *MemoryClient) Index(ctx context.Context, lpa Lpa) error {
+	if !c.indexingEnabled {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.documents[documentID(lpa)] = lpa
+	return nil
+}
+
+func (c *MemoryClient) Query(ctx context.Context, req QueryRequest) (*QueryResponse, error) {
+	sk, err := getSKFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	textTokens := tokenize(req.Text)
+
+	matches := c.match(sk.SK(), func(lpa Lpa) bool {
+		if len(req.Statuses) > 0 && !slices.Contains(req.Statuses, lpa.Status) {
+			return false
+		}
+
+		if len(req.LpaTypes) > 0 && !slices.ContainsFunc(req.LpaTypes, func(lpaType lpadata.LpaType) bool {
+			return lpaType.String() == lpa.LpaType
+		}) {
+			return false
+		}
+
+		if req.AssignedMemberID != "" && lpa.AssignedMemberID != req.AssignedMemberID {
+			return false
+		}
+
+		if req.Unassigned && lpa.AssignedMemberID != "" {
+			return false
+		}
+
+		if len(textTokens) > 0 {
+			documentTokens := tokenize(strings.Join(append([]string{lpa.LpaUID, lpa.Donor.FirstNames, lpa.Donor.LastName, lpa.CertificateProvider}, lpa.Attorneys...), " "))
+
+			for _, token := range textTokens {
+				if !slices.Contains(documentTokens, token) {
+					return false
+				}
+			}
+		}
+
+		return true
+	})
+
+	slices.SortStableFunc(matches, compareLpas(req.Sort))
+
+	if req.Page < 1 {
+		req.Page = 1
+	}
+
+	from := min((req.Page-1)*req.PageSize, len(matches))
+	to := min(from+req.PageSize, len(matches))
+
+	var keys []dynamo.Keys
+	for _, lpa := range matches[from:to] {
+		data, err := json.Marshal(lpa)
+		if err != nil {
+			return nil, err
+		}
+
+		var key dynamo.Keys
+		if err := json.Unmarshal(data, &key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return &QueryResponse{
+		Pagination: newPagination(len(matches), req.Page, req.PageSize),
+		Keys:       keys,
+	}, nil
+}
+
+func (c *MemoryClient) CountWithQuery(ctx context.Context, req CountWithQueryReq) (int, error) {
+	sk, err := getSKFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	matches := c.match(sk.SK(), func(lpa Lpa) bool {
+		return req.MustNotExist == "" || !fieldExists(lpa, req.MustNotExist)
+	})
+
+	return len(matches), nil
+}
+
+func (c *MemoryClient) Delete(ctx context.Context, lpa Lpa) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, document := range c.documents {
+		if document.PK == lpa.PK && document.SK == lpa.SK {
+			delete(c.documents, id)
+		}
+	}
+
+	return nil
+}
+
+// match returns the documents for the given SK that satisfy fn, ordered by
+// document ID so that results are stable.
+func (c *MemoryClient) match(sk string, fn func(Lpa) bool) []Lpa {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ids := make([]string, 0, len(c.documents))
+	for id := range c.documents {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	var matches []Lpa
+	for _, id := range ids {
+		lpa := c.documents[id]
+		if lpa.SK == sk && strings.HasPrefix(lpa.PK, dynamo.LpaKey("").PK()) && fn(lpa) {
+			matches = append(matches, lpa)
+		}
+	}
+
+	return matches
+}
+
+// compareLpas orders documents in the same way as sortFields, with documents
+// missing the sorted date coming last.
+func compareLpas(sort Sort) func(a, b Lpa) int {
+	byName := func(a, b Lpa) int {
+		return cmp.Or(
+			strings.Compare(a.Donor.FirstNames, b.Donor.FirstNames),
+			strings.Compare(a.Donor.LastName, b.Donor.LastName),
+		)
+	}
+
+	switch sort {
+	case SortLastUpdated:
+		return func(a, b Lpa) int {
+			return cmp.Or(
+				cmp.Compare(boolToInt(a.UpdatedAt.IsZero()), boolToInt(b.UpdatedAt.IsZero())),
+				b.UpdatedAt.Compare(a.UpdatedAt),
+			)
+		}
+	case SortDeadline:
+		return func(a, b Lpa) int {
+			return cmp.Or(
+				cmp.Compare(boolToInt(a.DeadlineAt.IsZero()), boolToInt(b.DeadlineAt.IsZero())),
+				a.DeadlineAt.Compare(b.DeadlineAt),
+				byName(a, b),
+			)
+		}
+	default:
+		return byName
+	}
+}
+
+// tokenize splits text in to lowercase words, approximating the standard
+// analyzer used for the text field.
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// fieldExists reports whether the document has a value for the, possibly
+// dotted, field name.
+func fieldExists(lpa Lpa, field string) bool {
+	data, _ := json.Marshal(lpa)
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return false
+	}
+
+	for _, part := range strings.Split(field, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		v = m[part]
+	}
+
+	switch v := v.(type) {
+	case nil:
+		return false
+	case []any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,263 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestMemoryClient(t *testing.T, lpas ...Lpa) *MemoryClient {
+	client := NewMemoryClient(true)
+	for _, lpa := range lpas {
+		assert.Nil(t, client.Index(ctx, lpa))
+	}
+
+	return client
+}
+
+func TestMemoryClientIndex(t *testing.T) {
+	client := newTestMemoryClient(t,
+		Lpa{PK: "LPA#1", SK: "DONOR#1", Donor: LpaDonor{FirstNames: "A"}},
+		Lpa{PK: "LPA#1", SK: "DONOR#1", Donor: LpaDonor{FirstNames: "B"}},
+	)
+
+	assert.Equal(t, map[string]Lpa{
+		"LPA--1": {PK: "LPA#1", SK: "DONOR#1", Donor: LpaDonor{FirstNames: "B"}},
+	}, client.documents)
+}
+
+func TestMemoryClientIndexWhenNotEnabled(t *testing.T) {
+	client := NewMemoryClient(false)
+
+	err := client.Index(ctx, Lpa{PK: "LPA#1", SK: "DONOR#1"})
+	assert.Nil(t, err)
+	assert.Empty(t, client.documents)
+}
+
+func TestMemoryClientQuery(t *testing.T) {
+	client := newTestMemoryClient(t,
+		Lpa{PK: "LPA#1", SK: "ORGANISATION#1", Donor: LpaDonor{FirstNames: "C"}},
+		Lpa{PK: "LPA#2", SK: "ORGANISATION#1", Donor: LpaDonor{FirstNames: "A"}},
+		Lpa{PK: "LPA#3", SK: "ORGANISATION#1", Donor: LpaDonor{FirstNames: "B"}},
+		Lpa{PK: "LPA#4", SK: "ORGANISATION#2", Donor: LpaDonor{FirstNames: "A"}},
+		Lpa{PK: "OTHER#5", SK: "ORGANISATION#1", Donor: LpaDonor{FirstNames: "A"}},
+	)
+
+	ctx := appcontext.ContextWithSession(ctx, &appcontext.Session{OrganisationID: "1"})
+
+	resp, err := client.Query(ctx, QueryRequest{Page: 2, PageSize: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, &QueryResponse{
+		Pagination: &Pagination{Total: 3, CurrentPage: 2, TotalPages: 2, PageSize: 2},
+		Keys:       []dynamo.Keys{{PK: dynamo.LpaKey("1"), SK: dynamo.OrganisationKey("1")}},
+	}, resp)
+}
+
+func TestMemoryClientQueryWhenPageZero(t *testing.T) {
+	client := newTestMemoryClient(t,
+		Lpa{PK: "LPA#1", SK: "ORGANISATION#1", Donor: LpaDonor{FirstNames: "B"}},
+		Lpa{PK: "LPA#2", SK: "ORGANISATION#1", Donor: LpaDonor{FirstNames: "A"}},
+	)
+
+	ctx := appcontext.ContextWithSession(ctx, &appcontext.Session{OrganisationID: "1"})
+
+	resp, err := client.Query(ctx, QueryRequest{Page: 0, PageSize: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, &QueryResponse{
+		Pagination: &Pagination{Total: 2, CurrentPage: 1, TotalPages: 2, PageSize: 1},
+		Keys:       []dynamo.Keys{{PK: dynamo.LpaKey("2"), SK: dynamo.OrganisationKey("1")}},
+	}, resp)
+}
+
+func TestMemoryClientQueryWhenDonor(t *testing.T) {
+	client := newTestMemoryClient(t,
+		Lpa{PK: "LPA#1", SK: "DONOR#1"},
+		Lpa{PK: "LPA#2", SK: "DONOR#2"},
+	)
+
+	ctx := appcontext.ContextWithSession(ctx, &appcontext.Session{SessionID: "1"})
+
+	resp, err := client.Query(ctx, QueryRequest{Page: 1, PageSize: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, []dynamo.Keys{{PK: dynamo.LpaKey("1"), SK: dynamo.DonorKey("1")}}, resp.Keys)
+}
+
+func TestMemoryClientQueryWithFilters(t *testing.T) {
+	client := newTestMemoryClient(t,
+		Lpa{PK: "LPA#1", SK: "ORGANISATION#1", LpaUID: "M-1111-2222-3333", LpaType: lpadata.LpaTypePropertyAndAffairs.String(), Status: StatusPaid, Donor: LpaDonor{FirstNames: "Sam", LastName: "Smith"}, AssignedMemberID: "a"},
+		Lpa{PK: "LPA#2", SK: "ORGANISATION#1", LpaUID: "M-4444-5555-6666", LpaType: lpadata.LpaTypePersonalWelfare.String(), Status: StatusPaid, Donor: LpaDonor{FirstNames: "Sam", LastName: "Jones"}, Attorneys: []string{"Alex Smith"}, AssignedMemberID: "b"},
+		Lpa{PK: "LPA#3", SK: "ORGANISATION#1", LpaUID: "M-7777-8888-9999", LpaType: lpadata.LpaTypePropertyAndAffairs.String(), Status: StatusSigned, Donor: LpaDonor{FirstNames: "Jo", LastName: "Smith"}, CertificateProvider: "Charlie Brown"},
+	)
+
+	ctx := appcontext.ContextWithSession(ctx, &appcontext.Session{OrganisationID: "1"})
+
+	testcases := map[string]struct {
+		req  QueryRequest
+		keys []string
+	}{
+		"text": {
+			req:  QueryRequest{Text: "smith"},
+			keys: []string{"3", "2", "1"},
+		},
+		"text all words": {
+			req:  QueryRequest{Text: "SAM smith"},
+			keys: []string{"2", "1"},
+		},
+		"reference number": {
+			req:  QueryRequest{Text: "M-4444-5555-6666"},
+			keys: []string{"2"},
+		},
+		"certificate provider": {
+			req:  QueryRequest{Text: "brown"},
+			keys: []string{"3"},
+		},
+		"status": {
+			req:  QueryRequest{Statuses: []Status{StatusSigned, StatusWithdrawn}},
+			keys: []string{"3"},
+		},
+		"type": {
+			req:  QueryRequest{LpaTypes: []lpadata.LpaType{lpadata.LpaTypePersonalWelfare}},
+			keys: []string{"2"},
+		},
+		"assigned member": {
+			req:  QueryRequest{AssignedMemberID: "b"},
+			keys: []string{"2"},
+		},
+		"unassigned": {
+			req:  QueryRequest{Unassigned: true},
+			keys: []string{"3"},
+		},
+		"combined": {
+			req:  QueryRequest{Text: "smith", Statuses: []Status{StatusPaid}, LpaTypes: []lpadata.LpaType{lpadata.LpaTypePropertyAndAffairs}},
+			keys: []string{"1"},
+		},
+		"no matches": {
+			req: QueryRequest{Text: "nobody"},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			tc.req.Page = 1
+			tc.req.PageSize = 10
+
+			resp, err := client.Query(ctx, tc.req)
+			assert.Nil(t, err)
+			assert.Equal(t, len(tc.keys), resp.Pagination.Total)
+
+			var keys []dynamo.Keys
+			for _, key := range tc.keys {
+				keys = append(keys, dynamo.Keys{PK: dynamo.LpaKey(key), SK: dynamo.OrganisationKey("1")})
+			}
+			assert.Equal(t, keys, resp.Keys)
+		})
+	}
+}
+
+func TestMemoryClientQueryWithSort(t *testing.T) {
+	now := time.Date(2020, time.January, 2, 3, 4, 5, 6, time.UTC)
+
+	client := newTestMemoryClient(t,
+		Lpa{PK: "LPA#1", SK: "ORGANISATION#1", Donor: LpaDonor{FirstNames: "B", LastName: "A"}, UpdatedAt: now, DeadlineAt: now},
+		Lpa{PK: "LPA#2", SK: "ORGANISATION#1", Donor: LpaDonor{FirstNames: "A", LastName: "B"}, UpdatedAt: now.Add(time.Hour)},
+		Lpa{PK: "LPA#3", SK: "ORGANISATION#1", Donor: LpaDonor{FirstNames: "A", LastName: "A"}, DeadlineAt: now.Add(-time.Hour)},
+		Lpa{PK: "LPA#4", SK: "ORGANISATION#1", Donor: LpaDonor{FirstNames: "C", LastName: "A"}, UpdatedAt: now.Add(-time.Hour), DeadlineAt: now},
+	)
+
+	ctx := appcontext.ContextWithSession(ctx, &appcontext.Session{OrganisationID: "1"})
+
+	testcases := map[Sort][]string{
+		Sort(0):         {"3", "2", "1", "4"},
+		SortDonorName:   {"3", "2", "1", "4"},
+		SortLastUpdated: {"2", "1", "4", "3"},
+		SortDeadline:    {"3", "1", "4", "2"},
+	}
+
+	for sort, expected := range testcases {
+		t.Run(sort.String(), func(t *testing.T) {
+			resp, err := client.Query(ctx, QueryRequest{Page: 1, PageSize: 10, Sort: sort})
+			assert.Nil(t, err)
+
+			var keys []dynamo.Keys
+			for _, key := range expected {
+				keys = append(keys, dynamo.Keys{PK: dynamo.LpaKey(key), SK: dynamo.OrganisationKey("1")})
+			}
+			assert.Equal(t, keys, resp.Keys)
+		})
+	}
+}
+
+func TestMemoryClientQueryWhenNoSession(t *testing.T) {
+	_, err := NewMemoryClient(true).Query(ctx, QueryRequest{})
+	assert.Equal(t, appcontext.SessionMissingError{}, err)
+}
+
+func TestMemoryClientCountWithQuery(t *testing.T) {
+	client := newTestMemoryClient(t,
+		Lpa{PK: "LPA#1", SK: "ORGANISATION#1", Attorneys: []string{"A"}},
+		Lpa{PK: "LPA#2", SK: "ORGANISATION#1", SignedAt: time.Now()},
+		Lpa{PK: "LPA#3", SK: "ORGANISATION#1", Donor: LpaDonor{FirstNames: "A"}},
+		Lpa{PK: "LPA#4", SK: "ORGANISATION#2"},
+	)
+
+	ctx := appcontext.ContextWithSession(ctx, &appcontext.Session{OrganisationID: "1"})
+
+	testcases := map[string]struct {
+		req   CountWithQueryReq
+		count int
+	}{
+		"no query": {
+			count: 3,
+		},
+		"date": {
+			req:   CountWithQueryReq{MustNotExist: "SignedAt"},
+			count: 2,
+		},
+		"list": {
+			req:   CountWithQueryReq{MustNotExist: "Attorneys"},
+			count: 2,
+		},
+		"nested": {
+			req:   CountWithQueryReq{MustNotExist: "Donor.FirstNames"},
+			count: 0,
+		},
+		"unknown": {
+			req:   CountWithQueryReq{MustNotExist: "RegisteredAt"},
+			count: 3,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			count, err := client.CountWithQuery(ctx, tc.req)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.count, count)
+		})
+	}
+}
+
+func TestMemoryClientCountWithQueryWhenNoSession(t *testing.T) {
+	_, err := NewMemoryClient(true).CountWithQuery(ctx, CountWithQueryReq{})
+	assert.Equal(t, appcontext.SessionMissingError{}, err)
+}
+
+func TestMemoryClientDelete(t *testing.T) {
+	client := newTestMemoryClient(t,
+		Lpa{PK: "LPA#1", SK: "ORGANISATION#1"},
+		Lpa{PK: "LPA#2", SK: "ORGANISATION#1"},
+	)
+
+	err := client.Delete(ctx, Lpa{PK: "LPA#1", SK: "ORGANISATION#1"})
+	assert.Nil(t, err)
+
+	err = client.Delete(ctx, Lpa{PK: "LPA#2", SK: "ORGANISATION#2"})
+	assert.Nil(t, err)
+
+	assert.Equal(t, map[string]Lpa{
+		"LPA--2": {PK: "LPA#2", SK: "ORGANISATION#1"},
+	}, client.documents)
+}
@@ -22,6 +22,124 @@ func (_m *mockOpensearchapiClient) EXPECT() *mockOpensearchapiClient_Expecter {
 	return &mockOpensearchapiClient_Expecter{mock: &_m.Mock}
 }
 
+// Aliases provides a mock function with given fields: ctx, req
+func (_m *mockOpensearchapiClient) Aliases(ctx context.Context, req opensearchapi.AliasesReq) (*opensearchapi.AliasesResp, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Aliases")
+	}
+
+	var r0 *opensearchapi.AliasesResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, opensearchapi.AliasesReq) (*opensearchapi.AliasesResp, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, opensearchapi.AliasesReq) *opensearchapi.AliasesResp); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*opensearchapi.AliasesResp)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, opensearchapi.AliasesReq) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockOpensearchapiClient_Aliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Aliases'
+type mockOpensearchapiClient_Aliases_Call struct {
+	*mock.Call
+}
+
+// Aliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req opensearchapi.AliasesReq
+func (_e *mockOpensearchapiClient_Expecter) Aliases(ctx interface{}, req interface{}) *mockOpensearchapiClient_Aliases_Call {
+	return &mockOpensearchapiClient_Aliases_Call{Call: _e.mock.On("Aliases", ctx, req)}
+}
+
+func (_c *mockOpensearchapiClient_Aliases_Call) Run(run func(ctx context.Context, req opensearchapi.AliasesReq)) *mockOpensearchapiClient_Aliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(opensearchapi.AliasesReq))
+	})
+	return _c
+}
+
+func (_c *mockOpensearchapiClient_Aliases_Call) Return(_a0 *opensearchapi.AliasesResp, _a1 error) *mockOpensearchapiClient_Aliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockOpensearchapiClient_Aliases_Call) RunAndReturn(run func(context.Context, opensearchapi.AliasesReq) (*opensearchapi.AliasesResp, error)) *mockOpensearchapiClient_Aliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Bulk provides a mock function with given fields: ctx, req
+func (_m *mockOpensearchapiClient) Bulk(ctx context.Context, req opensearchapi.BulkReq) (*opensearchapi.BulkResp, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Bulk")
+	}
+
+	var r0 *opensearchapi.BulkResp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, opensearchapi.BulkReq) (*opensearchapi.BulkResp, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, opensearchapi.BulkReq) *opensearchapi.BulkResp); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*opensearchapi.BulkResp)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, opensearchapi.BulkReq) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockOpensearchapiClient_Bulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Bulk'
+type mockOpensearchapiClient_Bulk_Call struct {
+	*mock.Call
+}
+
+// Bulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req opensearchapi.BulkReq
+func (_e *mockOpensearchapiClient_Expecter) Bulk(ctx interface{}, req interface{}) *mockOpensearchapiClient_Bulk_Call {
+	return &mockOpensearchapiClient_Bulk_Call{Call: _e.mock.On("Bulk", ctx, req)}
+}
+
+func (_c *mockOpensearchapiClient_Bulk_Call) Run(run func(ctx context.Context, req opensearchapi.BulkReq)) *mockOpensearchapiClient_Bulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(opensearchapi.BulkReq))
+	})
+	return _c
+}
+
+func (_c *mockOpensearchapiClient_Bulk_Call) Return(_a0 *opensearchapi.BulkResp, _a1 error) *mockOpensearchapiClient_Bulk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockOpensearchapiClient_Bulk_Call) RunAndReturn(run func(context.Context, opensearchapi.BulkReq) (*opensearchapi.BulkResp, error)) *mockOpensearchapiClient_Bulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Index provides a mock function with given fields: ctx, req
 func (_m *mockOpensearchapiClient) Index(ctx context.Context, req opensearchapi.IndexReq) (*opensearchapi.IndexResp, error) {
 	ret := _m.Called(ctx, req)
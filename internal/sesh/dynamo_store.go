@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/base64"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/gorilla/securecookie"
@@ -12,11 +14,17 @@ import (
 )
 
 type DynamoClient interface {
+	AllKeysByPK(ctx context.Context, pk dynamo.PK) ([]dynamo.Keys, error)
 	Create(ctx context.Context, v any) error
+	DeleteKeys(ctx context.Context, keys []dynamo.Keys) error
 	DeleteOne(ctx context.Context, pk dynamo.PK, sk dynamo.SK) error
 	OneActive(ctx context.Context, pk dynamo.PK, sk dynamo.SK, now time.Time, v interface{}) error
 }
 
+// maxTransactionItems is the number of items DynamoDB allows to be written in a
+// single transaction.
+const maxTransactionItems = 100
+
 func NewDynamoStore(dynamoClient DynamoClient, now func() time.Time, keyPairs ...[]byte) *DynamoStore {
 	return &DynamoStore{
 		Codecs: securecookie.CodecsFromPairs(keyPairs...),
@@ -92,6 +100,12 @@ type sessionData struct {
 	ExpiresAt time.Time `dynamodbav:",unixtime"`
 }
 
+type loginData struct {
+	PK        dynamo.LoginKeyType
+	SK        dynamo.MetadataKeyType
+	ExpiresAt time.Time `dynamodbav:",unixtime"`
+}
+
 func (s *DynamoStore) save(ctx context.Context, session *sessions.Session) error {
 	encoded, err := securecookie.EncodeMulti(session.Name(), session.Values, s.Codecs...)
 	if err != nil {
@@ -100,6 +114,18 @@ func (s *DynamoStore) save(ctx context.Context, session *sessions.Session) error
 
 	expiresAt := s.now().UTC().Add(time.Duration(session.Options.MaxAge) * time.Second)
 
+	// Login sessions are recorded against the user first, so that a session can
+	// never exist without being found by RevokeLogins.
+	if loginSession, ok := session.Values["session"].(*LoginSession); ok {
+		if err := s.dynamoClient.Create(ctx, loginData{
+			PK:        dynamo.LoginKey(loginSession.SessionID()),
+			SK:        dynamo.MetadataKey(session.ID),
+			ExpiresAt: expiresAt,
+		}); err != nil {
+			return err
+		}
+	}
+
 	return s.dynamoClient.Create(ctx, sessionData{
 		PK:        dynamo.SessionKey(session.ID),
 		SK:        dynamo.MetadataKey(session.ID),
@@ -123,3 +149,29 @@ func (s *DynamoStore) erase(ctx context.Context, session *sessions.Session) erro
 		dynamo.MetadataKey(session.ID),
 	)
 }
+
+// RevokeLogins deletes every session created for the OneLogin user with the
+// given session ID, signing them out on all devices.
+func (s *DynamoStore) RevokeLogins(ctx context.Context, sessionID string) error {
+	keys, err := s.dynamoClient.AllKeysByPK(ctx, dynamo.LoginKey(sessionID))
+	if err != nil {
+		return err
+	}
+
+	var toDelete []dynamo.Keys
+	for _, key := range keys {
+		id := strings.TrimPrefix(key.SK.SK(), dynamo.MetadataKey("").SK())
+
+		toDelete = append(toDelete,
+			dynamo.Keys{PK: dynamo.SessionKey(id), SK: dynamo.MetadataKey(id)},
+			key)
+	}
+
+	for chunk := range slices.Chunk(toDelete, maxTransactionItems) {
+		if err := s.dynamoClient.DeleteKeys(ctx, chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
@@ -170,6 +171,13 @@ func TestDynamoStoreSave(t *testing.T) {
 
 	expiresAt := testNow.UTC().Add(time.Duration(30) * time.Second)
 	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		Create(r.Context(), loginData{
+			PK:        dynamo.LoginKey(LoginSession{Sub: "x"}.SessionID()),
+			SK:        dynamo.MetadataKey(sessionID),
+			ExpiresAt: expiresAt,
+		}).
+		Return(nil)
 	dynamoClient.EXPECT().
 		Create(r.Context(), sessionData{
 			PK:        dynamo.SessionKey(sessionID),
@@ -230,6 +238,38 @@ func TestDynamoStoreSaveWhenDynamoErrors(t *testing.T) {
 	assert.Empty(t, w.Result().Cookies())
 }
 
+func TestDynamoStoreSaveWhenLoginDynamoErrors(t *testing.T) {
+	var (
+		r, _ = http.NewRequest(http.MethodGet, "/path?a=b", nil)
+		w    = httptest.NewRecorder()
+
+		sessionName    = "a-session-name"
+		sessionOptions = &sessions.Options{Path: "/", MaxAge: 30}
+		codecs         = securecookie.CodecsFromPairs(securecookie.GenerateRandomKey(32))
+	)
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(expectedError).
+		Once()
+
+	store := &DynamoStore{
+		dynamoClient: dynamoClient,
+		Options:      sessionOptions,
+		Codecs:       codecs,
+		now:          testNowFn,
+	}
+
+	session := sessions.NewSession(store, sessionName)
+	session.Values = map[any]any{"session": &LoginSession{Sub: "x"}}
+	session.Options = sessionOptions
+
+	err := store.Save(r, w, session)
+	assert.Equal(t, expectedError, err)
+	assert.Empty(t, w.Result().Cookies())
+}
+
 func TestDynamoStoreSaveWhenCodecErrors(t *testing.T) {
 	var (
 		r, _ = http.NewRequest(http.MethodGet, "/path?a=b", nil)
@@ -314,3 +354,82 @@ func TestDynamoStoreSaveWhenExpiringErrors(t *testing.T) {
 	assert.Equal(t, expectedError, err)
 	assert.Empty(t, w.Result().Cookies())
 }
+
+func TestDynamoStoreRevokeLogins(t *testing.T) {
+	ctx := context.Background()
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		AllKeysByPK(ctx, dynamo.LoginKey("a-session-id")).
+		Return([]dynamo.Keys{
+			{PK: dynamo.LoginKey("a-session-id"), SK: dynamo.MetadataKey("1")},
+			{PK: dynamo.LoginKey("a-session-id"), SK: dynamo.MetadataKey("2")},
+		}, nil)
+	dynamoClient.EXPECT().
+		DeleteKeys(ctx, []dynamo.Keys{
+			{PK: dynamo.SessionKey("1"), SK: dynamo.MetadataKey("1")},
+			{PK: dynamo.LoginKey("a-session-id"), SK: dynamo.MetadataKey("1")},
+			{PK: dynamo.SessionKey("2"), SK: dynamo.MetadataKey("2")},
+			{PK: dynamo.LoginKey("a-session-id"), SK: dynamo.MetadataKey("2")},
+		}).
+		Return(nil)
+
+	store := &DynamoStore{dynamoClient: dynamoClient}
+
+	err := store.RevokeLogins(ctx, "a-session-id")
+	assert.Nil(t, err)
+}
+
+func TestDynamoStoreRevokeLoginsWhenManySessions(t *testing.T) {
+	ctx := context.Background()
+
+	var keys []dynamo.Keys
+	for i := range 60 {
+		keys = append(keys, dynamo.Keys{PK: dynamo.LoginKey("a-session-id"), SK: dynamo.MetadataKey(strconv.Itoa(i))})
+	}
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		AllKeysByPK(ctx, mock.Anything).
+		Return(keys, nil)
+	dynamoClient.EXPECT().
+		DeleteKeys(ctx, mock.MatchedBy(func(keys []dynamo.Keys) bool { return len(keys) == 100 })).
+		Return(nil).
+		Once()
+	dynamoClient.EXPECT().
+		DeleteKeys(ctx, mock.MatchedBy(func(keys []dynamo.Keys) bool { return len(keys) == 20 })).
+		Return(nil).
+		Once()
+
+	store := &DynamoStore{dynamoClient: dynamoClient}
+
+	err := store.RevokeLogins(ctx, "a-session-id")
+	assert.Nil(t, err)
+}
+
+func TestDynamoStoreRevokeLoginsWhenAllKeysByPKErrors(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		AllKeysByPK(mock.Anything, mock.Anything).
+		Return(nil, expectedError)
+
+	store := &DynamoStore{dynamoClient: dynamoClient}
+
+	err := store.RevokeLogins(context.Background(), "a-session-id")
+	assert.Equal(t, expectedError, err)
+}
+
+func TestDynamoStoreRevokeLoginsWhenDeleteKeysErrors(t *testing.T) {
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		AllKeysByPK(mock.Anything, mock.Anything).
+		Return([]dynamo.Keys{{PK: dynamo.LoginKey("a-session-id"), SK: dynamo.MetadataKey("1")}}, nil)
+	dynamoClient.EXPECT().
+		DeleteKeys(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	store := &DynamoStore{dynamoClient: dynamoClient}
+
+	err := store.RevokeLogins(context.Background(), "a-session-id")
+	assert.Equal(t, expectedError, err)
+}
@@ -24,6 +24,65 @@ func (_m *mockDynamoClient) EXPECT() *mockDynamoClient_Expecter {
 	return &mockDynamoClient_Expecter{mock: &_m.Mock}
 }
 
+// AllKeysByPK provides a mock function with given fields: ctx, pk
+func (_m *mockDynamoClient) AllKeysByPK(ctx context.Context, pk dynamo.PK) ([]dynamo.Keys, error) {
+	ret := _m.Called(ctx, pk)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllKeysByPK")
+	}
+
+	var r0 []dynamo.Keys
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dynamo.PK) ([]dynamo.Keys, error)); ok {
+		return rf(ctx, pk)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dynamo.PK) []dynamo.Keys); ok {
+		r0 = rf(ctx, pk)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]dynamo.Keys)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dynamo.PK) error); ok {
+		r1 = rf(ctx, pk)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockDynamoClient_AllKeysByPK_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllKeysByPK'
+type mockDynamoClient_AllKeysByPK_Call struct {
+	*mock.Call
+}
+
+// AllKeysByPK is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pk dynamo.PK
+func (_e *mockDynamoClient_Expecter) AllKeysByPK(ctx interface{}, pk interface{}) *mockDynamoClient_AllKeysByPK_Call {
+	return &mockDynamoClient_AllKeysByPK_Call{Call: _e.mock.On("AllKeysByPK", ctx, pk)}
+}
+
+func (_c *mockDynamoClient_AllKeysByPK_Call) Run(run func(ctx context.Context, pk dynamo.PK)) *mockDynamoClient_AllKeysByPK_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dynamo.PK))
+	})
+	return _c
+}
+
+func (_c *mockDynamoClient_AllKeysByPK_Call) Return(_a0 []dynamo.Keys, _a1 error) *mockDynamoClient_AllKeysByPK_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockDynamoClient_AllKeysByPK_Call) RunAndReturn(run func(context.Context, dynamo.PK) ([]dynamo.Keys, error)) *mockDynamoClient_AllKeysByPK_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, v
 func (_m *mockDynamoClient) Create(ctx context.Context, v interface{}) error {
 	ret := _m.Called(ctx, v)
@@ -71,6 +130,53 @@ func (_c *mockDynamoClient_Create_Call) RunAndReturn(run func(context.Context, i
 	return _c
 }
 
+// DeleteKeys provides a mock function with given fields: ctx, keys
+func (_m *mockDynamoClient) DeleteKeys(ctx context.Context, keys []dynamo.Keys) error {
+	ret := _m.Called(ctx, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []dynamo.Keys) error); ok {
+		r0 = rf(ctx, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// mockDynamoClient_DeleteKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteKeys'
+type mockDynamoClient_DeleteKeys_Call struct {
+	*mock.Call
+}
+
+// DeleteKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keys []dynamo.Keys
+func (_e *mockDynamoClient_Expecter) DeleteKeys(ctx interface{}, keys interface{}) *mockDynamoClient_DeleteKeys_Call {
+	return &mockDynamoClient_DeleteKeys_Call{Call: _e.mock.On("DeleteKeys", ctx, keys)}
+}
+
+func (_c *mockDynamoClient_DeleteKeys_Call) Run(run func(ctx context.Context, keys []dynamo.Keys)) *mockDynamoClient_DeleteKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]dynamo.Keys))
+	})
+	return _c
+}
+
+func (_c *mockDynamoClient_DeleteKeys_Call) Return(_a0 error) *mockDynamoClient_DeleteKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockDynamoClient_DeleteKeys_Call) RunAndReturn(run func(context.Context, []dynamo.Keys) error) *mockDynamoClient_DeleteKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteOne provides a mock function with given fields: ctx, pk, sk
 func (_m *mockDynamoClient) DeleteOne(ctx context.Context, pk dynamo.PK, sk dynamo.SK) error {
 	ret := _m.Called(ctx, pk, sk)
@@ -2,6 +2,7 @@
 package sesh
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/gob"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/gorilla/sessions"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
 )
 
 // These are the cookie names in use. We need some to be able to overlap
@@ -71,15 +73,23 @@ type sessionsStore interface {
 	sessions.Store
 }
 
+type loginRevoker interface {
+	RevokeLogins(ctx context.Context, sessionID string) error
+}
+
 type Store struct {
 	s sessionsStore
 	d sessionsStore
+	r loginRevoker
 }
 
 func NewStore(dynamoClient DynamoClient, keyPairs [][]byte) *Store {
+	dynamoStore := NewDynamoStore(dynamoClient, time.Now, keyPairs...)
+
 	return &Store{
 		s: sessions.NewCookieStore(keyPairs...),
-		d: NewDynamoStore(dynamoClient, time.Now, keyPairs...),
+		d: dynamoStore,
+		r: dynamoStore,
 	}
 }
 
@@ -154,6 +164,12 @@ type LoginSession struct {
 	OrganisationID   string
 	OrganisationName string
 	HasLPAs          bool
+	// Permission is the member's permission in the organisation, so that it can
+	// be checked outside of the supporter pages
+	Permission supporterdata.Permission
+	// MemberID identifies the member in the organisation, so that LPAs assigned
+	// to them can be recognised
+	MemberID string
 }
 
 // SessionID is a safe version of the OneLogin sub, that is used to form
@@ -179,6 +195,12 @@ func (s *Store) ClearLogin(r *http.Request, w http.ResponseWriter) error {
 	return clearSession(s.d, cookieSession, r, w)
 }
 
+// RevokeLogins signs the OneLogin user with the given session ID out of every
+// session they have.
+func (s *Store) RevokeLogins(ctx context.Context, sessionID string) error {
+	return s.r.RevokeLogins(ctx, sessionID)
+}
+
 type PaymentSession struct {
 	PaymentID string
 }
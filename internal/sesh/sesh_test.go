@@ -1,6 +1,7 @@
 package sesh
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -135,6 +136,20 @@ func TestClearLogin(t *testing.T) {
 	assert.Equal(t, expectedError, err)
 }
 
+func TestRevokeLogins(t *testing.T) {
+	ctx := context.Background()
+
+	revoker := newMockLoginRevoker(t)
+	revoker.EXPECT().
+		RevokeLogins(ctx, "a-session-id").
+		Return(expectedError)
+
+	store := &Store{r: revoker}
+
+	err := store.RevokeLogins(ctx, "a-session-id")
+	assert.Equal(t, expectedError, err)
+}
+
 func TestPayment(t *testing.T) {
 	var (
 		r, _   = http.NewRequest(http.MethodGet, "/path?a=b", nil)
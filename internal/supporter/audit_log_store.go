@@ -0,0 +1,106 @@
+package supporter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/random"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+)
+
+const auditLogPageSize = 50
+
+// AuditLogStore records the actions taken by members of an organisation.
+type AuditLogStore struct {
+	dynamoClient DynamoClient
+	uuidString   func() string
+	now          func() time.Time
+}
+
+func NewAuditLogStore(dynamoClient DynamoClient) *AuditLogStore {
+	return &AuditLogStore{
+		dynamoClient: dynamoClient,
+		uuidString:   random.UUID,
+		now:          time.Now,
+	}
+}
+
+// Create records the event against the organisation in the session, with the
+// member in the session as the actor.
+func (s *AuditLogStore) Create(ctx context.Context, event supporterdata.AuditEvent) error {
+	data, err := appcontext.SessionFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if data.OrganisationID == "" {
+		return errors.New("auditLogStore.Create requires OrganisationID")
+	}
+
+	return s.dynamoClient.Create(ctx, newAuditEvent(data, data.OrganisationID, s.now(), s.uuidString(), event))
+}
+
+// GetPage returns up to auditLogPageSize events recorded for the organisation
+// in the session, most recent first. Only events for action are returned,
+// unless it is empty. When after is given the events start after the event
+// with that key. The key to get the next page is returned, or an empty string
+// when there are no more events.
+func (s *AuditLogStore) GetPage(ctx context.Context, action supporterdata.AuditAction, after string) ([]supporterdata.AuditEvent, string, error) {
+	data, err := appcontext.SessionFromContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if data.OrganisationID == "" {
+		return nil, "", errors.New("auditLogStore.GetPage requires OrganisationID")
+	}
+
+	if !strings.HasPrefix(after, dynamo.PartialAuditLogKey().SK()) {
+		after = ""
+	}
+
+	var events []supporterdata.AuditEvent
+	for {
+		var page []supporterdata.AuditEvent
+		next, err := s.dynamoClient.PageByPartialSK(ctx, dynamo.OrganisationKey(data.OrganisationID), dynamo.PartialAuditLogKey(), auditLogPageSize, after, &page)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for i, event := range page {
+			if !action.Empty() && event.Action != action {
+				continue
+			}
+
+			events = append(events, event)
+			if len(events) == auditLogPageSize {
+				if i == len(page)-1 {
+					return events, next, nil
+				}
+
+				return events, event.SK.SK(), nil
+			}
+		}
+
+		if next == "" {
+			return events, "", nil
+		}
+
+		after = next
+	}
+}
+
+// newAuditEvent keys the event for the organisation so it can be written
+// alongside the change it records.
+func newAuditEvent(session *appcontext.Session, organisationID string, now time.Time, id string, event supporterdata.AuditEvent) *supporterdata.AuditEvent {
+	event.PK = dynamo.OrganisationKey(organisationID)
+	event.SK = dynamo.AuditLogKey(now, id)
+	event.CreatedAt = now
+	event.ActorEmail = session.Email
+
+	return &event
+}
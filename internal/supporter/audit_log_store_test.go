@@ -0,0 +1,164 @@
+package supporter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditLogStoreCreate(t *testing.T) {
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "org-id", Email: "a@example.org"})
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		Create(ctx, &supporterdata.AuditEvent{
+			PK:         dynamo.OrganisationKey("org-id"),
+			SK:         dynamo.AuditLogKey(testNow, "a-uuid"),
+			CreatedAt:  testNow,
+			Action:     supporterdata.AuditActionOrganisationRenamed,
+			ActorEmail: "a@example.org",
+			Previous:   "Old name",
+			Current:    "New name",
+		}).
+		Return(nil)
+
+	store := &AuditLogStore{dynamoClient: dynamoClient, now: testNowFn, uuidString: func() string { return "a-uuid" }}
+
+	err := store.Create(ctx, supporterdata.AuditEvent{
+		Action:   supporterdata.AuditActionOrganisationRenamed,
+		Previous: "Old name",
+		Current:  "New name",
+	})
+	assert.Nil(t, err)
+}
+
+func TestAuditLogStoreCreateWhenSessionMissing(t *testing.T) {
+	testcases := map[string]context.Context{
+		"missing session":        context.Background(),
+		"missing OrganisationID": appcontext.ContextWithSession(context.Background(), &appcontext.Session{}),
+	}
+
+	for name, ctx := range testcases {
+		t.Run(name, func(t *testing.T) {
+			store := &AuditLogStore{}
+
+			err := store.Create(ctx, supporterdata.AuditEvent{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAuditLogStoreCreateWhenDynamoClientErrors(t *testing.T) {
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "org-id"})
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	store := &AuditLogStore{dynamoClient: dynamoClient, now: testNowFn, uuidString: func() string { return "a-uuid" }}
+
+	err := store.Create(ctx, supporterdata.AuditEvent{})
+	assert.Equal(t, expectedError, err)
+}
+
+func TestAuditLogStoreGetPage(t *testing.T) {
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "org-id"})
+	events := []supporterdata.AuditEvent{{Subject: "a"}, {Subject: "b"}}
+
+	testcases := map[string]string{
+		"first page": "",
+		"next page":  "AUDITLOG#2020-01-02T03:04:05Z#an-id",
+	}
+
+	for name, after := range testcases {
+		t.Run(name, func(t *testing.T) {
+			dynamoClient := newMockDynamoClient(t)
+			dynamoClient.ExpectPageByPartialSK(ctx, dynamo.OrganisationKey("org-id"), dynamo.PartialAuditLogKey(), int32(auditLogPageSize), after,
+				events, "", nil)
+
+			store := &AuditLogStore{dynamoClient: dynamoClient}
+
+			result, next, err := store.GetPage(ctx, supporterdata.AuditAction(0), after)
+			assert.Nil(t, err)
+			assert.Equal(t, events, result)
+			assert.Equal(t, "", next)
+		})
+	}
+}
+
+func TestAuditLogStoreGetPageWhenAfterNotAuditLogKey(t *testing.T) {
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "org-id"})
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.ExpectPageByPartialSK(ctx, mock.Anything, mock.Anything, mock.Anything, "",
+		nil, "", nil)
+
+	store := &AuditLogStore{dynamoClient: dynamoClient}
+
+	_, _, err := store.GetPage(ctx, supporterdata.AuditAction(0), "MEMBER#abc")
+	assert.Nil(t, err)
+}
+
+func TestAuditLogStoreGetPageWithAction(t *testing.T) {
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "org-id"})
+
+	var firstPage, secondPage, expected []supporterdata.AuditEvent
+	for i := range auditLogPageSize {
+		firstPage = append(firstPage, supporterdata.AuditEvent{SK: dynamo.AuditLogKeyType(fmt.Sprintf("AUDITLOG#1#%d", i)), Action: supporterdata.AuditActionLpaCreated})
+		secondPage = append(secondPage, supporterdata.AuditEvent{SK: dynamo.AuditLogKeyType(fmt.Sprintf("AUDITLOG#2#%d", i)), Action: supporterdata.AuditActionLpaAssigned})
+
+		if i%2 == 0 {
+			firstPage[i].Action = supporterdata.AuditActionLpaAssigned
+			expected = append(expected, firstPage[i])
+		}
+	}
+	expected = append(expected, secondPage[:auditLogPageSize/2]...)
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.ExpectPageByPartialSK(ctx, mock.Anything, mock.Anything, mock.Anything, "",
+		firstPage, "AUDITLOG#1#49", nil)
+	dynamoClient.ExpectPageByPartialSK(ctx, mock.Anything, mock.Anything, mock.Anything, "AUDITLOG#1#49",
+		secondPage, "", nil)
+
+	store := &AuditLogStore{dynamoClient: dynamoClient}
+
+	events, next, err := store.GetPage(ctx, supporterdata.AuditActionLpaAssigned, "")
+	assert.Nil(t, err)
+	assert.Equal(t, expected, events)
+	assert.Equal(t, "AUDITLOG#2#24", next)
+}
+
+func TestAuditLogStoreGetPageWhenSessionMissing(t *testing.T) {
+	testcases := map[string]context.Context{
+		"missing session":        context.Background(),
+		"missing OrganisationID": appcontext.ContextWithSession(context.Background(), &appcontext.Session{}),
+	}
+
+	for name, ctx := range testcases {
+		t.Run(name, func(t *testing.T) {
+			store := &AuditLogStore{}
+
+			_, _, err := store.GetPage(ctx, supporterdata.AuditAction(0), "")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAuditLogStoreGetPageWhenDynamoClientErrors(t *testing.T) {
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "org-id"})
+
+	dynamoClient := newMockDynamoClient(t)
+	dynamoClient.ExpectPageByPartialSK(ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, nil, "", expectedError)
+
+	store := &AuditLogStore{dynamoClient: dynamoClient}
+
+	_, _, err := store.GetPage(ctx, supporterdata.AuditAction(0), "")
+	assert.Equal(t, expectedError, err)
+}
@@ -22,6 +22,7 @@ type DynamoClient interface {
 	DeleteOne(ctx context.Context, pk dynamo.PK, sk dynamo.SK) error
 	One(ctx context.Context, pk dynamo.PK, sk dynamo.SK, v interface{}) error
 	OneBySK(ctx context.Context, sk dynamo.SK, v interface{}) error
+	PageByPartialSK(ctx context.Context, pk dynamo.PK, partialSK dynamo.SK, limit int32, after string, v interface{}) (string, error)
 	Put(ctx context.Context, v interface{}) error
 	WriteTransaction(ctx context.Context, transaction *dynamo.Transaction) error
 }
@@ -65,7 +66,12 @@ func (s *MemberStore) CreateMemberInvite(ctx context.Context, organisation *supp
 
 	transaction := dynamo.NewTransaction().
 		Create(invite).
-		Create(dynamo.ReservedSK(invite.SK))
+		Create(dynamo.ReservedSK(invite.SK)).
+		Create(newAuditEvent(data, data.OrganisationID, s.now(), s.uuidString(), supporterdata.AuditEvent{
+			Action:  supporterdata.AuditActionMemberInvited,
+			Subject: email,
+			Current: permission.String(),
+		}))
 
 	if err := s.dynamoClient.WriteTransaction(ctx, transaction); err != nil {
 		return fmt.Errorf("error creating member invite: %w", err)
@@ -75,11 +81,20 @@ func (s *MemberStore) CreateMemberInvite(ctx context.Context, organisation *supp
 }
 
 func (s *MemberStore) DeleteMemberInvite(ctx context.Context, organisationID, email string) error {
+	data, err := appcontext.SessionFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
 	invite := dynamo.Keys{PK: dynamo.OrganisationKey(organisationID), SK: dynamo.MemberInviteKey(email)}
 
 	transaction := dynamo.NewTransaction().
 		Delete(invite).
-		Delete(dynamo.ReservedSK(invite.SK))
+		Delete(dynamo.ReservedSK(invite.SK)).
+		Create(newAuditEvent(data, organisationID, s.now(), s.uuidString(), supporterdata.AuditEvent{
+			Action:  supporterdata.AuditActionMemberInviteRecalled,
+			Subject: email,
+		}))
 
 	if err := s.dynamoClient.WriteTransaction(ctx, transaction); err != nil {
 		return fmt.Errorf("error deleting member invite: %w", err)
@@ -176,7 +191,12 @@ func (s *MemberStore) CreateFromInvite(ctx context.Context, invite *supporterdat
 		Delete(dynamo.ReservedSK(invite.SK)).
 		// In GetAny and OrganisationStore.Get we rely on there being only one
 		// member per session ID. This assumption may need to change in the future.
-		Create(dynamo.ReservedSK(member.SK))
+		Create(dynamo.ReservedSK(member.SK)).
+		Create(newAuditEvent(data, invite.OrganisationID, s.now(), s.uuidString(), supporterdata.AuditEvent{
+			Action:  supporterdata.AuditActionMemberJoined,
+			Subject: invite.Email,
+			Current: invite.Permission.String(),
+		}))
 
 	if err := s.dynamoClient.WriteTransaction(ctx, transaction); err != nil {
 		return fmt.Errorf("error creating member from invite: %w", err)
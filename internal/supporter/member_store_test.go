@@ -13,7 +13,7 @@ import (
 )
 
 func TestMemberStoreCreateMemberInvite(t *testing.T) {
-	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "an-id"})
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "an-id", Email: "admin@example.com"})
 
 	dynamoClient := newMockDynamoClient(t)
 	dynamoClient.EXPECT().
@@ -30,10 +30,19 @@ func TestMemberStoreCreateMemberInvite(t *testing.T) {
 				Permission:       supporterdata.PermissionNone,
 				InviteCode:       invitecode.HashedFromString("abcde"),
 			}).
-			Create(dynamo.ReservedSK(dynamo.MemberInviteKey("email@example.com")))).
+			Create(dynamo.ReservedSK(dynamo.MemberInviteKey("email@example.com"))).
+			Create(&supporterdata.AuditEvent{
+				PK:         dynamo.OrganisationKey("an-id"),
+				SK:         dynamo.AuditLogKey(testNow, "a-uuid"),
+				CreatedAt:  testNow,
+				Action:     supporterdata.AuditActionMemberInvited,
+				ActorEmail: "admin@example.com",
+				Subject:    "email@example.com",
+				Current:    "none",
+			})).
 		Return(nil)
 
-	memberStore := &MemberStore{dynamoClient: dynamoClient, now: testNowFn}
+	memberStore := &MemberStore{dynamoClient: dynamoClient, now: testNowFn, uuidString: func() string { return "a-uuid" }}
 
 	err := memberStore.CreateMemberInvite(ctx, &supporterdata.Organisation{ID: "a-uuid", Name: "org name"}, "a", "b", "email@example.com", invitecode.HashedFromString("abcde"), supporterdata.PermissionNone)
 	assert.Nil(t, err)
@@ -64,15 +73,30 @@ func TestMemberStoreDeleteMemberInvite(t *testing.T) {
 	dynamoClient.EXPECT().
 		WriteTransaction(ctx, dynamo.NewTransaction().
 			Delete(dynamo.Keys{PK: dynamo.OrganisationKey("org-id"), SK: dynamo.MemberInviteKey("email")}).
-			Delete(dynamo.ReservedSK(dynamo.MemberInviteKey("email")))).
+			Delete(dynamo.ReservedSK(dynamo.MemberInviteKey("email"))).
+			Create(&supporterdata.AuditEvent{
+				PK:         dynamo.OrganisationKey("org-id"),
+				SK:         dynamo.AuditLogKey(testNow, "a-uuid"),
+				CreatedAt:  testNow,
+				Action:     supporterdata.AuditActionMemberInviteRecalled,
+				ActorEmail: "a@example.org",
+				Subject:    "email",
+			})).
 		Return(nil)
 
-	memberStore := &MemberStore{dynamoClient: dynamoClient}
+	memberStore := &MemberStore{dynamoClient: dynamoClient, now: testNowFn, uuidString: func() string { return "a-uuid" }}
 	err := memberStore.DeleteMemberInvite(ctx, "org-id", "email")
 
 	assert.Nil(t, err)
 }
 
+func TestMemberStoreDeleteMemberInviteWhenSessionMissing(t *testing.T) {
+	memberStore := &MemberStore{}
+	err := memberStore.DeleteMemberInvite(context.Background(), "org-id", "email")
+
+	assert.Error(t, err)
+}
+
 func TestMemberStoreDeleteMemberInviteWhenError(t *testing.T) {
 	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{Email: "a@example.org"})
 
@@ -81,7 +105,7 @@ func TestMemberStoreDeleteMemberInviteWhenError(t *testing.T) {
 		WriteTransaction(mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	memberStore := &MemberStore{dynamoClient: dynamoClient}
+	memberStore := &MemberStore{dynamoClient: dynamoClient, now: testNowFn, uuidString: func() string { return "a-uuid" }}
 	err := memberStore.DeleteMemberInvite(ctx, "org-id", "email")
 
 	assert.ErrorIs(t, err, expectedError)
@@ -139,7 +163,7 @@ func TestMemberStoreCreateMemberInviteWhenErrors(t *testing.T) {
 		WriteTransaction(ctx, mock.Anything).
 		Return(expectedError)
 
-	memberStore := &MemberStore{dynamoClient: dynamoClient, now: testNowFn}
+	memberStore := &MemberStore{dynamoClient: dynamoClient, now: testNowFn, uuidString: func() string { return "a-uuid" }}
 
 	err := memberStore.CreateMemberInvite(ctx, &supporterdata.Organisation{}, "a", "b", "email@example.com", invitecode.HashedFromString("abcde"), supporterdata.PermissionNone)
 	assert.ErrorIs(t, err, expectedError)
@@ -438,7 +462,7 @@ func TestMemberStoreCreateWhenDynamoErrors(t *testing.T) {
 }
 
 func TestMemberStoreCreateFromInvite(t *testing.T) {
-	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{SessionID: "session-id"})
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{SessionID: "session-id", Email: "ab@example.org"})
 
 	invite := &supporterdata.MemberInvite{
 		PK:             "pk",
@@ -474,7 +498,16 @@ func TestMemberStoreCreateFromInvite(t *testing.T) {
 			}).
 			Delete(dynamo.Keys{PK: dynamo.OrganisationKey("org-id"), SK: dynamo.MemberInviteKey(invite.Email)}).
 			Delete(dynamo.ReservedSK(invite.SK)).
-			Create(dynamo.ReservedSK(dynamo.MemberKey("session-id")))).
+			Create(dynamo.ReservedSK(dynamo.MemberKey("session-id"))).
+			Create(&supporterdata.AuditEvent{
+				PK:         dynamo.OrganisationKey("org-id"),
+				SK:         dynamo.AuditLogKey(testNow, "a-uuid"),
+				CreatedAt:  testNow,
+				Action:     supporterdata.AuditActionMemberJoined,
+				ActorEmail: "ab@example.org",
+				Subject:    "ab@example.org",
+				Current:    "admin",
+			})).
 		Return(nil)
 
 	memberStore := &MemberStore{dynamoClient: dynamoClient, now: testNowFn, uuidString: func() string { return "a-uuid" }}
@@ -311,6 +311,67 @@ func (_c *mockDynamoClient_OneBySK_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// PageByPartialSK provides a mock function with given fields: ctx, pk, partialSK, limit, after, v
+func (_m *mockDynamoClient) PageByPartialSK(ctx context.Context, pk dynamo.PK, partialSK dynamo.SK, limit int32, after string, v interface{}) (string, error) {
+	ret := _m.Called(ctx, pk, partialSK, limit, after, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PageByPartialSK")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dynamo.PK, dynamo.SK, int32, string, interface{}) (string, error)); ok {
+		return rf(ctx, pk, partialSK, limit, after, v)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dynamo.PK, dynamo.SK, int32, string, interface{}) string); ok {
+		r0 = rf(ctx, pk, partialSK, limit, after, v)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dynamo.PK, dynamo.SK, int32, string, interface{}) error); ok {
+		r1 = rf(ctx, pk, partialSK, limit, after, v)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// mockDynamoClient_PageByPartialSK_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PageByPartialSK'
+type mockDynamoClient_PageByPartialSK_Call struct {
+	*mock.Call
+}
+
+// PageByPartialSK is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pk dynamo.PK
+//   - partialSK dynamo.SK
+//   - limit int32
+//   - after string
+//   - v interface{}
+func (_e *mockDynamoClient_Expecter) PageByPartialSK(ctx interface{}, pk interface{}, partialSK interface{}, limit interface{}, after interface{}, v interface{}) *mockDynamoClient_PageByPartialSK_Call {
+	return &mockDynamoClient_PageByPartialSK_Call{Call: _e.mock.On("PageByPartialSK", ctx, pk, partialSK, limit, after, v)}
+}
+
+func (_c *mockDynamoClient_PageByPartialSK_Call) Run(run func(ctx context.Context, pk dynamo.PK, partialSK dynamo.SK, limit int32, after string, v interface{})) *mockDynamoClient_PageByPartialSK_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(dynamo.PK), args[2].(dynamo.SK), args[3].(int32), args[4].(string), args[5].(interface{}))
+	})
+	return _c
+}
+
+func (_c *mockDynamoClient_PageByPartialSK_Call) Return(_a0 string, _a1 error) *mockDynamoClient_PageByPartialSK_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *mockDynamoClient_PageByPartialSK_Call) RunAndReturn(run func(context.Context, dynamo.PK, dynamo.SK, int32, string, interface{}) (string, error)) *mockDynamoClient_PageByPartialSK_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Put provides a mock function with given fields: ctx, v
 func (_m *mockDynamoClient) Put(ctx context.Context, v interface{}) error {
 	ret := _m.Called(ctx, v)
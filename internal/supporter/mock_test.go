@@ -40,6 +40,17 @@ func (m *mockDynamoClient) ExpectAllByPartialSK(ctx, pk, partialSk, data interfa
 		})
 }
 
+func (m *mockDynamoClient) ExpectPageByPartialSK(ctx, pk, partialSk, limit, after, data interface{}, next string, err error) {
+	m.
+		On("PageByPartialSK", ctx, pk, partialSk, limit, after, mock.Anything).
+		Return(func(ctx context.Context, pk dynamo.PK, partialSk dynamo.SK, limit int32, after string, v interface{}) (string, error) {
+			b, _ := json.Marshal(data)
+			json.Unmarshal(b, v)
+			return next, err
+		}).
+		Once()
+}
+
 func (m *mockDynamoClient) ExpectAllBySK(ctx, sk, data interface{}, err error) {
 	m.
 		On("AllBySK", ctx, sk, mock.Anything).
@@ -59,7 +59,14 @@ func (s *OrganisationStore) Create(ctx context.Context, member *supporterdata.Me
 		CreatedAt: s.now(),
 	}
 
-	if err := s.dynamoClient.Create(ctx, organisation); err != nil {
+	transaction := dynamo.NewTransaction().
+		Create(organisation).
+		Create(newAuditEvent(data, organisation.ID, s.now(), s.uuidString(), supporterdata.AuditEvent{
+			Action:  supporterdata.AuditActionOrganisationCreated,
+			Subject: name,
+		}))
+
+	if err := s.dynamoClient.WriteTransaction(ctx, transaction); err != nil {
 		return nil, fmt.Errorf("error creating organisation: %w", err)
 	}
 
@@ -98,7 +105,10 @@ func (s *OrganisationStore) Put(ctx context.Context, organisation *supporterdata
 	return s.dynamoClient.Put(ctx, organisation)
 }
 
-func (s *OrganisationStore) CreateLPA(ctx context.Context) (*donordata.Provided, error) {
+// CreateLPA creates an LPA for the organisation in the session, assigned to the
+// member with assignedMemberID. An empty assignedMemberID leaves the LPA
+// unassigned.
+func (s *OrganisationStore) CreateLPA(ctx context.Context, assignedMemberID string) (*donordata.Provided, error) {
 	data, err := appcontext.SessionFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -112,11 +122,12 @@ func (s *OrganisationStore) CreateLPA(ctx context.Context) (*donordata.Provided,
 	donorUID := s.newUID()
 
 	donor := &donordata.Provided{
-		PK:        dynamo.LpaKey(lpaID),
-		SK:        dynamo.LpaOwnerKey(dynamo.OrganisationKey(data.OrganisationID)),
-		LpaID:     lpaID,
-		CreatedAt: s.now(),
-		Version:   1,
+		PK:               dynamo.LpaKey(lpaID),
+		SK:               dynamo.LpaOwnerKey(dynamo.OrganisationKey(data.OrganisationID)),
+		LpaID:            lpaID,
+		CreatedAt:        s.now(),
+		Version:          1,
+		AssignedMemberID: assignedMemberID,
 		Donor: donordata.Donor{
 			UID: donorUID,
 		},
@@ -128,7 +139,11 @@ func (s *OrganisationStore) CreateLPA(ctx context.Context) (*donordata.Provided,
 
 	transaction := dynamo.NewTransaction().
 		Create(dynamo.Keys{PK: donor.PK, SK: dynamo.ReservedKey(dynamo.DonorKey)}).
-		Create(donor)
+		Create(donor).
+		Create(newAuditEvent(data, data.OrganisationID, s.now(), s.uuidString(), supporterdata.AuditEvent{
+			Action: supporterdata.AuditActionLpaCreated,
+			LpaID:  lpaID,
+		}))
 
 	if err := s.dynamoClient.WriteTransaction(ctx, transaction); err != nil {
 		return nil, err
@@ -138,7 +153,19 @@ func (s *OrganisationStore) CreateLPA(ctx context.Context) (*donordata.Provided,
 }
 
 func (s *OrganisationStore) SoftDelete(ctx context.Context, organisation *supporterdata.Organisation) error {
+	data, err := appcontext.SessionFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
 	organisation.DeletedAt = s.now()
 
-	return s.dynamoClient.Put(ctx, organisation)
+	transaction := dynamo.NewTransaction().
+		Put(organisation).
+		Create(newAuditEvent(data, organisation.ID, s.now(), s.uuidString(), supporterdata.AuditEvent{
+			Action:  supporterdata.AuditActionOrganisationDeleted,
+			Subject: organisation.Name,
+		}))
+
+	return s.dynamoClient.WriteTransaction(ctx, transaction)
 }
@@ -17,13 +17,22 @@ func TestOrganisationStoreCreate(t *testing.T) {
 
 	dynamoClient := newMockDynamoClient(t)
 	dynamoClient.EXPECT().
-		Create(ctx, &supporterdata.Organisation{
-			PK:        dynamo.OrganisationKey("a-uuid"),
-			SK:        dynamo.OrganisationKey("a-uuid"),
-			ID:        "a-uuid",
-			CreatedAt: testNow,
-			Name:      "A name",
-		}).
+		WriteTransaction(ctx, dynamo.NewTransaction().
+			Create(&supporterdata.Organisation{
+				PK:        dynamo.OrganisationKey("a-uuid"),
+				SK:        dynamo.OrganisationKey("a-uuid"),
+				ID:        "a-uuid",
+				CreatedAt: testNow,
+				Name:      "A name",
+			}).
+			Create(&supporterdata.AuditEvent{
+				PK:         dynamo.OrganisationKey("a-uuid"),
+				SK:         dynamo.AuditLogKey(testNow, "a-uuid"),
+				CreatedAt:  testNow,
+				Action:     supporterdata.AuditActionOrganisationCreated,
+				ActorEmail: "a@example.org",
+				Subject:    "A name",
+			})).
 		Return(nil)
 
 	organisationStore := &OrganisationStore{dynamoClient: dynamoClient, now: testNowFn, uuidString: func() string { return "a-uuid" }}
@@ -61,7 +70,7 @@ func TestOrganisationStoreCreateWhenErrors(t *testing.T) {
 
 	dynamoClient := newMockDynamoClient(t)
 	dynamoClient.EXPECT().
-		Create(ctx, mock.Anything).
+		WriteTransaction(ctx, mock.Anything).
 		Return(expectedError)
 
 	organisationStore := &OrganisationStore{dynamoClient: dynamoClient, now: testNowFn, uuidString: func() string { return "a-uuid" }}
@@ -177,13 +186,14 @@ func TestOrganisationStorePut(t *testing.T) {
 }
 
 func TestOrganisationStoreCreateLPA(t *testing.T) {
-	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "an-id"})
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "an-id", Email: "a@example.org"})
 	expectedDonor := &donordata.Provided{
-		PK:        dynamo.LpaKey("a-uuid"),
-		SK:        dynamo.LpaOwnerKey(dynamo.OrganisationKey("an-id")),
-		LpaID:     "a-uuid",
-		CreatedAt: testNow,
-		Version:   1,
+		PK:               dynamo.LpaKey("a-uuid"),
+		SK:               dynamo.LpaOwnerKey(dynamo.OrganisationKey("an-id")),
+		LpaID:            "a-uuid",
+		CreatedAt:        testNow,
+		Version:          1,
+		AssignedMemberID: "member-id",
 		Donor: donordata.Donor{
 			UID: testUID,
 		},
@@ -193,7 +203,18 @@ func TestOrganisationStoreCreateLPA(t *testing.T) {
 	dynamoClient := newMockDynamoClient(t)
 	dynamoClient.EXPECT().
 		WriteTransaction(ctx, &dynamo.Transaction{
-			Creates: []any{dynamo.Keys{PK: expectedDonor.PK, SK: dynamo.ReservedKey(dynamo.DonorKey)}, expectedDonor},
+			Creates: []any{
+				dynamo.Keys{PK: expectedDonor.PK, SK: dynamo.ReservedKey(dynamo.DonorKey)},
+				expectedDonor,
+				&supporterdata.AuditEvent{
+					PK:         dynamo.OrganisationKey("an-id"),
+					SK:         dynamo.AuditLogKey(testNow, "a-uuid"),
+					CreatedAt:  testNow,
+					Action:     supporterdata.AuditActionLpaCreated,
+					ActorEmail: "a@example.org",
+					LpaID:      "a-uuid",
+				},
+			},
 		}).
 		Return(nil)
 
@@ -204,7 +225,7 @@ func TestOrganisationStoreCreateLPA(t *testing.T) {
 		newUID:       testUIDFn,
 	}
 
-	donor, err := organisationStore.CreateLPA(ctx)
+	donor, err := organisationStore.CreateLPA(ctx, "member-id")
 
 	assert.Nil(t, err)
 	assert.Equal(t, expectedDonor, donor)
@@ -220,7 +241,7 @@ func TestOrganisationStoreCreateLPAWithSessionMissing(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			organisationStore := &OrganisationStore{dynamoClient: nil, now: testNowFn, uuidString: func() string { return "a-uuid" }}
 
-			_, err := organisationStore.CreateLPA(ctx)
+			_, err := organisationStore.CreateLPA(ctx, "")
 			assert.Error(t, err)
 		})
 	}
@@ -241,31 +262,47 @@ func TestOrganisationStoreCreateLPAWhenDynamoError(t *testing.T) {
 		newUID:       testUIDFn,
 	}
 
-	_, err := organisationStore.CreateLPA(ctx)
+	_, err := organisationStore.CreateLPA(ctx, "")
 
 	assert.Equal(t, expectedError, err)
 }
 
 func TestOrganisationStoreSoftDelete(t *testing.T) {
-	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "an-id", SessionID: "session-id"})
+	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "an-id", SessionID: "session-id", Email: "a@example.org"})
 
 	dynamoClient := newMockDynamoClient(t)
 	dynamoClient.EXPECT().
-		Put(ctx, &supporterdata.Organisation{DeletedAt: testNow}).
+		WriteTransaction(ctx, dynamo.NewTransaction().
+			Put(&supporterdata.Organisation{ID: "an-id", Name: "A name", DeletedAt: testNow}).
+			Create(&supporterdata.AuditEvent{
+				PK:         dynamo.OrganisationKey("an-id"),
+				SK:         dynamo.AuditLogKey(testNow, "a-uuid"),
+				CreatedAt:  testNow,
+				Action:     supporterdata.AuditActionOrganisationDeleted,
+				ActorEmail: "a@example.org",
+				Subject:    "A name",
+			})).
 		Return(nil)
 
 	organisationStore := &OrganisationStore{dynamoClient: dynamoClient, now: testNowFn, uuidString: func() string { return "a-uuid" }}
 
-	err := organisationStore.SoftDelete(ctx, &supporterdata.Organisation{})
+	err := organisationStore.SoftDelete(ctx, &supporterdata.Organisation{ID: "an-id", Name: "A name"})
 	assert.Nil(t, err)
 }
 
+func TestOrganisationStoreSoftDeleteWhenSessionMissing(t *testing.T) {
+	organisationStore := &OrganisationStore{}
+
+	err := organisationStore.SoftDelete(context.Background(), &supporterdata.Organisation{})
+	assert.Error(t, err)
+}
+
 func TestOrganisationStoreSoftDeleteWhenDynamoClientError(t *testing.T) {
 	ctx := appcontext.ContextWithSession(context.Background(), &appcontext.Session{OrganisationID: "an-id", SessionID: "session-id"})
 
 	dynamoClient := newMockDynamoClient(t)
 	dynamoClient.EXPECT().
-		Put(mock.Anything, mock.Anything).
+		WriteTransaction(mock.Anything, mock.Anything).
 		Return(expectedError)
 
 	organisationStore := &OrganisationStore{dynamoClient: dynamoClient, now: testNowFn, uuidString: func() string { return "a-uuid" }}
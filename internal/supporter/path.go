@@ -8,6 +8,9 @@ import (
 )
 
 const (
+	PathAuditLog                      = Path("/manage-organisation/audit-log")
+	PathAuditLogExport                = Path("/manage-organisation/audit-log/export")
+	PathBulkInviteMembers             = Path("/bulk-invite-members")
 	PathConfirmDonorCanInteractOnline = Path("/confirm-donor-can-interact-online")
 	PathContactOPGForPaperForms       = Path("/contact-opg-for-paper-forms")
 	PathDashboard                     = Path("/dashboard")
@@ -20,8 +23,11 @@ const (
 	PathOrganisationCreated           = Path("/organisation-or-company-created")
 	PathOrganisationDetails           = Path("/manage-organisation/organisation-details")
 
-	PathDonorAccess = LpaPath("/donor-access")
-	PathViewLPA     = LpaPath("/view-lpa")
+	PathAccessCodes        = LpaPath("/access-codes")
+	PathAssignLPA          = LpaPath("/assign-lpa")
+	PathCommunicationsSent = LpaPath("/communications-sent")
+	PathDonorAccess        = LpaPath("/donor-access")
+	PathViewLPA            = LpaPath("/view-lpa")
 )
 
 type Path string
@@ -48,7 +54,8 @@ func (p Path) IsManageOrganisation() bool {
 	return p == PathOrganisationDetails ||
 		p == PathEditOrganisationName ||
 		p == PathManageTeamMembers ||
-		p == PathEditMember
+		p == PathEditMember ||
+		p == PathAuditLog
 }
 
 type LpaPath string
@@ -39,6 +39,7 @@ func TestSupporterPathIsManageOrganisation(t *testing.T) {
 	assert.True(t, PathEditOrganisationName.IsManageOrganisation())
 	assert.True(t, PathManageTeamMembers.IsManageOrganisation())
 	assert.True(t, PathEditMember.IsManageOrganisation())
+	assert.True(t, PathAuditLog.IsManageOrganisation())
 }
 
 func TestSupporterLpaPathString(t *testing.T) {
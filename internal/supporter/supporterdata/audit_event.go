@@ -0,0 +1,46 @@
+package supporterdata
+
+import (
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+)
+
+//go:generate go tool enumerator -type AuditAction -linecomment -trimprefix -empty
+type AuditAction uint8
+
+const (
+	AuditActionOrganisationCreated     AuditAction = iota + 1 // organisation-created
+	AuditActionOrganisationRenamed                            // organisation-renamed
+	AuditActionOrganisationDeleted                            // organisation-deleted
+	AuditActionMemberInvited                                  // member-invited
+	AuditActionMemberInviteRecalled                           // member-invite-recalled
+	AuditActionMemberJoined                                   // member-joined
+	AuditActionMemberPermissionChanged                        // member-permission-changed
+	AuditActionMemberStatusChanged                            // member-status-changed
+	AuditActionLpaCreated                                     // lpa-created
+	AuditActionLpaAssigned                                    // lpa-assigned
+	AuditActionDonorAccessInvited                             // donor-access-invited
+	AuditActionDonorAccessRecalled                            // donor-access-recalled
+	AuditActionDonorAccessRemoved                             // donor-access-removed
+)
+
+// An AuditEvent records an action taken by a member of an organisation. Events
+// are only ever created, they are not updated or deleted.
+type AuditEvent struct {
+	PK        dynamo.OrganisationKeyType
+	SK        dynamo.AuditLogKeyType
+	CreatedAt time.Time
+	Action    AuditAction
+	// ActorEmail is the email address of the member who took the action
+	ActorEmail string
+	// Subject identifies what the action was taken on, for example the email
+	// address of a member
+	Subject string
+	// LpaID is set when the action relates to an LPA
+	LpaID string
+	// Previous and Current describe the change made, where the action is a
+	// change
+	Previous string
+	Current  string
+}
@@ -0,0 +1,18 @@
+package supporterdata
+
+// A Capability is something a member of an organisation can be allowed to do.
+//
+//go:generate go tool enumerator -type Capability -linecomment -trimprefix
+type Capability uint8
+
+const (
+	CapabilityViewLpas           Capability = iota + 1 // view-lpas
+	CapabilityCreateLpa                                // create-lpa
+	CapabilityEditAnyLpa                               // edit-any-lpa
+	CapabilityEditAssignedLpa                          // edit-assigned-lpa
+	CapabilityManageDonorAccess                        // manage-donor-access
+	CapabilityManageMembers                            // manage-members
+	CapabilityManageOrganisation                       // manage-organisation
+	CapabilityAssignLpas                               // assign-lpas
+	CapabilityViewAuditLog                             // view-audit-log
+)
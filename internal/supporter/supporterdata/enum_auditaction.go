@@ -0,0 +1,179 @@
+// Code generated by "enumerator -type AuditAction -linecomment -trimprefix -empty"; DO NOT EDIT.
+
+package supporterdata
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[AuditActionOrganisationCreated-1]
+	_ = x[AuditActionOrganisationRenamed-2]
+	_ = x[AuditActionOrganisationDeleted-3]
+	_ = x[AuditActionMemberInvited-4]
+	_ = x[AuditActionMemberInviteRecalled-5]
+	_ = x[AuditActionMemberJoined-6]
+	_ = x[AuditActionMemberPermissionChanged-7]
+	_ = x[AuditActionMemberStatusChanged-8]
+	_ = x[AuditActionLpaCreated-9]
+	_ = x[AuditActionLpaAssigned-10]
+	_ = x[AuditActionDonorAccessInvited-11]
+	_ = x[AuditActionDonorAccessRecalled-12]
+	_ = x[AuditActionDonorAccessRemoved-13]
+}
+
+const _AuditAction_name = "organisation-createdorganisation-renamedorganisation-deletedmember-invitedmember-invite-recalledmember-joinedmember-permission-changedmember-status-changedlpa-createdlpa-assigneddonor-access-inviteddonor-access-recalleddonor-access-removed"
+
+var _AuditAction_index = [...]uint8{0, 20, 40, 60, 74, 96, 109, 134, 155, 166, 178, 198, 219, 239}
+
+func (i AuditAction) String() string {
+	if i == 0 {
+		return ""
+	}
+	i -= 1
+	if i >= AuditAction(len(_AuditAction_index)-1) {
+		return "AuditAction(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _AuditAction_name[_AuditAction_index[i]:_AuditAction_index[i+1]]
+}
+
+func (i AuditAction) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *AuditAction) UnmarshalText(text []byte) error {
+	val, err := ParseAuditAction(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
+
+func (i AuditAction) IsOrganisationCreated() bool {
+	return i == AuditActionOrganisationCreated
+}
+
+func (i AuditAction) IsOrganisationRenamed() bool {
+	return i == AuditActionOrganisationRenamed
+}
+
+func (i AuditAction) IsOrganisationDeleted() bool {
+	return i == AuditActionOrganisationDeleted
+}
+
+func (i AuditAction) IsMemberInvited() bool {
+	return i == AuditActionMemberInvited
+}
+
+func (i AuditAction) IsMemberInviteRecalled() bool {
+	return i == AuditActionMemberInviteRecalled
+}
+
+func (i AuditAction) IsMemberJoined() bool {
+	return i == AuditActionMemberJoined
+}
+
+func (i AuditAction) IsMemberPermissionChanged() bool {
+	return i == AuditActionMemberPermissionChanged
+}
+
+func (i AuditAction) IsMemberStatusChanged() bool {
+	return i == AuditActionMemberStatusChanged
+}
+
+func (i AuditAction) IsLpaCreated() bool {
+	return i == AuditActionLpaCreated
+}
+
+func (i AuditAction) IsLpaAssigned() bool {
+	return i == AuditActionLpaAssigned
+}
+
+func (i AuditAction) IsDonorAccessInvited() bool {
+	return i == AuditActionDonorAccessInvited
+}
+
+func (i AuditAction) IsDonorAccessRecalled() bool {
+	return i == AuditActionDonorAccessRecalled
+}
+
+func (i AuditAction) IsDonorAccessRemoved() bool {
+	return i == AuditActionDonorAccessRemoved
+}
+
+func ParseAuditAction(s string) (AuditAction, error) {
+	switch s {
+	case "":
+		return AuditAction(0), nil
+	case "organisation-created":
+		return AuditActionOrganisationCreated, nil
+	case "organisation-renamed":
+		return AuditActionOrganisationRenamed, nil
+	case "organisation-deleted":
+		return AuditActionOrganisationDeleted, nil
+	case "member-invited":
+		return AuditActionMemberInvited, nil
+	case "member-invite-recalled":
+		return AuditActionMemberInviteRecalled, nil
+	case "member-joined":
+		return AuditActionMemberJoined, nil
+	case "member-permission-changed":
+		return AuditActionMemberPermissionChanged, nil
+	case "member-status-changed":
+		return AuditActionMemberStatusChanged, nil
+	case "lpa-created":
+		return AuditActionLpaCreated, nil
+	case "lpa-assigned":
+		return AuditActionLpaAssigned, nil
+	case "donor-access-invited":
+		return AuditActionDonorAccessInvited, nil
+	case "donor-access-recalled":
+		return AuditActionDonorAccessRecalled, nil
+	case "donor-access-removed":
+		return AuditActionDonorAccessRemoved, nil
+	default:
+		return AuditAction(0), fmt.Errorf("invalid AuditAction '%s'", s)
+	}
+}
+
+type AuditActionOptions struct {
+	OrganisationCreated     AuditAction
+	OrganisationRenamed     AuditAction
+	OrganisationDeleted     AuditAction
+	MemberInvited           AuditAction
+	MemberInviteRecalled    AuditAction
+	MemberJoined            AuditAction
+	MemberPermissionChanged AuditAction
+	MemberStatusChanged     AuditAction
+	LpaCreated              AuditAction
+	LpaAssigned             AuditAction
+	DonorAccessInvited      AuditAction
+	DonorAccessRecalled     AuditAction
+	DonorAccessRemoved      AuditAction
+}
+
+var AuditActionValues = AuditActionOptions{
+	OrganisationCreated:     AuditActionOrganisationCreated,
+	OrganisationRenamed:     AuditActionOrganisationRenamed,
+	OrganisationDeleted:     AuditActionOrganisationDeleted,
+	MemberInvited:           AuditActionMemberInvited,
+	MemberInviteRecalled:    AuditActionMemberInviteRecalled,
+	MemberJoined:            AuditActionMemberJoined,
+	MemberPermissionChanged: AuditActionMemberPermissionChanged,
+	MemberStatusChanged:     AuditActionMemberStatusChanged,
+	LpaCreated:              AuditActionLpaCreated,
+	LpaAssigned:             AuditActionLpaAssigned,
+	DonorAccessInvited:      AuditActionDonorAccessInvited,
+	DonorAccessRecalled:     AuditActionDonorAccessRecalled,
+	DonorAccessRemoved:      AuditActionDonorAccessRemoved,
+}
+
+func (i AuditAction) Empty() bool {
+	return i == AuditAction(0)
+}
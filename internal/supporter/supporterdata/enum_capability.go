@@ -0,0 +1,134 @@
+// Code generated by "enumerator -type Capability -linecomment -trimprefix"; DO NOT EDIT.
+
+package supporterdata
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[CapabilityViewLpas-1]
+	_ = x[CapabilityCreateLpa-2]
+	_ = x[CapabilityEditAnyLpa-3]
+	_ = x[CapabilityEditAssignedLpa-4]
+	_ = x[CapabilityManageDonorAccess-5]
+	_ = x[CapabilityManageMembers-6]
+	_ = x[CapabilityManageOrganisation-7]
+	_ = x[CapabilityAssignLpas-8]
+	_ = x[CapabilityViewAuditLog-9]
+}
+
+const _Capability_name = "view-lpascreate-lpaedit-any-lpaedit-assigned-lpamanage-donor-accessmanage-membersmanage-organisationassign-lpasview-audit-log"
+
+var _Capability_index = [...]uint8{0, 9, 19, 31, 48, 67, 81, 100, 111, 125}
+
+func (i Capability) String() string {
+	i -= 1
+	if i >= Capability(len(_Capability_index)-1) {
+		return "Capability(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _Capability_name[_Capability_index[i]:_Capability_index[i+1]]
+}
+
+func (i Capability) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *Capability) UnmarshalText(text []byte) error {
+	val, err := ParseCapability(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = val
+	return nil
+}
+
+func (i Capability) IsViewLpas() bool {
+	return i == CapabilityViewLpas
+}
+
+func (i Capability) IsCreateLpa() bool {
+	return i == CapabilityCreateLpa
+}
+
+func (i Capability) IsEditAnyLpa() bool {
+	return i == CapabilityEditAnyLpa
+}
+
+func (i Capability) IsEditAssignedLpa() bool {
+	return i == CapabilityEditAssignedLpa
+}
+
+func (i Capability) IsManageDonorAccess() bool {
+	return i == CapabilityManageDonorAccess
+}
+
+func (i Capability) IsManageMembers() bool {
+	return i == CapabilityManageMembers
+}
+
+func (i Capability) IsManageOrganisation() bool {
+	return i == CapabilityManageOrganisation
+}
+
+func (i Capability) IsAssignLpas() bool {
+	return i == CapabilityAssignLpas
+}
+
+func (i Capability) IsViewAuditLog() bool {
+	return i == CapabilityViewAuditLog
+}
+
+func ParseCapability(s string) (Capability, error) {
+	switch s {
+	case "view-lpas":
+		return CapabilityViewLpas, nil
+	case "create-lpa":
+		return CapabilityCreateLpa, nil
+	case "edit-any-lpa":
+		return CapabilityEditAnyLpa, nil
+	case "edit-assigned-lpa":
+		return CapabilityEditAssignedLpa, nil
+	case "manage-donor-access":
+		return CapabilityManageDonorAccess, nil
+	case "manage-members":
+		return CapabilityManageMembers, nil
+	case "manage-organisation":
+		return CapabilityManageOrganisation, nil
+	case "assign-lpas":
+		return CapabilityAssignLpas, nil
+	case "view-audit-log":
+		return CapabilityViewAuditLog, nil
+	default:
+		return Capability(0), fmt.Errorf("invalid Capability '%s'", s)
+	}
+}
+
+type CapabilityOptions struct {
+	ViewLpas           Capability
+	CreateLpa          Capability
+	EditAnyLpa         Capability
+	EditAssignedLpa    Capability
+	ManageDonorAccess  Capability
+	ManageMembers      Capability
+	ManageOrganisation Capability
+	AssignLpas         Capability
+	ViewAuditLog       Capability
+}
+
+var CapabilityValues = CapabilityOptions{
+	ViewLpas:           CapabilityViewLpas,
+	CreateLpa:          CapabilityCreateLpa,
+	EditAnyLpa:         CapabilityEditAnyLpa,
+	EditAssignedLpa:    CapabilityEditAssignedLpa,
+	ManageDonorAccess:  CapabilityManageDonorAccess,
+	ManageMembers:      CapabilityManageMembers,
+	ManageOrganisation: CapabilityManageOrganisation,
+	AssignLpas:         CapabilityAssignLpas,
+	ViewAuditLog:       CapabilityViewAuditLog,
+}
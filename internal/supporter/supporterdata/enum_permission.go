@@ -13,11 +13,14 @@ func _() {
 	var x [1]struct{}
 	_ = x[PermissionNone-0]
 	_ = x[PermissionAdmin-1]
+	_ = x[PermissionAuditor-2]
+	_ = x[PermissionCaseHandler-3]
+	_ = x[PermissionTeamManager-4]
 }
 
-const _Permission_name = "noneadmin"
+const _Permission_name = "noneadminauditorcase-handlerteam-manager"
 
-var _Permission_index = [...]uint8{0, 4, 9}
+var _Permission_index = [...]uint8{0, 4, 9, 16, 28, 40}
 
 func (i Permission) String() string {
 	if i >= Permission(len(_Permission_index)-1) {
@@ -48,23 +51,47 @@ func (i Permission) IsAdmin() bool {
 	return i == PermissionAdmin
 }
 
+func (i Permission) IsAuditor() bool {
+	return i == PermissionAuditor
+}
+
+func (i Permission) IsCaseHandler() bool {
+	return i == PermissionCaseHandler
+}
+
+func (i Permission) IsTeamManager() bool {
+	return i == PermissionTeamManager
+}
+
 func ParsePermission(s string) (Permission, error) {
 	switch s {
 	case "none":
 		return PermissionNone, nil
 	case "admin":
 		return PermissionAdmin, nil
+	case "auditor":
+		return PermissionAuditor, nil
+	case "case-handler":
+		return PermissionCaseHandler, nil
+	case "team-manager":
+		return PermissionTeamManager, nil
 	default:
 		return Permission(0), fmt.Errorf("invalid Permission '%s'", s)
 	}
 }
 
 type PermissionOptions struct {
-	None  Permission
-	Admin Permission
+	None        Permission
+	Admin       Permission
+	Auditor     Permission
+	CaseHandler Permission
+	TeamManager Permission
 }
 
 var PermissionValues = PermissionOptions{
-	None:  PermissionNone,
-	Admin: PermissionAdmin,
+	None:        PermissionNone,
+	Admin:       PermissionAdmin,
+	Auditor:     PermissionAuditor,
+	CaseHandler: PermissionCaseHandler,
+	TeamManager: PermissionTeamManager,
 }
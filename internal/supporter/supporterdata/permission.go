@@ -4,6 +4,64 @@ package supporterdata
 type Permission uint8
 
 const (
-	PermissionNone  Permission = iota // none
-	PermissionAdmin                   // admin
+	PermissionNone        Permission = iota // none
+	PermissionAdmin                         // admin
+	PermissionAuditor                       // auditor
+	PermissionCaseHandler                   // case-handler
+	PermissionTeamManager                   // team-manager
 )
+
+// capabilities lists what each Permission allows a member to do. PermissionNone
+// is the default for members, so keeps the ability to create and edit any LPA
+// in the organisation.
+var capabilities = map[Permission][]Capability{
+	PermissionNone: {
+		CapabilityViewLpas,
+		CapabilityCreateLpa,
+		CapabilityEditAnyLpa,
+		CapabilityManageDonorAccess,
+	},
+	PermissionAdmin: {
+		CapabilityViewLpas,
+		CapabilityCreateLpa,
+		CapabilityEditAnyLpa,
+		CapabilityManageDonorAccess,
+		CapabilityManageMembers,
+		CapabilityManageOrganisation,
+		CapabilityAssignLpas,
+		CapabilityViewAuditLog,
+	},
+	PermissionAuditor: {
+		CapabilityViewLpas,
+	},
+	PermissionCaseHandler: {
+		CapabilityViewLpas,
+		CapabilityCreateLpa,
+		CapabilityEditAssignedLpa,
+		CapabilityManageDonorAccess,
+	},
+	PermissionTeamManager: {
+		CapabilityViewLpas,
+		CapabilityCreateLpa,
+		CapabilityEditAnyLpa,
+		CapabilityManageDonorAccess,
+		CapabilityManageMembers,
+	},
+}
+
+// Can returns true if the Permission grants the capability.
+func (i Permission) Can(capability Capability) bool {
+	for _, c := range capabilities[i] {
+		if c == capability {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CanEditLpa returns true if the Permission allows editing an LPA, where
+// isAssigned is whether the LPA has been assigned to the member.
+func (i Permission) CanEditLpa(isAssigned bool) bool {
+	return i.Can(CapabilityEditAnyLpa) || (isAssigned && i.Can(CapabilityEditAssignedLpa))
+}
@@ -0,0 +1,77 @@
+package supporterdata
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionCan(t *testing.T) {
+	testcases := map[Permission][]Capability{
+		PermissionNone:        {CapabilityViewLpas, CapabilityCreateLpa, CapabilityEditAnyLpa, CapabilityManageDonorAccess},
+		PermissionAdmin:       {CapabilityViewLpas, CapabilityCreateLpa, CapabilityEditAnyLpa, CapabilityManageDonorAccess, CapabilityManageMembers, CapabilityManageOrganisation, CapabilityAssignLpas, CapabilityViewAuditLog},
+		PermissionAuditor:     {CapabilityViewLpas},
+		PermissionCaseHandler: {CapabilityViewLpas, CapabilityCreateLpa, CapabilityEditAssignedLpa, CapabilityManageDonorAccess},
+		PermissionTeamManager: {CapabilityViewLpas, CapabilityCreateLpa, CapabilityEditAnyLpa, CapabilityManageDonorAccess, CapabilityManageMembers},
+	}
+
+	allCapabilities := []Capability{
+		CapabilityViewLpas,
+		CapabilityCreateLpa,
+		CapabilityEditAnyLpa,
+		CapabilityEditAssignedLpa,
+		CapabilityManageDonorAccess,
+		CapabilityManageMembers,
+		CapabilityManageOrganisation,
+		CapabilityAssignLpas,
+		CapabilityViewAuditLog,
+	}
+
+	for permission, expected := range testcases {
+		t.Run(permission.String(), func(t *testing.T) {
+			for _, capability := range allCapabilities {
+				assert.Equal(t, slices.Contains(expected, capability), permission.Can(capability), capability.String())
+			}
+		})
+	}
+}
+
+func TestPermissionCanWhenUnknown(t *testing.T) {
+	assert.False(t, Permission(99).Can(CapabilityViewLpas))
+}
+
+func TestPermissionCanEditLpa(t *testing.T) {
+	testcases := map[string]struct {
+		permission Permission
+		isAssigned bool
+		expected   bool
+	}{
+		"admin": {
+			permission: PermissionAdmin,
+			expected:   true,
+		},
+		"member": {
+			permission: PermissionNone,
+			expected:   true,
+		},
+		"case handler assigned": {
+			permission: PermissionCaseHandler,
+			isAssigned: true,
+			expected:   true,
+		},
+		"case handler not assigned": {
+			permission: PermissionCaseHandler,
+		},
+		"auditor assigned": {
+			permission: PermissionAuditor,
+			isAssigned: true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.permission.CanEditLpa(tc.isAssigned))
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package supporterpage
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/accesscode/accesscodedata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor/actoruid"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+)
+
+type accessCodesData struct {
+	App       appcontext.Data
+	Errors    validation.List
+	Lpa       *lpadata.Lpa
+	Summaries []accesscodedata.Summary
+}
+
+func AccessCodes(tmpl template.Template, lpaStoreResolvingService LpaStoreResolvingService, accessCodeStore AccessCodeStore, accessCodeSender AccessCodeSender) Handler {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, _ *supporterdata.Organisation, _ *supporterdata.Member) error {
+		// Getting the LPA first ensures it belongs to the organisation.
+		lpa, err := lpaStoreResolvingService.Get(r.Context())
+		if err != nil {
+			return err
+		}
+
+		summaries, err := accessCodeStore.Summaries(r.Context(), lpa)
+		if err != nil {
+			return err
+		}
+
+		if r.Method == http.MethodPost {
+			if !appData.Can(supporterdata.CapabilityManageDonorAccess) {
+				return ErrPermissionDenied
+			}
+
+			actorUID, err := actoruid.Parse(r.FormValue("actor-uid"))
+			if err != nil {
+				return err
+			}
+
+			var resend *accesscodedata.Summary
+			for _, summary := range summaries {
+				if summary.ActorUID == actorUID && summary.CanResend() {
+					resend = &summary
+					break
+				}
+			}
+
+			if resend == nil {
+				return errors.New("cannot resend access code to actor")
+			}
+
+			if err := accessCodeSender.ResendAccessCode(r.Context(), appData, lpa, actorUID); err != nil {
+				return fmt.Errorf("error resending access code: %w", err)
+			}
+
+			return supporter.PathAccessCodes.RedirectQuery(w, r, appData, appData.LpaID, url.Values{
+				"resent": {resend.FullName},
+			})
+		}
+
+		return tmpl(w, &accessCodesData{
+			App:       appData,
+			Lpa:       lpa,
+			Summaries: summaries,
+		})
+	}
+}
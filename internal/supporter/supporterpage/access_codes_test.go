@@ -0,0 +1,192 @@
+package supporterpage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/accesscode/accesscodedata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor/actoruid"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetAccessCodes(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpa := &lpadata.Lpa{LpaUID: "lpa-uid"}
+	summaries := []accesscodedata.Summary{{ActorUID: actoruid.New(), Status: accesscodedata.StatusUsed}}
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(r.Context()).
+		Return(lpa, nil)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Summaries(r.Context(), lpa).
+		Return(summaries, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &accessCodesData{
+			App:       testAppData,
+			Lpa:       lpa,
+			Summaries: summaries,
+		}).
+		Return(nil)
+
+	err := AccessCodes(template.Execute, lpaStoreResolvingService, accessCodeStore, nil)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+
+	assert.Nil(t, err)
+}
+
+func TestGetAccessCodesWhenLpaStoreResolvingServiceErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(nil, expectedError)
+
+	err := AccessCodes(nil, lpaStoreResolvingService, nil, nil)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+	assert.Equal(t, expectedError, err)
+}
+
+func TestGetAccessCodesWhenAccessCodeStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(&lpadata.Lpa{}, nil)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Summaries(mock.Anything, mock.Anything).
+		Return(nil, expectedError)
+
+	err := AccessCodes(nil, lpaStoreResolvingService, accessCodeStore, nil)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+	assert.Equal(t, expectedError, err)
+}
+
+func TestPostAccessCodes(t *testing.T) {
+	actorUID := actoruid.New()
+	form := url.Values{"actor-uid": {actorUID.String()}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	lpa := &lpadata.Lpa{LpaUID: "lpa-uid"}
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(r.Context()).
+		Return(lpa, nil)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Summaries(r.Context(), lpa).
+		Return([]accesscodedata.Summary{
+			{ActorUID: actorUID, ActorType: actor.TypeCertificateProvider, FullName: "Charlie Cooper", Status: accesscodedata.StatusSent},
+		}, nil)
+
+	accessCodeSender := newMockAccessCodeSender(t)
+	accessCodeSender.EXPECT().
+		ResendAccessCode(r.Context(), testLpaAppData, lpa, actorUID).
+		Return(nil)
+
+	err := AccessCodes(nil, lpaStoreResolvingService, accessCodeStore, accessCodeSender)(testLpaAppData, w, r, &supporterdata.Organisation{}, nil)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, supporter.PathAccessCodes.Format("lpa-id")+"?resent=Charlie+Cooper", resp.Header.Get("Location"))
+}
+
+func TestPostAccessCodesWhenCannotResend(t *testing.T) {
+	actorUID := actoruid.New()
+	form := url.Values{"actor-uid": {actorUID.String()}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(&lpadata.Lpa{}, nil)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Summaries(mock.Anything, mock.Anything).
+		Return([]accesscodedata.Summary{{ActorUID: actorUID, ActorType: actor.TypeAttorney, Status: accesscodedata.StatusUsed}}, nil)
+
+	err := AccessCodes(nil, lpaStoreResolvingService, accessCodeStore, nil)(testLpaAppData, w, r, &supporterdata.Organisation{}, nil)
+	assert.Error(t, err)
+}
+
+func TestPostAccessCodesWhenAccessCodeSenderErrors(t *testing.T) {
+	actorUID := actoruid.New()
+	form := url.Values{"actor-uid": {actorUID.String()}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(&lpadata.Lpa{}, nil)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Summaries(mock.Anything, mock.Anything).
+		Return([]accesscodedata.Summary{{ActorUID: actorUID, ActorType: actor.TypeAttorney, Status: accesscodedata.StatusSent}}, nil)
+
+	accessCodeSender := newMockAccessCodeSender(t)
+	accessCodeSender.EXPECT().
+		ResendAccessCode(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	err := AccessCodes(nil, lpaStoreResolvingService, accessCodeStore, accessCodeSender)(testLpaAppData, w, r, &supporterdata.Organisation{}, nil)
+	assert.ErrorIs(t, err, expectedError)
+}
+
+func TestPostAccessCodesWhenCannotManageDonorAccess(t *testing.T) {
+	form := url.Values{"actor-uid": {actoruid.New().String()}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(&lpadata.Lpa{}, nil)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		Summaries(mock.Anything, mock.Anything).
+		Return([]accesscodedata.Summary{}, nil)
+
+	appData := appcontext.Data{
+		LpaID:         "lpa-id",
+		SupporterData: &appcontext.SupporterData{Permission: supporterdata.PermissionAuditor},
+	}
+
+	err := AccessCodes(nil, lpaStoreResolvingService, accessCodeStore, nil)(appData, w, r, &supporterdata.Organisation{}, nil)
+	assert.Equal(t, ErrPermissionDenied, err)
+}
@@ -0,0 +1,131 @@
+package supporterpage
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+)
+
+const assignedToUnassigned = "unassigned"
+
+type assignLPAData struct {
+	App     appcontext.Data
+	Errors  validation.List
+	Form    *assignLPAForm
+	Donor   *donordata.Provided
+	Members []*supporterdata.Member
+}
+
+func AssignLPA(tmpl template.Template, donorStore DonorStore, memberStore MemberStore, auditLogStore AuditLogStore) Handler {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, _ *supporterdata.Organisation, _ *supporterdata.Member) error {
+		provided, err := donorStore.Get(r.Context())
+		if err != nil {
+			return err
+		}
+
+		members, err := memberStore.GetAll(r.Context())
+		if err != nil {
+			return err
+		}
+
+		// Suspended members can't pick up new work, but are kept in the list when
+		// they are the current assignee so the form reflects the LPA.
+		var assignable []*supporterdata.Member
+		for _, member := range members {
+			if !member.Status.IsSuspended() || provided.IsAssignedTo(member.ID) {
+				assignable = append(assignable, member)
+			}
+		}
+
+		data := &assignLPAData{
+			App:     appData,
+			Form:    &assignLPAForm{AssignedTo: assignedToUnassigned},
+			Donor:   provided,
+			Members: assignable,
+		}
+
+		if provided.AssignedMemberID != "" {
+			data.Form.AssignedTo = provided.AssignedMemberID
+		}
+
+		if r.Method == http.MethodPost {
+			data.Form = readAssignLPAForm(r)
+			data.Errors = data.Form.Validate(assignable)
+
+			if data.Errors.None() {
+				assignedMemberID := data.Form.AssignedTo
+				if assignedMemberID == assignedToUnassigned {
+					assignedMemberID = ""
+				}
+
+				if assignedMemberID != provided.AssignedMemberID {
+					event := supporterdata.AuditEvent{
+						Action:   supporterdata.AuditActionLpaAssigned,
+						LpaID:    provided.LpaID,
+						Previous: memberEmail(members, provided.AssignedMemberID),
+						Current:  memberEmail(members, assignedMemberID),
+					}
+
+					provided.AssignedMemberID = assignedMemberID
+
+					if err := donorStore.Put(r.Context(), provided); err != nil {
+						return err
+					}
+
+					if err := auditLogStore.Create(r.Context(), event); err != nil {
+						return err
+					}
+				}
+
+				return supporter.PathViewLPA.RedirectQuery(w, r, appData, appData.LpaID, url.Values{
+					"assignmentUpdated": {"1"},
+				})
+			}
+		}
+
+		return tmpl(w, data)
+	}
+}
+
+// memberEmail identifies a member in the audit log, an LPA that is not assigned
+// is recorded as empty.
+func memberEmail(members []*supporterdata.Member, memberID string) string {
+	for _, member := range members {
+		if member.ID == memberID {
+			return member.Email
+		}
+	}
+
+	return ""
+}
+
+type assignLPAForm struct {
+	AssignedTo string
+}
+
+func readAssignLPAForm(r *http.Request) *assignLPAForm {
+	return &assignLPAForm{
+		AssignedTo: page.PostFormString(r, "assigned-to"),
+	}
+}
+
+func (f *assignLPAForm) Validate(members []*supporterdata.Member) validation.List {
+	var errors validation.List
+
+	options := []string{assignedToUnassigned}
+	for _, member := range members {
+		options = append(options, member.ID)
+	}
+
+	errors.String("assigned-to", "whoToAssignThisLpaTo", f.AssignedTo,
+		validation.Select(options...))
+
+	return errors
+}
@@ -0,0 +1,369 @@
+package supporterpage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	testActiveMember    = &supporterdata.Member{ID: "a", Email: "a@example.com", Status: supporterdata.StatusActive}
+	testSuspendedMember = &supporterdata.Member{ID: "b", Status: supporterdata.StatusSuspended}
+)
+
+func TestGetAssignLPA(t *testing.T) {
+	testcases := map[string]struct {
+		provided *donordata.Provided
+		form     *assignLPAForm
+		members  []*supporterdata.Member
+	}{
+		"unassigned": {
+			provided: &donordata.Provided{},
+			form:     &assignLPAForm{AssignedTo: "unassigned"},
+			members:  []*supporterdata.Member{testActiveMember},
+		},
+		"assigned": {
+			provided: &donordata.Provided{AssignedMemberID: "a"},
+			form:     &assignLPAForm{AssignedTo: "a"},
+			members:  []*supporterdata.Member{testActiveMember},
+		},
+		"assigned to suspended member": {
+			provided: &donordata.Provided{AssignedMemberID: "b"},
+			form:     &assignLPAForm{AssignedTo: "b"},
+			members:  []*supporterdata.Member{testActiveMember, testSuspendedMember},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+			donorStore := newMockDonorStore(t)
+			donorStore.EXPECT().
+				Get(r.Context()).
+				Return(tc.provided, nil)
+
+			memberStore := newMockMemberStore(t)
+			memberStore.EXPECT().
+				GetAll(r.Context()).
+				Return([]*supporterdata.Member{testActiveMember, testSuspendedMember}, nil)
+
+			template := newMockTemplate(t)
+			template.EXPECT().
+				Execute(w, &assignLPAData{
+					App:     testLpaAppData,
+					Form:    tc.form,
+					Donor:   tc.provided,
+					Members: tc.members,
+				}).
+				Return(nil)
+
+			err := AssignLPA(template.Execute, donorStore, memberStore, nil)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestGetAssignLPAWhenDonorStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(mock.Anything).
+		Return(nil, expectedError)
+
+	err := AssignLPA(nil, donorStore, nil, nil)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestGetAssignLPAWhenMemberStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(mock.Anything).
+		Return(&donordata.Provided{}, nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(mock.Anything).
+		Return(nil, expectedError)
+
+	err := AssignLPA(nil, donorStore, memberStore, nil)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestGetAssignLPAWhenTemplateErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(mock.Anything).
+		Return(&donordata.Provided{}, nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(mock.Anything).
+		Return(nil, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, mock.Anything).
+		Return(expectedError)
+
+	err := AssignLPA(template.Execute, donorStore, memberStore, nil)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestPostAssignLPA(t *testing.T) {
+	testcases := map[string]struct {
+		assignedTo string
+		from       string
+		to         string
+		event      supporterdata.AuditEvent
+	}{
+		"assign": {
+			assignedTo: "a",
+			to:         "a",
+			event:      supporterdata.AuditEvent{Action: supporterdata.AuditActionLpaAssigned, LpaID: "lpa-id", Current: "a@example.com"},
+		},
+		"reassign": {
+			assignedTo: "a",
+			from:       "c",
+			to:         "a",
+			event:      supporterdata.AuditEvent{Action: supporterdata.AuditActionLpaAssigned, LpaID: "lpa-id", Previous: "c@example.com", Current: "a@example.com"},
+		},
+		"unassign": {
+			assignedTo: "unassigned",
+			from:       "a",
+			event:      supporterdata.AuditEvent{Action: supporterdata.AuditActionLpaAssigned, LpaID: "lpa-id", Previous: "a@example.com"},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			form := url.Values{"assigned-to": {tc.assignedTo}}
+
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+			r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+			donorStore := newMockDonorStore(t)
+			donorStore.EXPECT().
+				Get(r.Context()).
+				Return(&donordata.Provided{LpaID: "lpa-id", AssignedMemberID: tc.from}, nil)
+			donorStore.EXPECT().
+				Put(r.Context(), &donordata.Provided{LpaID: "lpa-id", AssignedMemberID: tc.to}).
+				Return(nil)
+
+			memberStore := newMockMemberStore(t)
+			memberStore.EXPECT().
+				GetAll(r.Context()).
+				Return([]*supporterdata.Member{testActiveMember, {ID: "c", Email: "c@example.com"}}, nil)
+
+			auditLogStore := newMockAuditLogStore(t)
+			auditLogStore.EXPECT().
+				Create(r.Context(), tc.event).
+				Return(nil)
+
+			err := AssignLPA(nil, donorStore, memberStore, auditLogStore)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+			resp := w.Result()
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusFound, resp.StatusCode)
+			assert.Equal(t, supporter.PathViewLPA.Format("lpa-id")+"?assignmentUpdated=1", resp.Header.Get("Location"))
+		})
+	}
+}
+
+func TestPostAssignLPAWhenOlderHashVersion(t *testing.T) {
+	form := url.Values{"assigned-to": {"a"}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	provided := &donordata.Provided{LpaID: "lpa-id", HashVersion: 0, Hash: 0x7fbebbae00d80403}
+	assert.False(t, provided.HashChanged())
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(r.Context()).
+		Return(provided, nil)
+	donorStore.EXPECT().
+		Put(r.Context(), mock.MatchedBy(func(donor *donordata.Provided) bool {
+			return donor.AssignedMemberID == "a" && donor.HashChanged()
+		})).
+		Return(nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return([]*supporterdata.Member{testActiveMember}, nil)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		Create(r.Context(), mock.Anything).
+		Return(nil)
+
+	err := AssignLPA(nil, donorStore, memberStore, auditLogStore)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+
+	assert.Nil(t, err)
+}
+
+func TestPostAssignLPAWhenUnchanged(t *testing.T) {
+	form := url.Values{"assigned-to": {"a"}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(r.Context()).
+		Return(&donordata.Provided{AssignedMemberID: "a"}, nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return([]*supporterdata.Member{testActiveMember}, nil)
+
+	err := AssignLPA(nil, donorStore, memberStore, nil)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, supporter.PathViewLPA.Format("lpa-id")+"?assignmentUpdated=1", resp.Header.Get("Location"))
+}
+
+func TestPostAssignLPAWhenDonorStoreErrors(t *testing.T) {
+	form := url.Values{"assigned-to": {"a"}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(r.Context()).
+		Return(&donordata.Provided{}, nil)
+	donorStore.EXPECT().
+		Put(r.Context(), mock.Anything).
+		Return(expectedError)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return([]*supporterdata.Member{testActiveMember}, nil)
+
+	err := AssignLPA(nil, donorStore, memberStore, nil)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestPostAssignLPAWhenAuditLogStoreErrors(t *testing.T) {
+	form := url.Values{"assigned-to": {"a"}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(r.Context()).
+		Return(&donordata.Provided{}, nil)
+	donorStore.EXPECT().
+		Put(r.Context(), mock.Anything).
+		Return(nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return([]*supporterdata.Member{testActiveMember}, nil)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		Create(r.Context(), mock.Anything).
+		Return(expectedError)
+
+	err := AssignLPA(nil, donorStore, memberStore, auditLogStore)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestPostAssignLPAWhenValidationErrors(t *testing.T) {
+	form := url.Values{"assigned-to": {"b"}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(r.Context()).
+		Return(&donordata.Provided{}, nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return([]*supporterdata.Member{testActiveMember, testSuspendedMember}, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, mock.MatchedBy(func(data *assignLPAData) bool {
+			return assert.Equal(t, validation.With("assigned-to", validation.SelectError{Label: "whoToAssignThisLpaTo"}), data.Errors)
+		})).
+		Return(nil)
+
+	err := AssignLPA(template.Execute, donorStore, memberStore, nil)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+
+	assert.Nil(t, err)
+}
+
+func TestAssignLPAFormValidate(t *testing.T) {
+	members := []*supporterdata.Member{testActiveMember}
+
+	testcases := map[string]struct {
+		form   *assignLPAForm
+		errors validation.List
+	}{
+		"member": {
+			form: &assignLPAForm{AssignedTo: "a"},
+		},
+		"unassigned": {
+			form: &assignLPAForm{AssignedTo: "unassigned"},
+		},
+		"missing": {
+			form:   &assignLPAForm{},
+			errors: validation.With("assigned-to", validation.SelectError{Label: "whoToAssignThisLpaTo"}),
+		},
+		"unknown member": {
+			form:   &assignLPAForm{AssignedTo: "z"},
+			errors: validation.With("assigned-to", validation.SelectError{Label: "whoToAssignThisLpaTo"}),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.errors, tc.form.Validate(members))
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package supporterpage
+
+import (
+	"encoding/csv"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+)
+
+var auditActions = []supporterdata.AuditAction{
+	supporterdata.AuditActionOrganisationCreated,
+	supporterdata.AuditActionOrganisationRenamed,
+	supporterdata.AuditActionOrganisationDeleted,
+	supporterdata.AuditActionMemberInvited,
+	supporterdata.AuditActionMemberInviteRecalled,
+	supporterdata.AuditActionMemberJoined,
+	supporterdata.AuditActionMemberPermissionChanged,
+	supporterdata.AuditActionMemberStatusChanged,
+	supporterdata.AuditActionLpaCreated,
+	supporterdata.AuditActionLpaAssigned,
+	supporterdata.AuditActionDonorAccessInvited,
+	supporterdata.AuditActionDonorAccessRecalled,
+	supporterdata.AuditActionDonorAccessRemoved,
+}
+
+type auditLogData struct {
+	App     appcontext.Data
+	Errors  validation.List
+	Events  []supporterdata.AuditEvent
+	Action  supporterdata.AuditAction
+	Actions []supporterdata.AuditAction
+	// After is the key to show the next page of events from, it is empty when
+	// there are no more events.
+	After string
+}
+
+// NextPageQuery returns the query string to link to the next page of events,
+// keeping the action filter.
+func (d *auditLogData) NextPageQuery() string {
+	query := url.Values{"after": {d.After}}
+	if !d.Action.Empty() {
+		query.Set("action", d.Action.String())
+	}
+
+	return "?" + query.Encode()
+}
+
+func AuditLog(tmpl template.Template, auditLogStore AuditLogStore) Handler {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, _ *supporterdata.Organisation, _ *supporterdata.Member) error {
+		action, _ := supporterdata.ParseAuditAction(r.FormValue("action"))
+
+		events, after, err := auditLogStore.GetPage(r.Context(), action, r.FormValue("after"))
+		if err != nil {
+			return err
+		}
+
+		return tmpl(w, &auditLogData{
+			App:     appData,
+			Events:  events,
+			Action:  action,
+			Actions: auditActions,
+			After:   after,
+		})
+	}
+}
+
+// AuditLogExport writes every event, for the action if given, reading them a
+// page at a time.
+func AuditLogExport(auditLogStore AuditLogStore) Handler {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, _ *supporterdata.Organisation, _ *supporterdata.Member) error {
+		action, _ := supporterdata.ParseAuditAction(r.FormValue("action"))
+
+		events, after, err := auditLogStore.GetPage(r.Context(), action, "")
+		if err != nil {
+			return err
+		}
+
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="audit-log.csv"`)
+
+		cw := csv.NewWriter(w)
+		_ = cw.Write([]string{"Date", "Member", "Action", "Subject", "LPA ID", "Previous", "Current"})
+
+		for {
+			for _, event := range events {
+				_ = cw.Write([]string{
+					event.CreatedAt.Format(time.RFC3339),
+					csvCell(event.ActorEmail),
+					event.Action.String(),
+					csvCell(event.Subject),
+					csvCell(event.LpaID),
+					csvCell(event.Previous),
+					csvCell(event.Current),
+				})
+			}
+
+			if after == "" {
+				break
+			}
+
+			cw.Flush()
+			events, after, err = auditLogStore.GetPage(r.Context(), action, after)
+			if err != nil {
+				return err
+			}
+		}
+
+		cw.Flush()
+		return cw.Error()
+	}
+}
+
+// csvCell stops a value being run as a formula when the export is opened in
+// spreadsheet software.
+func csvCell(s string) string {
+	if s != "" && strings.ContainsAny(s[:1], "=+-@\t\r") {
+		return "'" + s
+	}
+
+	return s
+}
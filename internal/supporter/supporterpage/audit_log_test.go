@@ -0,0 +1,205 @@
+package supporterpage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testAuditEvents = []supporterdata.AuditEvent{{
+	CreatedAt:  time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC),
+	Action:     supporterdata.AuditActionMemberPermissionChanged,
+	ActorEmail: "admin@example.com",
+	Subject:    "member@example.com",
+	Previous:   "none",
+	Current:    "admin",
+}, {
+	CreatedAt:  time.Date(2020, time.January, 1, 3, 4, 5, 0, time.UTC),
+	Action:     supporterdata.AuditActionLpaCreated,
+	ActorEmail: "member@example.com",
+	LpaID:      "lpa-id",
+}}
+
+func TestGetAuditLog(t *testing.T) {
+	testcases := map[string]struct {
+		query  string
+		action supporterdata.AuditAction
+		after  string
+	}{
+		"all": {},
+		"filtered": {
+			query:  "?action=lpa-created",
+			action: supporterdata.AuditActionLpaCreated,
+		},
+		"unknown action": {
+			query: "?action=what",
+		},
+		"next page": {
+			query:  "?action=lpa-created&after=AUDITLOG%23a",
+			action: supporterdata.AuditActionLpaCreated,
+			after:  "AUDITLOG#a",
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(http.MethodGet, "/"+tc.query, nil)
+
+			auditLogStore := newMockAuditLogStore(t)
+			auditLogStore.EXPECT().
+				GetPage(r.Context(), tc.action, tc.after).
+				Return(testAuditEvents, "AUDITLOG#b", nil)
+
+			template := newMockTemplate(t)
+			template.EXPECT().
+				Execute(w, &auditLogData{
+					App:     testAppData,
+					Events:  testAuditEvents,
+					Action:  tc.action,
+					Actions: auditActions,
+					After:   "AUDITLOG#b",
+				}).
+				Return(nil)
+
+			err := AuditLog(template.Execute, auditLogStore)(testAppData, w, r, nil, nil)
+			resp := w.Result()
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		})
+	}
+}
+
+func TestGetAuditLogWhenAuditLogStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		GetPage(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, "", expectedError)
+
+	err := AuditLog(nil, auditLogStore)(testAppData, w, r, nil, nil)
+	assert.Equal(t, expectedError, err)
+}
+
+func TestGetAuditLogWhenTemplateErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		GetPage(mock.Anything, mock.Anything, mock.Anything).
+		Return(testAuditEvents, "", nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	err := AuditLog(template.Execute, auditLogStore)(testAppData, w, r, nil, nil)
+	assert.Equal(t, expectedError, err)
+}
+
+func TestAuditLogDataNextPageQuery(t *testing.T) {
+	assert.Equal(t, "?after=AUDITLOG%23a", (&auditLogData{After: "AUDITLOG#a"}).NextPageQuery())
+	assert.Equal(t, "?action=lpa-created&after=AUDITLOG%23a", (&auditLogData{After: "AUDITLOG#a", Action: supporterdata.AuditActionLpaCreated}).NextPageQuery())
+}
+
+func TestGetAuditLogExport(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		GetPage(r.Context(), supporterdata.AuditAction(0), "").
+		Return(testAuditEvents[:1], "AUDITLOG#a", nil)
+	auditLogStore.EXPECT().
+		GetPage(r.Context(), supporterdata.AuditAction(0), "AUDITLOG#a").
+		Return(testAuditEvents[1:], "", nil)
+
+	err := AuditLogExport(auditLogStore)(testAppData, w, r, nil, nil)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="audit-log.csv"`, resp.Header.Get("Content-Disposition"))
+	assert.Equal(t, "Date,Member,Action,Subject,LPA ID,Previous,Current\n"+
+		"2020-01-02T03:04:05Z,admin@example.com,member-permission-changed,member@example.com,,none,admin\n"+
+		"2020-01-01T03:04:05Z,member@example.com,lpa-created,,lpa-id,,\n", w.Body.String())
+}
+
+func TestGetAuditLogExportWhenFiltered(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/?action=lpa-created", nil)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		GetPage(r.Context(), supporterdata.AuditActionLpaCreated, "").
+		Return(testAuditEvents[1:], "", nil)
+
+	err := AuditLogExport(auditLogStore)(testAppData, w, r, nil, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Date,Member,Action,Subject,LPA ID,Previous,Current\n"+
+		"2020-01-01T03:04:05Z,member@example.com,lpa-created,,lpa-id,,\n", w.Body.String())
+}
+
+func TestGetAuditLogExportEscapesFormulas(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		GetPage(r.Context(), supporterdata.AuditAction(0), "").
+		Return([]supporterdata.AuditEvent{{
+			CreatedAt:  time.Date(2020, time.January, 2, 3, 4, 5, 0, time.UTC),
+			Action:     supporterdata.AuditActionOrganisationRenamed,
+			ActorEmail: "@admin@example.com",
+			Subject:    "-1",
+			Previous:   "=HYPERLINK(\"http://example.com\")",
+			Current:    "+1",
+		}}, "", nil)
+
+	err := AuditLogExport(auditLogStore)(testAppData, w, r, nil, nil)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Date,Member,Action,Subject,LPA ID,Previous,Current\n"+
+		"2020-01-02T03:04:05Z,'@admin@example.com,organisation-renamed,'-1,,\"'=HYPERLINK(\"\"http://example.com\"\")\",'+1\n", w.Body.String())
+}
+
+func TestGetAuditLogExportWhenAuditLogStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		GetPage(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, "", expectedError)
+
+	err := AuditLogExport(auditLogStore)(testAppData, w, r, nil, nil)
+	assert.Equal(t, expectedError, err)
+}
+
+func TestGetAuditLogExportWhenAuditLogStoreErrorsOnNextPage(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		GetPage(mock.Anything, mock.Anything, "").
+		Return(testAuditEvents, "AUDITLOG#a", nil)
+	auditLogStore.EXPECT().
+		GetPage(mock.Anything, mock.Anything, "AUDITLOG#a").
+		Return(nil, "", expectedError)
+
+	err := AuditLogExport(auditLogStore)(testAppData, w, r, nil, nil)
+	assert.Equal(t, expectedError, err)
+}
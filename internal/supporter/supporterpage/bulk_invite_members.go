@@ -0,0 +1,288 @@
+package supporterpage
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/invitecode"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+)
+
+const (
+	maxBulkInviteFileSize = 1 << 20 // 1Mb
+	maxBulkInviteRows     = 100
+)
+
+var bulkInviteHeader = []string{"first names", "last name", "email", "permission"}
+
+type bulkInviteMembersData struct {
+	App     appcontext.Data
+	Errors  validation.List
+	Rows    []*bulkInviteRow
+	MaxRows int
+	// Sent is set when invites have been sent, but not all of them could be.
+	Sent bool
+}
+
+// ValidRows returns the rows that will be invited when the upload is
+// confirmed.
+func (d *bulkInviteMembersData) ValidRows() []*bulkInviteRow {
+	var rows []*bulkInviteRow
+	for _, row := range d.Rows {
+		if row.Errors.None() {
+			rows = append(rows, row)
+		}
+	}
+
+	return rows
+}
+
+// SentCount returns the number of rows that have been sent an invite.
+func (d *bulkInviteMembersData) SentCount() int {
+	count := 0
+	for _, row := range d.Rows {
+		if row.Sent {
+			count++
+		}
+	}
+
+	return count
+}
+
+type bulkInviteRow struct {
+	// Permission is the value as given, so that it can be shown when it is not
+	// valid.
+	Permission string
+	Form       *inviteMemberForm
+	Errors     validation.List
+	Sent       bool
+}
+
+func BulkInviteMembers(logger Logger, tmpl template.Template, memberStore MemberStore, notifyClient NotifyClient, generate invitecode.Generator, appPublicURL string) Handler {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, organisation *supporterdata.Organisation, _ *supporterdata.Member) error {
+		data := &bulkInviteMembersData{
+			App:     appData,
+			MaxRows: maxBulkInviteRows,
+		}
+
+		if r.Method == http.MethodPost {
+			// The file is uploaded as multipart/form-data, the preview is then
+			// confirmed as a normal form post.
+			mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			confirming := mediaType != "multipart/form-data"
+
+			if confirming {
+				data.Rows = readBulkInviteRows(r, appData.IsAdmin())
+			} else {
+				data.Rows, data.Errors = readBulkInviteFile(w, r, appData.IsAdmin())
+			}
+
+			if data.Errors.None() {
+				members, err := memberStore.GetAll(r.Context())
+				if err != nil {
+					return err
+				}
+
+				invites, err := memberStore.InvitedMembers(r.Context())
+				if err != nil {
+					return err
+				}
+
+				checkBulkInviteDuplicates(data.Rows, members, invites)
+			}
+
+			if confirming && len(data.Rows) > 0 && len(data.ValidRows()) == len(data.Rows) {
+				// Carry on when an invite can't be sent, so that the rest of the
+				// file is not left half done.
+				for _, row := range data.Rows {
+					if err := sendMemberInvite(r.Context(), appData, organisation, row.Form, memberStore, notifyClient, generate, appPublicURL); err != nil {
+						logger.ErrorContext(r.Context(), "error sending bulk member invite", slog.Any("err", err))
+						row.Errors.Add("invite", validation.CustomError{Label: "errorInviteCouldNotBeSent"})
+					} else {
+						row.Sent = true
+					}
+				}
+
+				if data.SentCount() == len(data.Rows) {
+					return supporter.PathManageTeamMembers.RedirectQuery(w, r, appData, url.Values{"invitesSent": {strconv.Itoa(len(data.Rows))}})
+				}
+
+				data.Sent = true
+			}
+		}
+
+		return tmpl(w, data)
+	}
+}
+
+// readBulkInviteFile reads the rows of an uploaded CSV file, which must have a
+// header row matching bulkInviteHeader.
+func readBulkInviteFile(w http.ResponseWriter, r *http.Request, canGrantAdmin bool) ([]*bulkInviteRow, validation.List) {
+	// allow some space for the other fields
+	r.Body = http.MaxBytesReader(w, r.Body, maxBulkInviteFileSize+1<<10)
+
+	if err := r.ParseMultipartForm(maxBulkInviteFileSize); err != nil {
+		return nil, validation.With("members", validation.CustomError{Label: "errorCsvFileTooBig"})
+	}
+
+	file, fileHeader, err := r.FormFile("members")
+	if err != nil {
+		if errors.Is(err, http.ErrMissingFile) {
+			return nil, validation.With("members", validation.CustomError{Label: "errorCsvFileNotSelected"})
+		}
+
+		return nil, validation.With("members", validation.CustomError{Label: "errorCsvFileCouldNotBeRead"})
+	}
+	defer file.Close()
+
+	if fileHeader.Size == 0 {
+		return nil, validation.With("members", validation.CustomError{Label: "errorCsvFileIsEmpty"})
+	}
+
+	reader := csv.NewReader(io.LimitReader(file, maxBulkInviteFileSize))
+	reader.FieldsPerRecord = len(bulkInviteHeader)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, validation.With("members", validation.CustomError{Label: "errorCsvFileIsEmpty"})
+	}
+	if err != nil || !isBulkInviteHeader(header) {
+		return nil, validation.With("members", validation.CustomError{Label: "errorCsvFileMustHaveColumns"})
+	}
+
+	var rows []*bulkInviteRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, validation.With("members", validation.CustomError{Label: "errorCsvFileCouldNotBeRead"})
+		}
+
+		if strings.Join(record, "") == "" {
+			continue
+		}
+
+		if len(rows) == maxBulkInviteRows {
+			return nil, validation.With("members", validation.CustomFormattedError{
+				Label: "errorCsvFileHasTooManyRows",
+				Data:  map[string]any{"Max": maxBulkInviteRows},
+			})
+		}
+
+		rows = append(rows, newBulkInviteRow(record[0], record[1], record[2], record[3], canGrantAdmin))
+	}
+
+	if len(rows) == 0 {
+		return nil, validation.With("members", validation.CustomError{Label: "errorCsvFileIsEmpty"})
+	}
+
+	return rows, nil
+}
+
+func isBulkInviteHeader(header []string) bool {
+	if len(header) != len(bulkInviteHeader) {
+		return false
+	}
+
+	for i, name := range header {
+		if i == 0 {
+			// spreadsheet software may add a byte order mark
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+
+		if !strings.EqualFold(strings.TrimSpace(name), bulkInviteHeader[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// readBulkInviteRows reads the rows confirmed on the preview, so that they can
+// be checked again before any invites are sent.
+func readBulkInviteRows(r *http.Request, canGrantAdmin bool) []*bulkInviteRow {
+	_ = r.ParseForm()
+
+	firstNames := r.PostForm["first-names"]
+	lastNames := r.PostForm["last-name"]
+	emails := r.PostForm["email"]
+	permissions := r.PostForm["permission"]
+
+	if len(lastNames) != len(firstNames) || len(emails) != len(firstNames) || len(permissions) != len(firstNames) || len(firstNames) > maxBulkInviteRows {
+		return nil
+	}
+
+	rows := make([]*bulkInviteRow, len(firstNames))
+	for i := range firstNames {
+		rows[i] = newBulkInviteRow(firstNames[i], lastNames[i], emails[i], permissions[i], canGrantAdmin)
+	}
+
+	return rows
+}
+
+func newBulkInviteRow(firstNames, lastName, email, permission string, canGrantAdmin bool) *bulkInviteRow {
+	row := &bulkInviteRow{
+		Permission: strings.TrimSpace(permission),
+		Form: &inviteMemberForm{
+			FirstNames:    strings.TrimSpace(firstNames),
+			LastName:      strings.TrimSpace(lastName),
+			Email:         strings.TrimSpace(email),
+			canGrantAdmin: canGrantAdmin,
+		},
+	}
+
+	if row.Permission == "" {
+		row.Permission = supporterdata.PermissionNone.String()
+	}
+
+	var err error
+	row.Form.Permission, err = supporterdata.ParsePermission(row.Permission)
+	row.Errors = row.Form.Validate()
+
+	if err != nil && !row.Errors.Has("permission") {
+		row.Errors.Add("permission", validation.SelectError{Label: "theirRole"})
+	}
+
+	return row
+}
+
+// checkBulkInviteDuplicates adds an error to any row with the email of an
+// existing member, a pending invite, or an earlier row.
+func checkBulkInviteDuplicates(rows []*bulkInviteRow, members []*supporterdata.Member, invites []*supporterdata.MemberInvite) {
+	seen := map[string]string{}
+
+	for _, invite := range invites {
+		seen[strings.ToLower(invite.Email)] = "errorEmailAlreadyInvited"
+	}
+
+	for _, member := range members {
+		seen[strings.ToLower(member.Email)] = "errorEmailAlreadyATeamMember"
+	}
+
+	for _, row := range rows {
+		if row.Errors.Has("email") {
+			continue
+		}
+
+		email := strings.ToLower(row.Form.Email)
+		if label, ok := seen[email]; ok {
+			row.Errors.Add("email", validation.CustomError{Label: label})
+		} else {
+			seen[email] = "errorEmailDuplicatedInFile"
+		}
+	}
+}
@@ -0,0 +1,384 @@
+package supporterpage
+
+import (
+	"bytes"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/localize"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newBulkInviteRequest(t *testing.T, content string) *http.Request {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	_ = writer.WriteField("csrf", "123")
+	if content != "" {
+		part, err := writer.CreateFormFile("members", "members.csv")
+		assert.Nil(t, err)
+		_, _ = part.Write([]byte(content))
+	}
+	_ = writer.Close()
+
+	r, _ := http.NewRequest(http.MethodPost, "/", &buf)
+	r.Header.Add("Content-Type", writer.FormDataContentType())
+
+	return r
+}
+
+func TestGetBulkInviteMembers(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &bulkInviteMembersData{
+			App:     testAppData,
+			MaxRows: maxBulkInviteRows,
+		}).
+		Return(nil)
+
+	err := BulkInviteMembers(nil, template.Execute, nil, nil, nil, "http://base")(testAppData, w, r, nil, nil)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestPostBulkInviteMembersWhenUploading(t *testing.T) {
+	w := httptest.NewRecorder()
+	r := newBulkInviteRequest(t, "\ufeffFirst names,Last name,Email,Permission\n"+
+		"Alice,Smith,alice@example.com,case-handler\n"+
+		"Bob,Jones,bob@example.com,\n"+
+		"Carol,Brown,member@example.com,none\n"+
+		"Dan,Green,invited@example.com,auditor\n"+
+		"Eve,White,Alice@example.com,team-manager\n"+
+		"Fay,Black,what,admin\n"+
+		",,,\n"+
+		"Gil,Grey,gil@example.com,boss\n")
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return([]*supporterdata.Member{{Email: "member@example.com"}}, nil)
+	memberStore.EXPECT().
+		InvitedMembers(r.Context()).
+		Return([]*supporterdata.MemberInvite{{Email: "invited@example.com"}}, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &bulkInviteMembersData{
+			App:     testAppData,
+			MaxRows: maxBulkInviteRows,
+			Rows: []*bulkInviteRow{{
+				Permission: "case-handler",
+				Form:       &inviteMemberForm{FirstNames: "Alice", LastName: "Smith", Email: "alice@example.com", Permission: supporterdata.PermissionCaseHandler},
+			}, {
+				Permission: "none",
+				Form:       &inviteMemberForm{FirstNames: "Bob", LastName: "Jones", Email: "bob@example.com", Permission: supporterdata.PermissionNone},
+			}, {
+				Permission: "none",
+				Form:       &inviteMemberForm{FirstNames: "Carol", LastName: "Brown", Email: "member@example.com", Permission: supporterdata.PermissionNone},
+				Errors:     validation.With("email", validation.CustomError{Label: "errorEmailAlreadyATeamMember"}),
+			}, {
+				Permission: "auditor",
+				Form:       &inviteMemberForm{FirstNames: "Dan", LastName: "Green", Email: "invited@example.com", Permission: supporterdata.PermissionAuditor},
+				Errors:     validation.With("email", validation.CustomError{Label: "errorEmailAlreadyInvited"}),
+			}, {
+				Permission: "team-manager",
+				Form:       &inviteMemberForm{FirstNames: "Eve", LastName: "White", Email: "Alice@example.com", Permission: supporterdata.PermissionTeamManager},
+				Errors:     validation.With("email", validation.CustomError{Label: "errorEmailDuplicatedInFile"}),
+			}, {
+				Permission: "admin",
+				Form:       &inviteMemberForm{FirstNames: "Fay", LastName: "Black", Email: "what", Permission: supporterdata.PermissionAdmin},
+				Errors: validation.
+					With("email", validation.EmailError{Label: "email"}).
+					With("permission", validation.SelectError{Label: "theirRole"}),
+			}, {
+				Permission: "boss",
+				Form:       &inviteMemberForm{FirstNames: "Gil", LastName: "Grey", Email: "gil@example.com"},
+				Errors:     validation.With("permission", validation.SelectError{Label: "theirRole"}),
+			}},
+		}).
+		Return(nil)
+
+	err := BulkInviteMembers(nil, template.Execute, memberStore, nil, nil, "http://base")(testAppData, w, r, nil, nil)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestPostBulkInviteMembersWhenFileError(t *testing.T) {
+	testcases := map[string]struct {
+		content string
+		error   validation.FormattableError
+	}{
+		"not selected": {
+			error: validation.CustomError{Label: "errorCsvFileNotSelected"},
+		},
+		"no rows": {
+			content: "first names,last name,email,permission\n,,,\n",
+			error:   validation.CustomError{Label: "errorCsvFileIsEmpty"},
+		},
+		"wrong header": {
+			content: "name,email,permission\na,b@example.com,none\n",
+			error:   validation.CustomError{Label: "errorCsvFileMustHaveColumns"},
+		},
+		"wrong number of fields": {
+			content: "first names,last name,email,permission\na,b,c@example.com\n",
+			error:   validation.CustomError{Label: "errorCsvFileCouldNotBeRead"},
+		},
+		"too many rows": {
+			content: "first names,last name,email,permission\n" + strings.Repeat("a,b,c@example.com,none\n", maxBulkInviteRows+1),
+			error: validation.CustomFormattedError{
+				Label: "errorCsvFileHasTooManyRows",
+				Data:  map[string]any{"Max": maxBulkInviteRows},
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := newBulkInviteRequest(t, tc.content)
+
+			template := newMockTemplate(t)
+			template.EXPECT().
+				Execute(w, &bulkInviteMembersData{
+					App:     testAppData,
+					MaxRows: maxBulkInviteRows,
+					Errors:  validation.With("members", tc.error),
+				}).
+				Return(nil)
+
+			err := BulkInviteMembers(nil, template.Execute, nil, nil, nil, "http://base")(testAppData, w, r, nil, nil)
+			resp := w.Result()
+
+			assert.Nil(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		})
+	}
+}
+
+func TestPostBulkInviteMembersWhenSending(t *testing.T) {
+	form := url.Values{
+		"first-names": {"Alice", "Bob"},
+		"last-name":   {"Smith", "Jones"},
+		"email":       {"alice@example.com", "bob@example.com"},
+		"permission":  {"case-handler", "none"},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	organisation := &supporterdata.Organisation{Name: "My organisation"}
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return([]*supporterdata.Member{{Email: "member@example.com"}}, nil)
+	memberStore.EXPECT().
+		InvitedMembers(r.Context()).
+		Return([]*supporterdata.MemberInvite{{Email: "invited@example.com"}}, nil)
+	memberStore.EXPECT().
+		CreateMemberInvite(r.Context(), organisation, "Alice", "Smith", "alice@example.com", testInviteHashedCode, supporterdata.PermissionCaseHandler).
+		Return(nil)
+	memberStore.EXPECT().
+		CreateMemberInvite(r.Context(), organisation, "Bob", "Jones", "bob@example.com", testInviteHashedCode, supporterdata.PermissionNone).
+		Return(nil)
+
+	notifyClient := newMockNotifyClient(t)
+	for _, email := range []string{"alice@example.com", "bob@example.com"} {
+		notifyClient.EXPECT().
+			SendEmail(r.Context(), notify.ToCustomEmail(localize.En, email), notify.OrganisationMemberInviteEmail{
+				OrganisationName:      "My organisation",
+				InviterEmail:          "supporter@example.com",
+				InviteCode:            testInvitePlainCode.Plain(),
+				JoinAnOrganisationURL: "http://base" + page.PathSupporterStart.Format(),
+			}).
+			Return(nil)
+	}
+
+	err := BulkInviteMembers(nil, nil, memberStore, notifyClient, testGenerateInviteFn, "http://base")(testOrgMemberAppData, w, r, organisation, nil)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	assert.Equal(t, supporter.PathManageTeamMembers.Format()+"?invitesSent=2", resp.Header.Get("Location"))
+}
+
+func TestPostBulkInviteMembersWhenSendingAndRowNoLongerValid(t *testing.T) {
+	form := url.Values{
+		"first-names": {"Alice", "Bob"},
+		"last-name":   {"Smith", "Jones"},
+		"email":       {"alice@example.com", "bob@example.com"},
+		"permission":  {"case-handler", "none"},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return(nil, nil)
+	memberStore.EXPECT().
+		InvitedMembers(r.Context()).
+		Return([]*supporterdata.MemberInvite{{Email: "bob@example.com"}}, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &bulkInviteMembersData{
+			App:     testAppData,
+			MaxRows: maxBulkInviteRows,
+			Rows: []*bulkInviteRow{{
+				Permission: "case-handler",
+				Form:       &inviteMemberForm{FirstNames: "Alice", LastName: "Smith", Email: "alice@example.com", Permission: supporterdata.PermissionCaseHandler},
+			}, {
+				Permission: "none",
+				Form:       &inviteMemberForm{FirstNames: "Bob", LastName: "Jones", Email: "bob@example.com", Permission: supporterdata.PermissionNone},
+				Errors:     validation.With("email", validation.CustomError{Label: "errorEmailAlreadyInvited"}),
+			}},
+		}).
+		Return(nil)
+
+	err := BulkInviteMembers(nil, template.Execute, memberStore, nil, nil, "http://base")(testAppData, w, r, nil, nil)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestPostBulkInviteMembersWhenStoreErrors(t *testing.T) {
+	form := url.Values{
+		"first-names": {"Alice"},
+		"last-name":   {"Smith"},
+		"email":       {"alice@example.com"},
+		"permission":  {"none"},
+	}
+
+	testcases := map[string]func(*mockMemberStore, *mockNotifyClient){
+		"GetAll": func(memberStore *mockMemberStore, _ *mockNotifyClient) {
+			memberStore.EXPECT().
+				GetAll(mock.Anything).
+				Return(nil, expectedError)
+		},
+		"InvitedMembers": func(memberStore *mockMemberStore, _ *mockNotifyClient) {
+			memberStore.EXPECT().
+				GetAll(mock.Anything).
+				Return(nil, nil)
+			memberStore.EXPECT().
+				InvitedMembers(mock.Anything).
+				Return(nil, expectedError)
+		},
+	}
+
+	for name, setupMocks := range testcases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+			r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+			memberStore := newMockMemberStore(t)
+			notifyClient := newMockNotifyClient(t)
+			setupMocks(memberStore, notifyClient)
+
+			err := BulkInviteMembers(nil, nil, memberStore, notifyClient, testGenerateInviteFn, "http://base")(testOrgMemberAppData, w, r, &supporterdata.Organisation{}, nil)
+			assert.Equal(t, expectedError, err)
+		})
+	}
+}
+
+func TestPostBulkInviteMembersWhenSomeInvitesCannotBeSent(t *testing.T) {
+	form := url.Values{
+		"first-names": {"Alice", "Bob", "Carol"},
+		"last-name":   {"Smith", "Jones", "Brown"},
+		"email":       {"alice@example.com", "bob@example.com", "carol@example.com"},
+		"permission":  {"none", "none", "none"},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	organisation := &supporterdata.Organisation{Name: "My organisation"}
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return(nil, nil)
+	memberStore.EXPECT().
+		InvitedMembers(r.Context()).
+		Return(nil, nil)
+	memberStore.EXPECT().
+		CreateMemberInvite(r.Context(), organisation, "Alice", "Smith", "alice@example.com", testInviteHashedCode, supporterdata.PermissionNone).
+		Return(expectedError)
+	memberStore.EXPECT().
+		CreateMemberInvite(r.Context(), organisation, "Bob", "Jones", "bob@example.com", testInviteHashedCode, supporterdata.PermissionNone).
+		Return(nil)
+	memberStore.EXPECT().
+		CreateMemberInvite(r.Context(), organisation, "Carol", "Brown", "carol@example.com", testInviteHashedCode, supporterdata.PermissionNone).
+		Return(nil)
+
+	notifyClient := newMockNotifyClient(t)
+	notifyClient.EXPECT().
+		SendEmail(r.Context(), notify.ToCustomEmail(localize.En, "bob@example.com"), mock.Anything).
+		Return(expectedError)
+	notifyClient.EXPECT().
+		SendEmail(r.Context(), notify.ToCustomEmail(localize.En, "carol@example.com"), mock.Anything).
+		Return(nil)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		ErrorContext(r.Context(), "error sending bulk member invite", slog.Any("err", expectedError)).
+		Twice()
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &bulkInviteMembersData{
+			App:     testOrgMemberAppData,
+			MaxRows: maxBulkInviteRows,
+			Sent:    true,
+			Rows: []*bulkInviteRow{{
+				Permission: "none",
+				Form:       &inviteMemberForm{FirstNames: "Alice", LastName: "Smith", Email: "alice@example.com"},
+				Errors:     validation.With("invite", validation.CustomError{Label: "errorInviteCouldNotBeSent"}),
+			}, {
+				Permission: "none",
+				Form:       &inviteMemberForm{FirstNames: "Bob", LastName: "Jones", Email: "bob@example.com"},
+				Errors:     validation.With("invite", validation.CustomError{Label: "errorInviteCouldNotBeSent"}),
+			}, {
+				Permission: "none",
+				Form:       &inviteMemberForm{FirstNames: "Carol", LastName: "Brown", Email: "carol@example.com"},
+				Sent:       true,
+			}},
+		}).
+		Return(nil)
+
+	err := BulkInviteMembers(logger, template.Execute, memberStore, notifyClient, testGenerateInviteFn, "http://base")(testOrgMemberAppData, w, r, organisation, nil)
+	resp := w.Result()
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestBulkInviteMembersDataSentCount(t *testing.T) {
+	data := &bulkInviteMembersData{Rows: []*bulkInviteRow{{Sent: true}, {}, {Sent: true}}}
+
+	assert.Equal(t, 2, data.SentCount())
+}
@@ -0,0 +1,40 @@
+package supporterpage
+
+import (
+	"net/http"
+
+	"github.com/ministryofjustice/opg-go-common/template"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
+)
+
+type communicationsSentData struct {
+	App           appcontext.Data
+	Errors        validation.List
+	Lpa           *lpadata.Lpa
+	Notifications []notify.Notification
+}
+
+func CommunicationsSent(tmpl template.Template, lpaStoreResolvingService LpaStoreResolvingService, notificationStore NotificationStore) Handler {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, _ *supporterdata.Organisation, _ *supporterdata.Member) error {
+		// Getting the LPA first ensures it belongs to the organisation.
+		lpa, err := lpaStoreResolvingService.Get(r.Context())
+		if err != nil {
+			return err
+		}
+
+		notifications, err := notificationStore.GetAll(r.Context())
+		if err != nil {
+			return err
+		}
+
+		return tmpl(w, &communicationsSentData{
+			App:           appData,
+			Lpa:           lpa,
+			Notifications: notifications,
+		})
+	}
+}
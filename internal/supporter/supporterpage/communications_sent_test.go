@@ -0,0 +1,101 @@
+package supporterpage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/notify"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetCommunicationsSent(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpa := &lpadata.Lpa{LpaUID: "lpa-uid"}
+	notifications := []notify.Notification{{NotifyID: "a"}}
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(r.Context()).
+		Return(lpa, nil)
+
+	notificationStore := newMockNotificationStore(t)
+	notificationStore.EXPECT().
+		GetAll(r.Context()).
+		Return(notifications, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &communicationsSentData{
+			App:           testAppData,
+			Lpa:           lpa,
+			Notifications: notifications,
+		}).
+		Return(nil)
+
+	err := CommunicationsSent(template.Execute, lpaStoreResolvingService, notificationStore)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+
+	assert.Nil(t, err)
+}
+
+func TestGetCommunicationsSentWhenLpaStoreResolvingServiceErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(nil, expectedError)
+
+	err := CommunicationsSent(nil, lpaStoreResolvingService, nil)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestGetCommunicationsSentWhenNotificationStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(&lpadata.Lpa{}, nil)
+
+	notificationStore := newMockNotificationStore(t)
+	notificationStore.EXPECT().
+		GetAll(mock.Anything).
+		Return(nil, expectedError)
+
+	err := CommunicationsSent(nil, lpaStoreResolvingService, notificationStore)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestGetCommunicationsSentWhenTemplateErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(mock.Anything).
+		Return(&lpadata.Lpa{}, nil)
+
+	notificationStore := newMockNotificationStore(t)
+	notificationStore.EXPECT().
+		GetAll(mock.Anything).
+		Return(nil, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, mock.Anything).
+		Return(expectedError)
+
+	err := CommunicationsSent(template.Execute, lpaStoreResolvingService, notificationStore)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+
+	assert.Equal(t, expectedError, err)
+}
@@ -19,7 +19,7 @@ type confirmDonorCanInteractOnlineData struct {
 }
 
 func ConfirmDonorCanInteractOnline(tmpl template.Template, organisationStore OrganisationStore) Handler {
-	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, organisation *supporterdata.Organisation, _ *supporterdata.Member) error {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, organisation *supporterdata.Organisation, member *supporterdata.Member) error {
 		data := &confirmDonorCanInteractOnlineData{
 			App:  appData,
 			Form: form.NewYesNoForm(form.YesNoUnknown),
@@ -30,7 +30,7 @@ func ConfirmDonorCanInteractOnline(tmpl template.Template, organisationStore Org
 			data.Errors = data.Form.Validate()
 
 			if data.Form.YesNo.IsYes() {
-				donorProvided, err := organisationStore.CreateLPA(r.Context())
+				donorProvided, err := organisationStore.CreateLPA(r.Context(), member.ID)
 				if err != nil {
 					return err
 				}
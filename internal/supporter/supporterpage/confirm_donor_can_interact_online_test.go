@@ -46,10 +46,10 @@ func TestPostConfirmDonorCanInteractOnlineWhenYes(t *testing.T) {
 
 	organisationStore := newMockOrganisationStore(t)
 	organisationStore.EXPECT().
-		CreateLPA(r.Context()).
+		CreateLPA(r.Context(), "member-id").
 		Return(&donordata.Provided{LpaID: "lpa-id"}, nil)
 
-	err := ConfirmDonorCanInteractOnline(nil, organisationStore)(testAppData, w, r, &supporterdata.Organisation{ID: "org-id"}, nil)
+	err := ConfirmDonorCanInteractOnline(nil, organisationStore)(testAppData, w, r, &supporterdata.Organisation{ID: "org-id"}, &supporterdata.Member{ID: "member-id"})
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -102,10 +102,10 @@ func TestPostConfirmDonorCanInteractOnlineWhenOrganisationStoreError(t *testing.
 
 	organisationStore := newMockOrganisationStore(t)
 	organisationStore.EXPECT().
-		CreateLPA(r.Context()).
+		CreateLPA(r.Context(), "member-id").
 		Return(&donordata.Provided{}, expectedError)
 
-	err := ConfirmDonorCanInteractOnline(nil, organisationStore)(testAppData, w, r, &supporterdata.Organisation{ID: "org-id"}, nil)
+	err := ConfirmDonorCanInteractOnline(nil, organisationStore)(testAppData, w, r, &supporterdata.Organisation{ID: "org-id"}, &supporterdata.Member{ID: "member-id"})
 	resp := w.Result()
 
 	assert.Equal(t, expectedError, err)
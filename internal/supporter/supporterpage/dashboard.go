@@ -3,13 +3,18 @@ package supporterpage
 import (
 	"context"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/ministryofjustice/opg-go-common/template"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/search"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/uid"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
 )
 
@@ -18,41 +23,160 @@ type SearchClient interface {
 	CountWithQuery(ctx context.Context, req search.CountWithQueryReq) (int, error)
 }
 
+// The values of the assignee filter that are not member IDs.
+const (
+	assigneeMine       = "mine"
+	assigneeUnassigned = "unassigned"
+)
+
 type dashboardData struct {
-	App         appcontext.Data
-	Errors      validation.List
-	Donors      []donordata.Provided
-	CurrentPage int
-	Pagination  *search.Pagination
+	App            appcontext.Data
+	Errors         validation.List
+	Form           *dashboardForm
+	Donors         []donordata.Provided
+	Members        []*supporterdata.Member
+	CurrentPage    int
+	Pagination     *search.Pagination
+	StatusOptions  search.StatusOptions
+	LpaTypeOptions lpadata.LpaTypeOptions
+	SortOptions    search.SortOptions
 }
 
-func Dashboard(tmpl template.Template, donorStore DonorStore, searchClient SearchClient) Handler {
+// MemberName returns the name of the member with the ID, or an empty string if
+// there is no such member.
+func (d *dashboardData) MemberName(id string) string {
+	for _, member := range d.Members {
+		if member.ID == id {
+			return member.FullName()
+		}
+	}
+
+	return ""
+}
+
+func Dashboard(tmpl template.Template, donorStore DonorStore, searchClient SearchClient, memberStore MemberStore) Handler {
 	const pageSize = 10
 
-	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, organisation *supporterdata.Organisation, _ *supporterdata.Member) error {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, organisation *supporterdata.Organisation, member *supporterdata.Member) error {
 		page, err := strconv.Atoi(r.FormValue("page"))
 		if err != nil {
 			page = 1
 		}
 
-		resp, err := searchClient.Query(r.Context(), search.QueryRequest{
+		members, err := memberStore.GetAll(r.Context())
+		if err != nil {
+			return err
+		}
+
+		form := readDashboardForm(r)
+		data := &dashboardData{
+			App:            appData,
+			Form:           form,
+			Members:        members,
+			CurrentPage:    page,
+			StatusOptions:  search.StatusValues,
+			LpaTypeOptions: lpadata.LpaTypeValues,
+			SortOptions:    search.SortValues,
+		}
+
+		text, err := form.SearchText()
+		if err != nil {
+			data.Errors = validation.With("search", validation.CustomError{Label: "referenceNumberNotValid"})
+			return tmpl(w, data)
+		}
+
+		req := search.QueryRequest{
 			Page:     page,
 			PageSize: pageSize,
-		})
+			Text:     text,
+			Sort:     form.Sort,
+		}
+		if !form.Status.Empty() {
+			req.Statuses = []search.Status{form.Status}
+		}
+		if !form.LpaType.Empty() {
+			req.LpaTypes = []lpadata.LpaType{form.LpaType}
+		}
+		switch form.Assignee {
+		case assigneeMine:
+			req.AssignedMemberID = member.ID
+		case assigneeUnassigned:
+			req.Unassigned = true
+		default:
+			req.AssignedMemberID = form.Assignee
+		}
+
+		resp, err := searchClient.Query(r.Context(), req)
 		if err != nil {
 			return err
 		}
 
-		donors, err := donorStore.GetByKeys(r.Context(), resp.Keys)
+		data.Donors, err = donorStore.GetByKeys(r.Context(), resp.Keys)
 		if err != nil {
 			return err
 		}
 
-		return tmpl(w, &dashboardData{
-			App:         appData,
-			Donors:      donors,
-			CurrentPage: page,
-			Pagination:  resp.Pagination,
-		})
+		data.Pagination = resp.Pagination
+		return tmpl(w, data)
 	}
 }
+
+type dashboardForm struct {
+	Text     string
+	Status   search.Status
+	LpaType  lpadata.LpaType
+	Assignee string
+	Sort     search.Sort
+}
+
+func readDashboardForm(r *http.Request) *dashboardForm {
+	form := &dashboardForm{
+		Text:     strings.TrimSpace(r.FormValue("search")),
+		Assignee: r.FormValue("assignee"),
+	}
+
+	form.Status, _ = search.ParseStatus(r.FormValue("status"))
+	form.LpaType, _ = lpadata.ParseLpaType(r.FormValue("type"))
+	form.Sort, _ = search.ParseSort(r.FormValue("sort"))
+
+	return form
+}
+
+// uidShape matches text in the shape of an LPA UID, M-XXXX-XXXX-XXXX, with or
+// without hyphens or spaces between the groups.
+var uidShape = regexp.MustCompile(`^[Mm][- ]?[[:alnum:]]{4}[- ]?[[:alnum:]]{4}[- ]?[[:alnum:]]{4}$`)
+
+// SearchText returns the text to search for. When the text is in the shape of a
+// reference number it is put in the format used for LPA UIDs, and an error is
+// returned if the reference number is not valid.
+func (f *dashboardForm) SearchText() (string, error) {
+	if !uidShape.MatchString(f.Text) {
+		return f.Text, nil
+	}
+
+	return uid.Parse(f.Text)
+}
+
+// PageQuery returns the query string to link to a page of results, keeping any
+// filters that have been applied.
+func (f *dashboardForm) PageQuery(page int) string {
+	values := url.Values{}
+	if f.Text != "" {
+		values.Set("search", f.Text)
+	}
+	if !f.Status.Empty() {
+		values.Set("status", f.Status.String())
+	}
+	if !f.LpaType.Empty() {
+		values.Set("type", f.LpaType.String())
+	}
+	if f.Assignee != "" {
+		values.Set("assignee", f.Assignee)
+	}
+	if !f.Sort.Empty() {
+		values.Set("sort", f.Sort.String())
+	}
+	values.Set("page", strconv.Itoa(page))
+
+	return "?" + values.Encode()
+}
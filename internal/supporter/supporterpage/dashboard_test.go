@@ -7,7 +7,11 @@ import (
 
 	donordata "github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/dynamo"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/search"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/uid"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/validation"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -27,6 +31,12 @@ func TestGetDashboard(t *testing.T) {
 			keys := []dynamo.Keys{{PK: dynamo.LpaKey("a"), SK: dynamo.OrganisationKey("b")}}
 			pagination := &search.Pagination{Total: 10}
 			donors := []donordata.Provided{{LpaID: "abc"}}
+			members := []*supporterdata.Member{{ID: "member-id"}}
+
+			memberStore := newMockMemberStore(t)
+			memberStore.EXPECT().
+				GetAll(r.Context()).
+				Return(members, nil)
 
 			searchClient := newMockSearchClient(t)
 			searchClient.EXPECT().
@@ -41,14 +51,19 @@ func TestGetDashboard(t *testing.T) {
 			template := newMockTemplate(t)
 			template.EXPECT().
 				Execute(w, &dashboardData{
-					App:         testAppData,
-					Donors:      donors,
-					CurrentPage: page,
-					Pagination:  pagination,
+					App:            testAppData,
+					Form:           &dashboardForm{},
+					Donors:         donors,
+					Members:        members,
+					CurrentPage:    page,
+					Pagination:     pagination,
+					StatusOptions:  search.StatusValues,
+					LpaTypeOptions: lpadata.LpaTypeValues,
+					SortOptions:    search.SortValues,
 				}).
 				Return(expectedError)
 
-			err := Dashboard(template.Execute, donorStore, searchClient)(testAppData, w, r, nil, nil)
+			err := Dashboard(template.Execute, donorStore, searchClient, memberStore)(testAppData, w, r, nil, nil)
 			resp := w.Result()
 
 			assert.Equal(t, expectedError, err)
@@ -57,16 +72,196 @@ func TestGetDashboard(t *testing.T) {
 	}
 }
 
+func TestGetDashboardWithFilters(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/?search=+John+Smith+&status=signed&type=personal-welfare&assignee=member-id&sort=deadline&page=2", nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return(nil, nil)
+
+	searchClient := newMockSearchClient(t)
+	searchClient.EXPECT().
+		Query(r.Context(), search.QueryRequest{
+			Page:             2,
+			PageSize:         10,
+			Text:             "John Smith",
+			Statuses:         []search.Status{search.StatusSigned},
+			LpaTypes:         []lpadata.LpaType{lpadata.LpaTypePersonalWelfare},
+			Sort:             search.SortDeadline,
+			AssignedMemberID: "member-id",
+		}).
+		Return(&search.QueryResponse{Pagination: &search.Pagination{}}, nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		GetByKeys(r.Context(), mock.Anything).
+		Return(nil, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, mock.MatchedBy(func(data *dashboardData) bool {
+			return assert.Equal(t, &dashboardForm{
+				Text:     "John Smith",
+				Status:   search.StatusSigned,
+				LpaType:  lpadata.LpaTypePersonalWelfare,
+				Assignee: "member-id",
+				Sort:     search.SortDeadline,
+			}, data.Form)
+		})).
+		Return(nil)
+
+	err := Dashboard(template.Execute, donorStore, searchClient, memberStore)(testAppData, w, r, nil, nil)
+	assert.Nil(t, err)
+}
+
+func TestGetDashboardWithAssignee(t *testing.T) {
+	testcases := map[string]search.QueryRequest{
+		"mine":       {Page: 1, PageSize: 10, AssignedMemberID: "my-id"},
+		"unassigned": {Page: 1, PageSize: 10, Unassigned: true},
+		"other-id":   {Page: 1, PageSize: 10, AssignedMemberID: "other-id"},
+	}
+
+	for assignee, req := range testcases {
+		t.Run(assignee, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(http.MethodGet, "/?assignee="+assignee, nil)
+
+			memberStore := newMockMemberStore(t)
+			memberStore.EXPECT().
+				GetAll(r.Context()).
+				Return(nil, nil)
+
+			searchClient := newMockSearchClient(t)
+			searchClient.EXPECT().
+				Query(r.Context(), req).
+				Return(&search.QueryResponse{Pagination: &search.Pagination{}}, nil)
+
+			donorStore := newMockDonorStore(t)
+			donorStore.EXPECT().
+				GetByKeys(r.Context(), mock.Anything).
+				Return(nil, nil)
+
+			template := newMockTemplate(t)
+			template.EXPECT().
+				Execute(w, mock.Anything).
+				Return(nil)
+
+			err := Dashboard(template.Execute, donorStore, searchClient, memberStore)(testAppData, w, r, nil, &supporterdata.Member{ID: "my-id"})
+			assert.Nil(t, err)
+		})
+	}
+}
+
+func TestGetDashboardWithReferenceNumber(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/?search=m+3444+7777+999r", nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return(nil, nil)
+
+	searchClient := newMockSearchClient(t)
+	searchClient.EXPECT().
+		Query(r.Context(), search.QueryRequest{Page: 1, PageSize: 10, Text: "M-3444-7777-999R"}).
+		Return(&search.QueryResponse{Pagination: &search.Pagination{}}, nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		GetByKeys(r.Context(), mock.Anything).
+		Return(nil, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, mock.Anything).
+		Return(nil)
+
+	err := Dashboard(template.Execute, donorStore, searchClient, memberStore)(testAppData, w, r, nil, nil)
+	assert.Nil(t, err)
+}
+
+func TestGetDashboardWithInvalidReferenceNumber(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/?search=M-3444-7777-999Q", nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return(nil, nil)
+
+	template := newMockTemplate(t)
+	template.EXPECT().
+		Execute(w, &dashboardData{
+			App:            testAppData,
+			Errors:         validation.With("search", validation.CustomError{Label: "referenceNumberNotValid"}),
+			Form:           &dashboardForm{Text: "M-3444-7777-999Q"},
+			CurrentPage:    1,
+			StatusOptions:  search.StatusValues,
+			LpaTypeOptions: lpadata.LpaTypeValues,
+			SortOptions:    search.SortValues,
+		}).
+		Return(nil)
+
+	err := Dashboard(template.Execute, nil, nil, memberStore)(testAppData, w, r, nil, nil)
+	assert.Nil(t, err)
+}
+
+func TestDashboardFormSearchText(t *testing.T) {
+	testcases := map[string]string{
+		"":                 "",
+		"John Smith":       "John Smith",
+		"M-3444-7777-999R": "M-3444-7777-999R",
+		"M34447777999R":    "M-3444-7777-999R",
+		"Mary Smith":       "Mary Smith",
+		"Mary Anne Smith":  "Mary Anne Smith",
+		"M-":               "M-",
+		"m-1111-2222":      "m-1111-2222",
+	}
+
+	for text, expected := range testcases {
+		t.Run(text, func(t *testing.T) {
+			result, err := (&dashboardForm{Text: text}).SearchText()
+			assert.Nil(t, err)
+			assert.Equal(t, expected, result)
+		})
+	}
+
+	for _, text := range []string{"m-1111-2222-3333", "M-3444-7777-999Q", "M 3444 7777 999Q"} {
+		t.Run(text, func(t *testing.T) {
+			_, err := (&dashboardForm{Text: text}).SearchText()
+			assert.Equal(t, uid.ErrInvalidUID, err)
+		})
+	}
+}
+
+func TestDashboardFormPageQuery(t *testing.T) {
+	assert.Equal(t, "?page=3", (&dashboardForm{}).PageQuery(3))
+	assert.Equal(t, "?assignee=mine&page=1&search=John+Smith&sort=last-updated&status=paid&type=property-and-affairs", (&dashboardForm{
+		Text:     "John Smith",
+		Status:   search.StatusPaid,
+		LpaType:  lpadata.LpaTypePropertyAndAffairs,
+		Assignee: "mine",
+		Sort:     search.SortLastUpdated,
+	}).PageQuery(1))
+}
+
 func TestGetDashboardWhenSearchClientErrors(t *testing.T) {
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest(http.MethodGet, "/", nil)
 
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return(nil, nil)
+
 	searchClient := newMockSearchClient(t)
 	searchClient.EXPECT().
 		Query(r.Context(), search.QueryRequest{Page: 1, PageSize: 10}).
 		Return(&search.QueryResponse{Keys: []dynamo.Keys{}, Pagination: &search.Pagination{}}, expectedError)
 
-	err := Dashboard(nil, nil, searchClient)(testAppData, w, r, nil, nil)
+	err := Dashboard(nil, nil, searchClient, memberStore)(testAppData, w, r, nil, nil)
 	assert.Equal(t, expectedError, err)
 }
 
@@ -74,6 +269,11 @@ func TestGetDashboardWhenDonorStoreErrors(t *testing.T) {
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest(http.MethodGet, "/", nil)
 
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return(nil, nil)
+
 	searchClient := newMockSearchClient(t)
 	searchClient.EXPECT().
 		Query(r.Context(), search.QueryRequest{Page: 1, PageSize: 10}).
@@ -84,6 +284,30 @@ func TestGetDashboardWhenDonorStoreErrors(t *testing.T) {
 		GetByKeys(r.Context(), mock.Anything).
 		Return(nil, expectedError)
 
-	err := Dashboard(nil, donorStore, searchClient)(testAppData, w, r, nil, nil)
+	err := Dashboard(nil, donorStore, searchClient, memberStore)(testAppData, w, r, nil, nil)
+	assert.Equal(t, expectedError, err)
+}
+
+func TestGetDashboardWhenMemberStoreErrors(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetAll(r.Context()).
+		Return(nil, expectedError)
+
+	err := Dashboard(nil, nil, nil, memberStore)(testAppData, w, r, nil, nil)
 	assert.Equal(t, expectedError, err)
 }
+
+func TestDashboardDataMemberName(t *testing.T) {
+	data := &dashboardData{Members: []*supporterdata.Member{
+		{ID: "a", FirstNames: "Alice", LastName: "Moxom"},
+		{ID: "b", FirstNames: "Bob", LastName: "Smith"},
+	}}
+
+	assert.Equal(t, "Bob Smith", data.MemberName("b"))
+	assert.Equal(t, "", data.MemberName("c"))
+	assert.Equal(t, "", data.MemberName(""))
+}
@@ -27,7 +27,7 @@ type donorAccessData struct {
 	SupporterLink *supporterdata.LpaLink
 }
 
-func DonorAccess(logger Logger, tmpl template.Template, donorStore DonorStore, accessCodeStore AccessCodeStore, notifyClient NotifyClient, donorStartURL string, generate accesscodedata.Generator) Handler {
+func DonorAccess(logger Logger, tmpl template.Template, donorStore DonorStore, accessCodeStore AccessCodeStore, auditLogStore AuditLogStore, notifyClient NotifyClient, donorStartURL string, generate accesscodedata.Generator) Handler {
 	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, organisation *supporterdata.Organisation, member *supporterdata.Member) error {
 		donor, err := donorStore.Get(r.Context())
 		if err != nil {
@@ -50,6 +50,14 @@ func DonorAccess(logger Logger, tmpl template.Template, donorStore DonorStore, a
 					return err
 				}
 
+				if err := auditLogStore.Create(r.Context(), supporterdata.AuditEvent{
+					Action:  supporterdata.AuditActionDonorAccessRecalled,
+					Subject: supporterLink.InviteSentTo,
+					LpaID:   appData.LpaID,
+				}); err != nil {
+					return err
+				}
+
 				return supporter.PathViewLPA.RedirectQuery(w, r, appData, appData.LpaID, url.Values{
 					"inviteRecalledFor": {supporterLink.InviteSentTo},
 				})
@@ -64,6 +72,14 @@ func DonorAccess(logger Logger, tmpl template.Template, donorStore DonorStore, a
 				}
 				logger.InfoContext(r.Context(), "donor access removed", slog.String("lpa_id", appData.LpaID))
 
+				if err := auditLogStore.Create(r.Context(), supporterdata.AuditEvent{
+					Action:  supporterdata.AuditActionDonorAccessRemoved,
+					Subject: supporterLink.InviteSentTo,
+					LpaID:   appData.LpaID,
+				}); err != nil {
+					return err
+				}
+
 				return supporter.PathViewLPA.RedirectQuery(w, r, appData, appData.LpaID, url.Values{
 					"accessRemovedFor": {supporterLink.InviteSentTo},
 				})
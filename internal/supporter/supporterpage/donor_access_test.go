@@ -77,7 +77,7 @@ func TestGetDonorAccess(t *testing.T) {
 				Execute(w, tc.data).
 				Return(expectedError)
 
-			err := DonorAccess(nil, template.Execute, donorStore, accessCodeStore, nil, "", nil)(testLpaAppData, w, r, nil, nil)
+			err := DonorAccess(nil, template.Execute, donorStore, accessCodeStore, nil, nil, "", nil)(testLpaAppData, w, r, nil, nil)
 			resp := w.Result()
 
 			assert.Equal(t, expectedError, err)
@@ -95,7 +95,7 @@ func TestGetDonorAccessWhenDonorStoreErrors(t *testing.T) {
 		Get(r.Context()).
 		Return(&donordata.Provided{}, expectedError)
 
-	err := DonorAccess(nil, nil, donorStore, nil, nil, "", nil)(testLpaAppData, w, r, nil, nil)
+	err := DonorAccess(nil, nil, donorStore, nil, nil, nil, "", nil)(testLpaAppData, w, r, nil, nil)
 	assert.Equal(t, expectedError, err)
 }
 
@@ -113,7 +113,7 @@ func TestGetDonorAccessWhenAccessCodeStoreErrors(t *testing.T) {
 		GetDonorAccess(r.Context()).
 		Return(supporterdata.LpaLink{}, expectedError)
 
-	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, "", nil)(testLpaAppData, w, r, nil, nil)
+	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, nil, "", nil)(testLpaAppData, w, r, nil, nil)
 	assert.Equal(t, expectedError, err)
 }
 
@@ -175,7 +175,7 @@ func TestPostDonorAccess(t *testing.T) {
 		Return("Translation")
 	testLpaAppData.Localizer = localizer
 
-	err := DonorAccess(nil, nil, donorStore, accessCodeStore, notifyClient, "http://whatever/start", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{PK: dynamo.OrganisationKey("org-id"), ID: "org-id", Name: "Helpers"}, &supporterdata.Member{FirstNames: "John", LastName: "Smith"})
+	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, notifyClient, "http://whatever/start", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{PK: dynamo.OrganisationKey("org-id"), ID: "org-id", Name: "Helpers"}, &supporterdata.Member{FirstNames: "John", LastName: "Smith"})
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -203,7 +203,7 @@ func TestPostDonorAccessWhenDonorUpdateErrors(t *testing.T) {
 		GetDonorAccess(r.Context()).
 		Return(supporterdata.LpaLink{}, dynamo.NotFoundError{})
 
-	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, "", nil)(testLpaAppData, w, r, &supporterdata.Organisation{ID: "org-id"}, nil)
+	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, nil, "", nil)(testLpaAppData, w, r, &supporterdata.Organisation{ID: "org-id"}, nil)
 	assert.Equal(t, expectedError, err)
 }
 
@@ -227,7 +227,7 @@ func TestPostDonorAccessWhenAccessCodeStoreErrors(t *testing.T) {
 		PutDonorAccess(r.Context(), mock.Anything, mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, "", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{ID: "org-id"}, nil)
+	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, nil, "", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{ID: "org-id"}, nil)
 	assert.Equal(t, expectedError, err)
 }
 
@@ -262,7 +262,7 @@ func TestPostDonorAccessWhenNotifyErrors(t *testing.T) {
 		Return("Translation")
 	testLpaAppData.Localizer = localizer
 
-	err := DonorAccess(nil, nil, donorStore, accessCodeStore, notifyClient, "", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{ID: "org-id"}, &supporterdata.Member{})
+	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, notifyClient, "", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{ID: "org-id"}, &supporterdata.Member{})
 	assert.Equal(t, expectedError, err)
 }
 
@@ -290,7 +290,7 @@ func TestPostDonorAccessWhenValidationError(t *testing.T) {
 		})).
 		Return(nil)
 
-	err := DonorAccess(nil, template.Execute, donorStore, accessCodeStore, nil, "", nil)(testLpaAppData, w, r, &supporterdata.Organisation{ID: "org-id"}, nil)
+	err := DonorAccess(nil, template.Execute, donorStore, accessCodeStore, nil, nil, "", nil)(testLpaAppData, w, r, &supporterdata.Organisation{ID: "org-id"}, nil)
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -319,7 +319,16 @@ func TestPostDonorAccessRecall(t *testing.T) {
 		DeleteDonorAccess(r.Context(), supporterLink).
 		Return(nil)
 
-	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, "http://whatever", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		Create(r.Context(), supporterdata.AuditEvent{
+			Action:  supporterdata.AuditActionDonorAccessRecalled,
+			Subject: "email@example.com",
+			LpaID:   "lpa-id",
+		}).
+		Return(nil)
+
+	err := DonorAccess(nil, nil, donorStore, accessCodeStore, auditLogStore, nil, "http://whatever", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -349,7 +358,36 @@ func TestPostDonorAccessRecallWhenDeleteErrors(t *testing.T) {
 		DeleteDonorAccess(r.Context(), supporterLink).
 		Return(expectedError)
 
-	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, "http://whatever", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, nil, "http://whatever", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+	assert.Equal(t, expectedError, err)
+}
+
+func TestPostDonorAccessRecallWhenAuditLogStoreErrors(t *testing.T) {
+	form := url.Values{"action": {"recall"}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(r.Context()).
+		Return(&donordata.Provided{}, nil)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		GetDonorAccess(r.Context()).
+		Return(supporterdata.LpaLink{}, nil)
+	accessCodeStore.EXPECT().
+		DeleteDonorAccess(r.Context(), mock.Anything).
+		Return(nil)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		Create(r.Context(), mock.Anything).
+		Return(expectedError)
+
+	err := DonorAccess(nil, nil, donorStore, accessCodeStore, auditLogStore, nil, "http://whatever", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
 	assert.Equal(t, expectedError, err)
 }
 
@@ -425,7 +463,16 @@ func TestPostDonorAccessRemove(t *testing.T) {
 	logger.EXPECT().
 		InfoContext(r.Context(), "donor access removed", slog.String("lpa_id", "lpa-id"))
 
-	err := DonorAccess(logger, nil, donorStore, accessCodeStore, nil, "http://whatever", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		Create(r.Context(), supporterdata.AuditEvent{
+			Action:  supporterdata.AuditActionDonorAccessRemoved,
+			Subject: "email@example.com",
+			LpaID:   "lpa-id",
+		}).
+		Return(nil)
+
+	err := DonorAccess(logger, nil, donorStore, accessCodeStore, auditLogStore, nil, "http://whatever", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -456,7 +503,7 @@ func TestPostDonorAccessRemoveWhenDonorHasPaid(t *testing.T) {
 		Get(r.Context()).
 		Return(donor, nil)
 
-	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, "http://whatever", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, nil, "http://whatever", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
 	resp := w.Result()
 
 	assert.Error(t, err)
@@ -485,9 +532,43 @@ func TestPostDonorAccessRemoveWhenDeleteLinkError(t *testing.T) {
 		DeleteDonorAccess(mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, "http://whatever", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+	err := DonorAccess(nil, nil, donorStore, accessCodeStore, nil, nil, "http://whatever", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
 	resp := w.Result()
 
 	assert.Equal(t, expectedError, err)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func TestPostDonorAccessRemoveWhenAuditLogStoreErrors(t *testing.T) {
+	form := url.Values{"action": {"remove"}}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	accessCodeStore := newMockAccessCodeStore(t)
+	accessCodeStore.EXPECT().
+		GetDonorAccess(mock.Anything).
+		Return(supporterdata.LpaLink{}, nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(mock.Anything).
+		Return(&donordata.Provided{}, nil)
+	donorStore.EXPECT().
+		DeleteDonorAccess(mock.Anything, mock.Anything).
+		Return(nil)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		InfoContext(mock.Anything, mock.Anything, mock.Anything)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	err := DonorAccess(logger, nil, donorStore, accessCodeStore, auditLogStore, nil, "http://whatever", testGenerateFn)(testLpaAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+
+	assert.Equal(t, expectedError, err)
+}
@@ -14,14 +14,15 @@ import (
 )
 
 type editMemberData struct {
-	App        appcontext.Data
-	Errors     validation.List
-	Form       *editMemberForm
-	Member     *supporterdata.Member
-	CanEditAll bool
+	App           appcontext.Data
+	Errors        validation.List
+	Form          *editMemberForm
+	Member        *supporterdata.Member
+	CanEditAll    bool
+	CanGrantAdmin bool
 }
 
-func EditMember(logger Logger, tmpl template.Template, memberStore MemberStore) Handler {
+func EditMember(logger Logger, tmpl template.Template, memberStore MemberStore, auditLogStore AuditLogStore) Handler {
 	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, organisation *supporterdata.Organisation, member *supporterdata.Member) error {
 		memberID := r.FormValue("id")
 		isLoggedInMember := member.ID == memberID
@@ -34,7 +35,10 @@ func EditMember(logger Logger, tmpl template.Template, memberStore MemberStore)
 			member = memberByID
 		}
 
-		canEditAll := appData.IsAdmin() && !isLoggedInMember
+		// Members who can manage other members cannot edit themselves, and only
+		// admins can edit other admins.
+		canEditAll := appData.Can(supporterdata.CapabilityManageMembers) && !isLoggedInMember &&
+			(appData.IsAdmin() || !member.Permission.IsAdmin())
 
 		data := &editMemberData{
 			App: appData,
@@ -46,17 +50,19 @@ func EditMember(logger Logger, tmpl template.Template, memberStore MemberStore)
 				Status:            member.Status,
 				StatusOptions:     supporterdata.StatusValues,
 			},
-			Member:     member,
-			CanEditAll: canEditAll,
+			Member:        member,
+			CanEditAll:    canEditAll,
+			CanGrantAdmin: appData.IsAdmin(),
 		}
 
 		if r.Method == http.MethodPost {
-			data.Form = readEditMemberForm(r, canEditAll)
+			data.Form = readEditMemberForm(r, canEditAll, data.CanGrantAdmin)
 			data.Errors = data.Form.Validate()
 
 			if data.Errors.None() {
 				query := url.Values{}
 				changed := false
+				var events []supporterdata.AuditEvent
 
 				if data.Form.FirstNames != member.FirstNames || data.Form.LastName != member.LastName {
 					changed = true
@@ -74,12 +80,24 @@ func EditMember(logger Logger, tmpl template.Template, memberStore MemberStore)
 					if data.Form.Permission != member.Permission {
 						changed = true
 						logger.InfoContext(r.Context(), "member permission changed", slog.String("member_id", member.ID), slog.String("permission_old", member.Permission.String()), slog.String("permission_new", data.Form.Permission.String()))
+						events = append(events, supporterdata.AuditEvent{
+							Action:   supporterdata.AuditActionMemberPermissionChanged,
+							Subject:  member.Email,
+							Previous: member.Permission.String(),
+							Current:  data.Form.Permission.String(),
+						})
 						member.Permission = data.Form.Permission
 					}
 
 					if data.Form.Status != member.Status {
 						changed = true
 						logger.InfoContext(r.Context(), "member status changed", slog.String("member_id", member.ID), slog.String("status_old", member.Status.String()), slog.String("status_new", data.Form.Status.String()))
+						events = append(events, supporterdata.AuditEvent{
+							Action:   supporterdata.AuditActionMemberStatusChanged,
+							Subject:  member.Email,
+							Previous: member.Status.String(),
+							Current:  data.Form.Status.String(),
+						})
 						query.Add("statusUpdated", data.Form.Status.String())
 						query.Add("statusEmail", member.Email)
 						member.Status = data.Form.Status
@@ -92,8 +110,14 @@ func EditMember(logger Logger, tmpl template.Template, memberStore MemberStore)
 					}
 				}
 
+				for _, event := range events {
+					if err := auditLogStore.Create(r.Context(), event); err != nil {
+						return err
+					}
+				}
+
 				redirect := supporter.PathDashboard
-				if appData.IsAdmin() {
+				if appData.Can(supporterdata.CapabilityManageMembers) {
 					redirect = supporter.PathManageTeamMembers
 				}
 
@@ -115,13 +139,15 @@ type editMemberForm struct {
 	StatusOptions     supporterdata.StatusOptions
 	StatusError       error
 	canEditAll        bool
+	canGrantAdmin     bool
 }
 
-func readEditMemberForm(r *http.Request, canEditAll bool) *editMemberForm {
+func readEditMemberForm(r *http.Request, canEditAll, canGrantAdmin bool) *editMemberForm {
 	f := &editMemberForm{
-		FirstNames: page.PostFormString(r, "first-names"),
-		LastName:   page.PostFormString(r, "last-name"),
-		canEditAll: canEditAll,
+		FirstNames:    page.PostFormString(r, "first-names"),
+		LastName:      page.PostFormString(r, "last-name"),
+		canEditAll:    canEditAll,
+		canGrantAdmin: canGrantAdmin,
 	}
 
 	if canEditAll {
@@ -144,8 +170,8 @@ func (f *editMemberForm) Validate() validation.List {
 		validation.StringTooLong(61))
 
 	if f.canEditAll {
-		errors.Options("permission", "makeThisPersonAnAdmin", []string{f.Permission.String()},
-			validation.Select(supporterdata.PermissionNone.String(), supporterdata.PermissionAdmin.String()))
+		errors.Options("permission", "theirRole", []string{f.Permission.String()},
+			validation.Select(assignablePermissions(f.canGrantAdmin)...))
 
 		errors.Error("status", "status", f.StatusError,
 			validation.Selected())
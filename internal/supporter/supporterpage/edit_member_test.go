@@ -50,7 +50,7 @@ func TestGetEditMember(t *testing.T) {
 		}).
 		Return(nil)
 
-	err := EditMember(nil, template.Execute, memberStore)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+	err := EditMember(nil, template.Execute, memberStore, nil)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -66,7 +66,7 @@ func TestGetEditMemberWhenMemberStoreError(t *testing.T) {
 		GetByID(r.Context(), mock.Anything).
 		Return(nil, expectedError)
 
-	err := EditMember(nil, nil, memberStore)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+	err := EditMember(nil, nil, memberStore, nil)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
 	resp := w.Result()
 
 	assert.Equal(t, expectedError, err)
@@ -82,7 +82,7 @@ func TestGetEditMemberWhenTemplateError(t *testing.T) {
 		Execute(w, mock.Anything).
 		Return(expectedError)
 
-	err := EditMember(nil, template.Execute, nil)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{ID: "an-id"})
+	err := EditMember(nil, template.Execute, nil, nil)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{ID: "an-id"})
 	resp := w.Result()
 
 	assert.Equal(t, expectedError, err)
@@ -155,7 +155,7 @@ func TestPostEditMember(t *testing.T) {
 				Put(r.Context(), tc.expectedMember).
 				Return(nil)
 
-			err := EditMember(nil, nil, memberStore)(appcontext.Data{
+			err := EditMember(nil, nil, memberStore, nil)(appcontext.Data{
 				LoginSessionEmail: "self@example.org",
 				SupporterData: &appcontext.SupporterData{
 					Permission: tc.userPermission,
@@ -210,7 +210,25 @@ func TestPostEditMemberWhenOtherMember(t *testing.T) {
 	logger.EXPECT().
 		InfoContext(r.Context(), "member permission changed", slog.String("member_id", "member-id"), slog.String("permission_old", "none"), slog.String("permission_new", "admin"))
 
-	err := EditMember(logger, nil, memberStore)(appcontext.Data{
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		Create(r.Context(), supporterdata.AuditEvent{
+			Action:   supporterdata.AuditActionMemberPermissionChanged,
+			Subject:  "team-member@example.org",
+			Previous: "none",
+			Current:  "admin",
+		}).
+		Return(nil)
+	auditLogStore.EXPECT().
+		Create(r.Context(), supporterdata.AuditEvent{
+			Action:   supporterdata.AuditActionMemberStatusChanged,
+			Subject:  "team-member@example.org",
+			Previous: "active",
+			Current:  "suspended",
+		}).
+		Return(nil)
+
+	err := EditMember(logger, nil, memberStore, auditLogStore)(appcontext.Data{
 		LoginSessionEmail: "self@example.org",
 		SupporterData: &appcontext.SupporterData{
 			Permission: supporterdata.PermissionAdmin,
@@ -254,7 +272,7 @@ func TestPostEditMemberNoUpdate(t *testing.T) {
 			r, _ := http.NewRequest(http.MethodPost, "/?id=an-id", strings.NewReader(form.Encode()))
 			r.Header.Add("Content-Type", page.FormUrlEncoded)
 
-			err := EditMember(nil, nil, nil)(appcontext.Data{
+			err := EditMember(nil, nil, nil, nil)(appcontext.Data{
 				LoginSessionEmail: "self@example.org",
 				SupporterData: &appcontext.SupporterData{
 					Permission: tc.userPermission,
@@ -299,7 +317,7 @@ func TestPostEditMemberNoUpdateWhenOtherMember(t *testing.T) {
 			Permission: supporterdata.PermissionAdmin,
 		}, nil)
 
-	err := EditMember(nil, nil, memberStore)(appcontext.Data{
+	err := EditMember(nil, nil, memberStore, nil)(appcontext.Data{
 		LoginSessionEmail: "self@example.org",
 		SupporterData: &appcontext.SupporterData{
 			Permission: supporterdata.PermissionAdmin,
@@ -331,13 +349,51 @@ func TestPostEditMemberWhenOrganisationStorePutError(t *testing.T) {
 		Put(mock.Anything, mock.Anything).
 		Return(expectedError)
 
-	err := EditMember(nil, nil, memberStore)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+	err := EditMember(nil, nil, memberStore, nil)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
 	resp := w.Result()
 
 	assert.Equal(t, expectedError, err)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
 
+func TestPostEditMemberWhenAuditLogStoreError(t *testing.T) {
+	form := url.Values{
+		"first-names": {"a"},
+		"last-name":   {"b"},
+		"status":      {"suspended"},
+		"permission":  {"none"},
+	}
+
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodPost, "/?id=an-id", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetByID(mock.Anything, mock.Anything).
+		Return(&supporterdata.Member{FirstNames: "a", LastName: "b"}, nil)
+	memberStore.EXPECT().
+		Put(mock.Anything, mock.Anything).
+		Return(nil)
+
+	logger := newMockLogger(t)
+	logger.EXPECT().
+		InfoContext(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	err := EditMember(logger, nil, memberStore, auditLogStore)(appcontext.Data{
+		SupporterData: &appcontext.SupporterData{
+			Permission: supporterdata.PermissionAdmin,
+		},
+	}, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+
+	assert.Equal(t, expectedError, err)
+}
+
 func TestPostEditMemberWhenValidationError(t *testing.T) {
 	form := url.Values{
 		"first-names": {""},
@@ -372,7 +428,7 @@ func TestPostEditMemberWhenValidationError(t *testing.T) {
 		}).
 		Return(nil)
 
-	err := EditMember(nil, template.Execute, memberStore)(testAppData, w, r, nil, &supporterdata.Member{})
+	err := EditMember(nil, template.Execute, memberStore, nil)(testAppData, w, r, nil, &supporterdata.Member{})
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -407,7 +463,7 @@ func TestReadEditMemberForm(t *testing.T) {
 			r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(tc.form.Encode()))
 			r.Header.Add("Content-Type", page.FormUrlEncoded)
 
-			result := readEditMemberForm(r, tc.canEditAll)
+			result := readEditMemberForm(r, tc.canEditAll, false)
 
 			assert.Equal(t, "a", result.FirstNames)
 			assert.Equal(t, "b", result.LastName)
@@ -462,6 +518,35 @@ func TestEditMemberFormValidate(t *testing.T) {
 			},
 			errors: validation.With("status", validation.SelectError{Label: "status"}),
 		},
+		"case handler": {
+			form: &editMemberForm{
+				FirstNames: "a",
+				LastName:   "b",
+				Permission: supporterdata.PermissionCaseHandler,
+				Status:     supporterdata.StatusActive,
+				canEditAll: true,
+			},
+		},
+		"cannot grant admin": {
+			form: &editMemberForm{
+				FirstNames: "a",
+				LastName:   "b",
+				Permission: supporterdata.PermissionAdmin,
+				Status:     supporterdata.StatusActive,
+				canEditAll: true,
+			},
+			errors: validation.With("permission", validation.SelectError{Label: "theirRole"}),
+		},
+		"can grant admin": {
+			form: &editMemberForm{
+				FirstNames:    "a",
+				LastName:      "b",
+				Permission:    supporterdata.PermissionAdmin,
+				Status:        supporterdata.StatusActive,
+				canEditAll:    true,
+				canGrantAdmin: true,
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -17,7 +17,7 @@ type editOrganisationNameData struct {
 	Form   *organisationNameForm
 }
 
-func EditOrganisationName(tmpl template.Template, organisationStore OrganisationStore) Handler {
+func EditOrganisationName(tmpl template.Template, organisationStore OrganisationStore, auditLogStore AuditLogStore) Handler {
 	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, organisation *supporterdata.Organisation, _ *supporterdata.Member) error {
 		data := &editOrganisationNameData{
 			App: appData,
@@ -31,11 +31,21 @@ func EditOrganisationName(tmpl template.Template, organisationStore Organisation
 			data.Errors = data.Form.Validate()
 
 			if data.Errors.None() {
+				event := supporterdata.AuditEvent{
+					Action:   supporterdata.AuditActionOrganisationRenamed,
+					Previous: organisation.Name,
+					Current:  data.Form.Name,
+				}
+
 				organisation.Name = data.Form.Name
 				if err := organisationStore.Put(r.Context(), organisation); err != nil {
 					return err
 				}
 
+				if err := auditLogStore.Create(r.Context(), event); err != nil {
+					return err
+				}
+
 				return supporter.PathOrganisationDetails.RedirectQuery(w, r, appData, url.Values{"updated": {"name"}})
 			}
 		}
@@ -29,7 +29,7 @@ func TestGetEditOrganisationName(t *testing.T) {
 		}).
 		Return(nil)
 
-	err := EditOrganisationName(template.Execute, nil)(testAppData, w, r, organisation, nil)
+	err := EditOrganisationName(template.Execute, nil, nil)(testAppData, w, r, organisation, nil)
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -45,7 +45,7 @@ func TestGetEditOrganisationNameWhenTemplateErrors(t *testing.T) {
 		Execute(w, mock.Anything).
 		Return(expectedError)
 
-	err := EditOrganisationName(template.Execute, nil)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+	err := EditOrganisationName(template.Execute, nil, nil)(testAppData, w, r, &supporterdata.Organisation{}, nil)
 	resp := w.Result()
 
 	assert.Equal(t, expectedError, err)
@@ -64,7 +64,16 @@ func TestPostEditOrganisationName(t *testing.T) {
 		Put(r.Context(), &supporterdata.Organisation{PK: "ORG", Name: "My organisation"}).
 		Return(nil)
 
-	err := EditOrganisationName(nil, organisationStore)(testAppData, w, r, &supporterdata.Organisation{PK: "ORG"}, nil)
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		Create(r.Context(), supporterdata.AuditEvent{
+			Action:   supporterdata.AuditActionOrganisationRenamed,
+			Previous: "Old name",
+			Current:  "My organisation",
+		}).
+		Return(nil)
+
+	err := EditOrganisationName(nil, organisationStore, auditLogStore)(testAppData, w, r, &supporterdata.Organisation{PK: "ORG", Name: "Old name"}, nil)
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -90,7 +99,7 @@ func TestPostEditOrganisationNameWhenValidationError(t *testing.T) {
 		})).
 		Return(nil)
 
-	err := EditOrganisationName(template.Execute, nil)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+	err := EditOrganisationName(template.Execute, nil, nil)(testAppData, w, r, &supporterdata.Organisation{}, nil)
 	resp := w.Result()
 
 	assert.Nil(t, err)
@@ -112,9 +121,34 @@ func TestPostEditOrganisationNameWhenOrganisationStoreErrors(t *testing.T) {
 		Put(r.Context(), mock.Anything).
 		Return(expectedError)
 
-	err := EditOrganisationName(nil, organisationStore)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+	err := EditOrganisationName(nil, organisationStore, nil)(testAppData, w, r, &supporterdata.Organisation{}, nil)
 	resp := w.Result()
 
 	assert.Equal(t, expectedError, err)
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 }
+
+func TestPostEditOrganisationNameWhenAuditLogStoreErrors(t *testing.T) {
+	form := url.Values{
+		"name": {"My name"},
+	}
+
+	w := httptest.NewRecorder()
+
+	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
+	r.Header.Add("Content-Type", page.FormUrlEncoded)
+
+	organisationStore := newMockOrganisationStore(t)
+	organisationStore.EXPECT().
+		Put(mock.Anything, mock.Anything).
+		Return(nil)
+
+	auditLogStore := newMockAuditLogStore(t)
+	auditLogStore.EXPECT().
+		Create(mock.Anything, mock.Anything).
+		Return(expectedError)
+
+	err := EditOrganisationName(nil, organisationStore, auditLogStore)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+
+	assert.Equal(t, expectedError, err)
+}
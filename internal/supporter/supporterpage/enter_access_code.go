@@ -59,6 +59,7 @@ func EnterAccessCode(logger Logger, tmpl template.Template, memberStore MemberSt
 
 				loginSession.OrganisationID = invite.OrganisationID
 				loginSession.OrganisationName = invite.OrganisationName
+				loginSession.Permission = invite.Permission
 
 				logger.InfoContext(r.Context(), "member invite redeemed", slog.String("organisation_id", loginSession.OrganisationID))
 
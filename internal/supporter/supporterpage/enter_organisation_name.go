@@ -47,6 +47,8 @@ func EnterOrganisationName(logger Logger, tmpl template.Template, organisationSt
 
 				loginSession.OrganisationID = organisation.ID
 				loginSession.OrganisationName = organisation.Name
+				loginSession.Permission = member.Permission
+				loginSession.MemberID = member.ID
 				if err := sessionStore.SetLogin(r, w, loginSession); err != nil {
 					return err
 				}
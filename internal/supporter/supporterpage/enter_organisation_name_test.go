@@ -86,6 +86,7 @@ func TestPostEnterOrganisationName(t *testing.T) {
 			Email:            "name@example.com",
 			OrganisationID:   "org-id",
 			OrganisationName: "My organisation",
+			MemberID:         "a",
 		}).
 		Return(nil)
 
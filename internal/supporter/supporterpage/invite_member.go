@@ -1,6 +1,7 @@
 package supporterpage
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 
@@ -16,45 +17,28 @@ import (
 )
 
 type inviteMemberData struct {
-	App     appcontext.Data
-	Errors  validation.List
-	Form    *inviteMemberForm
-	Options supporterdata.PermissionOptions
+	App           appcontext.Data
+	Errors        validation.List
+	Form          *inviteMemberForm
+	Options       supporterdata.PermissionOptions
+	CanGrantAdmin bool
 }
 
 func InviteMember(tmpl template.Template, memberStore MemberStore, notifyClient NotifyClient, generate invitecode.Generator, appPublicURL string) Handler {
 	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, organisation *supporterdata.Organisation, _ *supporterdata.Member) error {
 		data := &inviteMemberData{
-			App:     appData,
-			Form:    &inviteMemberForm{},
-			Options: supporterdata.PermissionValues,
+			App:           appData,
+			Form:          &inviteMemberForm{},
+			Options:       supporterdata.PermissionValues,
+			CanGrantAdmin: appData.IsAdmin(),
 		}
 
 		if r.Method == http.MethodPost {
-			data.Form = readInviteMemberForm(r)
+			data.Form = readInviteMemberForm(r, data.CanGrantAdmin)
 			data.Errors = data.Form.Validate()
 
 			if data.Errors.None() {
-				plainCode, hashedCode := generate()
-
-				if err := memberStore.CreateMemberInvite(
-					r.Context(),
-					organisation,
-					data.Form.FirstNames,
-					data.Form.LastName,
-					data.Form.Email,
-					hashedCode,
-					data.Form.Permission,
-				); err != nil {
-					return err
-				}
-
-				if err := notifyClient.SendEmail(r.Context(), notify.ToCustomEmail(localize.En, data.Form.Email), notify.OrganisationMemberInviteEmail{
-					OrganisationName:      organisation.Name,
-					InviterEmail:          appData.LoginSessionEmail,
-					InviteCode:            plainCode.Plain(),
-					JoinAnOrganisationURL: appPublicURL + page.PathSupporterStart.Format(),
-				}); err != nil {
+				if err := sendMemberInvite(r.Context(), appData, organisation, data.Form, memberStore, notifyClient, generate, appPublicURL); err != nil {
 					return err
 				}
 
@@ -66,18 +50,45 @@ func InviteMember(tmpl template.Template, memberStore MemberStore, notifyClient
 	}
 }
 
+// sendMemberInvite creates the invite described by form and emails the code to
+// the invited member.
+func sendMemberInvite(ctx context.Context, appData appcontext.Data, organisation *supporterdata.Organisation, form *inviteMemberForm, memberStore MemberStore, notifyClient NotifyClient, generate invitecode.Generator, appPublicURL string) error {
+	plainCode, hashedCode := generate()
+
+	if err := memberStore.CreateMemberInvite(
+		ctx,
+		organisation,
+		form.FirstNames,
+		form.LastName,
+		form.Email,
+		hashedCode,
+		form.Permission,
+	); err != nil {
+		return err
+	}
+
+	return notifyClient.SendEmail(ctx, notify.ToCustomEmail(localize.En, form.Email), notify.OrganisationMemberInviteEmail{
+		OrganisationName:      organisation.Name,
+		InviterEmail:          appData.LoginSessionEmail,
+		InviteCode:            plainCode.Plain(),
+		JoinAnOrganisationURL: appPublicURL + page.PathSupporterStart.Format(),
+	})
+}
+
 type inviteMemberForm struct {
-	FirstNames string
-	LastName   string
-	Email      string
-	Permission supporterdata.Permission
+	FirstNames    string
+	LastName      string
+	Email         string
+	Permission    supporterdata.Permission
+	canGrantAdmin bool
 }
 
-func readInviteMemberForm(r *http.Request) *inviteMemberForm {
+func readInviteMemberForm(r *http.Request, canGrantAdmin bool) *inviteMemberForm {
 	form := &inviteMemberForm{
-		Email:      page.PostFormString(r, "email"),
-		FirstNames: page.PostFormString(r, "first-names"),
-		LastName:   page.PostFormString(r, "last-name"),
+		Email:         page.PostFormString(r, "email"),
+		FirstNames:    page.PostFormString(r, "first-names"),
+		LastName:      page.PostFormString(r, "last-name"),
+		canGrantAdmin: canGrantAdmin,
 	}
 
 	form.Permission, _ = supporterdata.ParsePermission(page.PostFormString(r, "permission"))
@@ -100,7 +111,7 @@ func (f *inviteMemberForm) Validate() validation.List {
 		validation.Empty(),
 		validation.Email())
 
-	errors.Options("permission", "makeThisPersonAnAdmin", []string{f.Permission.String()}, validation.Select(supporterdata.PermissionNone.String(), supporterdata.PermissionAdmin.String()))
+	errors.Options("permission", "theirRole", []string{f.Permission.String()}, validation.Select(assignablePermissions(f.canGrantAdmin)...))
 
 	return errors
 }
@@ -58,7 +58,7 @@ func TestPostInviteMember(t *testing.T) {
 		"email":       {"email@example.com"},
 		"first-names": {"a"},
 		"last-name":   {"b"},
-		"permission":  {"admin"},
+		"permission":  {"team-manager"},
 	}
 
 	w := httptest.NewRecorder()
@@ -69,7 +69,7 @@ func TestPostInviteMember(t *testing.T) {
 
 	memberStore := newMockMemberStore(t)
 	memberStore.EXPECT().
-		CreateMemberInvite(r.Context(), organisation, "a", "b", "email@example.com", testInviteHashedCode, supporterdata.PermissionAdmin).
+		CreateMemberInvite(r.Context(), organisation, "a", "b", "email@example.com", testInviteHashedCode, supporterdata.PermissionTeamManager).
 		Return(nil)
 
 	notifyClient := newMockNotifyClient(t)
@@ -95,7 +95,7 @@ func TestPostInviteMemberWhenValidationError(t *testing.T) {
 		"email":       {"what"},
 		"first-names": {"a"},
 		"last-name":   {"b"},
-		"permission":  {"admin"},
+		"permission":  {"team-manager"},
 	}
 
 	w := httptest.NewRecorder()
@@ -111,7 +111,7 @@ func TestPostInviteMemberWhenValidationError(t *testing.T) {
 				FirstNames: "a",
 				LastName:   "b",
 				Email:      "what",
-				Permission: supporterdata.PermissionAdmin,
+				Permission: supporterdata.PermissionTeamManager,
 			},
 			Options: supporterdata.PermissionValues,
 		}).
@@ -150,7 +150,7 @@ func TestPostInviteMemberWhenNotifySendErrors(t *testing.T) {
 		"email":       {"email@example.com"},
 		"first-names": {"a"},
 		"last-name":   {"b"},
-		"permission":  {"admin"},
+		"permission":  {"team-manager"},
 	}
 
 	w := httptest.NewRecorder()
@@ -176,18 +176,18 @@ func TestReadInviteMemberForm(t *testing.T) {
 		"email":       {"email@example.com"},
 		"first-names": {"a"},
 		"last-name":   {"b"},
-		"permission":  {"admin"},
+		"permission":  {"team-manager"},
 	}
 
 	r, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(form.Encode()))
 	r.Header.Add("Content-Type", page.FormUrlEncoded)
 
-	result := readInviteMemberForm(r)
+	result := readInviteMemberForm(r, true)
 
 	assert.Equal(t, "email@example.com", result.Email)
 	assert.Equal(t, "a", result.FirstNames)
 	assert.Equal(t, "b", result.LastName)
-	assert.Equal(t, supporterdata.PermissionAdmin, result.Permission)
+	assert.Equal(t, supporterdata.PermissionTeamManager, result.Permission)
 }
 
 func TestInviteMemberFormValidate(t *testing.T) {
@@ -238,7 +238,26 @@ func TestInviteMemberFormValidate(t *testing.T) {
 				Permission: supporterdata.Permission(99),
 			},
 			errors: validation.
-				With("permission", validation.SelectError{Label: "makeThisPersonAnAdmin"}),
+				With("permission", validation.SelectError{Label: "theirRole"}),
+		},
+		"valid admin": {
+			form: &inviteMemberForm{
+				Email:         "email@example.com",
+				FirstNames:    "a",
+				LastName:      "b",
+				Permission:    supporterdata.PermissionAdmin,
+				canGrantAdmin: true,
+			},
+		},
+		"cannot grant admin": {
+			form: &inviteMemberForm{
+				Email:      "email@example.com",
+				FirstNames: "a",
+				LastName:   "b",
+				Permission: supporterdata.PermissionAdmin,
+			},
+			errors: validation.
+				With("permission", validation.SelectError{Label: "theirRole"}),
 		},
 	}
 
@@ -88,6 +88,8 @@ func LoginCallback(logger Logger, oneLoginClient LoginCallbackOneLoginClient, se
 
 		loginSession.OrganisationID = organisation.ID
 		loginSession.OrganisationName = organisation.Name
+		loginSession.Permission = member.Permission
+		loginSession.MemberID = member.ID
 		if err := sessionStore.SetLogin(r, w, loginSession); err != nil {
 			return err
 		}
@@ -33,7 +33,7 @@ func ManageTeamMembers(tmpl template.Template, memberStore MemberStore, generate
 		}
 
 		if r.Method == http.MethodPost {
-			data.Form = readInviteMemberForm(r)
+			data.Form = readInviteMemberForm(r, appData.IsAdmin())
 			data.Errors = data.Form.Validate()
 
 			if data.Errors.None() {
@@ -123,7 +123,7 @@ func TestPostManageTeamMembers(t *testing.T) {
 		"email":       {"email@example.com"},
 		"first-names": {"a"},
 		"last-name":   {"b"},
-		"permission":  {"admin"},
+		"permission":  {"team-manager"},
 	}
 
 	w := httptest.NewRecorder()
@@ -137,7 +137,7 @@ func TestPostManageTeamMembers(t *testing.T) {
 		DeleteMemberInvite(r.Context(), organisation.ID, "email@example.com").
 		Return(nil)
 	memberStore.EXPECT().
-		CreateMemberInvite(r.Context(), organisation, "a", "b", "email@example.com", testInviteHashedCode, supporterdata.PermissionAdmin).
+		CreateMemberInvite(r.Context(), organisation, "a", "b", "email@example.com", testInviteHashedCode, supporterdata.PermissionTeamManager).
 		Return(nil)
 
 	notifyClient := newMockNotifyClient(t)
@@ -164,7 +164,7 @@ func TestPostManageTeamMembersWhenValidationErrors(t *testing.T) {
 		"email":       {"not an email"},
 		"first-names": {"a"},
 		"last-name":   {"b"},
-		"permission":  {"admin"},
+		"permission":  {"team-manager"},
 	}
 
 	w := httptest.NewRecorder()
@@ -198,7 +198,7 @@ func TestPostManageTeamMembersWhenMemberStoreErrors(t *testing.T) {
 				"email":       {"email@example.com"},
 				"first-names": {"a"},
 				"last-name":   {"b"},
-				"permission":  {"admin"},
+				"permission":  {"team-manager"},
 			}
 
 			w := httptest.NewRecorder()
@@ -231,7 +231,7 @@ func TestPostManageTeamMembersWhenNotifyClientError(t *testing.T) {
 		"email":       {"email@example.com"},
 		"first-names": {"a"},
 		"last-name":   {"b"},
-		"permission":  {"admin"},
+		"permission":  {"team-manager"},
 	}
 
 	w := httptest.NewRecorder()
@@ -21,6 +21,51 @@ func (_m *mockLogger) EXPECT() *mockLogger_Expecter {
 	return &mockLogger_Expecter{mock: &_m.Mock}
 }
 
+// ErrorContext provides a mock function with given fields: ctx, msg, args
+func (_m *mockLogger) ErrorContext(ctx context.Context, msg string, args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, msg)
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// mockLogger_ErrorContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ErrorContext'
+type mockLogger_ErrorContext_Call struct {
+	*mock.Call
+}
+
+// ErrorContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg string
+//   - args ...interface{}
+func (_e *mockLogger_Expecter) ErrorContext(ctx interface{}, msg interface{}, args ...interface{}) *mockLogger_ErrorContext_Call {
+	return &mockLogger_ErrorContext_Call{Call: _e.mock.On("ErrorContext",
+		append([]interface{}{ctx, msg}, args...)...)}
+}
+
+func (_c *mockLogger_ErrorContext_Call) Run(run func(ctx context.Context, msg string, args ...interface{})) *mockLogger_ErrorContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *mockLogger_ErrorContext_Call) Return() *mockLogger_ErrorContext_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *mockLogger_ErrorContext_Call) RunAndReturn(run func(context.Context, string, ...interface{})) *mockLogger_ErrorContext_Call {
+	_c.Run(run)
+	return _c
+}
+
 // InfoContext provides a mock function with given fields: ctx, msg, args
 func (_m *mockLogger) InfoContext(ctx context.Context, msg string, args ...interface{}) {
 	var _ca []interface{}
@@ -84,9 +84,9 @@ func (_c *mockOrganisationStore_Create_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
-// CreateLPA provides a mock function with given fields: ctx
-func (_m *mockOrganisationStore) CreateLPA(ctx context.Context) (*donordata.Provided, error) {
-	ret := _m.Called(ctx)
+// CreateLPA provides a mock function with given fields: ctx, assignedMemberID
+func (_m *mockOrganisationStore) CreateLPA(ctx context.Context, assignedMemberID string) (*donordata.Provided, error) {
+	ret := _m.Called(ctx, assignedMemberID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateLPA")
@@ -94,19 +94,19 @@ func (_m *mockOrganisationStore) CreateLPA(ctx context.Context) (*donordata.Prov
 
 	var r0 *donordata.Provided
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (*donordata.Provided, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*donordata.Provided, error)); ok {
+		return rf(ctx, assignedMemberID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) *donordata.Provided); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *donordata.Provided); ok {
+		r0 = rf(ctx, assignedMemberID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*donordata.Provided)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, assignedMemberID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -121,13 +121,14 @@ type mockOrganisationStore_CreateLPA_Call struct {
 
 // CreateLPA is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *mockOrganisationStore_Expecter) CreateLPA(ctx interface{}) *mockOrganisationStore_CreateLPA_Call {
-	return &mockOrganisationStore_CreateLPA_Call{Call: _e.mock.On("CreateLPA", ctx)}
+//   - assignedMemberID string
+func (_e *mockOrganisationStore_Expecter) CreateLPA(ctx interface{}, assignedMemberID interface{}) *mockOrganisationStore_CreateLPA_Call {
+	return &mockOrganisationStore_CreateLPA_Call{Call: _e.mock.On("CreateLPA", ctx, assignedMemberID)}
 }
 
-func (_c *mockOrganisationStore_CreateLPA_Call) Run(run func(ctx context.Context)) *mockOrganisationStore_CreateLPA_Call {
+func (_c *mockOrganisationStore_CreateLPA_Call) Run(run func(ctx context.Context, assignedMemberID string)) *mockOrganisationStore_CreateLPA_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
@@ -137,7 +138,7 @@ func (_c *mockOrganisationStore_CreateLPA_Call) Return(_a0 *donordata.Provided,
 	return _c
 }
 
-func (_c *mockOrganisationStore_CreateLPA_Call) RunAndReturn(run func(context.Context) (*donordata.Provided, error)) *mockOrganisationStore_CreateLPA_Call {
+func (_c *mockOrganisationStore_CreateLPA_Call) RunAndReturn(run func(context.Context, string) (*donordata.Provided, error)) *mockOrganisationStore_CreateLPA_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -11,7 +11,7 @@ import (
 var (
 	expectedError        = errors.New("err")
 	testAppData          = appcontext.Data{}
-	testLpaAppData       = appcontext.Data{LpaID: "lpa-id"}
+	testLpaAppData       = appcontext.Data{LpaID: "lpa-id", SupporterData: &appcontext.SupporterData{}}
 	testOrgMemberAppData = appcontext.Data{
 		SessionID:         "session-id",
 		Lang:              localize.En,
@@ -0,0 +1,20 @@
+package supporterpage
+
+import "github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
+
+// assignablePermissions returns the permissions that can be given to a member.
+// Only admins can make another member an admin.
+func assignablePermissions(canGrantAdmin bool) []string {
+	permissions := []string{
+		supporterdata.PermissionNone.String(),
+		supporterdata.PermissionCaseHandler.String(),
+		supporterdata.PermissionTeamManager.String(),
+		supporterdata.PermissionAuditor.String(),
+	}
+
+	if canGrantAdmin {
+		permissions = append(permissions, supporterdata.PermissionAdmin.String())
+	}
+
+	return permissions
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ministryofjustice/opg-go-common/template"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/accesscode/accesscodedata"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/actor/actoruid"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/appcontext"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/certificateprovider/certificateproviderdata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
@@ -30,6 +31,7 @@ import (
 
 type Logger interface {
 	InfoContext(ctx context.Context, msg string, args ...any)
+	ErrorContext(ctx context.Context, msg string, args ...any)
 }
 
 type LpaStoreResolvingService interface {
@@ -38,7 +40,7 @@ type LpaStoreResolvingService interface {
 
 type OrganisationStore interface {
 	Create(ctx context.Context, member *supporterdata.Member, name string) (*supporterdata.Organisation, error)
-	CreateLPA(ctx context.Context) (*donordata.Provided, error)
+	CreateLPA(ctx context.Context, assignedMemberID string) (*donordata.Provided, error)
 	Get(ctx context.Context) (*supporterdata.Organisation, error)
 	Put(ctx context.Context, organisation *supporterdata.Organisation) error
 	SoftDelete(ctx context.Context, organisation *supporterdata.Organisation) error
@@ -102,6 +104,20 @@ type AccessCodeStore interface {
 	PutDonorAccess(ctx context.Context, code accesscodedata.Hashed, link accesscodedata.Link, inviteSentTo string) error
 	GetDonorAccess(ctx context.Context) (supporterdata.LpaLink, error)
 	DeleteDonorAccess(ctx context.Context, link supporterdata.LpaLink) error
+	Summaries(ctx context.Context, lpa *lpadata.Lpa) ([]accesscodedata.Summary, error)
+}
+
+type AccessCodeSender interface {
+	ResendAccessCode(ctx context.Context, appData appcontext.Data, lpa *lpadata.Lpa, actorUID actoruid.UID) error
+}
+
+type AuditLogStore interface {
+	Create(ctx context.Context, event supporterdata.AuditEvent) error
+	GetPage(ctx context.Context, action supporterdata.AuditAction, after string) ([]supporterdata.AuditEvent, string, error)
+}
+
+type NotificationStore interface {
+	GetAll(ctx context.Context) ([]notify.Notification, error)
 }
 
 type Template func(w io.Writer, data interface{}) error
@@ -125,11 +141,14 @@ func Register(
 	notifyClient NotifyClient,
 	appPublicURL string,
 	memberStore MemberStore,
-	searchClient *search.Client,
+	searchClient search.Searcher,
 	donorStore DonorStore,
 	accessCodeStore AccessCodeStore,
+	accessCodeSender AccessCodeSender,
 	progressTracker ProgressTracker,
 	lpaStoreResolvingService LpaStoreResolvingService,
+	notificationStore NotificationStore,
+	auditLogStore AuditLogStore,
 	donorStartURL string,
 ) {
 	handleRoot := makeHandle(rootMux, sessionStore, errorHandler)
@@ -158,40 +177,70 @@ func Register(
 	handleWithSupporter(supporter.PathOrganisationCreated, None,
 		Guidance(tmpls.Get("organisation_created.gohtml")))
 	handleWithSupporter(supporter.PathDashboard, None,
-		Dashboard(tmpls.Get("dashboard.gohtml"), donorStore, searchClient))
-	handleWithSupporter(supporter.PathConfirmDonorCanInteractOnline, None,
+		Dashboard(tmpls.Get("dashboard.gohtml"), donorStore, searchClient, memberStore))
+	handleWithSupporter(supporter.PathConfirmDonorCanInteractOnline, RequireCreateLpa,
 		ConfirmDonorCanInteractOnline(tmpls.Get("confirm_donor_can_interact_online.gohtml"), organisationStore))
 	handleWithSupporter(supporter.PathContactOPGForPaperForms, None,
 		Guidance(tmpls.Get("contact_opg_for_paper_forms.gohtml")))
 	handleWithSupporter(supporter.PathViewLPA, None,
-		ViewLPA(tmpls.Get("view_lpa.gohtml"), lpaStoreResolvingService, progressTracker))
-
-	handleWithSupporter(supporter.PathOrganisationDetails, RequireAdmin,
+		ViewLPA(tmpls.Get("view_lpa.gohtml"), lpaStoreResolvingService, progressTracker, donorStore, memberStore))
+	handleWithSupporter(supporter.PathCommunicationsSent, None,
+		CommunicationsSent(tmpls.Get("communications_sent.gohtml"), lpaStoreResolvingService, notificationStore))
+	handleWithSupporter(supporter.PathAccessCodes, None,
+		AccessCodes(tmpls.Get("access_codes.gohtml"), lpaStoreResolvingService, accessCodeStore, accessCodeSender))
+	handleWithSupporter(supporter.PathAssignLPA, CanGoBack|RequireAssignLpas,
+		AssignLPA(tmpls.Get("assign_lpa.gohtml"), donorStore, memberStore, auditLogStore))
+
+	handleWithSupporter(supporter.PathOrganisationDetails, RequireManageOrganisation,
 		Guidance(tmpls.Get("organisation_details.gohtml")))
-	handleWithSupporter(supporter.PathEditOrganisationName, RequireAdmin,
-		EditOrganisationName(tmpls.Get("edit_organisation_name.gohtml"), organisationStore))
-	handleWithSupporter(supporter.PathManageTeamMembers, RequireAdmin,
+	handleWithSupporter(supporter.PathEditOrganisationName, RequireManageOrganisation,
+		EditOrganisationName(tmpls.Get("edit_organisation_name.gohtml"), organisationStore, auditLogStore))
+	handleWithSupporter(supporter.PathManageTeamMembers, RequireManageMembers,
 		ManageTeamMembers(tmpls.Get("manage_team_members.gohtml"), memberStore, invitecode.Generate, notifyClient, appPublicURL))
-	handleWithSupporter(supporter.PathInviteMember, CanGoBack|RequireAdmin,
+	handleWithSupporter(supporter.PathInviteMember, CanGoBack|RequireManageMembers,
 		InviteMember(tmpls.Get("invite_member.gohtml"), memberStore, notifyClient, invitecode.Generate, appPublicURL))
-	handleWithSupporter(supporter.PathDeleteOrganisation, CanGoBack,
+	handleWithSupporter(supporter.PathBulkInviteMembers, CanGoBack|RequireManageMembers,
+		BulkInviteMembers(logger, tmpls.Get("bulk_invite_members.gohtml"), memberStore, notifyClient, invitecode.Generate, appPublicURL))
+	handleWithSupporter(supporter.PathAuditLog, RequireViewAuditLog,
+		AuditLog(tmpls.Get("audit_log.gohtml"), auditLogStore))
+	handleWithSupporter(supporter.PathAuditLogExport, RequireViewAuditLog,
+		AuditLogExport(auditLogStore))
+	handleWithSupporter(supporter.PathDeleteOrganisation, CanGoBack|RequireManageOrganisation,
 		DeleteOrganisation(logger, tmpls.Get("delete_organisation.gohtml"), organisationStore, sessionStore, searchClient))
 	handleWithSupporter(supporter.PathEditMember, CanGoBack,
-		EditMember(logger, tmpls.Get("edit_member.gohtml"), memberStore))
+		EditMember(logger, tmpls.Get("edit_member.gohtml"), memberStore, auditLogStore))
 
-	handleWithSupporter(supporter.PathDonorAccess, CanGoBack,
-		DonorAccess(logger, tmpls.Get("donor_access.gohtml"), donorStore, accessCodeStore, notifyClient, donorStartURL, accesscodedata.Generate))
+	handleWithSupporter(supporter.PathDonorAccess, CanGoBack|RequireManageDonorAccess,
+		DonorAccess(logger, tmpls.Get("donor_access.gohtml"), donorStore, accessCodeStore, auditLogStore, notifyClient, donorStartURL, accesscodedata.Generate))
 }
 
-type HandleOpt byte
+type HandleOpt uint16
 
 const (
 	None HandleOpt = 1 << iota
 	RequireSession
-	RequireAdmin
 	CanGoBack
+	RequireCreateLpa
+	RequireManageDonorAccess
+	RequireManageMembers
+	RequireManageOrganisation
+	RequireAssignLpas
+	RequireViewAuditLog
 )
 
+// requiredCapabilities maps the HandleOpts that restrict a page to the
+// capability the member must have to use it.
+var requiredCapabilities = map[HandleOpt]supporterdata.Capability{
+	RequireCreateLpa:          supporterdata.CapabilityCreateLpa,
+	RequireManageDonorAccess:  supporterdata.CapabilityManageDonorAccess,
+	RequireManageMembers:      supporterdata.CapabilityManageMembers,
+	RequireManageOrganisation: supporterdata.CapabilityManageOrganisation,
+	RequireAssignLpas:         supporterdata.CapabilityAssignLpas,
+	RequireViewAuditLog:       supporterdata.CapabilityViewAuditLog,
+}
+
+var ErrPermissionDenied = errors.New("permission denied")
+
 func makeHandle(mux *http.ServeMux, store SessionStore, errorHandler page.ErrorHandler) func(page.Path, HandleOpt, page.Handler) {
 	return func(path page.Path, opt HandleOpt, h page.Handler) {
 		mux.HandleFunc(path.String(), func(w http.ResponseWriter, r *http.Request) {
@@ -295,9 +344,11 @@ func makeSupporterHandle(mux *http.ServeMux, store SessionStore, errorHandler pa
 				return
 			}
 
-			if opt&RequireAdmin != 0 && !member.Permission.IsAdmin() {
-				errorHandler(w, r, errors.New("permission denied"))
-				return
+			for requireOpt, capability := range requiredCapabilities {
+				if opt&requireOpt != 0 && !member.Permission.Can(capability) {
+					errorHandler(w, r, ErrPermissionDenied)
+					return
+				}
 			}
 
 			if member.Status.IsSuspended() {
@@ -311,6 +362,15 @@ func makeSupporterHandle(mux *http.ServeMux, store SessionStore, errorHandler pa
 				return
 			}
 
+			if loginSession.Permission != member.Permission || loginSession.MemberID != member.ID {
+				loginSession.Permission = member.Permission
+				loginSession.MemberID = member.ID
+				if err := store.SetLogin(r, w, loginSession); err != nil {
+					errorHandler(w, r, err)
+					return
+				}
+			}
+
 			appData.SupporterData.OrganisationName = organisation.Name
 			appData.SupporterData.Permission = member.Permission
 			appData.SupporterData.LoggedInSupporterID = member.ID
@@ -22,7 +22,7 @@ import (
 
 func TestRegister(t *testing.T) {
 	mux := http.NewServeMux()
-	Register(mux, &mockLogger{}, template.Templates{}, &onelogin.Client{}, &mockSessionStore{}, &mockOrganisationStore{}, nil, &notify.Client{}, "http://base", &mockMemberStore{}, &search.Client{}, &mockDonorStore{}, &mockAccessCodeStore{}, &mockProgressTracker{}, &lpastore.ResolvingService{}, "http://example.com/start")
+	Register(mux, &mockLogger{}, template.Templates{}, &onelogin.Client{}, &mockSessionStore{}, &mockOrganisationStore{}, nil, &notify.Client{}, "http://base", &mockMemberStore{}, &search.Client{}, &mockDonorStore{}, &mockAccessCodeStore{}, &mockAccessCodeSender{}, &mockProgressTracker{}, &lpastore.ResolvingService{}, &mockNotificationStore{}, &mockAuditLogStore{}, "http://example.com/start")
 
 	assert.Implements(t, (*http.Handler)(nil), mux)
 }
@@ -127,7 +127,7 @@ func TestMakeSupporterHandle(t *testing.T) {
 	sessionStore := newMockSessionStore(t)
 	sessionStore.EXPECT().
 		Login(r).
-		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org"}, nil)
+		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org", Permission: supporterdata.PermissionAdmin, MemberID: "member-id"}, nil)
 
 	organisationStore := newMockOrganisationStore(t)
 	organisationStore.EXPECT().
@@ -177,7 +177,7 @@ func TestMakeSupporterHandleWithLpaPath(t *testing.T) {
 	sessionStore := newMockSessionStore(t)
 	sessionStore.EXPECT().
 		Login(r).
-		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org"}, nil)
+		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org", Permission: supporterdata.PermissionAdmin, MemberID: "member-id"}, nil)
 
 	organisationStore := newMockOrganisationStore(t)
 	organisationStore.EXPECT().
@@ -218,7 +218,7 @@ func TestMakeSupporterHandleWithLpaPath(t *testing.T) {
 	assert.Equal(t, http.StatusTeapot, resp.StatusCode)
 }
 
-func TestMakeSupporterHandleWhenRequireAdmin(t *testing.T) {
+func TestMakeSupporterHandleWhenRequireCapability(t *testing.T) {
 	ctx := appcontext.ContextWithData(context.Background(), appcontext.Data{})
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/supporter/path", nil)
@@ -228,7 +228,7 @@ func TestMakeSupporterHandleWhenRequireAdmin(t *testing.T) {
 	sessionStore := newMockSessionStore(t)
 	sessionStore.EXPECT().
 		Login(r).
-		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org"}, nil)
+		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org", Permission: supporterdata.PermissionAdmin, MemberID: "member-id"}, nil)
 
 	organisationStore := newMockOrganisationStore(t)
 	organisationStore.EXPECT().
@@ -241,7 +241,7 @@ func TestMakeSupporterHandleWhenRequireAdmin(t *testing.T) {
 		Return(&supporterdata.Member{Permission: supporterdata.PermissionAdmin, ID: "member-id"}, nil)
 
 	handle := makeSupporterHandle(mux, sessionStore, nil, organisationStore, memberStore, nil)
-	handle(supporter.Path("/path"), RequireAdmin, func(appData appcontext.Data, hw http.ResponseWriter, hr *http.Request, organisation *supporterdata.Organisation, _ *supporterdata.Member) error {
+	handle(supporter.Path("/path"), RequireManageOrganisation, func(appData appcontext.Data, hw http.ResponseWriter, hr *http.Request, organisation *supporterdata.Organisation, _ *supporterdata.Member) error {
 		assert.Equal(t, appcontext.Data{
 			Page:      "/supporter/path",
 			SessionID: "cmFuZG9t",
@@ -267,7 +267,7 @@ func TestMakeSupporterHandleWhenRequireAdmin(t *testing.T) {
 	assert.Equal(t, http.StatusTeapot, resp.StatusCode)
 }
 
-func TestMakeSupporterHandleWhenRequireAdminAsNonAdmin(t *testing.T) {
+func TestMakeSupporterHandleWhenMissingCapability(t *testing.T) {
 	ctx := appcontext.ContextWithData(context.Background(), appcontext.Data{})
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/supporter/path", nil)
@@ -277,7 +277,7 @@ func TestMakeSupporterHandleWhenRequireAdminAsNonAdmin(t *testing.T) {
 	sessionStore := newMockSessionStore(t)
 	sessionStore.EXPECT().
 		Login(r).
-		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org"}, nil)
+		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org", Permission: supporterdata.PermissionAdmin, MemberID: "member-id"}, nil)
 
 	organisationStore := newMockOrganisationStore(t)
 	organisationStore.EXPECT().
@@ -291,15 +291,54 @@ func TestMakeSupporterHandleWhenRequireAdminAsNonAdmin(t *testing.T) {
 
 	errorHandler := newMockErrorHandler(t)
 	errorHandler.EXPECT().
-		Execute(w, r, mock.Anything)
+		Execute(w, r, ErrPermissionDenied)
 
 	handle := makeSupporterHandle(mux, sessionStore, errorHandler.Execute, organisationStore, memberStore, nil)
-	handle(supporter.Path("/path"), RequireAdmin, nil)
+	handle(supporter.Path("/path"), RequireManageOrganisation, nil)
 
 	mux.ServeHTTP(w, r)
 }
 
-func TestMakeSupporterHandleWhenSuspended(t *testing.T) {
+func TestMakeSupporterHandleWhenPermissionChanged(t *testing.T) {
+	ctx := appcontext.ContextWithData(context.Background(), appcontext.Data{})
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/supporter/path", nil)
+
+	mux := http.NewServeMux()
+
+	sessionStore := newMockSessionStore(t)
+	sessionStore.EXPECT().
+		Login(r).
+		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org", Permission: supporterdata.PermissionAdmin, MemberID: "member-id"}, nil)
+	sessionStore.EXPECT().
+		SetLogin(r, w, &sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org", Permission: supporterdata.PermissionAuditor, MemberID: "member-id"}).
+		Return(nil)
+
+	organisationStore := newMockOrganisationStore(t)
+	organisationStore.EXPECT().
+		Get(mock.Anything).
+		Return(&supporterdata.Organisation{ID: "org-id"}, nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		Get(mock.Anything).
+		Return(&supporterdata.Member{Permission: supporterdata.PermissionAuditor, ID: "member-id"}, nil)
+
+	handle := makeSupporterHandle(mux, sessionStore, nil, organisationStore, memberStore, nil)
+	handle(supporter.Path("/path"), None, func(appData appcontext.Data, hw http.ResponseWriter, hr *http.Request, organisation *supporterdata.Organisation, _ *supporterdata.Member) error {
+		assert.Equal(t, supporterdata.PermissionAuditor, appData.SupporterData.Permission)
+
+		hw.WriteHeader(http.StatusTeapot)
+		return nil
+	})
+
+	mux.ServeHTTP(w, r)
+	resp := w.Result()
+
+	assert.Equal(t, http.StatusTeapot, resp.StatusCode)
+}
+
+func TestMakeSupporterHandleWhenPermissionChangedAndSessionStoreErrors(t *testing.T) {
 	ctx := appcontext.ContextWithData(context.Background(), appcontext.Data{})
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/supporter/path", nil)
@@ -310,6 +349,41 @@ func TestMakeSupporterHandleWhenSuspended(t *testing.T) {
 	sessionStore.EXPECT().
 		Login(r).
 		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org"}, nil)
+	sessionStore.EXPECT().
+		SetLogin(r, w, mock.Anything).
+		Return(expectedError)
+
+	organisationStore := newMockOrganisationStore(t)
+	organisationStore.EXPECT().
+		Get(mock.Anything).
+		Return(&supporterdata.Organisation{ID: "org-id"}, nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		Get(mock.Anything).
+		Return(&supporterdata.Member{Permission: supporterdata.PermissionAuditor, ID: "member-id"}, nil)
+
+	errorHandler := newMockErrorHandler(t)
+	errorHandler.EXPECT().
+		Execute(w, r, expectedError)
+
+	handle := makeSupporterHandle(mux, sessionStore, errorHandler.Execute, organisationStore, memberStore, nil)
+	handle(supporter.Path("/path"), None, nil)
+
+	mux.ServeHTTP(w, r)
+}
+
+func TestMakeSupporterHandleWhenSuspended(t *testing.T) {
+	ctx := appcontext.ContextWithData(context.Background(), appcontext.Data{})
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/supporter/path", nil)
+
+	mux := http.NewServeMux()
+
+	sessionStore := newMockSessionStore(t)
+	sessionStore.EXPECT().
+		Login(r).
+		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org", Permission: supporterdata.PermissionAdmin, MemberID: "member-id"}, nil)
 
 	organisationStore := newMockOrganisationStore(t)
 	organisationStore.EXPECT().
@@ -335,7 +409,7 @@ func TestMakeSupporterHandleWhenSuspended(t *testing.T) {
 		Return(nil)
 
 	handle := makeSupporterHandle(mux, sessionStore, nil, organisationStore, memberStore, suspendedTmpl.Execute)
-	handle(supporter.Path("/path"), RequireAdmin, nil)
+	handle(supporter.Path("/path"), RequireManageOrganisation, nil)
 
 	mux.ServeHTTP(w, r)
 	resp := w.Result()
@@ -353,7 +427,7 @@ func TestMakeSupporterHandleWhenSuspendedTemplateErrors(t *testing.T) {
 	sessionStore := newMockSessionStore(t)
 	sessionStore.EXPECT().
 		Login(r).
-		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org"}, nil)
+		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org", Permission: supporterdata.PermissionAdmin, MemberID: "member-id"}, nil)
 
 	organisationStore := newMockOrganisationStore(t)
 	organisationStore.EXPECT().
@@ -383,7 +457,7 @@ func TestMakeSupporterHandleWhenSuspendedTemplateErrors(t *testing.T) {
 		Execute(w, r, expectedError)
 
 	handle := makeSupporterHandle(mux, sessionStore, errorHandler.Execute, organisationStore, memberStore, suspendedTmpl.Execute)
-	handle(supporter.Path("/path"), RequireAdmin, nil)
+	handle(supporter.Path("/path"), RequireManageOrganisation, nil)
 
 	mux.ServeHTTP(w, r)
 	resp := w.Result()
@@ -406,7 +480,7 @@ func TestMakeSupporterHandleWithSession(t *testing.T) {
 	sessionStore := newMockSessionStore(t)
 	sessionStore.EXPECT().
 		Login(r).
-		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org"}, nil)
+		Return(&sesh.LoginSession{Sub: "random", OrganisationID: "org-id", Email: "a@example.org", Permission: supporterdata.PermissionAdmin, MemberID: "member-id"}, nil)
 
 	organisationStore := newMockOrganisationStore(t)
 	organisationStore.EXPECT().
@@ -12,23 +12,40 @@ import (
 )
 
 type viewLPAData struct {
-	App      appcontext.Data
-	Errors   validation.List
-	Lpa      *lpadata.Lpa
-	Progress task.Progress
+	App        appcontext.Data
+	Errors     validation.List
+	Lpa        *lpadata.Lpa
+	Progress   task.Progress
+	CanEdit    bool
+	AssignedTo *supporterdata.Member
 }
 
-func ViewLPA(tmpl template.Template, lpaStoreResolvingService LpaStoreResolvingService, progressTracker ProgressTracker) Handler {
-	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, organisation *supporterdata.Organisation, _ *supporterdata.Member) error {
+func ViewLPA(tmpl template.Template, lpaStoreResolvingService LpaStoreResolvingService, progressTracker ProgressTracker, donorStore DonorStore, memberStore MemberStore) Handler {
+	return func(appData appcontext.Data, w http.ResponseWriter, r *http.Request, organisation *supporterdata.Organisation, member *supporterdata.Member) error {
 		lpa, err := lpaStoreResolvingService.Get(r.Context())
 		if err != nil {
 			return err
 		}
 
-		return tmpl(w, &viewLPAData{
+		provided, err := donorStore.Get(r.Context())
+		if err != nil {
+			return err
+		}
+
+		data := &viewLPAData{
 			App:      appData,
 			Lpa:      lpa,
 			Progress: progressTracker.Progress(lpa),
-		})
+			CanEdit:  member.Permission.CanEditLpa(provided.IsAssignedTo(member.ID)),
+		}
+
+		if provided.AssignedMemberID != "" {
+			data.AssignedTo, err = memberStore.GetByID(r.Context(), provided.AssignedMemberID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return tmpl(w, data)
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/donor/donordata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/task"
@@ -23,6 +24,11 @@ func TestGetViewLPA(t *testing.T) {
 		Get(r.Context()).
 		Return(lpa, nil)
 
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(r.Context()).
+		Return(&donordata.Provided{}, nil)
+
 	progressTracker := newMockProgressTracker(t)
 	progressTracker.EXPECT().
 		Progress(lpa).
@@ -34,14 +40,77 @@ func TestGetViewLPA(t *testing.T) {
 			App:      testAppData,
 			Lpa:      lpa,
 			Progress: task.Progress{Paid: task.ProgressTask{Done: true}},
+			CanEdit:  true,
 		}).
 		Return(nil)
 
-	err := ViewLPA(template.Execute, lpaStoreResolvingService, progressTracker)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+	err := ViewLPA(template.Execute, lpaStoreResolvingService, progressTracker, donorStore, nil)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
 
 	assert.Nil(t, err)
 }
 
+func TestGetViewLPAWhenAssigned(t *testing.T) {
+	testcases := map[string]struct {
+		member  *supporterdata.Member
+		canEdit bool
+	}{
+		"assigned to case handler": {
+			member:  &supporterdata.Member{ID: "member-id", Permission: supporterdata.PermissionCaseHandler},
+			canEdit: true,
+		},
+		"assigned to another member": {
+			member: &supporterdata.Member{ID: "other-id", Permission: supporterdata.PermissionCaseHandler},
+		},
+		"auditor": {
+			member: &supporterdata.Member{ID: "member-id", Permission: supporterdata.PermissionAuditor},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+			lpa := &lpadata.Lpa{LpaUID: "lpa-uid"}
+			assignedTo := &supporterdata.Member{ID: "member-id", FirstNames: "Alice"}
+
+			lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+			lpaStoreResolvingService.EXPECT().
+				Get(r.Context()).
+				Return(lpa, nil)
+
+			donorStore := newMockDonorStore(t)
+			donorStore.EXPECT().
+				Get(r.Context()).
+				Return(&donordata.Provided{AssignedMemberID: "member-id"}, nil)
+
+			memberStore := newMockMemberStore(t)
+			memberStore.EXPECT().
+				GetByID(r.Context(), "member-id").
+				Return(assignedTo, nil)
+
+			progressTracker := newMockProgressTracker(t)
+			progressTracker.EXPECT().
+				Progress(lpa).
+				Return(task.Progress{})
+
+			template := newMockTemplate(t)
+			template.EXPECT().
+				Execute(w, &viewLPAData{
+					App:        testAppData,
+					Lpa:        lpa,
+					CanEdit:    tc.canEdit,
+					AssignedTo: assignedTo,
+				}).
+				Return(nil)
+
+			err := ViewLPA(template.Execute, lpaStoreResolvingService, progressTracker, donorStore, memberStore)(testAppData, w, r, &supporterdata.Organisation{}, tc.member)
+
+			assert.Nil(t, err)
+		})
+	}
+}
+
 func TestGetViewLPAWhenLpaStoreClientError(t *testing.T) {
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest(http.MethodGet, "/", nil)
@@ -51,11 +120,59 @@ func TestGetViewLPAWhenLpaStoreClientError(t *testing.T) {
 		Get(r.Context()).
 		Return(nil, expectedError)
 
-	err := ViewLPA(nil, lpaStoreResolvingService, nil)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+	err := ViewLPA(nil, lpaStoreResolvingService, nil, nil, nil)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
 
 	assert.Error(t, err)
 }
 
+func TestGetViewLPAWhenDonorStoreError(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(r.Context()).
+		Return(&lpadata.Lpa{}, nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(r.Context()).
+		Return(nil, expectedError)
+
+	err := ViewLPA(nil, lpaStoreResolvingService, nil, donorStore, nil)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+
+	assert.Equal(t, expectedError, err)
+}
+
+func TestGetViewLPAWhenMemberStoreError(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest(http.MethodGet, "/", nil)
+
+	lpaStoreResolvingService := newMockLpaStoreResolvingService(t)
+	lpaStoreResolvingService.EXPECT().
+		Get(r.Context()).
+		Return(&lpadata.Lpa{}, nil)
+
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(r.Context()).
+		Return(&donordata.Provided{AssignedMemberID: "member-id"}, nil)
+
+	memberStore := newMockMemberStore(t)
+	memberStore.EXPECT().
+		GetByID(r.Context(), "member-id").
+		Return(nil, expectedError)
+
+	progressTracker := newMockProgressTracker(t)
+	progressTracker.EXPECT().
+		Progress(mock.Anything).
+		Return(task.Progress{})
+
+	err := ViewLPA(nil, lpaStoreResolvingService, progressTracker, donorStore, memberStore)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
+
+	assert.Equal(t, expectedError, err)
+}
+
 func TestGetViewLPAWhenTemplateError(t *testing.T) {
 	w := httptest.NewRecorder()
 	r, _ := http.NewRequest(http.MethodGet, "/", nil)
@@ -65,6 +182,11 @@ func TestGetViewLPAWhenTemplateError(t *testing.T) {
 		Get(r.Context()).
 		Return(&lpadata.Lpa{}, nil)
 
+	donorStore := newMockDonorStore(t)
+	donorStore.EXPECT().
+		Get(r.Context()).
+		Return(&donordata.Provided{}, nil)
+
 	progressTracker := newMockProgressTracker(t)
 	progressTracker.EXPECT().
 		Progress(mock.Anything).
@@ -75,7 +197,7 @@ func TestGetViewLPAWhenTemplateError(t *testing.T) {
 		Execute(w, mock.Anything).
 		Return(expectedError)
 
-	err := ViewLPA(template.Execute, lpaStoreResolvingService, progressTracker)(testAppData, w, r, &supporterdata.Organisation{}, nil)
+	err := ViewLPA(template.Execute, lpaStoreResolvingService, progressTracker, donorStore, nil)(testAppData, w, r, &supporterdata.Organisation{}, &supporterdata.Member{})
 
 	assert.Error(t, err)
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/lpastore/lpadata"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/page"
 	"github.com/ministryofjustice/opg-modernising-lpa/internal/place"
+	"github.com/ministryofjustice/opg-modernising-lpa/internal/supporter/supporterdata"
 )
 
 // Globals contains values that are used in templates and do not change as the
@@ -33,6 +34,7 @@ type Globals struct {
 	StaticHash    string
 	RumConfig     RumConfig
 	ActorTypes    actor.TypeOptions
+	Capabilities  supporterdata.CapabilityOptions
 	Paths         appPaths
 	DonorStartURL string
 }
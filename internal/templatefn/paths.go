@@ -56,6 +56,7 @@ type certificateProviderPaths struct {
 	TaskList                               certificateprovider.Path
 	WhatHappensNext                        certificateprovider.Path
 	WhoIsEligible                          certificateprovider.Path
+	WitnessCode                            certificateprovider.Path
 	YourPreferredLanguage                  certificateprovider.Path
 	YourRole                               certificateprovider.Path
 }
@@ -75,6 +76,9 @@ type supporterPaths struct {
 	SigningInAdvice       page.Path
 	Start                 page.Path
 
+	AuditLog                      supporter.Path
+	AuditLogExport                supporter.Path
+	BulkInviteMembers             supporter.Path
 	ConfirmDonorCanInteractOnline supporter.Path
 	ContactOPGForPaperForms       supporter.Path
 	Dashboard                     supporter.Path
@@ -87,8 +91,11 @@ type supporterPaths struct {
 	OrganisationCreated           supporter.Path
 	OrganisationDetails           supporter.Path
 
-	ViewLPA     supporter.LpaPath
-	DonorAccess supporter.LpaPath
+	ViewLPA            supporter.LpaPath
+	DonorAccess        supporter.LpaPath
+	CommunicationsSent supporter.LpaPath
+	AccessCodes        supporter.LpaPath
+	AssignLPA          supporter.LpaPath
 }
 
 type voucherPaths struct {
@@ -129,6 +136,7 @@ type appPaths struct {
 	PrivacyNotice               page.Path
 	Root                        page.Path
 	SignOut                     page.Path
+	SignOutAllDevices           page.Path
 	Start                       page.Path
 	SupporterFixtures           page.Path
 	TermsOfUse                  page.Path
@@ -147,6 +155,7 @@ type appPaths struct {
 	UnderstandingMentalCapacity              page.Path
 
 	AboutPayment                                         donor.Path
+	AccessCodes                                          donor.Path
 	AddCorrespondent                                     donor.Path
 	AreYouApplyingForFeeDiscountOrExemption              donor.Path
 	BecauseYouHaveChosenJointly                          donor.Path
@@ -162,6 +171,7 @@ type appPaths struct {
 	CheckYouCanSign                                      donor.Path
 	CheckYourDetails                                     donor.Path
 	CheckYourLpa                                         donor.Path
+	CommunicationsSent                                   donor.Path
 	ChooseAttorneys                                      donor.Path
 	ChooseAttorneysAddress                               donor.Path
 	ChooseAttorneysGuidance                              donor.Path
@@ -216,6 +226,7 @@ type appPaths struct {
 	NeedHelpSigningConfirmation                          donor.Path
 	OneLoginIdentityDetails                              donor.Path
 	PayFee                                               donor.Path
+	PayForBothLpas                                       donor.Path
 	PaymentConfirmation                                  donor.Path
 	PreviousApplicationNumber                            donor.Path
 	PreviousFee                                          donor.Path
@@ -299,6 +310,7 @@ var paths = appPaths{
 		TaskList:                               certificateprovider.PathTaskList,
 		WhatHappensNext:                        certificateprovider.PathWhatHappensNext,
 		WhoIsEligible:                          certificateprovider.PathWhoIsEligible,
+		WitnessCode:                            certificateprovider.PathWitnessCode,
 		YourPreferredLanguage:                  certificateprovider.PathYourPreferredLanguage,
 		YourRole:                               certificateprovider.PathYourRole,
 	},
@@ -337,6 +349,9 @@ var paths = appPaths{
 		Start:                 page.PathSupporterStart,
 		InviteExpired:         page.PathSupporterInviteExpired,
 
+		AuditLog:                      supporter.PathAuditLog,
+		AuditLogExport:                supporter.PathAuditLogExport,
+		BulkInviteMembers:             supporter.PathBulkInviteMembers,
 		ConfirmDonorCanInteractOnline: supporter.PathConfirmDonorCanInteractOnline,
 		ContactOPGForPaperForms:       supporter.PathContactOPGForPaperForms,
 		Dashboard:                     supporter.PathDashboard,
@@ -350,6 +365,9 @@ var paths = appPaths{
 		OrganisationDetails:           supporter.PathOrganisationDetails,
 		ViewLPA:                       supporter.PathViewLPA,
 		DonorAccess:                   supporter.PathDonorAccess,
+		CommunicationsSent:            supporter.PathCommunicationsSent,
+		AccessCodes:                   supporter.PathAccessCodes,
+		AssignLPA:                     supporter.PathAssignLPA,
 	},
 
 	Voucher: voucherPaths{
@@ -388,6 +406,7 @@ var paths = appPaths{
 	PrivacyNotice:               page.PathPrivacyNotice,
 	Root:                        page.PathRoot,
 	SignOut:                     page.PathSignOut,
+	SignOutAllDevices:           page.PathSignOutAllDevices,
 	Start:                       page.PathStart,
 	SupporterFixtures:           page.PathSupporterFixtures,
 	TermsOfUse:                  page.PathTermsOfUse,
@@ -405,10 +424,11 @@ var paths = appPaths{
 	UnderstandingLifeSustainingTreatment:     page.PathUnderstandingLifeSustainingTreatment,
 	UnderstandingMentalCapacity:              page.PathUnderstandingMentalCapacity,
 
-	AboutPayment:                                         donor.PathAboutPayment,
-	AddCorrespondent:                                     donor.PathAddCorrespondent,
-	AreYouApplyingForFeeDiscountOrExemption:              donor.PathAreYouApplyingForFeeDiscountOrExemption,
-	BecauseYouHaveChosenJointly:                          donor.PathBecauseYouHaveChosenJointly,
+	AboutPayment:                            donor.PathAboutPayment,
+	AccessCodes:                             donor.PathAccessCodes,
+	AddCorrespondent:                        donor.PathAddCorrespondent,
+	AreYouApplyingForFeeDiscountOrExemption: donor.PathAreYouApplyingForFeeDiscountOrExemption,
+	BecauseYouHaveChosenJointly:             donor.PathBecauseYouHaveChosenJointly,
 	BecauseYouHaveChosenJointlyForSomeSeverallyForOthers: donor.PathBecauseYouHaveChosenJointlyForSomeSeverallyForOthers,
 	CanYouSignYourLpa:                                    donor.PathCanYouSignYourLpa,
 	CertificateProviderAddress:                           donor.PathCertificateProviderAddress,
@@ -421,6 +441,7 @@ var paths = appPaths{
 	CheckYouCanSign:                                      donor.PathCheckYouCanSign,
 	CheckYourDetails:                                     donor.PathCheckYourDetails,
 	CheckYourLpa:                                         donor.PathCheckYourLpa,
+	CommunicationsSent:                                   donor.PathCommunicationsSent,
 	ChooseAttorneys:                                      donor.PathChooseAttorneys,
 	ChooseAttorneysAddress:                               donor.PathChooseAttorneysAddress,
 	ChooseAttorneysGuidance:                              donor.PathChooseAttorneysGuidance,
@@ -475,6 +496,7 @@ var paths = appPaths{
 	NeedHelpSigningConfirmation:                          donor.PathNeedHelpSigningConfirmation,
 	OneLoginIdentityDetails:                              donor.PathIdentityDetails,
 	PayFee:                                               donor.PathPayFee,
+	PayForBothLpas:                                       donor.PathPayForBothLpas,
 	PaymentConfirmation:                                  donor.PathPaymentConfirmation,
 	PreviousApplicationNumber:                            donor.PathPreviousApplicationNumber,
 	PreviousFee:                                          donor.PathPreviousFee,
@@ -0,0 +1,91 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rfcSecret is the SHA1 key used for the test vectors in RFC 6238.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestNewSecret(t *testing.T) {
+	secret := NewSecret()
+
+	key, err := decode(secret)
+	assert.Nil(t, err)
+	assert.Len(t, key, 20)
+	assert.NotEqual(t, secret, NewSecret())
+}
+
+func TestCode(t *testing.T) {
+	// The RFC gives 8 digit codes, these are the last 6 digits of each.
+	testcases := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+
+	for unix, expected := range testcases {
+		t.Run(expected, func(t *testing.T) {
+			code, err := Code(rfcSecret, time.Unix(unix, 0))
+			assert.Nil(t, err)
+			assert.Equal(t, expected, code)
+		})
+	}
+}
+
+func TestCodeWhenInvalidSecret(t *testing.T) {
+	_, err := Code("not base32!", time.Now())
+	assert.Error(t, err)
+}
+
+func TestMatch(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+
+	step, ok := Match(rfcSecret, "050471", now, 1)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now), step)
+
+	step, ok = Match(rfcSecret, "050471", now.Add(Period), 1)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now), step)
+
+	_, ok = Match(rfcSecret, "050471", now.Add(2*Period), 1)
+	assert.False(t, ok)
+}
+
+func TestMatchWhenInvalid(t *testing.T) {
+	testcases := map[string]struct {
+		secret string
+		code   string
+	}{
+		"wrong code":     {secret: rfcSecret, code: "123456"},
+		"wrong length":   {secret: rfcSecret, code: "5047"},
+		"invalid secret": {secret: "not base32!", code: "050471"},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			_, ok := Match(tc.secret, tc.code, time.Unix(1111111111, 0), 1)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestStepTime(t *testing.T) {
+	now := time.Date(2024, time.January, 2, 3, 4, 35, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2024, time.January, 2, 3, 4, 30, 0, time.UTC), StepTime(Step(now)))
+}
+
+func TestURI(t *testing.T) {
+	assert.Equal(t,
+		"otpauth://totp/OPG:Jo%20Smith?digits=6&issuer=OPG&period=30&secret=ABC",
+		URI("ABC", "OPG", "Jo Smith"))
+}
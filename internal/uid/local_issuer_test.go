@@ -0,0 +1,33 @@
+package uid
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalIssuerCreateCase(t *testing.T) {
+	issuer := &LocalIssuer{generate: func(prefix string) string {
+		assert.Equal(t, "", prefix)
+		return "M-3444-7777-999R"
+	}}
+
+	uid, err := issuer.CreateCase(context.Background(), &CreateCaseRequestBody{
+		Type:  validBody.Type,
+		Donor: validBody.Donor,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "M-3444-7777-999R", uid)
+}
+
+func TestLocalIssuerCreateCaseWhenInvalidBody(t *testing.T) {
+	issuer := NewLocalIssuer()
+
+	_, err := issuer.CreateCase(context.Background(), &CreateCaseRequestBody{})
+	assert.Error(t, err)
+}
+
+func TestLocalIssuerCheckHealth(t *testing.T) {
+	assert.Nil(t, NewLocalIssuer().CheckHealth(context.Background()))
+}
@@ -0,0 +1,85 @@
+package uid
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// characters are those used in a UID, chosen so that they cannot be confused
+// with each other when read aloud or handwritten.
+const characters = "346789QWERTYUPADFGHJKLXCVBNM"
+
+var ErrInvalidUID = errors.New("invalid LPA UID")
+
+// Parse checks that s is an LPA UID, in the format M-XXXX-XXXX-XXXX where the
+// final character is a check character for the others. Spaces, missing
+// hyphens and lowercase letters are accepted, the UID returned is always in
+// the canonical format.
+func Parse(s string) (string, error) {
+	compact := strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(s))
+
+	if len(compact) != 13 || compact[0] != 'M' {
+		return "", ErrInvalidUID
+	}
+
+	payload, check := compact[1:12], compact[12]
+	for i := range len(payload) {
+		if strings.IndexByte(characters, payload[i]) == -1 {
+			return "", ErrInvalidUID
+		}
+	}
+
+	if checkCharacter(payload) != check {
+		return "", ErrInvalidUID
+	}
+
+	return format(compact[1:]), nil
+}
+
+// Valid returns true if s is an LPA UID in the canonical format.
+func Valid(s string) bool {
+	parsed, err := Parse(s)
+	return err == nil && parsed == s
+}
+
+// Generate creates a random UID, starting with prefix which must only contain
+// characters allowed in a UID.
+func Generate(prefix string) string {
+	var sb strings.Builder
+	sb.WriteString(prefix)
+
+	for sb.Len() < 11 {
+		n, _ := rand.Int(rand.Reader, big.NewInt(int64(len(characters))))
+		sb.WriteByte(characters[n.Int64()])
+	}
+
+	payload := sb.String()[:11]
+	return format(payload + string(checkCharacter(payload)))
+}
+
+// checkCharacter calculates the check character for payload using the Luhn mod
+// N algorithm.
+func checkCharacter(payload string) byte {
+	n := len(characters)
+	factor := 2
+	sum := 0
+
+	for i := len(payload) - 1; i >= 0; i-- {
+		addend := factor * strings.IndexByte(characters, payload[i])
+		sum += addend/n + addend%n
+
+		if factor == 2 {
+			factor = 1
+		} else {
+			factor = 2
+		}
+	}
+
+	return characters[(n-sum%n)%n]
+}
+
+func format(s string) string {
+	return "M-" + s[0:4] + "-" + s[4:8] + "-" + s[8:12]
+}
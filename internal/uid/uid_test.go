@@ -0,0 +1,58 @@
+package uid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	testcases := map[string]string{
+		"M-3444-7777-999R":   "M-3444-7777-999R",
+		"m-3444-7777-999r":   "M-3444-7777-999R",
+		"M 3444 7777 999R":   "M-3444-7777-999R",
+		"M34447777999R":      "M-3444-7777-999R",
+		" M-FAKE-3467-QWE4 ": "M-FAKE-3467-QWE4",
+	}
+
+	for s, expected := range testcases {
+		t.Run(s, func(t *testing.T) {
+			uid, err := Parse(s)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, uid)
+		})
+	}
+}
+
+func TestParseWhenInvalid(t *testing.T) {
+	testcases := map[string]string{
+		"empty":                "",
+		"too short":            "M-3444-7777-999",
+		"too long":             "M-3444-7777-999R-3",
+		"wrong prefix":         "N-3444-7777-999R",
+		"disallowed character": "M-3444-7777-909R",
+		"wrong check":          "M-3444-7777-999Q",
+		"swapped characters":   "M-4344-7777-999R",
+	}
+
+	for name, s := range testcases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(s)
+			assert.Equal(t, ErrInvalidUID, err)
+		})
+	}
+}
+
+func TestValidUID(t *testing.T) {
+	assert.True(t, Valid("M-3444-7777-999R"))
+	assert.False(t, Valid("M34447777999R"))
+	assert.False(t, Valid("M-3444-7777-999Q"))
+}
+
+func TestGenerate(t *testing.T) {
+	for range 100 {
+		uid := Generate("FAKE")
+		assert.True(t, Valid(uid), uid)
+		assert.Equal(t, "M-FAKE-", uid[:7])
+	}
+}
@@ -313,6 +313,39 @@ func Postcode() PostcodeCheck {
 	return PostcodeCheck{}
 }
 
+type PostalCodeCheck struct {
+	format place.AddressFormat
+}
+
+func (c PostalCodeCheck) CheckString(label, value string) FormattableError {
+	if value != "" && !c.format.ValidPostalCode(value) {
+		return PostalCodeError{Label: label}
+	}
+
+	return nil
+}
+
+func PostalCode(format place.AddressFormat) PostalCodeCheck {
+	return PostalCodeCheck{format: format}
+}
+
+type AddressFieldCheck struct {
+	format place.AddressFormat
+	field  place.AddressField
+}
+
+func (c AddressFieldCheck) CheckString(label, value string) FormattableError {
+	if value == "" && c.format.Required(c.field) {
+		return EnterError{Label: label}
+	}
+
+	return nil
+}
+
+func AddressField(format place.AddressFormat, field place.AddressField) AddressFieldCheck {
+	return AddressFieldCheck{format: format, field: field}
+}
+
 type EmailCheck struct{}
 
 func (c EmailCheck) CheckString(label, value string) FormattableError {
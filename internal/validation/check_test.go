@@ -204,6 +204,32 @@ func TestCheckString(t *testing.T) {
 			checks:   []StringChecker{Postcode()},
 			expected: With(name, PostcodeError{Label: label}),
 		},
+		"postal code": {
+			input:  "75008",
+			checks: []StringChecker{PostalCode(place.AddressFormatFor("FR"))},
+		},
+		"postal code lowercase": {
+			input:  "1012 ab",
+			checks: []StringChecker{PostalCode(place.AddressFormatFor("NL"))},
+		},
+		"postal code not used in country": {
+			input:  "anything",
+			checks: []StringChecker{PostalCode(place.AddressFormatFor("AO"))},
+		},
+		"postal code invalid": {
+			input:    "7500",
+			checks:   []StringChecker{PostalCode(place.AddressFormatFor("FR"))},
+			expected: With(name, PostalCodeError{Label: label}),
+		},
+		"address field required": {
+			input:    "",
+			checks:   []StringChecker{AddressField(place.AddressFormatFor("US"), place.AddressFieldRegion)},
+			expected: With(name, EnterError{Label: label}),
+		},
+		"address field optional": {
+			input:  "",
+			checks: []StringChecker{AddressField(place.AddressFormatFor("FR"), place.AddressFieldRegion)},
+		},
 		"email": {
 			input:  "name@example.com",
 			checks: []StringChecker{Email()},
@@ -116,6 +116,16 @@ func (e PostcodeError) Format(l Localizer) string {
 	})
 }
 
+type PostalCodeError struct {
+	Label string
+}
+
+func (e PostalCodeError) Format(l Localizer) string {
+	return l.Format("errorPostalCode", map[string]any{
+		"Label": l.T(e.Label),
+	})
+}
+
 type EmailError struct {
 	Label string
 }
@@ -70,6 +70,7 @@ module "app" {
   search_index_name                                    = var.search_index_name
   search_collection_arn                                = var.search_collection_arn
   ecs_aws_otel_collector_version                       = var.ecs_aws_otel_collector_version
+  fee_schedules                                        = local.fee_schedules
   start_page_redirects = {
     enabled                 = var.start_page_redirects.enabled
     start_page_redirect_url = data.aws_default_tags.current.tags.environment-name != "production" ? "${data.aws_default_tags.current.tags.environment-name}.mainstreamcontent.modernising.opg.service.justice.gov.uk" : "mainstreamcontent.modernising.opg.service.justice.gov.uk"
@@ -20,6 +20,7 @@ module "event_received" {
   event_bus_name                 = module.event_bus.event_bus.name
   event_bus_arn                  = module.event_bus.event_bus.arn
   app_public_url                 = aws_route53_record.app.fqdn
+  fee_schedules                  = local.fee_schedules
   donor_start_url                = var.app_env_vars.donor_start_url
   certificate_provider_start_url = var.app_env_vars.certificate_provider_start_url
   attorney_start_url             = var.app_env_vars.attorney_start_url
@@ -27,6 +28,7 @@ module "event_received" {
   uid_base_url                   = var.uid_service.base_url
   lpa_store_base_url             = var.lpa_store_service.base_url
   lpa_store_secret_arn           = data.aws_secretsmanager_secret.lpa_store_jwt_key.arn
+  pay_base_url                   = data.aws_default_tags.current.tags.environment-name != "production" && var.mock_pay_enabled ? "http://mock-pay.${data.aws_default_tags.current.tags.environment-name}.internal.modernising.ecs:8080" : "https://publicapi.payments.service.gov.uk"
   allowed_api_arns = concat(
     var.uid_service.api_arns.post,
     var.lpa_store_service.api_arns.post,
@@ -0,0 +1,13 @@
+locals {
+  # The fees charged over time. When the statutory fee changes add a new
+  # schedule with the date it takes effect, existing schedules must not be
+  # changed as they are used for LPAs already applied for.
+  fee_schedules = jsonencode([
+    {
+      from    = "2023-11-20T00:00:00Z"
+      full    = 9200
+      half    = 4600
+      quarter = 2300
+    },
+  ])
+}
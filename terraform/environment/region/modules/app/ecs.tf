@@ -528,6 +528,10 @@ locals {
         {
           name  = "ENVIRONMENT",
           value = data.aws_default_tags.current.tags.environment-name
+        },
+        {
+          name  = "FEE_SCHEDULES",
+          value = var.fee_schedules
         }
       ]
     }
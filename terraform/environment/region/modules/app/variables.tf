@@ -178,3 +178,8 @@ variable "start_page_redirects" {
     start_page_redirect_url = string
   })
 }
+
+variable "fee_schedules" {
+  type        = string
+  description = "JSON list of the fees charged over time"
+}
@@ -21,6 +21,11 @@ data "aws_secretsmanager_secret" "gov_uk_notify_api_key" {
   provider = aws.region
 }
 
+data "aws_secretsmanager_secret" "gov_uk_pay_api_key" {
+  name     = "gov-uk-pay-api-key"
+  provider = aws.region
+}
+
 data "aws_secretsmanager_secret" "lpa_store_jwt_secret_key" {
   name     = "lpa-store-jwt-secret-key"
   provider = aws.region
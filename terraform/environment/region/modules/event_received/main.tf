@@ -5,6 +5,7 @@ module "event_received" {
   environment_variables = {
     LPAS_TABLE                     = var.lpas_table.name
     GOVUK_NOTIFY_BASE_URL          = "https://api.notifications.service.gov.uk"
+    GOVUK_PAY_BASE_URL             = var.pay_base_url
     APP_PUBLIC_URL                 = "https://${var.app_public_url}"
     DONOR_START_URL                = var.donor_start_url == "" ? "https://${var.app_public_url}/start" : var.donor_start_url
     CERTIFICATE_PROVIDER_START_URL = var.certificate_provider_start_url == "" ? "https://${var.app_public_url}/certificate-provider-start" : var.certificate_provider_start_url
@@ -21,6 +22,7 @@ module "event_received" {
     XRAY_ENABLED                   = 1
     ENVIRONMENT                    = data.aws_default_tags.current.tags.environment-name
     S3_UPLOADS_KMS_KEY_ALIAS       = data.aws_kms_alias.reduced_fees_uploads_s3_encryption.name
+    FEE_SCHEDULES                  = var.fee_schedules
   }
   image_uri            = "${var.lambda_function_image_ecr_url}:${var.lambda_function_image_tag}"
   aws_iam_role         = var.event_received_lambda_role
@@ -273,6 +275,7 @@ data "aws_iam_policy_document" "event_received" {
 
     resources = [
       data.aws_secretsmanager_secret.gov_uk_notify_api_key.arn,
+      data.aws_secretsmanager_secret.gov_uk_pay_api_key.arn,
       data.aws_secretsmanager_secret.lpa_store_jwt_secret_key.arn,
       data.aws_secretsmanager_secret.lpa_store_jwt_key.arn,
     ]
@@ -53,6 +53,10 @@ variable "lpa_store_secret_arn" {
   type = string
 }
 
+variable "pay_base_url" {
+  type = string
+}
+
 variable "allowed_api_arns" {
   type = list(string)
 }
@@ -88,3 +92,8 @@ variable "vpc_config" {
 variable "event_bus_dead_letter_queue" {
   type = any
 }
+
+variable "fee_schedules" {
+  type        = string
+  description = "JSON list of the fees charged over time"
+}
@@ -5,6 +5,7 @@ module "schedule_runner" {
   environment_variables = {
     EVENT_BUS_NAME                 = var.event_bus.name
     GOVUK_NOTIFY_BASE_URL          = "https://api.notifications.service.gov.uk"
+    GOVUK_PAY_BASE_URL             = var.pay_base_url
     LPAS_TABLE                     = var.lpas_table.name
     SEARCH_ENDPOINT                = var.search_endpoint
     SEARCH_INDEX_NAME              = var.search_index_name
@@ -16,6 +17,7 @@ module "schedule_runner" {
     CERTIFICATE_PROVIDER_START_URL = var.certificate_provider_start_url
     ATTORNEY_START_URL             = var.attorney_start_url
     ENVIRONMENT                    = data.aws_default_tags.current.tags.environment-name
+    FEE_SCHEDULES                  = var.fee_schedules
   }
   image_uri            = "${var.lambda_function_image_ecr_url}:${var.lambda_function_image_tag}"
   aws_iam_role         = var.schedule_runner_lambda_role
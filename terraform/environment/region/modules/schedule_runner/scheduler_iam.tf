@@ -61,6 +61,7 @@ data "aws_iam_policy_document" "schedule_runner" {
 
     resources = [
       data.aws_secretsmanager_secret.gov_uk_notify_api_key.arn,
+      data.aws_secretsmanager_secret.gov_uk_pay_api_key.arn,
       data.aws_secretsmanager_secret.lpa_store_jwt_key.arn,
     ]
   }
@@ -56,6 +56,10 @@ variable "lpa_store_base_url" {
   type = string
 }
 
+variable "pay_base_url" {
+  type = string
+}
+
 variable "app_public_url" {
   type = string
 }
@@ -63,3 +67,8 @@ variable "app_public_url" {
 variable "allowed_api_arns" {
   type = list(string)
 }
+
+variable "fee_schedules" {
+  type        = string
+  description = "JSON list of the fees charged over time"
+}
@@ -15,7 +15,9 @@ module "schedule_runner" {
   schedule_runner_scheduler      = var.iam_roles.schedule_runner_scheduler
   schedule_runner_lambda_role    = var.iam_roles.schedule_runner_lambda
   lpa_store_base_url             = var.lpa_store_service.base_url
+  pay_base_url                   = data.aws_default_tags.current.tags.environment-name != "production" && var.mock_pay_enabled ? "http://mock-pay.${data.aws_default_tags.current.tags.environment-name}.internal.modernising.ecs:8080" : "https://publicapi.payments.service.gov.uk"
   app_public_url                 = aws_route53_record.app.fqdn
+  fee_schedules                  = local.fee_schedules
   allowed_api_arns = concat(
     var.lpa_store_service.api_arns.get,
   )